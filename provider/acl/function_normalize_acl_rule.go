@@ -0,0 +1,63 @@
+package acl
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &normalizeAclRuleFunction{}
+
+// normalizeAclRuleFunction is the provider-defined function `normalize_acl_rule`.
+type normalizeAclRuleFunction struct{}
+
+// NewNormalizeAclRuleFunction returns a new function instance.
+func NewNormalizeAclRuleFunction() function.Function {
+	return &normalizeAclRuleFunction{}
+}
+
+// Metadata returns the function name.
+func (f *normalizeAclRuleFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "normalize_acl_rule"
+}
+
+// Definition defines the function parameters and return type.
+func (f *normalizeAclRuleFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Normalise a Redis ACL rule",
+		MarkdownDescription: "Parses a rule written in the Redis ACL syntax and returns its canonical form: directives separated by a " +
+			"single space, command and category names lower-cased and the `allkeys`, `allchannels`, `allcommands` and " +
+			"`nocommands` shorthands expanded. Fails if the rule is not valid ACL syntax.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "rule",
+				Description: "The ACL rule to normalise, e.g. `+@read ~cache:*`",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run parses and normalises the rule.
+func (f *normalizeAclRuleFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var rule string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &rule))
+	if resp.Error != nil {
+		return
+	}
+
+	normalized, err := NormalizeRule(rule)
+	if err != nil {
+		var syntaxErr *SyntaxError
+		if errors.As(err, &syntaxErr) {
+			resp.Error = function.NewArgumentFuncError(0, syntaxErr.Detail(rule))
+			return
+		}
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, normalized))
+}
@@ -0,0 +1,152 @@
+package acl
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/client"
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/utils"
+)
+
+// AclIdentityModel describes the resource identity shared by the ACL rule, role and user.
+type AclIdentityModel struct {
+	ID types.Int64 `tfsdk:"id"`
+}
+
+// aclIdentitySchema returns the identity schema shared by the ACL rule, role and user, which are identified by a
+// single account-wide ID.
+func aclIdentitySchema() identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			utils.IdentityId: identityschema.Int64Attribute{
+				Description:       "Identifier of the resource",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+// setAclIdentity records the resource identity from the resource ID, when the identity is supported.
+func setAclIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, id types.String, diagnostics *diag.Diagnostics) {
+	if identity == nil || diagnostics.HasError() {
+		return
+	}
+	aclId, err := strconv.Atoi(id.ValueString())
+	if err != nil {
+		diagnostics.AddError("Invalid resource ID", err.Error())
+		return
+	}
+	diagnostics.Append(identity.Set(ctx, AclIdentityModel{ID: types.Int64Value(int64(aclId))})...)
+}
+
+// importAclState imports an ACL rule, role or user by its identity, its ID or `name=<name>`, resolving the name
+// with resolve.
+func importAclState(ctx context.Context, api *client.ApiClient, resolve utils.NameResolverFunc, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var id string
+	if req.ID == "" && req.Identity != nil {
+		// Importing with an identity block rather than an import ID
+		var identity AclIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		id = strconv.FormatInt(identity.ID.ValueInt64(), 10)
+	} else {
+		id = req.ID
+		if name, ok := utils.IsImportByName(id); ok {
+			// Importing with name=<name>
+			resolved, err := resolve(ctx, api, name)
+			if err != nil {
+				resp.Diagnostics.AddError("Failed to import by name", err.Error())
+				return
+			}
+			id = resolved
+		}
+	}
+
+	aclId, err := strconv.Atoi(id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("Expected a numeric ID or name=<name>, got: %s. Error: %s", req.ID, err.Error()),
+		)
+		return
+	}
+
+	// Let the READ operation do the heavy lifting for importing values from the API.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), strconv.Itoa(aclId))...)
+	if resp.Identity != nil {
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, AclIdentityModel{ID: types.Int64Value(int64(aclId))})...)
+	}
+}
+
+// configureClient returns the provider configured client, or nil if the provider isn't configured yet.
+func configureClient(providerData any, diagnostics *diag.Diagnostics) *client.ApiClient {
+	if providerData == nil {
+		return nil
+	}
+
+	client, ok := providerData.(*client.ApiClient)
+	if !ok {
+		diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.ApiClient, got: %T. Please report this issue to the provider developers.", providerData),
+		)
+		return nil
+	}
+	return client
+}
+
+// nullTimeouts returns the timeouts of a resource read without a configuration, such as a listed resource.
+func nullTimeouts() timeouts.Value {
+	return timeouts.Value{
+		Object: types.ObjectNull(map[string]attr.Type{
+			"create": types.StringType,
+			"read":   types.StringType,
+			"update": types.StringType,
+			"delete": types.StringType,
+		}),
+	}
+}
+
+// waitForAclToBeDeleted waits until exists reports that the ACL rule, role or user described by description no
+// longer exists.
+func waitForAclToBeDeleted(ctx context.Context, description string, exists func() (bool, error)) error {
+	wait := &utils.Waiter{
+		Description: description,
+		Delay:       5 * time.Second,
+		Pending:     []string{"deleting"},
+		Target:      []string{"deleted"},
+		Timeout:     5 * time.Minute,
+
+		Refresh: func() (result interface{}, state string, err error) {
+			log.Printf("[DEBUG] Waiting for %s to be deleted", description)
+
+			found, err := exists()
+			if err != nil {
+				return nil, "", err
+			}
+			if found {
+				return "deleting", "deleting", nil
+			}
+			return "deleted", "deleted", nil
+		},
+	}
+	if _, err := wait.Wait(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
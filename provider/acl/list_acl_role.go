@@ -0,0 +1,75 @@
+package acl
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ list.ListResource              = &aclRoleListResource{}
+	_ list.ListResourceWithConfigure = &aclRoleListResource{}
+)
+
+// aclRoleListResource lists the ACL roles of the account. It shares the type name, client and read logic of the
+// managed resource.
+type aclRoleListResource struct {
+	aclRoleResource
+}
+
+// NewAclRoleListResource returns a new list resource instance.
+func NewAclRoleListResource() list.ListResource {
+	return &aclRoleListResource{}
+}
+
+// ListResourceConfigSchema defines the filters accepted by the list resource.
+func (r *aclRoleListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		Description: "Lists the account's ACL roles",
+	}
+}
+
+// List streams one result per ACL role.
+func (r *aclRoleListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	stream.Results = func(push func(list.ListResult) bool) {
+		roles, err := r.client.Client.Roles.List(ctx)
+		if err != nil {
+			result := list.ListResult{}
+			result.Diagnostics.AddError("Failed to list ACL roles", err.Error())
+			push(result)
+			return
+		}
+
+		var count int64
+		for _, role := range roles {
+			if req.Limit > 0 && count >= req.Limit {
+				return
+			}
+			count++
+
+			model := AclRoleModel{
+				ID:       types.StringValue(strconv.Itoa(redis.IntValue(role.ID))),
+				Rule:     types.SetNull(types.ObjectType{AttrTypes: roleRuleAttrTypes()}),
+				Timeouts: nullTimeouts(),
+			}
+
+			result := req.NewListResult(ctx)
+			result.DisplayName = redis.StringValue(role.Name)
+			setAclIdentity(ctx, result.Identity, model.ID, &result.Diagnostics)
+
+			if req.IncludeResource && !result.Diagnostics.HasError() {
+				if removed := r.readRole(ctx, &model, &result.Diagnostics); !removed && !result.Diagnostics.HasError() {
+					result.Diagnostics.Append(result.Resource.Set(ctx, model)...)
+				}
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package acl
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ list.ListResource              = &aclRuleListResource{}
+	_ list.ListResourceWithConfigure = &aclRuleListResource{}
+)
+
+// aclRuleListResource lists the ACL rules of the account. It shares the type name, client and read logic of the
+// managed resource.
+type aclRuleListResource struct {
+	aclRuleResource
+}
+
+// NewAclRuleListResource returns a new list resource instance.
+func NewAclRuleListResource() list.ListResource {
+	return &aclRuleListResource{}
+}
+
+// ListResourceConfigSchema defines the filters accepted by the list resource.
+func (r *aclRuleListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		Description: "Lists the account's ACL rules, excluding the predefined ones",
+	}
+}
+
+// List streams one result per ACL rule.
+func (r *aclRuleListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	stream.Results = func(push func(list.ListResult) bool) {
+		rules, err := r.client.Client.RedisRules.List(ctx)
+		if err != nil {
+			result := list.ListResult{}
+			result.Diagnostics.AddError("Failed to list ACL rules", err.Error())
+			push(result)
+			return
+		}
+
+		var count int64
+		for _, rule := range rules {
+			// Predefined rules cannot be managed by Terraform.
+			if redis.BoolValue(rule.IsDefault) {
+				continue
+			}
+			if req.Limit > 0 && count >= req.Limit {
+				return
+			}
+			count++
+
+			model := AclRuleModel{
+				ID:       types.StringValue(strconv.Itoa(redis.IntValue(rule.ID))),
+				Timeouts: nullTimeouts(),
+			}
+
+			result := req.NewListResult(ctx)
+			result.DisplayName = redis.StringValue(rule.Name)
+			setAclIdentity(ctx, result.Identity, model.ID, &result.Diagnostics)
+
+			if req.IncludeResource && !result.Diagnostics.HasError() {
+				if removed := r.readRule(ctx, &model, &result.Diagnostics); !removed && !result.Diagnostics.HasError() {
+					result.Diagnostics.Append(result.Resource.Set(ctx, model)...)
+				}
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package acl
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ list.ListResource              = &aclUserListResource{}
+	_ list.ListResourceWithConfigure = &aclUserListResource{}
+)
+
+// aclUserListResource lists the ACL users of the account. It shares the type name, client and read logic of the
+// managed resource.
+type aclUserListResource struct {
+	aclUserResource
+}
+
+// NewAclUserListResource returns a new list resource instance.
+func NewAclUserListResource() list.ListResource {
+	return &aclUserListResource{}
+}
+
+// ListResourceConfigSchema defines the filters accepted by the list resource.
+func (r *aclUserListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		Description: "Lists the account's ACL users",
+	}
+}
+
+// List streams one result per ACL user.
+func (r *aclUserListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	stream.Results = func(push func(list.ListResult) bool) {
+		users, err := r.client.Client.Users.List(ctx)
+		if err != nil {
+			result := list.ListResult{}
+			result.Diagnostics.AddError("Failed to list ACL users", err.Error())
+			push(result)
+			return
+		}
+
+		var count int64
+		for _, user := range users {
+			if req.Limit > 0 && count >= req.Limit {
+				return
+			}
+			count++
+
+			model := AclUserModel{
+				ID:       types.StringValue(strconv.Itoa(redis.IntValue(user.ID))),
+				Timeouts: nullTimeouts(),
+			}
+
+			result := req.NewListResult(ctx)
+			result.DisplayName = redis.StringValue(user.Name)
+			setAclIdentity(ctx, result.Identity, model.ID, &result.Diagnostics)
+
+			if req.IncludeResource && !result.Diagnostics.HasError() {
+				if removed := r.readUser(ctx, &model, &result.Diagnostics); !removed && !result.Diagnostics.HasError() {
+					result.Diagnostics.Append(result.Resource.Set(ctx, model)...)
+				}
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
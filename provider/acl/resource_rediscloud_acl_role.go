@@ -0,0 +1,281 @@
+package acl
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/client"
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/utils"
+)
+
+var (
+	_ resource.Resource                 = &aclRoleResource{}
+	_ resource.ResourceWithConfigure    = &aclRoleResource{}
+	_ resource.ResourceWithImportState  = &aclRoleResource{}
+	_ resource.ResourceWithModifyPlan   = &aclRoleResource{}
+	_ resource.ResourceWithIdentity     = &aclRoleResource{}
+	_ resource.ResourceWithUpgradeState = &aclRoleResource{}
+)
+
+// aclRoleResource is the resource implementation.
+type aclRoleResource struct {
+	client *client.ApiClient
+}
+
+// NewAclRoleResource returns a new resource instance.
+func NewAclRoleResource() resource.Resource {
+	return &aclRoleResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *aclRoleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_acl_role"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *aclRoleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if client := configureClient(req.ProviderData, &resp.Diagnostics); client != nil {
+		r.client = client
+	}
+}
+
+// Schema defines the schema for the resource.
+func (r *aclRoleResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = r.schema(ctx)
+}
+
+// schema returns the schema of the resource. Version 0 was implemented with the SDK, and is read with the same
+// schema before being upgraded.
+func (r *aclRoleResource) schema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Description: "Create an ACL Role within your Redis Enterprise Cloud Account",
+		Version:     1,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the role",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "A meaningful name to identify the role, must be unique",
+				Required:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"rule": schema.SetNestedBlock{
+				Description: "A set of rules which apply to the role",
+				Validators: []validator.Set{
+					setvalidator.IsRequired(),
+					setvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The name of the rule",
+							Required:    true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"database": schema.SetNestedBlock{
+							Description: "A set of databases to whom this rule applies within the role",
+							Validators: []validator.Set{
+								setvalidator.IsRequired(),
+								setvalidator.SizeAtLeast(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"subscription": schema.Int64Attribute{
+										Description: "The subscription (id) to which the database belongs",
+										Required:    true,
+									},
+									"database": schema.Int64Attribute{
+										Description: "The database (id)",
+										Required:    true,
+									},
+									"regions": schema.SetAttribute{
+										Description: "For ActiveActive databases only",
+										Optional:    true,
+										ElementType: types.StringType,
+										Validators: []validator.Set{
+											// The regions are omitted rather than empty for databases which aren't
+											// Active-Active
+											setvalidator.SizeAtLeast(1),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// IdentitySchema defines the identity schema for the resource.
+func (r *aclRoleResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = aclIdentitySchema()
+}
+
+// ModifyPlan checks that the databases the role refers to exist, and that only Active-Active databases have
+// regions, which are among the database's regions.
+func (r *aclRoleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when destroying
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+	if !req.State.Raw.IsNull() && req.Plan.Raw.Equal(req.State.Raw) {
+		return
+	}
+
+	var plan AclRoleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateRoleReferences(ctx, r.client, &plan)...)
+}
+
+// ImportState imports an existing resource.
+func (r *aclRoleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importAclState(ctx, r.client, utils.AclRoleNameResolver, req, resp)
+}
+
+// Create implements resource creation.
+func (r *aclRoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan AclRoleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Create(ctx, 5*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Call the CRUD implementation
+	r.createRole(ctx, &plan, &resp.Diagnostics)
+	if plan.ID.IsUnknown() {
+		return
+	}
+
+	// Set the state, even if the create failed once the role exists, so that it is tainted rather than lost.
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	setAclIdentity(ctx, resp.Identity, plan.ID, &resp.Diagnostics)
+}
+
+// Read implements resource reading.
+func (r *aclRoleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state AclRoleModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Read(ctx, 3*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Call the CRUD implementation
+	removed := r.readRole(ctx, &state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if removed {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Set the state
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	setAclIdentity(ctx, resp.Identity, state.ID, &resp.Diagnostics)
+}
+
+// Update implements resource updating.
+func (r *aclRoleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan AclRoleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state AclRoleModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Update(ctx, 5*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Preserve the ID from state
+	plan.ID = state.ID
+
+	// Call the CRUD implementation
+	r.updateRole(ctx, &plan, &state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read back the state to get computed values
+	r.readRole(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set the state
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	setAclIdentity(ctx, resp.Identity, plan.ID, &resp.Diagnostics)
+}
+
+// Delete implements resource deletion.
+func (r *aclRoleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state AclRoleModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Delete(ctx, 5*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Call the CRUD implementation
+	r.deleteRole(ctx, &state, &resp.Diagnostics)
+}
@@ -0,0 +1,266 @@
+package acl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/RedisLabs/rediscloud-go-api/service/access_control_lists/roles"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/client"
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/utils"
+)
+
+// createRole implements the Create operation for the ACL role resource.
+func (r *aclRoleResource) createRole(ctx context.Context, plan *AclRoleModel, diagnostics *diag.Diagnostics) {
+	rules, diags := buildRoleRules(ctx, plan.Rule)
+	diagnostics.Append(diags...)
+	if diagnostics.HasError() {
+		return
+	}
+
+	createRoleRequest := roles.CreateRoleRequest{
+		Name:       redis.String(plan.Name.ValueString()),
+		RedisRules: rules,
+	}
+
+	var id int
+	err := utils.WaitForTask(ctx, "create ACL role", func(ctx context.Context) error {
+		var err error
+		id, err = r.client.Client.Roles.Create(ctx, createRoleRequest)
+		return err
+	}, func(ctx context.Context) error {
+		return waitForRoleToBeActive(ctx, id, r.client)
+	})
+	if err != nil {
+		diagnostics.AddError("Failed to create ACL role", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(strconv.Itoa(id))
+
+	// Read back the state to get computed values
+	r.readRole(ctx, plan, diagnostics)
+}
+
+// readRole implements the Read operation for the ACL role resource.
+// Returns true if the resource was removed (not found).
+func (r *aclRoleResource) readRole(ctx context.Context, state *AclRoleModel, diagnostics *diag.Diagnostics) bool {
+	id, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		diagnostics.AddError("Invalid ACL role ID", err.Error())
+		return false
+	}
+
+	role, err := r.client.Client.Roles.Get(ctx, id)
+	if err != nil {
+		notFound := &roles.NotFound{}
+		if errors.As(err, &notFound) {
+			log.Printf("[DEBUG] ACL role %d not found, removing from state", id)
+			return true
+		}
+		diagnostics.AddError("Failed to read ACL role", err.Error())
+		return false
+	}
+
+	state.Name = types.StringValue(redis.StringValue(role.Name))
+
+	rules, diags := flattenRoleRules(role.RedisRules)
+	diagnostics.Append(diags...)
+	state.Rule = rules
+
+	return false
+}
+
+// updateRole implements the Update operation for the ACL role resource.
+func (r *aclRoleResource) updateRole(ctx context.Context, plan *AclRoleModel, state *AclRoleModel, diagnostics *diag.Diagnostics) {
+	if plan.Name.Equal(state.Name) && plan.Rule.Equal(state.Rule) {
+		return
+	}
+
+	id, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		diagnostics.AddError("Invalid ACL role ID", err.Error())
+		return
+	}
+
+	rules, diags := buildRoleRules(ctx, plan.Rule)
+	diagnostics.Append(diags...)
+	if diagnostics.HasError() {
+		return
+	}
+
+	updateRoleRequest := roles.CreateRoleRequest{
+		Name:       redis.String(plan.Name.ValueString()),
+		RedisRules: rules,
+	}
+
+	err = utils.WaitForTask(ctx, fmt.Sprintf("update ACL role %d", id), func(ctx context.Context) error {
+		return r.client.Client.Roles.Update(ctx, id, updateRoleRequest)
+	}, func(ctx context.Context) error {
+		return waitForRoleToBeActive(ctx, id, r.client)
+	})
+	if err != nil {
+		diagnostics.AddError("Failed to update ACL role", err.Error())
+		return
+	}
+}
+
+// deleteRole implements the Delete operation for the ACL role resource.
+func (r *aclRoleResource) deleteRole(ctx context.Context, state *AclRoleModel, diagnostics *diag.Diagnostics) {
+	id, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		diagnostics.AddError("Invalid ACL role ID", err.Error())
+		return
+	}
+
+	// Sometimes ACL Users and Roles flip between Active and Pending a few times after creation/update.
+	// This delay gives the API a chance to settle
+	// TODO Ultimately this is an API problem
+	if err := waitForRoleToBeActive(ctx, id, r.client); err != nil {
+		diagnostics.AddError("ACL role not active", err.Error())
+		return
+	}
+
+	if err := r.client.Client.Roles.Delete(ctx, id); err != nil {
+		diagnostics.AddError("Failed to delete ACL role", err.Error())
+		return
+	}
+
+	// Wait until it's really disappeared
+	err = waitForAclToBeDeleted(ctx, fmt.Sprintf("ACL role %d", id), func() (bool, error) {
+		_, err := r.client.Client.Roles.Get(ctx, id)
+		if err != nil {
+			notFound := &roles.NotFound{}
+			if errors.As(err, &notFound) {
+				return false, nil
+			}
+			return false, fmt.Errorf("error getting role: %w", err)
+		}
+		return true, nil
+	})
+	if err != nil {
+		diagnostics.AddError("ACL role failed to be deleted", err.Error())
+		return
+	}
+}
+
+// buildRoleRules converts the rule blocks to the API request format.
+func buildRoleRules(ctx context.Context, ruleSet types.Set) ([]*roles.CreateRuleInRoleRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	result := make([]*roles.CreateRuleInRoleRequest, 0)
+
+	var rules []RoleRuleModel
+	diags.Append(ruleSet.ElementsAs(ctx, &rules, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	for _, rule := range rules {
+		var databases []RoleDatabaseModel
+		diags.Append(rule.Database.ElementsAs(ctx, &databases, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		associateWithDatabases := make([]*roles.CreateDatabaseInRuleInRoleRequest, 0, len(databases))
+		for _, database := range databases {
+			var regions []*string
+			if utils.IsConfigured(database.Regions) {
+				var values []string
+				diags.Append(database.Regions.ElementsAs(ctx, &values, false)...)
+				regions = redis.StringSlice(values...)
+			}
+
+			associateWithDatabases = append(associateWithDatabases, &roles.CreateDatabaseInRuleInRoleRequest{
+				SubscriptionId: redis.Int(int(database.Subscription.ValueInt64())),
+				DatabaseId:     redis.Int(int(database.Database.ValueInt64())),
+				Regions:        regions,
+			})
+		}
+
+		result = append(result, &roles.CreateRuleInRoleRequest{
+			RuleName:  redis.String(rule.Name.ValueString()),
+			Databases: associateWithDatabases,
+		})
+	}
+
+	return result, diags
+}
+
+// flattenRoleRules converts the rules of the role to the rule blocks. Databases which aren't Active-Active have no
+// regions, which are null rather than empty.
+func flattenRoleRules(rules []*roles.GetRuleInRoleResponse) (types.Set, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	ruleType := types.ObjectType{AttrTypes: roleRuleAttrTypes()}
+	databaseType := types.ObjectType{AttrTypes: roleDatabaseAttrTypes()}
+
+	ruleValues := make([]attr.Value, 0, len(rules))
+	for _, rule := range rules {
+		databaseValues := make([]attr.Value, 0, len(rule.Databases))
+		for _, database := range rule.Databases {
+			regions := types.SetNull(types.StringType)
+			if len(database.Regions) > 0 {
+				regionValues := make([]attr.Value, 0, len(database.Regions))
+				for _, region := range database.Regions {
+					regionValues = append(regionValues, types.StringValue(redis.StringValue(region)))
+				}
+				regions = types.SetValueMust(types.StringType, regionValues)
+			}
+
+			databaseValues = append(databaseValues, types.ObjectValueMust(roleDatabaseAttrTypes(), map[string]attr.Value{
+				"subscription": types.Int64Value(int64(redis.IntValue(database.SubscriptionId))),
+				"database":     types.Int64Value(int64(redis.IntValue(database.DatabaseId))),
+				"regions":      regions,
+			}))
+		}
+
+		databaseSet, d := types.SetValue(databaseType, databaseValues)
+		diags.Append(d...)
+		if diags.HasError() {
+			return types.SetNull(ruleType), diags
+		}
+
+		ruleValues = append(ruleValues, types.ObjectValueMust(roleRuleAttrTypes(), map[string]attr.Value{
+			"name":     types.StringValue(redis.StringValue(rule.RuleName)),
+			"database": databaseSet,
+		}))
+	}
+
+	ruleSet, d := types.SetValue(ruleType, ruleValues)
+	diags.Append(d...)
+	return ruleSet, diags
+}
+
+func waitForRoleToBeActive(ctx context.Context, id int, api *client.ApiClient) error {
+	wait := &utils.Waiter{
+		Description: fmt.Sprintf("ACL role %d", id),
+		Delay:       5 * time.Second,
+		Pending:     []string{roles.StatusPending},
+		Target:      []string{roles.StatusActive},
+		Timeout:     5 * time.Minute,
+
+		Refresh: func() (result interface{}, state string, err error) {
+			log.Printf("[DEBUG] Waiting for role %d to be active", id)
+
+			role, err := api.Client.Roles.Get(ctx, id)
+			if err != nil {
+				return nil, "", err
+			}
+
+			return redis.StringValue(role.Status), redis.StringValue(role.Status), nil
+		},
+	}
+	if _, err := wait.Wait(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package acl
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// AclRoleModel describes the resource data model for the ACL role.
+type AclRoleModel struct {
+	ID       types.String   `tfsdk:"id"`
+	Name     types.String   `tfsdk:"name"`
+	Rule     types.Set      `tfsdk:"rule"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// RoleRuleModel describes a rule nested block of the role.
+type RoleRuleModel struct {
+	Name     types.String `tfsdk:"name"`
+	Database types.Set    `tfsdk:"database"`
+}
+
+// RoleDatabaseModel describes a database nested block within a rule of the role.
+type RoleDatabaseModel struct {
+	Subscription types.Int64 `tfsdk:"subscription"`
+	Database     types.Int64 `tfsdk:"database"`
+	Regions      types.Set   `tfsdk:"regions"`
+}
+
+// roleRuleAttrTypes returns the attribute types for rule objects.
+func roleRuleAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"name":     types.StringType,
+		"database": types.SetType{ElemType: types.ObjectType{AttrTypes: roleDatabaseAttrTypes()}},
+	}
+}
+
+// roleDatabaseAttrTypes returns the attribute types for database objects.
+func roleDatabaseAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"subscription": types.Int64Type,
+		"database":     types.Int64Type,
+		"regions":      types.SetType{ElemType: types.StringType},
+	}
+}
@@ -0,0 +1,89 @@
+package acl
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// UpgradeState upgrades the state of roles managed by the SDK implementation of the resource, version 0.
+func (r *aclRoleResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	// The SDK implementation's state has the same shape, so is read with the current schema.
+	priorSchema := r.schema(ctx)
+	priorSchema.Version = 0
+
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &priorSchema,
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var state AclRoleModel
+				resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				resp.Diagnostics.Append(upgradeAclRoleStateV0(ctx, &state)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+			},
+		},
+	}
+}
+
+// upgradeAclRoleStateV0 replaces the empty regions the SDK recorded for databases which aren't Active-Active with
+// null, so that existing roles refresh without changes.
+func upgradeAclRoleStateV0(ctx context.Context, state *AclRoleModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if state.Rule.IsNull() || state.Rule.IsUnknown() {
+		return diags
+	}
+
+	var rules []RoleRuleModel
+	diags.Append(state.Rule.ElementsAs(ctx, &rules, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	ruleValues := make([]attr.Value, 0, len(rules))
+	for _, rule := range rules {
+		var databases []RoleDatabaseModel
+		diags.Append(rule.Database.ElementsAs(ctx, &databases, false)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		databaseValues := make([]attr.Value, 0, len(databases))
+		for _, database := range databases {
+			regions := database.Regions
+			if len(regions.Elements()) == 0 {
+				regions = types.SetNull(types.StringType)
+			}
+			databaseValues = append(databaseValues, types.ObjectValueMust(roleDatabaseAttrTypes(), map[string]attr.Value{
+				"subscription": database.Subscription,
+				"database":     database.Database,
+				"regions":      regions,
+			}))
+		}
+
+		databaseSet, d := types.SetValue(types.ObjectType{AttrTypes: roleDatabaseAttrTypes()}, databaseValues)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+		ruleValues = append(ruleValues, types.ObjectValueMust(roleRuleAttrTypes(), map[string]attr.Value{
+			"name":     rule.Name,
+			"database": databaseSet,
+		}))
+	}
+
+	ruleSet, d := types.SetValue(types.ObjectType{AttrTypes: roleRuleAttrTypes()}, ruleValues)
+	diags.Append(d...)
+	state.Rule = ruleSet
+	return diags
+}
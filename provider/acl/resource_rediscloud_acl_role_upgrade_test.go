@@ -0,0 +1,98 @@
+package acl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testRoleRules builds the role's rule set from rule names to their databases.
+func testRoleRules(t *testing.T, rules map[string][]RoleDatabaseModel) types.Set {
+	t.Helper()
+
+	ruleValues := []attr.Value{}
+	for name, databases := range rules {
+		databaseValues := []attr.Value{}
+		for _, database := range databases {
+			databaseValues = append(databaseValues, types.ObjectValueMust(roleDatabaseAttrTypes(), map[string]attr.Value{
+				"subscription": database.Subscription,
+				"database":     database.Database,
+				"regions":      database.Regions,
+			}))
+		}
+		ruleValues = append(ruleValues, types.ObjectValueMust(roleRuleAttrTypes(), map[string]attr.Value{
+			"name":     types.StringValue(name),
+			"database": types.SetValueMust(types.ObjectType{AttrTypes: roleDatabaseAttrTypes()}, databaseValues),
+		}))
+	}
+	return types.SetValueMust(types.ObjectType{AttrTypes: roleRuleAttrTypes()}, ruleValues)
+}
+
+func testRoleDatabase(subId int64, dbId int64, regions ...string) RoleDatabaseModel {
+	regionSet := types.SetNull(types.StringType)
+	if regions != nil {
+		values := []attr.Value{}
+		for _, region := range regions {
+			values = append(values, types.StringValue(region))
+		}
+		regionSet = types.SetValueMust(types.StringType, values)
+	}
+	return RoleDatabaseModel{
+		Subscription: types.Int64Value(subId),
+		Database:     types.Int64Value(dbId),
+		Regions:      regionSet,
+	}
+}
+
+func TestUnitUpgradeAclRoleStateV0(t *testing.T) {
+	tests := []struct {
+		name     string
+		rule     types.Set
+		expected types.Set
+	}{
+		{
+			name: "empty regions become null",
+			rule: testRoleRules(t, map[string][]RoleDatabaseModel{
+				"cache": {testRoleDatabase(1, 2, []string{}...)},
+			}),
+			expected: testRoleRules(t, map[string][]RoleDatabaseModel{
+				"cache": {testRoleDatabase(1, 2)},
+			}),
+		},
+		{
+			name: "regions are kept",
+			rule: testRoleRules(t, map[string][]RoleDatabaseModel{
+				"cache":   {testRoleDatabase(1, 2, "us-east-1", "eu-west-1")},
+				"metrics": {testRoleDatabase(3, 4, []string{}...), testRoleDatabase(3, 5)},
+			}),
+			expected: testRoleRules(t, map[string][]RoleDatabaseModel{
+				"cache":   {testRoleDatabase(1, 2, "us-east-1", "eu-west-1")},
+				"metrics": {testRoleDatabase(3, 4), testRoleDatabase(3, 5)},
+			}),
+		},
+		{
+			name:     "null rules are kept",
+			rule:     types.SetNull(types.ObjectType{AttrTypes: roleRuleAttrTypes()}),
+			expected: types.SetNull(types.ObjectType{AttrTypes: roleRuleAttrTypes()}),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			state := &AclRoleModel{
+				ID:   types.StringValue("10"),
+				Name: types.StringValue("role"),
+				Rule: test.rule,
+			}
+
+			diags := upgradeAclRoleStateV0(context.Background(), state)
+			require.False(t, diags.HasError(), "%v", diags)
+			assert.True(t, test.expected.Equal(state.Rule), "expected %s, got %s", test.expected, state.Rule)
+			assert.Equal(t, "10", state.ID.ValueString())
+		})
+	}
+}
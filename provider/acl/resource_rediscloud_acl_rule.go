@@ -0,0 +1,212 @@
+package acl
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/client"
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/utils"
+)
+
+var (
+	_ resource.Resource                = &aclRuleResource{}
+	_ resource.ResourceWithConfigure   = &aclRuleResource{}
+	_ resource.ResourceWithImportState = &aclRuleResource{}
+	_ resource.ResourceWithIdentity    = &aclRuleResource{}
+)
+
+// aclRuleResource is the resource implementation.
+type aclRuleResource struct {
+	client *client.ApiClient
+}
+
+// NewAclRuleResource returns a new resource instance.
+func NewAclRuleResource() resource.Resource {
+	return &aclRuleResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *aclRuleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_acl_rule"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *aclRuleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if client := configureClient(req.ProviderData, &resp.Diagnostics); client != nil {
+		r.client = client
+	}
+}
+
+// Schema defines the schema for the resource. The schema is the same as the SDK implementation's, so its state is
+// read without an upgrade.
+func (r *aclRuleResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Create an ACL Rule within your Redis Enterprise Cloud Account",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the rule",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "A meaningful name to identify the rule, must be unique",
+				Required:    true,
+			},
+			"rule": schema.StringAttribute{
+				Description: "The Rule itself, must comply with Redis' ACL syntax",
+				Required:    true,
+				Validators: []validator.String{
+					ruleSyntaxValidator{},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// IdentitySchema defines the identity schema for the resource.
+func (r *aclRuleResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = aclIdentitySchema()
+}
+
+// ImportState imports an existing resource.
+func (r *aclRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importAclState(ctx, r.client, utils.AclRuleNameResolver, req, resp)
+}
+
+// Create implements resource creation.
+func (r *aclRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan AclRuleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Create(ctx, 5*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Call the CRUD implementation
+	r.createRule(ctx, &plan, &resp.Diagnostics)
+	if plan.ID.IsUnknown() {
+		return
+	}
+
+	// Set the state, even if the create failed once the rule exists, so that it is tainted rather than lost.
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	setAclIdentity(ctx, resp.Identity, plan.ID, &resp.Diagnostics)
+}
+
+// Read implements resource reading.
+func (r *aclRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state AclRuleModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Read(ctx, 3*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Call the CRUD implementation
+	removed := r.readRule(ctx, &state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if removed {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Set the state
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	setAclIdentity(ctx, resp.Identity, state.ID, &resp.Diagnostics)
+}
+
+// Update implements resource updating.
+func (r *aclRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan AclRuleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state AclRuleModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Update(ctx, 5*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Preserve the ID from state
+	plan.ID = state.ID
+
+	// Call the CRUD implementation
+	r.updateRule(ctx, &plan, &state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read back the state to get computed values
+	r.readRule(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set the state
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	setAclIdentity(ctx, resp.Identity, plan.ID, &resp.Diagnostics)
+}
+
+// Delete implements resource deletion.
+func (r *aclRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state AclRuleModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Delete(ctx, 5*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Call the CRUD implementation
+	r.deleteRule(ctx, &state, &resp.Diagnostics)
+}
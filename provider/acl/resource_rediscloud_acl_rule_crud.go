@@ -0,0 +1,159 @@
+package acl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/RedisLabs/rediscloud-go-api/service/access_control_lists/redis_rules"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/client"
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/utils"
+)
+
+// createRule implements the Create operation for the ACL rule resource.
+func (r *aclRuleResource) createRule(ctx context.Context, plan *AclRuleModel, diagnostics *diag.Diagnostics) {
+	createRule := redis_rules.CreateRedisRuleRequest{
+		Name:      redis.String(plan.Name.ValueString()),
+		RedisRule: redis.String(plan.Rule.ValueString()),
+	}
+
+	id, err := r.client.Client.RedisRules.Create(ctx, createRule)
+	if err != nil {
+		diagnostics.AddError("Failed to create ACL rule", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(strconv.Itoa(id))
+
+	if err := waitForRuleToBeActive(ctx, id, r.client); err != nil {
+		diagnostics.AddError("ACL rule failed to become active", err.Error())
+		return
+	}
+
+	// Read back the state to get computed values
+	r.readRule(ctx, plan, diagnostics)
+}
+
+// readRule implements the Read operation for the ACL rule resource.
+// Returns true if the resource was removed (not found).
+func (r *aclRuleResource) readRule(ctx context.Context, state *AclRuleModel, diagnostics *diag.Diagnostics) bool {
+	id, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		diagnostics.AddError("Invalid ACL rule ID", err.Error())
+		return false
+	}
+
+	rule, err := r.client.Client.RedisRules.Get(ctx, id)
+	if err != nil {
+		notFound := &redis_rules.NotFound{}
+		if errors.As(err, &notFound) {
+			log.Printf("[DEBUG] ACL rule %d not found, removing from state", id)
+			return true
+		}
+		diagnostics.AddError("Failed to read ACL rule", err.Error())
+		return false
+	}
+
+	state.Name = types.StringValue(redis.StringValue(rule.Name))
+
+	// The API may format the rule differently to how it was written, which isn't a change.
+	if !RulesEquivalent(state.Rule.ValueString(), redis.StringValue(rule.ACL)) {
+		state.Rule = types.StringValue(redis.StringValue(rule.ACL))
+	}
+
+	return false
+}
+
+// updateRule implements the Update operation for the ACL rule resource.
+func (r *aclRuleResource) updateRule(ctx context.Context, plan *AclRuleModel, state *AclRuleModel, diagnostics *diag.Diagnostics) {
+	if plan.Name.Equal(state.Name) && plan.Rule.Equal(state.Rule) {
+		return
+	}
+
+	id, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		diagnostics.AddError("Invalid ACL rule ID", err.Error())
+		return
+	}
+
+	updateRedisRuleRequest := redis_rules.CreateRedisRuleRequest{
+		RedisRule: redis.String(plan.Rule.ValueString()),
+	}
+	if !plan.Name.Equal(state.Name) {
+		updateRedisRuleRequest.Name = redis.String(plan.Name.ValueString())
+	}
+
+	if err := r.client.Client.RedisRules.Update(ctx, id, updateRedisRuleRequest); err != nil {
+		diagnostics.AddError("Failed to update ACL rule", err.Error())
+		return
+	}
+
+	if err := waitForRuleToBeActive(ctx, id, r.client); err != nil {
+		diagnostics.AddError("ACL rule failed to become active", err.Error())
+		return
+	}
+}
+
+// deleteRule implements the Delete operation for the ACL rule resource.
+func (r *aclRuleResource) deleteRule(ctx context.Context, state *AclRuleModel, diagnostics *diag.Diagnostics) {
+	id, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		diagnostics.AddError("Invalid ACL rule ID", err.Error())
+		return
+	}
+
+	if err := r.client.Client.RedisRules.Delete(ctx, id); err != nil {
+		diagnostics.AddError("Failed to delete ACL rule", err.Error())
+		return
+	}
+
+	// Wait until it's really disappeared
+	err = waitForAclToBeDeleted(ctx, fmt.Sprintf("ACL rule %d", id), func() (bool, error) {
+		_, err := r.client.Client.RedisRules.Get(ctx, id)
+		if err != nil {
+			notFound := &redis_rules.NotFound{}
+			if errors.As(err, &notFound) {
+				return false, nil
+			}
+			return false, fmt.Errorf("error getting rule: %w", err)
+		}
+		return true, nil
+	})
+	if err != nil {
+		diagnostics.AddError("ACL rule failed to be deleted", err.Error())
+		return
+	}
+}
+
+func waitForRuleToBeActive(ctx context.Context, id int, api *client.ApiClient) error {
+	wait := &utils.Waiter{
+		Description: fmt.Sprintf("ACL rule %d", id),
+		Delay:       5 * time.Second,
+		Pending:     []string{redis_rules.StatusPending},
+		Target:      []string{redis_rules.StatusActive},
+		Timeout:     5 * time.Minute,
+
+		Refresh: func() (result interface{}, state string, err error) {
+			log.Printf("[DEBUG] Waiting for rule %d to be active", id)
+
+			rule, err := api.Client.RedisRules.Get(ctx, id)
+			if err != nil {
+				return nil, "", err
+			}
+
+			return redis.StringValue(rule.Status), redis.StringValue(rule.Status), nil
+		},
+	}
+	if _, err := wait.Wait(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,14 @@
+package acl
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// AclRuleModel describes the resource data model for the ACL rule.
+type AclRuleModel struct {
+	ID       types.String   `tfsdk:"id"`
+	Name     types.String   `tfsdk:"name"`
+	Rule     types.String   `tfsdk:"rule"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
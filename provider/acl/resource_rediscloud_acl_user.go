@@ -0,0 +1,260 @@
+package acl
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/client"
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/utils"
+)
+
+var (
+	_ resource.Resource                = &aclUserResource{}
+	_ resource.ResourceWithConfigure   = &aclUserResource{}
+	_ resource.ResourceWithImportState = &aclUserResource{}
+	_ resource.ResourceWithIdentity    = &aclUserResource{}
+)
+
+// aclUserResource is the resource implementation.
+type aclUserResource struct {
+	client *client.ApiClient
+}
+
+// NewAclUserResource returns a new resource instance.
+func NewAclUserResource() resource.Resource {
+	return &aclUserResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *aclUserResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_acl_user"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *aclUserResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if client := configureClient(req.ProviderData, &resp.Diagnostics); client != nil {
+		r.client = client
+	}
+}
+
+// Schema defines the schema for the resource. The SDK implementation's state has the same attributes, apart from
+// the write-only password which is never stored, so it is read without an upgrade.
+func (r *aclUserResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Create an ACL User within your Redis Enterprise Cloud Account",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the user",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "A meaningful name to identify the user",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role": schema.StringAttribute{
+				Description: "The role which the user has",
+				Required:    true,
+			},
+			"password": schema.StringAttribute{
+				Description: "The user's password. Changing it replaces the user. Either this or `password_wo` must be set",
+				Optional:    true,
+				Sensitive:   true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("password_wo")),
+				},
+				PlanModifiers: []planmodifier.String{
+					// Moving the password to password_wo doesn't change it
+					stringplanmodifier.RequiresReplaceIf(
+						func(_ context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+							resp.RequiresReplace = !req.PlanValue.IsNull()
+						},
+						"Changing the password replaces the user, unless the password is moved to password_wo.",
+						"Changing the password replaces the user, unless the password is moved to `password_wo`.",
+					),
+				},
+			},
+			"password_wo": schema.StringAttribute{
+				Description: "The user's password, which is never stored in the plan or state. Requires Terraform 1.11 or later. Change `password_wo_version` to change the password",
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+			},
+			"password_wo_version": schema.Int64Attribute{
+				Description: "The version of `password_wo`. Changing it updates the user's password to the current value of `password_wo`",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("password_wo")),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// IdentitySchema defines the identity schema for the resource.
+func (r *aclUserResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = aclIdentitySchema()
+}
+
+// ImportState imports an existing resource.
+func (r *aclUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importAclState(ctx, r.client, utils.AclUserNameResolver, req, resp)
+}
+
+// Create implements resource creation.
+func (r *aclUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan AclUserModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Write-only values are only available from the configuration
+	var config AclUserModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Create(ctx, 5*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Call the CRUD implementation
+	r.createUser(ctx, &plan, config.PasswordWO, &resp.Diagnostics)
+	if plan.ID.IsUnknown() {
+		return
+	}
+
+	// Set the state, even if the create failed once the user exists, so that it is tainted rather than lost.
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	setAclIdentity(ctx, resp.Identity, plan.ID, &resp.Diagnostics)
+}
+
+// Read implements resource reading.
+func (r *aclUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state AclUserModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Read(ctx, 3*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Call the CRUD implementation
+	removed := r.readUser(ctx, &state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if removed {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Set the state
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	setAclIdentity(ctx, resp.Identity, state.ID, &resp.Diagnostics)
+}
+
+// Update implements resource updating.
+func (r *aclUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan AclUserModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state AclUserModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Write-only values are only available from the configuration
+	var config AclUserModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Update(ctx, 5*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Preserve the ID from state
+	plan.ID = state.ID
+
+	// Call the CRUD implementation
+	r.updateUser(ctx, &plan, &state, config.PasswordWO, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read back the state to get computed values
+	r.readUser(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set the state
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	setAclIdentity(ctx, resp.Identity, plan.ID, &resp.Diagnostics)
+}
+
+// Delete implements resource deletion.
+func (r *aclUserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state AclUserModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Delete(ctx, 5*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Call the CRUD implementation
+	r.deleteUser(ctx, &state, &resp.Diagnostics)
+}
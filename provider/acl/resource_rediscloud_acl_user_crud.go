@@ -0,0 +1,177 @@
+package acl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/RedisLabs/rediscloud-go-api/service/access_control_lists/users"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/client"
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/utils"
+)
+
+// createUser implements the Create operation for the ACL user resource. passwordWO is the write-only password from
+// the configuration.
+func (r *aclUserResource) createUser(ctx context.Context, plan *AclUserModel, passwordWO types.String, diagnostics *diag.Diagnostics) {
+	password := plan.Password
+	if !utils.IsConfigured(password) {
+		password = passwordWO
+	}
+
+	createUser := users.CreateUserRequest{
+		Name:     redis.String(plan.Name.ValueString()),
+		Role:     redis.String(plan.Role.ValueString()),
+		Password: redis.String(password.ValueString()),
+	}
+
+	var id int
+	err := utils.WaitForTask(ctx, "create ACL user", func(ctx context.Context) error {
+		var err error
+		id, err = r.client.Client.Users.Create(ctx, createUser)
+		return err
+	}, func(ctx context.Context) error {
+		return waitForUserToBeActive(ctx, id, r.client)
+	})
+	if err != nil {
+		diagnostics.AddError("Failed to create ACL user", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(strconv.Itoa(id))
+
+	// Read back the state to get computed values
+	r.readUser(ctx, plan, diagnostics)
+}
+
+// readUser implements the Read operation for the ACL user resource. The password isn't returned by the API.
+// Returns true if the resource was removed (not found).
+func (r *aclUserResource) readUser(ctx context.Context, state *AclUserModel, diagnostics *diag.Diagnostics) bool {
+	id, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		diagnostics.AddError("Invalid ACL user ID", err.Error())
+		return false
+	}
+
+	user, err := r.client.Client.Users.Get(ctx, id)
+	if err != nil {
+		notFound := &users.NotFound{}
+		if errors.As(err, &notFound) {
+			log.Printf("[DEBUG] ACL user %d not found, removing from state", id)
+			return true
+		}
+		diagnostics.AddError("Failed to read ACL user", err.Error())
+		return false
+	}
+
+	state.Name = types.StringValue(redis.StringValue(user.Name))
+	state.Role = types.StringValue(redis.StringValue(user.Role))
+
+	return false
+}
+
+// updateUser implements the Update operation for the ACL user resource. The write-only password, passwordWO, is
+// only sent when its version changes or the password is moved to it.
+func (r *aclUserResource) updateUser(ctx context.Context, plan *AclUserModel, state *AclUserModel, passwordWO types.String, diagnostics *diag.Diagnostics) {
+	changePasswordWO := utils.IsConfigured(passwordWO) &&
+		(!plan.PasswordWOVersion.Equal(state.PasswordWOVersion) || !state.Password.IsNull())
+	if plan.Role.Equal(state.Role) && plan.Password.Equal(state.Password) && !changePasswordWO {
+		return
+	}
+
+	id, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		diagnostics.AddError("Invalid ACL user ID", err.Error())
+		return
+	}
+
+	updateUserRequest := users.UpdateUserRequest{
+		Role: redis.String(plan.Role.ValueString()),
+	}
+	if utils.IsConfigured(plan.Password) {
+		updateUserRequest.Password = redis.String(plan.Password.ValueString())
+	} else if changePasswordWO {
+		updateUserRequest.Password = redis.String(passwordWO.ValueString())
+	}
+
+	err = utils.WaitForTask(ctx, fmt.Sprintf("update ACL user %d", id), func(ctx context.Context) error {
+		return r.client.Client.Users.Update(ctx, id, updateUserRequest)
+	}, func(ctx context.Context) error {
+		return waitForUserToBeActive(ctx, id, r.client)
+	})
+	if err != nil {
+		diagnostics.AddError("Failed to update ACL user", err.Error())
+		return
+	}
+}
+
+// deleteUser implements the Delete operation for the ACL user resource.
+func (r *aclUserResource) deleteUser(ctx context.Context, state *AclUserModel, diagnostics *diag.Diagnostics) {
+	id, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		diagnostics.AddError("Invalid ACL user ID", err.Error())
+		return
+	}
+
+	// Sometimes ACL Users and Roles flip between Active and Pending a few times after creation/update.
+	// This delay gives the API a chance to settle
+	// TODO Ultimately this is an API problem
+	if err := waitForUserToBeActive(ctx, id, r.client); err != nil {
+		diagnostics.AddError("ACL user not active", err.Error())
+		return
+	}
+
+	if err := r.client.Client.Users.Delete(ctx, id); err != nil {
+		diagnostics.AddError("Failed to delete ACL user", err.Error())
+		return
+	}
+
+	// Wait until it's really disappeared
+	err = waitForAclToBeDeleted(ctx, fmt.Sprintf("ACL user %d", id), func() (bool, error) {
+		_, err := r.client.Client.Users.Get(ctx, id)
+		if err != nil {
+			notFound := &users.NotFound{}
+			if errors.As(err, &notFound) {
+				return false, nil
+			}
+			return false, fmt.Errorf("error getting user: %w", err)
+		}
+		return true, nil
+	})
+	if err != nil {
+		diagnostics.AddError("ACL user failed to be deleted", err.Error())
+		return
+	}
+}
+
+func waitForUserToBeActive(ctx context.Context, id int, api *client.ApiClient) error {
+	wait := &utils.Waiter{
+		Description: fmt.Sprintf("ACL user %d", id),
+		Delay:       5 * time.Second,
+		Pending:     []string{users.StatusPending},
+		Target:      []string{users.StatusActive},
+		Timeout:     5 * time.Minute,
+
+		Refresh: func() (result interface{}, state string, err error) {
+			log.Printf("[DEBUG] Waiting for user %d to be active", id)
+
+			user, err := api.Client.Users.Get(ctx, id)
+			if err != nil {
+				return nil, "", err
+			}
+
+			return redis.StringValue(user.Status), redis.StringValue(user.Status), nil
+		},
+	}
+	if _, err := wait.Wait(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
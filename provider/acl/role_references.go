@@ -0,0 +1,190 @@
+package acl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/RedisLabs/rediscloud-go-api/service/databases"
+	fixedDatabases "github.com/RedisLabs/rediscloud-go-api/service/fixed/databases"
+	fixedSubscriptions "github.com/RedisLabs/rediscloud-go-api/service/fixed/subscriptions"
+	"github.com/RedisLabs/rediscloud-go-api/service/subscriptions"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/client"
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/utils"
+)
+
+// roleSubscription is what's needed of a subscription to check the databases a role refers to.
+type roleSubscription struct {
+	found          bool
+	essentials     bool
+	deploymentType string
+}
+
+// validateRoleReferences checks that every database the role's rules refer to exists, and that regions are only
+// given for Active-Active databases, naming regions the database is deployed to. Databases whose IDs aren't known
+// yet, such as databases created in the same apply, aren't checked.
+func validateRoleReferences(ctx context.Context, api *client.ApiClient, plan *AclRoleModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if !utils.IsConfigured(plan.Rule) {
+		return diags
+	}
+
+	var rules []RoleRuleModel
+	diags.Append(plan.Rule.ElementsAs(ctx, &rules, true)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	subs := map[int]roleSubscription{}
+	for _, rule := range rules {
+		if !utils.IsConfigured(rule.Database) {
+			continue
+		}
+		var dbs []RoleDatabaseModel
+		diags.Append(rule.Database.ElementsAs(ctx, &dbs, true)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		for _, db := range dbs {
+			if !utils.IsConfigured(db.Subscription) || !utils.IsConfigured(db.Database) || db.Regions.IsUnknown() {
+				continue
+			}
+			subId := int(db.Subscription.ValueInt64())
+			dbId := int(db.Database.ValueInt64())
+			var regions []string
+			if !db.Regions.IsNull() {
+				diags.Append(db.Regions.ElementsAs(ctx, &regions, true)...)
+			}
+
+			sub, ok := subs[subId]
+			if !ok {
+				var err error
+				sub, err = getRoleSubscription(ctx, api, subId)
+				if err != nil {
+					diags.AddError("Failed to check the role's databases", err.Error())
+					return diags
+				}
+				subs[subId] = sub
+			}
+
+			diags.Append(validateRoleDatabase(ctx, api, rule.Name.ValueString(), sub, subId, dbId, regions)...)
+		}
+	}
+
+	return diags
+}
+
+// getRoleSubscription looks up a subscription, which may be an Essentials subscription.
+func getRoleSubscription(ctx context.Context, api *client.ApiClient, subId int) (roleSubscription, error) {
+	sub, err := api.Client.Subscription.Get(ctx, subId)
+	if err == nil {
+		return roleSubscription{found: true, deploymentType: redis.StringValue(sub.DeploymentType)}, nil
+	}
+	notFound := &subscriptions.NotFound{}
+	if !errors.As(err, &notFound) {
+		return roleSubscription{}, err
+	}
+
+	if _, err := api.Client.FixedSubscriptions.Get(ctx, subId); err != nil {
+		fixedNotFound := &fixedSubscriptions.NotFound{}
+		if errors.As(err, &fixedNotFound) {
+			return roleSubscription{}, nil
+		}
+		return roleSubscription{}, err
+	}
+	return roleSubscription{found: true, essentials: true}, nil
+}
+
+// validateRoleDatabase checks a single database a rule of the role refers to.
+func validateRoleDatabase(ctx context.Context, api *client.ApiClient, ruleName string, sub roleSubscription, subId int, dbId int, regions []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !sub.found {
+		diags.AddAttributeError(
+			path.Root("rule"),
+			"Subscription not found",
+			fmt.Sprintf("The role's rule %q refers to subscription %d, which doesn't exist.", ruleName, subId),
+		)
+		return diags
+	}
+
+	databaseNotFound := func() {
+		diags.AddAttributeError(
+			path.Root("rule"),
+			"Database not found",
+			fmt.Sprintf("The role's rule %q refers to database %d in subscription %d, which doesn't exist.", ruleName, dbId, subId),
+		)
+	}
+	regionsNotSupported := func() {
+		diags.AddAttributeError(
+			path.Root("rule"),
+			"Regions only apply to Active-Active databases",
+			fmt.Sprintf("The role's rule %q gives regions for database %d in subscription %d, which isn't an Active-Active database. Remove the regions.", ruleName, dbId, subId),
+		)
+	}
+
+	switch {
+	case sub.essentials:
+		if _, err := api.Client.FixedDatabases.Get(ctx, subId, dbId); err != nil {
+			notFound := &fixedDatabases.NotFound{}
+			if errors.As(err, &notFound) {
+				databaseNotFound()
+				return diags
+			}
+			diags.AddError("Failed to check the role's databases", err.Error())
+			return diags
+		}
+		if len(regions) > 0 {
+			regionsNotSupported()
+		}
+
+	case sub.deploymentType == subscriptions.SubscriptionDeploymentTypeActiveActive:
+		db, err := api.Client.Database.GetActiveActive(ctx, subId, dbId)
+		if err != nil {
+			notFound := &databases.NotFound{}
+			if errors.As(err, &notFound) {
+				databaseNotFound()
+				return diags
+			}
+			diags.AddError("Failed to check the role's databases", err.Error())
+			return diags
+		}
+
+		var deployed []string
+		for _, crdb := range db.CrdbDatabases {
+			deployed = append(deployed, redis.StringValue(crdb.Region))
+		}
+		for _, region := range regions {
+			if !slices.Contains(deployed, region) {
+				diags.AddAttributeError(
+					path.Root("rule"),
+					"Region not found",
+					fmt.Sprintf("The role's rule %q refers to region %q of database %d in subscription %d, which isn't one of the database's regions: %s.", ruleName, region, dbId, subId, strings.Join(deployed, ", ")),
+				)
+			}
+		}
+
+	default:
+		if _, err := api.Client.Database.Get(ctx, subId, dbId); err != nil {
+			notFound := &databases.NotFound{}
+			if errors.As(err, &notFound) {
+				databaseNotFound()
+				return diags
+			}
+			diags.AddError("Failed to check the role's databases", err.Error())
+			return diags
+		}
+		if len(regions) > 0 {
+			regionsNotSupported()
+		}
+	}
+
+	return diags
+}
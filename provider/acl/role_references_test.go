@@ -0,0 +1,116 @@
+package acl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/RedisLabs/rediscloud-go-api/service/databases"
+	"github.com/RedisLabs/rediscloud-go-api/service/subscriptions"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/client"
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/fakeapi"
+)
+
+func TestUnitValidateRoleReferences(t *testing.T) {
+	ctx := context.Background()
+	fake := fakeapi.New(fakeapi.Options{})
+	t.Cleanup(fake.Close)
+	api, err := fake.Client()
+	require.NoError(t, err)
+
+	proSubId, err := api.Subscription.Create(ctx, subscriptions.CreateSubscription{
+		Name:            redis.String("pro"),
+		PaymentMethodID: redis.Int(fakeapi.PaymentMethodId),
+		CloudProviders: []*subscriptions.CreateCloudProvider{{
+			Provider: redis.String("AWS"),
+			Regions:  []*subscriptions.CreateRegion{{Region: redis.String("us-east-1")}},
+		}},
+	})
+	require.NoError(t, err)
+	proDbId, err := api.Database.Create(ctx, proSubId, databases.CreateDatabase{
+		Name:            redis.String("pro-db"),
+		DatasetSizeInGB: redis.Float64(1),
+	})
+	require.NoError(t, err)
+
+	aaSubId, err := api.Subscription.Create(ctx, subscriptions.CreateSubscription{
+		Name:            redis.String("active-active"),
+		DeploymentType:  redis.String(subscriptions.SubscriptionDeploymentTypeActiveActive),
+		PaymentMethodID: redis.Int(fakeapi.PaymentMethodId),
+		CloudProviders: []*subscriptions.CreateCloudProvider{{
+			Provider: redis.String("AWS"),
+			Regions: []*subscriptions.CreateRegion{
+				{Region: redis.String("us-east-1"), Networking: &subscriptions.CreateNetworking{DeploymentCIDR: redis.String("10.0.0.0/24")}},
+				{Region: redis.String("eu-west-1"), Networking: &subscriptions.CreateNetworking{DeploymentCIDR: redis.String("10.0.1.0/24")}},
+			},
+		}},
+	})
+	require.NoError(t, err)
+	aaDbId, err := api.Database.ActiveActiveCreate(ctx, aaSubId, databases.CreateActiveActiveDatabase{
+		Name:            redis.String("aa-db"),
+		DatasetSizeInGB: redis.Float64(1),
+	})
+	require.NoError(t, err)
+
+	pro := int64(proSubId)
+	aa := int64(aaSubId)
+	tests := []struct {
+		name      string
+		databases []RoleDatabaseModel
+		errors    []string
+	}{
+		{
+			name:      "existing databases",
+			databases: []RoleDatabaseModel{testRoleDatabase(pro, int64(proDbId)), testRoleDatabase(aa, int64(aaDbId), "eu-west-1")},
+		},
+		{
+			name: "unknown database IDs aren't checked",
+			databases: []RoleDatabaseModel{{
+				Subscription: types.Int64Value(pro),
+				Database:     types.Int64Unknown(),
+				Regions:      types.SetNull(types.StringType),
+			}},
+		},
+		{
+			name:      "missing database",
+			databases: []RoleDatabaseModel{testRoleDatabase(pro, int64(proDbId)+1000)},
+			errors:    []string{"Database not found"},
+		},
+		{
+			name:      "missing subscription",
+			databases: []RoleDatabaseModel{testRoleDatabase(pro+1000, int64(proDbId))},
+			errors:    []string{"Subscription not found"},
+		},
+		{
+			name:      "regions of a database which isn't Active-Active",
+			databases: []RoleDatabaseModel{testRoleDatabase(pro, int64(proDbId), "us-east-1")},
+			errors:    []string{"Regions only apply to Active-Active databases"},
+		},
+		{
+			name:      "region the database isn't deployed to",
+			databases: []RoleDatabaseModel{testRoleDatabase(aa, int64(aaDbId), "ap-south-1")},
+			errors:    []string{"Region not found"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			plan := &AclRoleModel{
+				Name: types.StringValue("role"),
+				Rule: testRoleRules(t, map[string][]RoleDatabaseModel{"rule": test.databases}),
+			}
+
+			diags := validateRoleReferences(ctx, &client.ApiClient{Client: api}, plan)
+
+			var summaries []string
+			for _, d := range diags.Errors() {
+				summaries = append(summaries, d.Summary())
+			}
+			assert.Equal(t, test.errors, summaries, "%v", diags)
+		})
+	}
+}
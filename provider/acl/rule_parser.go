@@ -0,0 +1,421 @@
+package acl
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// TokenKind identifies the type of directive a Token represents within a Redis ACL rule.
+type TokenKind int
+
+const (
+	// TokenCommand is a command permission such as `+get`, `-flushall` or `+config|get`.
+	TokenCommand TokenKind = iota
+	// TokenCategory is a command category permission such as `+@read` or `-@dangerous`.
+	TokenCategory
+	// TokenKeyPattern is a key pattern such as `~cache:*`, `%R~ro:*` or `%W~wo:*`.
+	TokenKeyPattern
+	// TokenChannelPattern is a Pub/Sub channel pattern such as `&notifications:*`.
+	TokenChannelPattern
+	// TokenKeyword is a bare directive such as `allkeys` or `resetchannels`.
+	TokenKeyword
+	// TokenSelector is a parenthesised group of directives, e.g. `(+get ~secondary:*)`.
+	TokenSelector
+)
+
+// Key permissions that can be granted with the `%<permission>~<pattern>` form.
+const (
+	KeyPermissionRead      = "R"
+	KeyPermissionWrite     = "W"
+	KeyPermissionReadWrite = "RW"
+)
+
+// keywordAliases maps each supported bare keyword to its canonical written form.
+// Keywords that are shorthands for a more general directive are rewritten so that
+// equivalent rules normalise to the same string.
+var keywordAliases = map[string]string{
+	"allkeys":       "~*",
+	"allchannels":   "&*",
+	"allcommands":   "+@all",
+	"nocommands":    "-@all",
+	"resetkeys":     "resetkeys",
+	"resetchannels": "resetchannels",
+}
+
+// userDirectives are valid in `ACL SETUSER` but manage the user itself rather than its
+// permissions, so Redis Cloud does not accept them as part of an ACL rule.
+var userDirectives = map[string]bool{
+	"on":                    true,
+	"off":                   true,
+	"nopass":                true,
+	"resetpass":             true,
+	"reset":                 true,
+	"clearselectors":        true,
+	"sanitize-payload":      true,
+	"skip-sanitize-payload": true,
+}
+
+// Token is a single directive within a parsed ACL rule.
+type Token struct {
+	Kind TokenKind
+	// Offset is the zero-based character offset of the token within the original rule.
+	Offset int
+	// Raw is the text of the token exactly as written.
+	Raw string
+	// Allow is true for `+` command/category directives and false for `-`.
+	Allow bool
+	// Name is the command, category or keyword name, lower-cased.
+	Name string
+	// Permission is one of the KeyPermission constants for key patterns.
+	Permission string
+	// Pattern is the glob for key and channel patterns.
+	Pattern string
+	// Selector holds the directives inside a parenthesised selector.
+	Selector []Token
+}
+
+// String returns the canonical form of the token.
+func (t Token) String() string {
+	switch t.Kind {
+	case TokenCommand:
+		return signOf(t.Allow) + t.Name
+	case TokenCategory:
+		return signOf(t.Allow) + "@" + t.Name
+	case TokenKeyPattern:
+		if t.Permission == KeyPermissionReadWrite {
+			return "~" + t.Pattern
+		}
+		return "%" + t.Permission + "~" + t.Pattern
+	case TokenChannelPattern:
+		return "&" + t.Pattern
+	case TokenKeyword:
+		return keywordAliases[t.Name]
+	case TokenSelector:
+		return "(" + joinTokens(t.Selector) + ")"
+	}
+	return t.Raw
+}
+
+// Rule is a parsed Redis ACL rule.
+type Rule struct {
+	Tokens []Token
+}
+
+// String returns the canonical form of the rule: directives separated by a single space,
+// command and category names lower-cased and keyword shorthands expanded.
+func (r *Rule) String() string {
+	return joinTokens(r.Tokens)
+}
+
+// SyntaxError describes why an ACL rule could not be parsed.
+type SyntaxError struct {
+	// Offset is the zero-based character offset at which the problem was found.
+	Offset int
+	// Token is the directive containing the problem, if any.
+	Token string
+	// Message describes the problem.
+	Message string
+}
+
+func (e *SyntaxError) Error() string {
+	if e.Token == "" {
+		return fmt.Sprintf("character %d: %s", e.Offset, e.Message)
+	}
+	return fmt.Sprintf("character %d (%q): %s", e.Offset, e.Token, e.Message)
+}
+
+// Detail renders the error for a diagnostic, pointing at the offending character in the rule.
+func (e *SyntaxError) Detail(rule string) string {
+	return fmt.Sprintf("Invalid ACL rule at character %d: %s\n\n  %s\n  %s^", e.Offset, e.Message, rule, strings.Repeat(" ", e.Offset))
+}
+
+// ParseRule parses a rule written in the Redis ACL syntax. Only the directives that make up a
+// Redis Cloud ACL rule are accepted: command and category permissions, key and channel
+// patterns, the associated keywords and selectors.
+func ParseRule(rule string) (*Rule, error) {
+	runes := []rune(rule)
+	words := splitWords(runes)
+	if len(words) == 0 {
+		return nil, &SyntaxError{Offset: 0, Message: "rule must contain at least one directive"}
+	}
+
+	var tokens []Token
+	for i := 0; i < len(words); i++ {
+		w := words[i]
+
+		if strings.HasPrefix(w.text, "(") {
+			selector, consumed, err := parseSelector(words[i:])
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, selector)
+			i += consumed - 1
+			continue
+		}
+
+		if strings.HasPrefix(w.text, ")") {
+			return nil, &SyntaxError{Offset: w.offset, Token: w.text, Message: "unexpected ')' without a matching '('"}
+		}
+
+		token, err := parseDirective(w)
+		if err != nil {
+			return nil, err
+		}
+		tokens = append(tokens, token)
+	}
+
+	return &Rule{Tokens: tokens}, nil
+}
+
+// NormalizeRule parses the rule and returns its canonical form.
+func NormalizeRule(rule string) (string, error) {
+	parsed, err := ParseRule(rule)
+	if err != nil {
+		return "", err
+	}
+	return parsed.String(), nil
+}
+
+// RulesEquivalent reports whether two rules normalise to the same canonical form. Rules that
+// cannot be parsed are only equivalent if they are identical.
+func RulesEquivalent(a, b string) bool {
+	if a == b {
+		return true
+	}
+	normalizedA, err := NormalizeRule(a)
+	if err != nil {
+		return false
+	}
+	normalizedB, err := NormalizeRule(b)
+	if err != nil {
+		return false
+	}
+	return normalizedA == normalizedB
+}
+
+type word struct {
+	text   string
+	offset int
+}
+
+// splitWords splits the rule on whitespace, recording the character offset of each word.
+func splitWords(runes []rune) []word {
+	var words []word
+	start := -1
+	for i, r := range runes {
+		if unicode.IsSpace(r) {
+			if start >= 0 {
+				words = append(words, word{text: string(runes[start:i]), offset: start})
+				start = -1
+			}
+			continue
+		}
+		if start < 0 {
+			start = i
+		}
+	}
+	if start >= 0 {
+		words = append(words, word{text: string(runes[start:]), offset: start})
+	}
+	return words
+}
+
+// parseSelector consumes the words making up a selector, starting with the word that opens it.
+// Following Redis, a selector opens with a word beginning with '(' and closes with the first
+// word ending in ')'. It returns the selector token and the number of words consumed.
+func parseSelector(words []word) (Token, int, error) {
+	open := words[0]
+	var inner []word
+	closed := false
+	consumed := 0
+
+	for i, w := range words {
+		consumed = i + 1
+		text := w.text
+		offset := w.offset
+
+		if i == 0 {
+			text = strings.TrimPrefix(text, "(")
+			offset++
+		}
+		if strings.HasPrefix(text, "(") {
+			return Token{}, 0, &SyntaxError{Offset: offset, Token: w.text, Message: "selectors cannot be nested"}
+		}
+		if strings.HasSuffix(text, ")") {
+			text = strings.TrimSuffix(text, ")")
+			closed = true
+		}
+		if text != "" {
+			inner = append(inner, word{text: text, offset: offset})
+		}
+		if closed {
+			break
+		}
+	}
+
+	if !closed {
+		return Token{}, 0, &SyntaxError{Offset: open.offset, Token: open.text, Message: "selector is missing its closing ')'"}
+	}
+	if len(inner) == 0 {
+		return Token{}, 0, &SyntaxError{Offset: open.offset, Token: open.text, Message: "selector must contain at least one directive"}
+	}
+
+	var raw []string
+	for _, w := range words[:consumed] {
+		raw = append(raw, w.text)
+	}
+
+	selector := Token{Kind: TokenSelector, Offset: open.offset, Raw: strings.Join(raw, " ")}
+	for _, w := range inner {
+		token, err := parseDirective(w)
+		if err != nil {
+			return Token{}, 0, err
+		}
+		selector.Selector = append(selector.Selector, token)
+	}
+
+	return selector, consumed, nil
+}
+
+// parseDirective parses a single whitespace-delimited directive.
+func parseDirective(w word) (Token, error) {
+	text := w.text
+	lower := strings.ToLower(text)
+	token := Token{Offset: w.offset, Raw: text}
+
+	if _, ok := keywordAliases[lower]; ok {
+		token.Kind = TokenKeyword
+		token.Name = lower
+		return token, nil
+	}
+	if userDirectives[lower] {
+		return Token{}, &SyntaxError{Offset: w.offset, Token: text, Message: "user-level directives are not supported in an ACL rule"}
+	}
+
+	switch text[0] {
+	case '>', '<', '#', '!':
+		return Token{}, &SyntaxError{Offset: w.offset, Token: text, Message: "passwords cannot be set in an ACL rule, manage them on the ACL user instead"}
+	case '~':
+		token.Kind = TokenKeyPattern
+		token.Permission = KeyPermissionReadWrite
+		token.Pattern = text[1:]
+		if token.Pattern == "" {
+			return Token{}, &SyntaxError{Offset: w.offset + 1, Token: text, Message: "key pattern must not be empty"}
+		}
+		return token, nil
+	case '%':
+		return parseKeyPermission(w)
+	case '&':
+		token.Kind = TokenChannelPattern
+		token.Pattern = text[1:]
+		if token.Pattern == "" {
+			return Token{}, &SyntaxError{Offset: w.offset + 1, Token: text, Message: "channel pattern must not be empty"}
+		}
+		return token, nil
+	case '+', '-':
+		token.Allow = text[0] == '+'
+		if strings.HasPrefix(text[1:], "@") {
+			token.Kind = TokenCategory
+			name := []rune(text[2:])
+			if len(name) == 0 {
+				return Token{}, &SyntaxError{Offset: w.offset + 2, Token: text, Message: "category name must not be empty"}
+			}
+			for i, r := range name {
+				if !isCategoryRune(r) {
+					return Token{}, &SyntaxError{Offset: w.offset + 2 + i, Token: text, Message: fmt.Sprintf("invalid character %q in category name", r)}
+				}
+			}
+			token.Name = strings.ToLower(string(name))
+			return token, nil
+		}
+
+		token.Kind = TokenCommand
+		name := []rune(text[1:])
+		if len(name) == 0 {
+			return Token{}, &SyntaxError{Offset: w.offset + 1, Token: text, Message: "command name must not be empty"}
+		}
+		for i, r := range name {
+			if r == '|' && (i == 0 || i == len(name)-1 || name[i-1] == '|') {
+				return Token{}, &SyntaxError{Offset: w.offset + 1 + i, Token: text, Message: "subcommand separator '|' must be between a command and a subcommand"}
+			}
+			if !isCommandRune(r) {
+				return Token{}, &SyntaxError{Offset: w.offset + 1 + i, Token: text, Message: fmt.Sprintf("invalid character %q in command name", r)}
+			}
+		}
+		token.Name = strings.ToLower(string(name))
+		return token, nil
+	}
+
+	return Token{}, &SyntaxError{
+		Offset:  w.offset,
+		Token:   text,
+		Message: "unrecognised directive, expected one of +<command>, -<command>, +@<category>, -@<category>, ~<pattern>, %R~<pattern>, %W~<pattern>, &<pattern>, a selector or a keyword such as allkeys or resetchannels",
+	}
+}
+
+// parseKeyPermission parses the `%<permission>~<pattern>` form, e.g. `%R~cache:*`.
+func parseKeyPermission(w word) (Token, error) {
+	runes := []rune(w.text)
+	read, write := false, false
+
+	i := 1
+	for ; i < len(runes) && runes[i] != '~'; i++ {
+		switch unicode.ToUpper(runes[i]) {
+		case 'R':
+			read = true
+		case 'W':
+			write = true
+		default:
+			return Token{}, &SyntaxError{Offset: w.offset + i, Token: w.text, Message: fmt.Sprintf("invalid key permission %q, expected R, W or RW", runes[i])}
+		}
+	}
+
+	if i == len(runes) {
+		return Token{}, &SyntaxError{Offset: w.offset + i, Token: w.text, Message: "key permission must be followed by '~' and a pattern"}
+	}
+	if !read && !write {
+		return Token{}, &SyntaxError{Offset: w.offset + 1, Token: w.text, Message: "key permission must include R, W or RW"}
+	}
+	if i == len(runes)-1 {
+		return Token{}, &SyntaxError{Offset: w.offset + i + 1, Token: w.text, Message: "key pattern must not be empty"}
+	}
+
+	permission := KeyPermissionReadWrite
+	if !write {
+		permission = KeyPermissionRead
+	} else if !read {
+		permission = KeyPermissionWrite
+	}
+
+	return Token{
+		Kind:       TokenKeyPattern,
+		Offset:     w.offset,
+		Raw:        w.text,
+		Permission: permission,
+		Pattern:    string(runes[i+1:]),
+	}, nil
+}
+
+func isCommandRune(r rune) bool {
+	return r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '-' || r == '.' || r == '|')
+}
+
+func isCategoryRune(r rune) bool {
+	return r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '-')
+}
+
+func signOf(allow bool) string {
+	if allow {
+		return "+"
+	}
+	return "-"
+}
+
+func joinTokens(tokens []Token) string {
+	parts := make([]string, 0, len(tokens))
+	for _, t := range tokens {
+		parts = append(parts, t.String())
+	}
+	return strings.Join(parts, " ")
+}
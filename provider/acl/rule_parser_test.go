@@ -0,0 +1,128 @@
+package acl
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitParseRule_Valid(t *testing.T) {
+	tests := []struct {
+		rule       string
+		normalized string
+	}{
+		{"+@all", "+@all"},
+		{"+@read ~cache:*", "+@read ~cache:*"},
+		{"+@all -@dangerous ~*", "+@all -@dangerous ~*"},
+		{"  +@READ   -FLUSHALL\t~*  ", "+@read -flushall ~*"},
+		{"+config|get -config|set", "+config|get -config|set"},
+		{"+json.get +ft.search", "+json.get +ft.search"},
+		{"%R~ro:* %W~wo:* %RW~rw:*", "%R~ro:* %W~wo:* ~rw:*"},
+		{"%wr~both:*", "~both:*"},
+		{"&notifications:*", "&notifications:*"},
+		{"allkeys allchannels allcommands", "~* &* +@all"},
+		{"nocommands resetkeys resetchannels", "-@all resetkeys resetchannels"},
+		{"ALLKEYS", "~*"},
+		{"+@read ~app:* (+set ~secondary:*)", "+@read ~app:* (+set ~secondary:*)"},
+		{"+@read ( +set  %W~secondary:* )", "+@read (+set %W~secondary:*)"},
+		{"(+get ~a:*) (+set ~b:*)", "(+get ~a:*) (+set ~b:*)"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.rule, func(t *testing.T) {
+			normalized, err := NormalizeRule(test.rule)
+			require.NoError(t, err)
+			assert.Equal(t, test.normalized, normalized)
+
+			// Normalising is idempotent
+			again, err := NormalizeRule(normalized)
+			require.NoError(t, err)
+			assert.Equal(t, normalized, again)
+		})
+	}
+}
+
+func TestUnitParseRule_Invalid(t *testing.T) {
+	tests := []struct {
+		rule   string
+		offset int
+	}{
+		{"", 0},
+		{"   ", 0},
+		{"+@read foo", 7},
+		{"+@", 2},
+		{"+@re*d", 4},
+		{"+", 1},
+		{"+get@", 4},
+		{"+config||get", 8},
+		{"+|get", 1},
+		{"~", 1},
+		{"~* &", 4},
+		{"%X~foo", 1},
+		{"%R", 2},
+		{"%~foo", 1},
+		{"%R~", 3},
+		{"+@read on", 7},
+		{"+@read >secret", 7},
+		{"reset +@all", 0},
+		{"+@read (+get ~a:*", 7},
+		{"+@read ()", 7},
+		{"+@read (+get (+set))", 13},
+		{"+@read ) ~*", 7},
+		{"(+get ~a:* foo)", 11},
+	}
+
+	for _, test := range tests {
+		t.Run(test.rule, func(t *testing.T) {
+			_, err := ParseRule(test.rule)
+			require.Error(t, err)
+
+			var syntaxErr *SyntaxError
+			require.True(t, errors.As(err, &syntaxErr), "expected a SyntaxError, got %T", err)
+			assert.Equal(t, test.offset, syntaxErr.Offset, "%s", syntaxErr)
+		})
+	}
+}
+
+func TestUnitParseRule_Tokens(t *testing.T) {
+	rule, err := ParseRule("+@read -del %R~ro:* &chan (+set ~b:*)")
+	require.NoError(t, err)
+	require.Len(t, rule.Tokens, 5)
+
+	assert.Equal(t, TokenCategory, rule.Tokens[0].Kind)
+	assert.True(t, rule.Tokens[0].Allow)
+	assert.Equal(t, "read", rule.Tokens[0].Name)
+
+	assert.Equal(t, TokenCommand, rule.Tokens[1].Kind)
+	assert.False(t, rule.Tokens[1].Allow)
+	assert.Equal(t, 7, rule.Tokens[1].Offset)
+
+	assert.Equal(t, TokenKeyPattern, rule.Tokens[2].Kind)
+	assert.Equal(t, KeyPermissionRead, rule.Tokens[2].Permission)
+	assert.Equal(t, "ro:*", rule.Tokens[2].Pattern)
+
+	assert.Equal(t, TokenChannelPattern, rule.Tokens[3].Kind)
+	assert.Equal(t, "chan", rule.Tokens[3].Pattern)
+
+	assert.Equal(t, TokenSelector, rule.Tokens[4].Kind)
+	require.Len(t, rule.Tokens[4].Selector, 2)
+	assert.Equal(t, 27, rule.Tokens[4].Selector[0].Offset)
+}
+
+func TestUnitRulesEquivalent(t *testing.T) {
+	assert.True(t, RulesEquivalent("+@read ~*", "+@READ  allkeys"))
+	assert.True(t, RulesEquivalent("%RW~a:*", "~a:*"))
+	assert.False(t, RulesEquivalent("+@read ~*", "~* +@read"))
+	assert.False(t, RulesEquivalent("+@read", "+@write"))
+	assert.False(t, RulesEquivalent("+@read foo", "+@read  foo"))
+	assert.True(t, RulesEquivalent("+@read foo", "+@read foo"))
+}
+
+func TestUnitSyntaxErrorDetail(t *testing.T) {
+	_, err := ParseRule("+@read %X~a")
+	var syntaxErr *SyntaxError
+	require.True(t, errors.As(err, &syntaxErr))
+	assert.Equal(t, "Invalid ACL rule at character 8: invalid key permission 'X', expected R, W or RW\n\n  +@read %X~a\n          ^", syntaxErr.Detail("+@read %X~a"))
+}
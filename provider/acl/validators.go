@@ -0,0 +1,38 @@
+package acl
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// ruleSyntaxValidator checks a rule against the Redis ACL grammar at plan time, pointing at the offending
+// character rather than waiting for the API to reject the rule.
+type ruleSyntaxValidator struct{}
+
+var _ validator.String = ruleSyntaxValidator{}
+
+func (v ruleSyntaxValidator) Description(_ context.Context) string {
+	return "value must be a valid Redis ACL rule"
+}
+
+func (v ruleSyntaxValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v ruleSyntaxValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	rule := req.ConfigValue.ValueString()
+	if _, err := ParseRule(rule); err != nil {
+		detail := err.Error()
+		var syntaxErr *SyntaxError
+		if errors.As(err, &syntaxErr) {
+			detail = syntaxErr.Detail(rule)
+		}
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid ACL rule syntax", detail)
+	}
+}
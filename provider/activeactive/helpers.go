@@ -2,12 +2,9 @@ package activeactive
 
 import (
 	"context"
-	"errors"
 	"fmt"
-	"log"
 	"strconv"
 	"strings"
-	"time"
 
 	"github.com/RedisLabs/rediscloud-go-api/redis"
 	"github.com/RedisLabs/rediscloud-go-api/service/databases"
@@ -15,17 +12,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 
-	"github.com/RedisLabs/terraform-provider-rediscloud/provider/client"
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/utils"
 )
 
-// Default source IP for public access (when public_endpoint_access is true).
-const defaultPublicSourceIP = "0.0.0.0/0"
-
-// Default RFC1918 private IP ranges used when public_endpoint_access is false.
-var defaultPrivateIPRanges = []string{"10.0.0.0/8", "172.16.0.0/12", "192.168.0.0/16", "100.64.0.0/10"}
-
 // parseResourceId parses a resource ID in the format "subId/dbId" and returns the components.
 func parseResourceId(id string) (subId int, dbId int, err error) {
 	parts := strings.Split(id, "/")
@@ -48,37 +38,6 @@ func buildResourceId(subId, dbId int) string {
 	return fmt.Sprintf("%d/%d", subId, dbId)
 }
 
-// isDefaultGlobalSourceIPs checks if the given source IPs match one of the default patterns:
-// - RFC1918 private ranges (when public_endpoint_access is false)
-// - Public access "0.0.0.0/0" (when public_endpoint_access is true)
-// This is used to determine if global_source_ips should be re-computed when public_endpoint_access changes.
-func isDefaultGlobalSourceIPs(sourceIPs []string) bool {
-	if len(sourceIPs) == 0 {
-		return true
-	}
-
-	// Check if it's the default public access pattern
-	if len(sourceIPs) == 1 && sourceIPs[0] == defaultPublicSourceIP {
-		return true
-	}
-
-	// Check if it matches the RFC1918 private ranges
-	if len(sourceIPs) == len(defaultPrivateIPRanges) {
-		privateIPSet := make(map[string]bool)
-		for _, ip := range defaultPrivateIPRanges {
-			privateIPSet[ip] = true
-		}
-		for _, ip := range sourceIPs {
-			if !privateIPSet[ip] {
-				return false
-			}
-		}
-		return true
-	}
-
-	return false
-}
-
 // flattenModulesToNames converts a slice of Module objects to a slice of module names.
 func flattenModulesToNames(modules []*databases.Module) []string {
 	moduleNames := make([]string, 0, len(modules))
@@ -163,77 +122,16 @@ func mapToStringMap(ctx context.Context, m types.Map) (map[string]string, diag.D
 	return result, diags
 }
 
-// buildAlertsFromSet converts a types.Set of alerts to the API request format.
-func buildAlertsFromSet(ctx context.Context, alertSet types.Set) ([]*databases.Alert, diag.Diagnostics) {
-	if alertSet.IsNull() || alertSet.IsUnknown() {
-		return []*databases.Alert{}, nil
-	}
-
-	var alertModels []AlertModel
-	diags := alertSet.ElementsAs(ctx, &alertModels, false)
-	if diags.HasError() {
-		return nil, diags
-	}
-
-	alerts := make([]*databases.Alert, 0, len(alertModels))
-	for _, alert := range alertModels {
-		alerts = append(alerts, &databases.Alert{
-			Name:  redis.String(alert.Name.ValueString()),
-			Value: redis.Int(int(alert.Value.ValueInt64())),
-		})
-	}
-
-	return alerts, nil
-}
-
-// flattenAlertsToSet converts API alert responses to a types.Set.
-func flattenAlertsToSet(alerts []*databases.Alert) (types.Set, diag.Diagnostics) {
-	alertAttrTypes := map[string]attr.Type{
-		"name":  types.StringType,
-		"value": types.Int64Type,
-	}
-
-	if len(alerts) == 0 {
-		return types.SetNull(types.ObjectType{AttrTypes: alertAttrTypes}), nil
-	}
-
-	elements := make([]attr.Value, 0, len(alerts))
-	for _, alert := range alerts {
-		obj, diags := types.ObjectValue(alertAttrTypes, map[string]attr.Value{
-			"name":  types.StringValue(redis.StringValue(alert.Name)),
-			"value": types.Int64Value(int64(redis.IntValue(alert.Value))),
-		})
-		if diags.HasError() {
-			return types.SetNull(types.ObjectType{AttrTypes: alertAttrTypes}), diags
-		}
-		elements = append(elements, obj)
-	}
-
-	return types.SetValue(types.ObjectType{AttrTypes: alertAttrTypes}, elements)
-}
-
 // getOverrideRegionAttrTypes returns the attribute types for the override_region block.
 func getOverrideRegionAttrTypes() map[string]attr.Type {
-	alertAttrTypes := map[string]attr.Type{
-		"name":  types.StringType,
-		"value": types.Int64Type,
-	}
-
-	remoteBackupAttrTypes := map[string]attr.Type{
-		"interval":     types.StringType,
-		"time_utc":     types.StringType,
-		"storage_type": types.StringType,
-		"storage_path": types.StringType,
-	}
-
 	return map[string]attr.Type{
 		"name":                             types.StringType,
-		"override_global_alert":            types.SetType{ElemType: types.ObjectType{AttrTypes: alertAttrTypes}},
+		"override_global_alert":            types.SetType{ElemType: types.ObjectType{AttrTypes: utils.AlertAttrTypes()}},
 		"override_global_password":         types.StringType,
 		"override_global_source_ips":       types.SetType{ElemType: types.StringType},
 		"override_global_data_persistence": types.StringType,
 		"enable_default_user":              types.BoolType,
-		"remote_backup":                    types.ListType{ElemType: types.ObjectType{AttrTypes: remoteBackupAttrTypes}},
+		"remote_backup":                    types.ListType{ElemType: types.ObjectType{AttrTypes: utils.RemoteBackupAttrTypes()}},
 	}
 }
 
@@ -258,13 +156,13 @@ func getOverrideRegionFromSet(ctx context.Context, overrideRegionSet types.Set,
 	return nil, nil
 }
 
-// buildBackupPlan converts a RemoteBackupModel to the API request format.
+// buildBackupPlan converts a utils.RemoteBackupModel to the API request format.
 func buildBackupPlan(ctx context.Context, remoteBackupList types.List) (*databases.DatabaseBackupConfig, diag.Diagnostics) {
 	if remoteBackupList.IsNull() || remoteBackupList.IsUnknown() || len(remoteBackupList.Elements()) == 0 {
 		return nil, nil
 	}
 
-	var backups []RemoteBackupModel
+	var backups []utils.RemoteBackupModel
 	diags := remoteBackupList.ElementsAs(ctx, &backups, false)
 	if diags.HasError() {
 		return nil, diags
@@ -292,12 +190,7 @@ func buildBackupPlan(ctx context.Context, remoteBackupList types.List) (*databas
 // flattenBackupPlan converts API backup response to a types.List for remote_backup.
 // Note: The API doesn't return storage_type, so we preserve it from state if available.
 func flattenBackupPlan(backup *databases.Backup, stateStorageType string) (types.List, diag.Diagnostics) {
-	remoteBackupAttrTypes := map[string]attr.Type{
-		"interval":     types.StringType,
-		"time_utc":     types.StringType,
-		"storage_type": types.StringType,
-		"storage_path": types.StringType,
-	}
+	remoteBackupAttrTypes := utils.RemoteBackupAttrTypes()
 
 	if backup == nil || !redis.BoolValue(backup.Enabled) {
 		return types.ListNull(types.ObjectType{AttrTypes: remoteBackupAttrTypes}), nil
@@ -324,37 +217,6 @@ func flattenBackupPlan(backup *databases.Backup, stateStorageType string) (types
 	return types.ListValue(types.ObjectType{AttrTypes: remoteBackupAttrTypes}, []attr.Value{obj})
 }
 
-// waitForDatabaseToBeDeleted waits for the database to be deleted using retry.StateChangeConf.
-func waitForDatabaseToBeDeleted(ctx context.Context, subId, dbId int, api *client.ApiClient) error {
-	wait := &retry.StateChangeConf{
-		Delay:        30 * time.Second,
-		Pending:      []string{"pending"},
-		Target:       []string{"deleted"},
-		Timeout:      10 * time.Minute,
-		PollInterval: 30 * time.Second,
-
-		Refresh: func() (result interface{}, state string, err error) {
-			log.Printf("[DEBUG] Waiting for database %d to be deleted", dbId)
-
-			_, err = api.Client.Database.Get(ctx, subId, dbId)
-			if err != nil {
-				notFound := &databases.NotFound{}
-				if errors.As(err, &notFound) {
-					return "deleted", "deleted", nil
-				}
-				return nil, "", err
-			}
-
-			return "pending", "pending", nil
-		},
-	}
-	if _, err := wait.WaitForStateContext(ctx); err != nil {
-		return err
-	}
-
-	return nil
-}
-
 // stringPtrSlice converts a slice of strings to a slice of string pointers.
 func stringPtrSlice(slice []string) []*string {
 	if slice == nil {
@@ -385,7 +247,7 @@ func getAlertsFromOverrideRegion(ctx context.Context, region *OverrideRegionMode
 		return nil, nil
 	}
 
-	return buildAlertsFromSet(ctx, region.OverrideGlobalAlert)
+	return utils.BuildAlerts(ctx, region.OverrideGlobalAlert)
 }
 
 // boolPtrValue safely gets a bool value from a pointer, returning the default if nil.
@@ -0,0 +1,107 @@
+package activeactive
+
+import (
+	"context"
+
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/RedisLabs/rediscloud-go-api/service/subscriptions"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/utils"
+)
+
+var (
+	_ list.ListResource              = &activeActiveDatabaseListResource{}
+	_ list.ListResourceWithConfigure = &activeActiveDatabaseListResource{}
+)
+
+// activeActiveDatabaseListResource lists the active-active databases of the account. It shares
+// the type name, client and read logic of the managed resource.
+type activeActiveDatabaseListResource struct {
+	activeActiveDatabaseResource
+}
+
+// activeActiveDatabaseListModel describes the list resource configuration.
+type activeActiveDatabaseListModel struct {
+	SubscriptionID types.Int64 `tfsdk:"subscription_id"`
+}
+
+// NewActiveActiveDatabaseListResource returns a new list resource instance.
+func NewActiveActiveDatabaseListResource() list.ListResource {
+	return &activeActiveDatabaseListResource{}
+}
+
+// ListResourceConfigSchema defines the filters accepted by the list resource.
+func (r *activeActiveDatabaseListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		Description: "Lists the databases of the account's Active-Active subscriptions",
+		Attributes: map[string]listschema.Attribute{
+			"subscription_id": listschema.Int64Attribute{
+				Description: "Only list the databases of this subscription. When omitted, every Active-Active subscription is listed",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// List streams one result per active-active database.
+func (r *activeActiveDatabaseListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config activeActiveDatabaseListModel
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		subs, err := utils.ListSubscriptions(ctx, r.client, subscriptions.SubscriptionDeploymentTypeActiveActive, int(config.SubscriptionID.ValueInt64()))
+		if err != nil {
+			result := list.ListResult{}
+			result.Diagnostics.AddError("Failed to list subscriptions", err.Error())
+			push(result)
+			return
+		}
+
+		var count int64
+		for _, sub := range subs {
+			subId := redis.IntValue(sub.ID)
+			dbs := r.client.Client.Database.ListActiveActive(ctx, subId)
+			for dbs.Next() {
+				if req.Limit > 0 && count >= req.Limit {
+					return
+				}
+				count++
+
+				db := dbs.Value()
+				dbId := redis.IntValue(db.ID)
+				model := ActiveActiveDatabaseModel{
+					ID:             types.StringValue(buildResourceId(subId, dbId)),
+					SubscriptionID: types.Int64Value(int64(subId)),
+					DbID:           types.Int64Value(int64(dbId)),
+				}
+
+				result := req.NewListResult(ctx)
+				result.DisplayName = redis.StringValue(db.Name)
+				setIdentity(ctx, result.Identity, &model, &result.Diagnostics)
+
+				if req.IncludeResource && !result.Diagnostics.HasError() {
+					if removed := r.readDatabase(ctx, &model, &result.Diagnostics); !removed && !result.Diagnostics.HasError() {
+						result.Diagnostics.Append(result.Resource.Set(ctx, model)...)
+					}
+				}
+
+				if !push(result) {
+					return
+				}
+			}
+			if err := dbs.Err(); err != nil {
+				result := list.ListResult{}
+				result.Diagnostics.AddError("Failed to list databases", err.Error())
+				push(result)
+				return
+			}
+		}
+	}
+}
@@ -3,16 +3,19 @@ package activeactive
 import (
 	"context"
 	"fmt"
+	"log"
 	"strings"
 	"time"
 
 	"github.com/RedisLabs/rediscloud-go-api/service/databases"
+	"github.com/RedisLabs/rediscloud-go-api/service/subscriptions"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
@@ -23,9 +26,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/RedisLabs/terraform-provider-rediscloud/provider/client"
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/utils"
 )
 
 var (
@@ -33,6 +38,7 @@ var (
 	_ resource.ResourceWithConfigure   = &activeActiveDatabaseResource{}
 	_ resource.ResourceWithImportState = &activeActiveDatabaseResource{}
 	_ resource.ResourceWithModifyPlan  = &activeActiveDatabaseResource{}
+	_ resource.ResourceWithIdentity    = &activeActiveDatabaseResource{}
 )
 
 // activeActiveDatabaseResource is the resource implementation.
@@ -132,7 +138,7 @@ func (r *activeActiveDatabaseResource) Schema(_ context.Context, _ resource.Sche
 				Description: "Defines the hour automatic backups are made - only applicable when interval is `every-12-hours` or `every-24-hours`",
 				Optional:    true,
 				Validators: []validator.String{
-					TimeValidator(),
+					utils.TimeValidator(),
 				},
 			},
 			"storage_type": schema.StringAttribute{
@@ -180,7 +186,7 @@ func (r *activeActiveDatabaseResource) Schema(_ context.Context, _ resource.Sche
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
-					StringLengthBetween(0, 40),
+					utils.StringLengthBetween(0, 40),
 				},
 			},
 			"memory_limit_in_gb": schema.Float64Attribute{
@@ -285,7 +291,7 @@ func (r *activeActiveDatabaseResource) Schema(_ context.Context, _ resource.Sche
 				Description: "The initial RESP version for all databases provisioned under this AA database. This information is only used when creating a new database and any changes will be ignored after this.",
 				Optional:    true,
 				Validators: []validator.String{
-					RespVersionValidator(),
+					utils.RespVersionValidator(),
 				},
 			},
 			"global_modules": schema.ListAttribute{
@@ -320,7 +326,7 @@ func (r *activeActiveDatabaseResource) Schema(_ context.Context, _ resource.Sche
 					int64planmodifier.RequiresReplace(),
 				},
 				Validators: []validator.Int64{
-					PortRangeValidator(),
+					utils.PortRangeValidator(),
 				},
 			},
 			"tags": schema.MapAttribute{
@@ -328,6 +334,14 @@ func (r *activeActiveDatabaseResource) Schema(_ context.Context, _ resource.Sche
 				Optional:    true,
 				ElementType: types.StringType,
 			},
+			utils.DeletionProtectionKey: utils.DeletionProtectionAttribute(),
+			utils.CreationPendingKey: schema.BoolAttribute{
+				Description: utils.CreationPendingDescription,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					utils.ResumeCreateModifier(),
+				},
+			},
 		},
 		Blocks: map[string]schema.Block{
 			"global_alert": schema.SetNestedBlock{
@@ -380,6 +394,22 @@ func (r *activeActiveDatabaseResource) Schema(_ context.Context, _ resource.Sche
 	}
 }
 
+// IdentitySchema defines the resource identity: the subscription and database identifiers.
+func (r *activeActiveDatabaseResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"subscription_id": identityschema.Int64Attribute{
+				Description:       "Identifier of the subscription the database belongs to",
+				RequiredForImport: true,
+			},
+			"db_id": identityschema.Int64Attribute{
+				Description:       "Identifier of the database",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 // ModifyPlan implements custom plan modification logic.
 func (r *activeActiveDatabaseResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	// If the plan is null (resource is being destroyed), skip validation
@@ -439,7 +469,7 @@ func (r *activeActiveDatabaseResource) ModifyPlan(ctx context.Context, req resou
 				continue
 			}
 
-			var backups []RemoteBackupModel
+			var backups []utils.RemoteBackupModel
 			diags := region.RemoteBackup.ElementsAs(ctx, &backups, false)
 			resp.Diagnostics.Append(diags...)
 			if resp.Diagnostics.HasError() {
@@ -532,20 +562,49 @@ func (r *activeActiveDatabaseResource) ModifyPlan(ctx context.Context, req resou
 
 // ImportState imports an existing resource.
 func (r *activeActiveDatabaseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Parse the import ID (expected format: subscription_id/db_id)
-	subId, dbId, err := parseResourceId(req.ID)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Invalid import ID",
-			fmt.Sprintf("Expected import ID in format 'subscription_id/db_id', got: %s. Error: %s", req.ID, err.Error()),
-		)
-		return
+	var subId, dbId int
+	if req.ID == "" && req.Identity != nil {
+		// Importing with an identity block rather than an import ID
+		var identity utils.DatabaseIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		subId, dbId = int(identity.SubscriptionID.ValueInt64()), int(identity.DbID.ValueInt64())
+	} else {
+		id := req.ID
+		if name, ok := utils.IsImportByName(id); ok {
+			// Importing with name=<subscription name>/<database name>
+			resolved, err := utils.DatabaseNameResolver(subscriptions.SubscriptionDeploymentTypeActiveActive)(ctx, r.client, name)
+			if err != nil {
+				resp.Diagnostics.AddError("Failed to import by name", err.Error())
+				return
+			}
+			id = resolved
+		}
+
+		// Parse the import ID (expected format: subscription_id/db_id)
+		var err error
+		subId, dbId, err = parseResourceId(id)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid import ID",
+				fmt.Sprintf("Expected import ID in format 'subscription_id/db_id' or 'name=<subscription name>/<database name>', got: %s. Error: %s", req.ID, err.Error()),
+			)
+			return
+		}
 	}
 
 	// Set the ID and required fields
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), buildResourceId(subId, dbId))...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("subscription_id"), int64(subId))...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("db_id"), int64(dbId))...)
+	if resp.Identity != nil {
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, utils.DatabaseIdentityModel{
+			SubscriptionID: types.Int64Value(int64(subId)),
+			DbID:           types.Int64Value(int64(dbId)),
+		})...)
+	}
 }
 
 // Create implements resource creation.
@@ -566,6 +625,14 @@ func (r *activeActiveDatabaseResource) Create(ctx context.Context, req resource.
 	// Set the state
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	if plan.CreationPending.ValueBool() {
+		// The computed values can't be read back until the create is resumed.
+		if err := utils.NullUnknownValues(&resp.State); err != nil {
+			resp.Diagnostics.AddError("Failed to save pending database", err.Error())
+			return
+		}
+	}
+	setIdentity(ctx, resp.Identity, &plan, &resp.Diagnostics)
 }
 
 // Read implements resource reading.
@@ -591,6 +658,7 @@ func (r *activeActiveDatabaseResource) Read(ctx context.Context, req resource.Re
 	// Set the state
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.Identity, &state, &resp.Diagnostics)
 }
 
 // Update implements resource updating.
@@ -613,6 +681,21 @@ func (r *activeActiveDatabaseResource) Update(ctx context.Context, req resource.
 	plan.ID = state.ID
 	plan.DbID = state.DbID
 
+	// Resume a create which was interrupted, keeping the database pending until it is active
+	if state.CreationPending.ValueBool() {
+		subId, dbId, err := parseResourceId(state.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid resource ID", err.Error())
+			return
+		}
+		log.Printf("[INFO] Resuming the interrupted create of database %d", dbId)
+		if err := r.waitForDatabaseCreate(ctx, subId, dbId); err != nil {
+			resp.Diagnostics.AddError("Database failed to become active", err.Error())
+			resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+			return
+		}
+	}
+
 	// Call the CRUD implementation
 	r.updateDatabase(ctx, &plan, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
@@ -628,6 +711,7 @@ func (r *activeActiveDatabaseResource) Update(ctx context.Context, req resource.
 	// Set the state
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	setIdentity(ctx, resp.Identity, &plan, &resp.Diagnostics)
 }
 
 // Delete implements resource deletion.
@@ -643,6 +727,17 @@ func (r *activeActiveDatabaseResource) Delete(ctx context.Context, req resource.
 	r.deleteDatabase(ctx, &state, &resp.Diagnostics)
 }
 
+// setIdentity records the resource identity from the model, when the identity is supported.
+func setIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, model *ActiveActiveDatabaseModel, diagnostics *diag.Diagnostics) {
+	if identity == nil || diagnostics.HasError() {
+		return
+	}
+	diagnostics.Append(identity.Set(ctx, utils.DatabaseIdentityModel{
+		SubscriptionID: model.SubscriptionID,
+		DbID:           model.DbID,
+	})...)
+}
+
 // timeouts returns the resource timeouts.
 func (r *activeActiveDatabaseResource) timeouts() map[string]time.Duration {
 	return map[string]time.Duration{
@@ -653,24 +748,6 @@ func (r *activeActiveDatabaseResource) timeouts() map[string]time.Duration {
 	}
 }
 
-// getAlertAttrTypes returns the attribute types for alert objects.
-func getAlertAttrTypes() map[string]attr.Type {
-	return map[string]attr.Type{
-		"name":  types.StringType,
-		"value": types.Int64Type,
-	}
-}
-
-// getRemoteBackupAttrTypes returns the attribute types for remote_backup objects.
-func getRemoteBackupAttrTypes() map[string]attr.Type {
-	return map[string]attr.Type{
-		"interval":     types.StringType,
-		"time_utc":     types.StringType,
-		"storage_type": types.StringType,
-		"storage_path": types.StringType,
-	}
-}
-
 // overrideRegionHasConfig checks if an override region block has any configuration set
 // beyond just the name (which is always required).
 func overrideRegionHasConfig(region OverrideRegionModel) bool {
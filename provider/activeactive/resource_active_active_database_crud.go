@@ -3,6 +3,7 @@ package activeactive
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
 
 	"github.com/RedisLabs/rediscloud-go-api/redis"
@@ -19,14 +20,18 @@ import (
 func (r *activeActiveDatabaseResource) createDatabase(ctx context.Context, plan *ActiveActiveDatabaseModel, diagnostics *diag.Diagnostics) {
 	subId := int(plan.SubscriptionID.ValueInt64())
 
-	// Acquire subscription mutex
-	utils.SubscriptionMutex.Lock(subId)
+	// Take this change's turn on the subscription
+	change, err := utils.DatabaseChanges.Begin(ctx, r.client, subId)
+	if err != nil {
+		diagnostics.AddError("Failed to lock subscription", err.Error())
+		return
+	}
+	defer change.End()
 
 	// Build alerts from plan
-	alerts, diags := buildAlertsFromSet(ctx, plan.GlobalAlert)
+	alerts, diags := utils.BuildAlerts(ctx, plan.GlobalAlert)
 	diagnostics.Append(diags...)
 	if diagnostics.HasError() {
-		utils.SubscriptionMutex.Unlock(subId)
 		return
 	}
 
@@ -34,7 +39,6 @@ func (r *activeActiveDatabaseResource) createDatabase(ctx context.Context, plan
 	modules, diags := listToStringSlice(ctx, plan.GlobalModules)
 	diagnostics.Append(diags...)
 	if diagnostics.HasError() {
-		utils.SubscriptionMutex.Unlock(subId)
 		return
 	}
 
@@ -47,14 +51,12 @@ func (r *activeActiveDatabaseResource) createDatabase(ctx context.Context, plan
 	sourceIPs, diags := setToStringSlice(ctx, plan.GlobalSourceIPs)
 	diagnostics.Append(diags...)
 	if diagnostics.HasError() {
-		utils.SubscriptionMutex.Unlock(subId)
 		return
 	}
 
 	// Get regions from subscription to set up local throughputs
 	regions, err := r.client.Client.Regions.List(ctx, subId)
 	if err != nil {
-		utils.SubscriptionMutex.Unlock(subId)
 		diagnostics.AddError("Failed to get subscription regions", err.Error())
 		return
 	}
@@ -114,8 +116,7 @@ func (r *activeActiveDatabaseResource) createDatabase(ctx context.Context, plan
 	}
 
 	// Wait for subscription to be active before creating database
-	if err := utils.WaitForSubscriptionToBeActive(ctx, subId, r.client); err != nil {
-		utils.SubscriptionMutex.Unlock(subId)
+	if err := change.WaitForSubscriptionToBeActive(ctx, r.client); err != nil {
 		diagnostics.AddError("Subscription not active", err.Error())
 		return
 	}
@@ -123,7 +124,6 @@ func (r *activeActiveDatabaseResource) createDatabase(ctx context.Context, plan
 	// Create the database
 	dbId, err := r.client.Client.Database.ActiveActiveCreate(ctx, subId, createDatabase)
 	if err != nil {
-		utils.SubscriptionMutex.Unlock(subId)
 		diagnostics.AddError("Failed to create database", err.Error())
 		return
 	}
@@ -132,22 +132,22 @@ func (r *activeActiveDatabaseResource) createDatabase(ctx context.Context, plan
 	plan.ID = types.StringValue(buildResourceId(subId, dbId))
 	plan.DbID = types.Int64Value(int64(dbId))
 
-	// Wait for database to be active
-	if err := utils.WaitForDatabaseToBeActive(ctx, subId, dbId, r.client); err != nil {
-		utils.SubscriptionMutex.Unlock(subId)
+	// Wait for database and subscription to be active. If the apply is interrupted meanwhile, the database is saved
+	// as pending, for the next apply to resume waiting for it.
+	if err := r.waitForDatabaseCreate(ctx, subId, dbId); err != nil {
+		if utils.CreateInterrupted(ctx, err) {
+			plan.CreationPending = types.BoolValue(true)
+			warning := utils.CreationPendingWarning(fmt.Sprintf("Database %d", dbId), err)
+			diagnostics.AddWarning(warning.Summary, warning.Detail)
+			return
+		}
 		diagnostics.AddError("Database failed to become active", err.Error())
 		return
 	}
+	plan.CreationPending = types.BoolValue(false)
 
-	// Wait for subscription to be active
-	if err := utils.WaitForSubscriptionToBeActive(ctx, subId, r.client); err != nil {
-		utils.SubscriptionMutex.Unlock(subId)
-		diagnostics.AddError("Subscription failed to become active", err.Error())
-		return
-	}
-
-	// Release mutex before update (update will acquire it again)
-	utils.SubscriptionMutex.Unlock(subId)
+	// End this change before the update, which takes another turn
+	change.End()
 
 	// Some attributes on a database are not accessible by the create API.
 	// Run the update function to apply any additional changes.
@@ -160,6 +160,14 @@ func (r *activeActiveDatabaseResource) createDatabase(ctx context.Context, plan
 	r.readDatabase(ctx, plan, diagnostics)
 }
 
+// waitForDatabaseCreate waits for a new database, and then its subscription, to be active.
+func (r *activeActiveDatabaseResource) waitForDatabaseCreate(ctx context.Context, subId int, dbId int) error {
+	if err := utils.WaitForDatabaseToBeActive(ctx, subId, dbId, r.client); err != nil {
+		return err
+	}
+	return utils.WaitForSubscriptionToBeActive(ctx, subId, r.client)
+}
+
 // readDatabase implements the Read operation for the active-active database resource.
 // Returns true if the resource was removed (not found).
 func (r *activeActiveDatabaseResource) readDatabase(ctx context.Context, state *ActiveActiveDatabaseModel, diagnostics *diag.Diagnostics) bool {
@@ -190,6 +198,7 @@ func (r *activeActiveDatabaseResource) readDatabase(ctx context.Context, state *
 	state.SubscriptionID = types.Int64Value(int64(subId))
 	state.DbID = types.Int64Value(int64(redis.IntValue(db.ID)))
 	state.Name = types.StringValue(redis.StringValue(db.Name))
+	state.DeletionProtection = utils.DeletionProtectionValue(state.DeletionProtection)
 	state.DataEviction = types.StringValue(redis.StringValue(db.DataEvictionPolicy))
 	state.SupportOssClusterAPI = types.BoolValue(redis.BoolValue(db.SupportOSSClusterAPI))
 	state.ExternalEndpointForOssClusterAPI = types.BoolValue(redis.BoolValue(db.UseExternalEndpointForOSSClusterAPI))
@@ -212,7 +221,7 @@ func (r *activeActiveDatabaseResource) readDatabase(ctx context.Context, state *
 	}
 
 	var globalSourceIPs []string
-	if !isDefaultGlobalSourceIPs(currentSourceIPs) {
+	if !utils.IsDefaultSourceIPs(currentSourceIPs) {
 		// User has a custom value - preserve it
 		globalSourceIPs = currentSourceIPs
 	} else {
@@ -228,9 +237,9 @@ func (r *activeActiveDatabaseResource) readDatabase(ctx context.Context, state *
 		}
 
 		if subscription.PublicEndpointAccess != nil && !*subscription.PublicEndpointAccess {
-			globalSourceIPs = defaultPrivateIPRanges
+			globalSourceIPs = utils.DefaultPrivateIPRanges
 		} else {
-			globalSourceIPs = []string{defaultPublicSourceIP}
+			globalSourceIPs = []string{utils.DefaultPublicSourceIP}
 		}
 	}
 
@@ -343,7 +352,7 @@ func ensureNoUnknownFields(state *ActiveActiveDatabaseModel) {
 		state.ClientTLSCertificates = types.ListNull(types.StringType)
 	}
 	if state.GlobalAlert.IsUnknown() {
-		state.GlobalAlert = types.SetNull(types.ObjectType{AttrTypes: getAlertAttrTypes()})
+		state.GlobalAlert = types.SetNull(types.ObjectType{AttrTypes: utils.AlertAttrTypes()})
 	}
 }
 
@@ -355,12 +364,16 @@ func (r *activeActiveDatabaseResource) updateDatabase(ctx context.Context, plan
 		return
 	}
 
-	// Acquire subscription mutex
-	utils.SubscriptionMutex.Lock(subId)
-	defer utils.SubscriptionMutex.Unlock(subId)
+	// Take this change's turn on the subscription
+	change, err := utils.DatabaseChanges.Begin(ctx, r.client, subId)
+	if err != nil {
+		diagnostics.AddError("Failed to lock subscription", err.Error())
+		return
+	}
+	defer change.End()
 
 	// Build alerts from plan
-	alerts, diags := buildAlertsFromSet(ctx, plan.GlobalAlert)
+	alerts, diags := utils.BuildAlerts(ctx, plan.GlobalAlert)
 	diagnostics.Append(diags...)
 	if diagnostics.HasError() {
 		return
@@ -397,7 +410,7 @@ func (r *activeActiveDatabaseResource) updateDatabase(ctx context.Context, plan
 	}
 
 	// Handle global_source_ips defaults based on subscription's public_endpoint_access setting
-	if isDefaultGlobalSourceIPs(globalSourceIPs) {
+	if utils.IsDefaultSourceIPs(globalSourceIPs) {
 		subscription, err := r.client.Client.Subscription.Get(ctx, subId)
 		if err != nil {
 			diagnostics.AddError("Failed to get subscription", err.Error())
@@ -405,9 +418,9 @@ func (r *activeActiveDatabaseResource) updateDatabase(ctx context.Context, plan
 		}
 
 		if subscription.PublicEndpointAccess != nil && !*subscription.PublicEndpointAccess {
-			update.GlobalSourceIP = stringPtrSlice(defaultPrivateIPRanges)
+			update.GlobalSourceIP = stringPtrSlice(utils.DefaultPrivateIPRanges)
 		} else {
-			update.GlobalSourceIP = []*string{redis.String(defaultPublicSourceIP)}
+			update.GlobalSourceIP = []*string{redis.String(utils.DefaultPublicSourceIP)}
 		}
 	}
 
@@ -465,24 +478,15 @@ func (r *activeActiveDatabaseResource) updateDatabase(ctx context.Context, plan
 		}
 	}
 
-	// Execute update
-	if err := r.client.Client.Database.ActiveActiveUpdate(ctx, subId, dbId, update); err != nil {
+	// Execute update, waiting for its task to complete. A failed task's error description is the diagnostic.
+	err = utils.WaitForTask(ctx, fmt.Sprintf("update database %d", dbId), func(ctx context.Context) error {
+		return r.client.Client.Database.ActiveActiveUpdate(ctx, subId, dbId, update)
+	}, utils.DatabaseFallback(subId, dbId, r.client))
+	if err != nil {
 		diagnostics.AddError("Failed to update database", err.Error())
 		return
 	}
 
-	// Wait for database to be active
-	if err := utils.WaitForDatabaseToBeActive(ctx, subId, dbId, r.client); err != nil {
-		diagnostics.AddError("Database failed to become active after update", err.Error())
-		return
-	}
-
-	// Wait for subscription to be active
-	if err := utils.WaitForSubscriptionToBeActive(ctx, subId, r.client); err != nil {
-		diagnostics.AddError("Subscription failed to become active after update", err.Error())
-		return
-	}
-
 	// Update tags using the tags service
 	// When tags is null (not in config), delete all tags by sending empty list
 	tagList := make([]*redisTags.Tag, 0)
@@ -515,9 +519,18 @@ func (r *activeActiveDatabaseResource) deleteDatabase(ctx context.Context, state
 		return
 	}
 
+	utils.CheckDeletionProtection(state.DeletionProtection, fmt.Sprintf("Database %d", dbId), diagnostics)
+	if diagnostics.HasError() {
+		return
+	}
+
 	// Acquire subscription mutex
-	utils.SubscriptionMutex.Lock(subId)
-	defer utils.SubscriptionMutex.Unlock(subId)
+	unlock, err := utils.LockSubscription(ctx, subId)
+	if err != nil {
+		diagnostics.AddError("Failed to lock subscription", err.Error())
+		return
+	}
+	defer unlock()
 
 	// Wait for database to be active before deletion
 	if err := utils.WaitForDatabaseToBeActive(ctx, subId, dbId, r.client); err != nil {
@@ -525,17 +538,16 @@ func (r *activeActiveDatabaseResource) deleteDatabase(ctx context.Context, state
 		return
 	}
 
-	// BUG FIX: Actually return the error instead of swallowing it
-	if err := r.client.Client.Database.Delete(ctx, subId, dbId); err != nil {
+	// Delete, waiting for its task to complete, or for the database to be gone if the task wasn't seen
+	err = utils.WaitForTask(ctx, fmt.Sprintf("delete database %d", dbId), func(ctx context.Context) error {
+		return r.client.Client.Database.Delete(ctx, subId, dbId)
+	}, func(ctx context.Context) error {
+		return utils.WaitForDatabaseToBeDeleted(ctx, subId, dbId, r.client)
+	})
+	if err != nil {
 		diagnostics.AddError("Failed to delete database", err.Error())
 		return
 	}
-
-	// Wait for deletion to complete
-	if err := waitForDatabaseToBeDeleted(ctx, subId, dbId, r.client); err != nil {
-		diagnostics.AddError("Database deletion failed", err.Error())
-		return
-	}
 }
 
 // buildRegionsFromPlan builds the regions list for the update request from the plan.
@@ -557,7 +569,7 @@ func (r *activeActiveDatabaseResource) buildRegionsFromPlan(ctx context.Context,
 	globalSourceIPs, diags := setToStringSlice(ctx, plan.GlobalSourceIPs)
 	allDiags.Append(diags...)
 
-	globalAlerts, diags := buildAlertsFromSet(ctx, plan.GlobalAlert)
+	globalAlerts, diags := utils.BuildAlerts(ctx, plan.GlobalAlert)
 	allDiags.Append(diags...)
 
 	if allDiags.HasError() {
@@ -581,7 +593,7 @@ func (r *activeActiveDatabaseResource) buildRegionsFromPlan(ctx context.Context,
 		}
 
 		// Build override alerts or use global alerts
-		overrideAlerts, diags := buildAlertsFromSet(ctx, region.OverrideGlobalAlert)
+		overrideAlerts, diags := utils.BuildAlerts(ctx, region.OverrideGlobalAlert)
 		allDiags.Append(diags...)
 		if len(overrideAlerts) > 0 {
 			regionProps.Alerts = &overrideAlerts
@@ -629,8 +641,8 @@ func (r *activeActiveDatabaseResource) buildRegionsFromPlan(ctx context.Context,
 func (r *activeActiveDatabaseResource) buildOverrideRegionFromAPI(ctx context.Context, db *databases.ActiveActiveDatabase, state *ActiveActiveDatabaseModel) (types.Set, diag.Diagnostics) {
 	var allDiags diag.Diagnostics
 
-	alertAttrTypes := getAlertAttrTypes()
-	remoteBackupAttrTypes := getRemoteBackupAttrTypes()
+	alertAttrTypes := utils.AlertAttrTypes()
+	remoteBackupAttrTypes := utils.RemoteBackupAttrTypes()
 	overrideRegionAttrTypes := map[string]attr.Type{
 		"name":                             types.StringType,
 		"override_global_alert":            types.SetType{ElemType: types.ObjectType{AttrTypes: alertAttrTypes}},
@@ -680,7 +692,7 @@ func (r *activeActiveDatabaseResource) buildOverrideRegionFromAPI(ctx context.Co
 		if stateRegion != nil && !stateRegion.OverrideGlobalSourceIPs.IsNull() && len(stateRegion.OverrideGlobalSourceIPs.Elements()) > 0 {
 			sourceIPs := stringSliceValue(regionDb.Security.SourceIPs)
 			// Filter out default source IPs
-			if !isDefaultGlobalSourceIPs(sourceIPs) {
+			if !utils.IsDefaultSourceIPs(sourceIPs) {
 				sourceIPSet, diags := stringSliceToSet(sourceIPs)
 				allDiags.Append(diags...)
 				regionConfig["override_global_source_ips"] = sourceIPSet
@@ -714,7 +726,7 @@ func (r *activeActiveDatabaseResource) buildOverrideRegionFromAPI(ctx context.Co
 
 		// Handle override_global_alert
 		if stateRegion != nil && !stateRegion.OverrideGlobalAlert.IsNull() && len(stateRegion.OverrideGlobalAlert.Elements()) > 0 {
-			alertSet, diags := flattenAlertsToSet(regionDb.Alerts)
+			alertSet, diags := utils.FlattenAlerts(regionDb.Alerts)
 			allDiags.Append(diags...)
 			regionConfig["override_global_alert"] = alertSet
 		} else {
@@ -743,7 +755,7 @@ func (r *activeActiveDatabaseResource) buildOverrideRegionFromAPI(ctx context.Co
 		// Get storage_type from state since API doesn't return it
 		stateStorageType := ""
 		if stateRegion != nil && !stateRegion.RemoteBackup.IsNull() && len(stateRegion.RemoteBackup.Elements()) > 0 {
-			var stateBackups []RemoteBackupModel
+			var stateBackups []utils.RemoteBackupModel
 			diags := stateRegion.RemoteBackup.ElementsAs(ctx, &stateBackups, false)
 			allDiags.Append(diags...)
 			if len(stateBackups) > 0 {
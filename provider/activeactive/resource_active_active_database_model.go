@@ -31,12 +31,8 @@ type ActiveActiveDatabaseModel struct {
 	PrivateEndpoint                  types.Map     `tfsdk:"private_endpoint"`
 	Port                             types.Int64   `tfsdk:"port"`
 	Tags                             types.Map     `tfsdk:"tags"`
-}
-
-// AlertModel describes the global_alert nested block.
-type AlertModel struct {
-	Name  types.String `tfsdk:"name"`
-	Value types.Int64  `tfsdk:"value"`
+	DeletionProtection               types.Bool    `tfsdk:"deletion_protection"`
+	CreationPending                  types.Bool    `tfsdk:"creation_pending"`
 }
 
 // OverrideRegionModel describes the override_region nested block.
@@ -49,11 +45,3 @@ type OverrideRegionModel struct {
 	EnableDefaultUser             types.Bool   `tfsdk:"enable_default_user"`
 	RemoteBackup                  types.List   `tfsdk:"remote_backup"`
 }
-
-// RemoteBackupModel describes the remote_backup nested block within override_region.
-type RemoteBackupModel struct {
-	Interval    types.String `tfsdk:"interval"`
-	TimeUTC     types.String `tfsdk:"time_utc"`
-	StorageType types.String `tfsdk:"storage_type"`
-	StoragePath types.String `tfsdk:"storage_path"`
-}
@@ -1,13 +1,80 @@
 package client
 
 import (
+	"context"
+	"fmt"
+	"net/http"
 	"os"
+	"sync"
 
 	rediscloudApi "github.com/RedisLabs/rediscloud-go-api"
+	"github.com/RedisLabs/rediscloud-go-api/service/account"
 )
 
 type ApiClient struct {
 	Client *rediscloudApi.Client
+	// BatchDatabaseChanges coalesces concurrent database creates and updates on the same subscription, as set by the
+	// provider's batch_database_changes option.
+	BatchDatabaseChanges bool
+	// MaxMonthlyCost is the highest monthly cost allowed for each subscription, as set by the provider's
+	// max_monthly_cost option, or 0 when it isn't set.
+	MaxMonthlyCost float64
+	// CheckAccountCIDROverlaps checks the deployment CIDRs of new subscriptions against those of the account's other
+	// subscriptions, as set by the provider's check_account_cidr_overlaps option.
+	CheckAccountCIDROverlaps bool
+
+	// regions caches the regions listed by Regions, which don't change while the provider runs.
+	regionsMu sync.Mutex
+	regions   []*account.Region
+
+	// regionNames caches the names of the regions of Active-Active subscriptions, by subscription and region ID. A
+	// region keeps its ID and name until it's deleted, and a recreated region gets a new ID.
+	regionNamesMu sync.Mutex
+	regionNames   map[int]map[int]string
+}
+
+// Regions returns the cloud provider regions available to the account, as listed by the rediscloud_regions data
+// source. The regions are only listed once per client, and a failed listing is retried by the next call.
+func (c *ApiClient) Regions(ctx context.Context) ([]*account.Region, error) {
+	c.regionsMu.Lock()
+	defer c.regionsMu.Unlock()
+
+	if c.regions == nil {
+		regions, err := c.Client.Account.ListRegions(ctx)
+		if err != nil {
+			return nil, err
+		}
+		c.regions = regions
+	}
+	return c.regions, nil
+}
+
+// RegionName returns the name of the region of an Active-Active subscription with the given ID, or an empty string if
+// the subscription has no such region. The regions of a subscription are listed again only when a region ID isn't
+// one of those listed before.
+func (c *ApiClient) RegionName(ctx context.Context, subId int, regionId int) (string, error) {
+	c.regionNamesMu.Lock()
+	defer c.regionNamesMu.Unlock()
+
+	if name, ok := c.regionNames[subId][regionId]; ok {
+		return name, nil
+	}
+
+	list, err := c.Client.Regions.List(ctx, subId)
+	if err != nil {
+		return "", err
+	}
+	names := make(map[int]string)
+	for _, region := range list.Regions {
+		if region.RegionId != nil && region.Region != nil {
+			names[*region.RegionId] = *region.Region
+		}
+	}
+	if c.regionNames == nil {
+		c.regionNames = make(map[int]map[int]string)
+	}
+	c.regionNames[subId] = names
+	return names[regionId], nil
 }
 
 // NewClient creates a new ApiClient using environment variables for configuration.
@@ -20,6 +87,12 @@ func NewClient() (*ApiClient, error) {
 		config = append(config, rediscloudApi.BaseURL(url))
 	}
 
+	transport, err := NewTransport()
+	if err != nil {
+		return nil, err
+	}
+	config = append(config, rediscloudApi.Transporter(transport))
+
 	client, err := rediscloudApi.NewClient(config...)
 	if err != nil {
 		return nil, err
@@ -29,3 +102,28 @@ func NewClient() (*ApiClient, error) {
 		Client: client,
 	}, nil
 }
+
+// NewTransport returns the RoundTripper the API client should use. This is http.DefaultTransport, unless a test
+// has enabled record/replay through VCREnvVar or fault injection through FaultInjectionEnvVar, wrapped to record the
+// tasks started by calls made with TrackTasks.
+func NewTransport() (http.RoundTripper, error) {
+	var transport http.RoundTripper = http.DefaultTransport
+
+	mode, err := VCRMode()
+	if err != nil {
+		return nil, err
+	}
+	if mode != "" {
+		transport = SharedVCRTransport(mode)
+	}
+
+	if spec := os.Getenv(FaultInjectionEnvVar); spec != "" {
+		config, err := ParseFaultConfig(spec)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", FaultInjectionEnvVar, err)
+		}
+		transport = NewFaultTransport(config, transport)
+	}
+
+	return NewTaskTransport(transport), nil
+}
@@ -0,0 +1,315 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// FaultInjectionEnvVar enables the fault-injection transport, for testing how the provider copes with a slow or
+// misbehaving API. It is a comma-separated list of faults, for example
+// `latency=200ms,throttle=0.1,server_error=0.05,empty_task=0.5,reorder=0.3,seed=42`. It must never be set outside
+// of tests.
+const FaultInjectionEnvVar = "REDISCLOUD_FAULT_INJECTION"
+
+// FaultConfig describes the faults injected by a FaultTransport. Rates are the probability, between 0 and 1, of
+// injecting the fault into an eligible request.
+type FaultConfig struct {
+	// Latency is added to every request.
+	Latency time.Duration
+	// ThrottleRate is the rate at which requests are answered with 429 Too Many Requests, without reaching the API.
+	ThrottleRate float64
+	// ServerErrorRate is the rate at which requests are answered with 503 Service Unavailable, without reaching the
+	// API.
+	ServerErrorRate float64
+	// EmptyTaskRate is the rate at which a completed task, read again after it has already been seen to complete,
+	// loses its response resource. This is how the API reports Transit Gateway resources which are still being
+	// provisioned.
+	EmptyTaskRate float64
+	// TaskNotFoundRate is the rate at which a completed task carrying a resource is replaced by one which failed
+	// with a 404, as the API does for objects which are still being provisioned.
+	TaskNotFoundRate float64
+	// ReorderRate is the rate at which a status read from the API is replaced by the status previously read from the
+	// same place, so that status transitions appear to go backwards.
+	ReorderRate float64
+	// Limit is the number of times each kind of fault is injected, or 0 for no limit.
+	Limit int
+	// Seed seeds the random source deciding which requests are faulted, so that runs can be reproduced.
+	Seed int64
+}
+
+// ParseFaultConfig parses the value of FaultInjectionEnvVar.
+func ParseFaultConfig(spec string) (FaultConfig, error) {
+	var config FaultConfig
+	for _, part := range strings.Split(spec, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		name, value, ok := strings.Cut(part, "=")
+		if !ok {
+			return config, fmt.Errorf("invalid fault %q, expected name=value", part)
+		}
+
+		var err error
+		switch name {
+		case "latency":
+			config.Latency, err = time.ParseDuration(value)
+		case "throttle":
+			config.ThrottleRate, err = parseRate(value)
+		case "server_error":
+			config.ServerErrorRate, err = parseRate(value)
+		case "empty_task":
+			config.EmptyTaskRate, err = parseRate(value)
+		case "task_not_found":
+			config.TaskNotFoundRate, err = parseRate(value)
+		case "reorder":
+			config.ReorderRate, err = parseRate(value)
+		case "limit":
+			config.Limit, err = strconv.Atoi(value)
+		case "seed":
+			config.Seed, err = strconv.ParseInt(value, 10, 64)
+		default:
+			return config, fmt.Errorf("unknown fault %q", name)
+		}
+		if err != nil {
+			return config, fmt.Errorf("invalid value for fault %q: %w", name, err)
+		}
+	}
+	return config, nil
+}
+
+func parseRate(value string) (float64, error) {
+	rate, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, err
+	}
+	if rate < 0 || rate > 1 {
+		return 0, fmt.Errorf("rate %v must be between 0 and 1", rate)
+	}
+	return rate, nil
+}
+
+const (
+	faultThrottle     = "throttle"
+	faultServerError  = "server_error"
+	faultEmptyTask    = "empty_task"
+	faultTaskNotFound = "task_not_found"
+	faultReorder      = "reorder"
+)
+
+// FaultTransport is an http.RoundTripper injecting the faults described by its FaultConfig into the requests it
+// passes on to the wrapped RoundTripper.
+type FaultTransport struct {
+	config  FaultConfig
+	wrapped http.RoundTripper
+
+	mu             sync.Mutex
+	random         *rand.Rand
+	injected       map[string]int
+	completedTasks map[string]bool
+	statuses       map[string]string
+}
+
+// NewFaultTransport wraps a RoundTripper, injecting the given faults.
+func NewFaultTransport(config FaultConfig, wrapped http.RoundTripper) *FaultTransport {
+	return &FaultTransport{
+		config:         config,
+		wrapped:        wrapped,
+		random:         rand.New(rand.NewSource(config.Seed)),
+		injected:       map[string]int{},
+		completedTasks: map[string]bool{},
+		statuses:       map[string]string{},
+	}
+}
+
+// SetFaults replaces the faults injected, and forgets those injected so far and the responses seen, as if the
+// transport was new.
+func (t *FaultTransport) SetFaults(config FaultConfig) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.config = config
+	t.random = rand.New(rand.NewSource(config.Seed))
+	t.injected = map[string]int{}
+	t.completedTasks = map[string]bool{}
+	t.statuses = map[string]string{}
+}
+
+// Injected returns the number of times the named fault has been injected, for tests to check against.
+func (t *FaultTransport) Injected(fault string) int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.injected[fault]
+}
+
+// inject decides whether to inject a fault, at the given rate. It must be called with the mutex held.
+func (t *FaultTransport) inject(fault string, rate float64) bool {
+	if rate <= 0 || (t.config.Limit > 0 && t.injected[fault] >= t.config.Limit) {
+		return false
+	}
+	if rate < 1 && t.random.Float64() >= rate {
+		return false
+	}
+	t.injected[fault]++
+	return true
+}
+
+func (t *FaultTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	t.mu.Lock()
+	latency := t.config.Latency
+	t.mu.Unlock()
+	if latency > 0 {
+		select {
+		case <-time.After(latency):
+		case <-request.Context().Done():
+			return nil, request.Context().Err()
+		}
+	}
+
+	t.mu.Lock()
+	throttle := t.inject(faultThrottle, t.config.ThrottleRate)
+	serverError := !throttle && t.inject(faultServerError, t.config.ServerErrorRate)
+	t.mu.Unlock()
+
+	if throttle {
+		return faultResponse(request, http.StatusTooManyRequests, `{"status":429,"description":"Too many requests (injected fault)"}`), nil
+	}
+	if serverError {
+		return faultResponse(request, http.StatusServiceUnavailable, `{"status":503,"description":"Service unavailable (injected fault)"}`), nil
+	}
+
+	response, err := t.wrapped.RoundTrip(request)
+	if err != nil || request.Method != http.MethodGet || response.StatusCode != http.StatusOK {
+		return response, err
+	}
+
+	body, err := io.ReadAll(response.Body)
+	_ = response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	var decoded interface{}
+	if json.Unmarshal(body, &decoded) == nil {
+		if object, ok := decoded.(map[string]interface{}); ok && t.rewrite(request, object) {
+			if rewritten, err := json.Marshal(object); err == nil {
+				body = rewritten
+			}
+		}
+	}
+
+	response.Body = io.NopCloser(bytes.NewReader(body))
+	response.ContentLength = int64(len(body))
+	response.Header.Del("Content-Length")
+	return response, nil
+}
+
+// rewrite injects the faults which alter a successful response body, reporting whether it changed the body.
+func (t *FaultTransport) rewrite(request *http.Request, body map[string]interface{}) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	taskId, isTask := body["taskId"].(string)
+	if !isTask || !strings.Contains(request.URL.Path, "/tasks/") {
+		return t.reorder(request.URL.Path, body)
+	}
+
+	// Only the resources of completed tasks are faulted: a task's own status drives the API client's task waiter,
+	// which must not be made to return before the task has completed.
+	response, _ := body["response"].(map[string]interface{})
+	if body["status"] != "processing-completed" || response == nil || response["resource"] == nil {
+		return false
+	}
+
+	seen := t.completedTasks[taskId]
+	t.completedTasks[taskId] = true
+
+	switch {
+	case t.inject(faultTaskNotFound, t.config.TaskNotFoundRate):
+		body["status"] = "processing-error"
+		body["response"] = map[string]interface{}{
+			"error": map[string]interface{}{
+				"type":        "NOT_FOUND",
+				"status":      "404 NOT_FOUND",
+				"description": "Resource not found (injected fault)",
+			},
+		}
+		return true
+	case seen && t.inject(faultEmptyTask, t.config.EmptyTaskRate):
+		delete(response, "resource")
+		return true
+	}
+
+	// Task IDs change on every request, so statuses within tasks are tracked by the kind of task instead.
+	return t.reorder("task:"+fmt.Sprint(body["commandType"]), response["resource"])
+}
+
+// reorder walks a response body, remembering every status it contains and replacing some with the status previously
+// seen in the same place. It must be called with the mutex held.
+func (t *FaultTransport) reorder(location string, value interface{}) bool {
+	changed := false
+	switch v := value.(type) {
+	case map[string]interface{}:
+		// Visit keys in a fixed order, so that a seed reproduces the same faults.
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			child := v[key]
+			path := location + "/" + key
+			if status, ok := child.(string); ok && key == "status" {
+				previous, seen := t.statuses[path]
+				t.statuses[path] = status
+				if seen && previous != status && t.inject(faultReorder, t.config.ReorderRate) {
+					// The stale status is reported once; the next read sees the real one.
+					v[key] = previous
+					changed = true
+				}
+				continue
+			}
+			if t.reorder(path, child) {
+				changed = true
+			}
+		}
+	case []interface{}:
+		for i, child := range v {
+			path := location
+			// Identify list items by their ID where they have one, so that reordered lists keep their history.
+			if object, ok := child.(map[string]interface{}); ok {
+				if id, ok := object["id"]; ok {
+					path += fmt.Sprintf("[id=%v]", id)
+				} else {
+					path += fmt.Sprintf("[%d]", i)
+				}
+			}
+			if t.reorder(path, child) {
+				changed = true
+			}
+		}
+	}
+	return changed
+}
+
+func faultResponse(request *http.Request, status int, body string) *http.Response {
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", status, http.StatusText(status)),
+		StatusCode:    status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": []string{"application/json"}},
+		Body:          io.NopCloser(strings.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       request,
+	}
+}
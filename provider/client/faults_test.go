@@ -0,0 +1,195 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitParseFaultConfig(t *testing.T) {
+	tests := []struct {
+		spec     string
+		expected FaultConfig
+		err      string
+	}{
+		{spec: "", expected: FaultConfig{}},
+		{
+			spec: "latency=250ms, throttle=0.1,server_error=0.05,empty_task=1,task_not_found=0.5,reorder=0.3,limit=2,seed=42",
+			expected: FaultConfig{
+				Latency:          250 * time.Millisecond,
+				ThrottleRate:     0.1,
+				ServerErrorRate:  0.05,
+				EmptyTaskRate:    1,
+				TaskNotFoundRate: 0.5,
+				ReorderRate:      0.3,
+				Limit:            2,
+				Seed:             42,
+			},
+		},
+		{spec: "throttle", err: `invalid fault "throttle", expected name=value`},
+		{spec: "flood=1", err: `unknown fault "flood"`},
+		{spec: "reorder=2", err: `invalid value for fault "reorder": rate 2 must be between 0 and 1`},
+		{spec: "latency=soon", err: `invalid value for fault "latency": time: invalid duration "soon"`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.spec, func(t *testing.T) {
+			config, err := ParseFaultConfig(test.spec)
+			if test.err != "" {
+				assert.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, config)
+		})
+	}
+}
+
+func TestUnitNewTransport(t *testing.T) {
+	t.Setenv(FaultInjectionEnvVar, "")
+	transport, err := NewTransport()
+	require.NoError(t, err)
+	require.IsType(t, &TaskTransport{}, transport)
+	assert.Equal(t, http.DefaultTransport, transport.(*TaskTransport).wrapped)
+
+	t.Setenv(FaultInjectionEnvVar, "throttle=0.5")
+	transport, err = NewTransport()
+	require.NoError(t, err)
+	require.IsType(t, &TaskTransport{}, transport)
+	assert.IsType(t, &FaultTransport{}, transport.(*TaskTransport).wrapped)
+
+	t.Setenv(FaultInjectionEnvVar, "throttle=lots")
+	_, err = NewTransport()
+	assert.ErrorContains(t, err, FaultInjectionEnvVar)
+}
+
+// faultTestServer answers every request with the given body.
+func faultTestServer(t *testing.T, body func(r *http.Request) string) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = io.WriteString(w, body(r))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func getJSON(t *testing.T, transport http.RoundTripper, url string) (int, map[string]interface{}) {
+	t.Helper()
+	request, err := http.NewRequest(http.MethodGet, url, nil)
+	require.NoError(t, err)
+	response, err := transport.RoundTrip(request)
+	require.NoError(t, err)
+	defer response.Body.Close()
+
+	var body map[string]interface{}
+	require.NoError(t, json.NewDecoder(response.Body).Decode(&body))
+	return response.StatusCode, body
+}
+
+func TestUnitFaultTransportErrorResponses(t *testing.T) {
+	server := faultTestServer(t, func(*http.Request) string { return `{"status":"active"}` })
+
+	tests := []struct {
+		name     string
+		config   FaultConfig
+		fault    string
+		expected int
+	}{
+		{name: "throttle", config: FaultConfig{ThrottleRate: 1, Limit: 2}, fault: faultThrottle, expected: http.StatusTooManyRequests},
+		{name: "server error", config: FaultConfig{ServerErrorRate: 1, Limit: 2}, fault: faultServerError, expected: http.StatusServiceUnavailable},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			transport := NewFaultTransport(test.config, http.DefaultTransport)
+
+			for i := 0; i < test.config.Limit; i++ {
+				status, _ := getJSON(t, transport, server.URL)
+				assert.Equal(t, test.expected, status)
+			}
+			status, body := getJSON(t, transport, server.URL)
+			assert.Equal(t, http.StatusOK, status, "faults stop once the limit is reached")
+			assert.Equal(t, "active", body["status"])
+			assert.Equal(t, test.config.Limit, transport.Injected(test.fault))
+
+			// New faults start from scratch.
+			transport.SetFaults(test.config)
+			assert.Equal(t, 0, transport.Injected(test.fault))
+			status, _ = getJSON(t, transport, server.URL)
+			assert.Equal(t, test.expected, status)
+		})
+	}
+}
+
+func TestUnitFaultTransportLatencyHonoursCancellation(t *testing.T) {
+	server := faultTestServer(t, func(*http.Request) string { return `{}` })
+	transport := NewFaultTransport(FaultConfig{Latency: time.Minute}, http.DefaultTransport)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+
+	_, err = transport.RoundTrip(request)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+const completedTask = `{"taskId":"t-1","commandType":"tgwGetRequest","status":"processing-completed",` +
+	`"response":{"resource":{"tgws":[{"id":1,"status":"available"}]}}}`
+
+func TestUnitFaultTransportEmptyTask(t *testing.T) {
+	server := faultTestServer(t, func(*http.Request) string { return completedTask })
+	transport := NewFaultTransport(FaultConfig{EmptyTaskRate: 1, Limit: 1}, http.DefaultTransport)
+
+	_, body := getJSON(t, transport, server.URL+"/tasks/t-1")
+	assert.NotNil(t, body["response"].(map[string]interface{})["resource"], "a task completing for the first time keeps its resource")
+
+	_, body = getJSON(t, transport, server.URL+"/tasks/t-1")
+	assert.Equal(t, "processing-completed", body["status"])
+	assert.NotContains(t, body["response"], "resource")
+
+	_, body = getJSON(t, transport, server.URL+"/tasks/t-1")
+	assert.NotNil(t, body["response"].(map[string]interface{})["resource"])
+}
+
+func TestUnitFaultTransportTaskNotFound(t *testing.T) {
+	server := faultTestServer(t, func(*http.Request) string { return completedTask })
+	transport := NewFaultTransport(FaultConfig{TaskNotFoundRate: 1, Limit: 1}, http.DefaultTransport)
+
+	_, body := getJSON(t, transport, server.URL+"/tasks/t-1")
+	assert.Equal(t, "processing-error", body["status"])
+	assert.Equal(t, "404 NOT_FOUND", body["response"].(map[string]interface{})["error"].(map[string]interface{})["status"])
+
+	_, body = getJSON(t, transport, server.URL+"/tasks/t-1")
+	assert.Equal(t, "processing-completed", body["status"])
+}
+
+func TestUnitFaultTransportReorder(t *testing.T) {
+	statuses := []string{"pending", "active", "active", "active"}
+	reads := 0
+	server := faultTestServer(t, func(*http.Request) string {
+		status := statuses[reads]
+		reads++
+		return `{"id":1,"status":"` + status + `","regions":[{"id":7,"status":"` + status + `"}]}`
+	})
+	transport := NewFaultTransport(FaultConfig{ReorderRate: 1}, http.DefaultTransport)
+
+	var seen []string
+	for range statuses {
+		_, body := getJSON(t, transport, server.URL+"/subscriptions/1")
+		region := body["regions"].([]interface{})[0].(map[string]interface{})
+		assert.Equal(t, body["status"], region["status"], "nested statuses are reordered too")
+		seen = append(seen, body["status"].(string))
+	}
+
+	// Every transition is delayed by one read, as though it arrived out of order.
+	assert.Equal(t, []string{"pending", "pending", "active", "active"}, seen)
+	assert.Equal(t, 2, transport.Injected(faultReorder))
+}
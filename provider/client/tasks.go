@@ -0,0 +1,172 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// Task states reported by the API.
+const (
+	TaskStatusCompleted = "processing-completed"
+	TaskStatusError     = "processing-error"
+)
+
+// Task is a Redis Cloud task started by an API call, as last seen by a TaskLog.
+type Task struct {
+	ID          string
+	CommandType string
+	Status      string
+	Description string
+	// ErrorDescription is the reason the API gave for the task failing.
+	ErrorDescription string
+	// Resource is the resource the task reported once completed, such as the pricing of a dry run, which the API
+	// client doesn't return.
+	Resource json.RawMessage
+}
+
+// Failed reports whether the task finished unsuccessfully.
+func (t *Task) Failed() bool {
+	return t.Status == TaskStatusError || t.ErrorDescription != ""
+}
+
+// TaskLog records the tasks started by the API calls made with a context returned by TrackTasks, as the API client
+// waits for them. The API client doesn't return the tasks it waits for, so this is how a caller learns how a task
+// ended.
+type TaskLog struct {
+	mu    sync.Mutex
+	tasks []*Task
+}
+
+type taskLogKey struct{}
+
+// TrackTasks returns a context recording the tasks started by the API calls made with it.
+func TrackTasks(ctx context.Context) (context.Context, *TaskLog) {
+	log := &TaskLog{}
+	return context.WithValue(ctx, taskLogKey{}, log), log
+}
+
+// Tasks returns a copy of every task started, in the order they were started.
+func (l *TaskLog) Tasks() []Task {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	tasks := make([]Task, len(l.tasks))
+	for i, task := range l.tasks {
+		tasks[i] = *task
+	}
+	return tasks
+}
+
+// Last returns a copy of the task started last, or nil if no task was started.
+func (l *TaskLog) Last() *Task {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if len(l.tasks) == 0 {
+		return nil
+	}
+	task := *l.tasks[len(l.tasks)-1]
+	return &task
+}
+
+// Completed reports whether at least one task was started, and every task started was seen to complete
+// successfully.
+func (l *TaskLog) Completed() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, task := range l.tasks {
+		if task.Status != TaskStatusCompleted || task.ErrorDescription != "" {
+			return false
+		}
+	}
+	return len(l.tasks) > 0
+}
+
+// taskBody is the part of a response body describing a task.
+type taskBody struct {
+	ID          string `json:"taskId"`
+	CommandType string `json:"commandType"`
+	Status      string `json:"status"`
+	Description string `json:"description"`
+	Response    *struct {
+		Resource json.RawMessage `json:"resource"`
+		Error    *struct {
+			Description string `json:"description"`
+		} `json:"error"`
+	} `json:"response"`
+}
+
+func (l *TaskLog) observe(request *http.Request, body taskBody) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var task *Task
+	for _, t := range l.tasks {
+		if t.ID == body.ID {
+			task = t
+		}
+	}
+	if task == nil {
+		// Only calls which change something start a task; reading a task this log hasn't seen started, such as the
+		// Transit Gateway API's own task reads, isn't a new task.
+		if request.Method == http.MethodGet {
+			return
+		}
+		task = &Task{ID: body.ID}
+		l.tasks = append(l.tasks, task)
+	}
+
+	if body.CommandType != "" {
+		task.CommandType = body.CommandType
+	}
+	if body.Status != "" {
+		task.Status = body.Status
+	}
+	if body.Description != "" {
+		task.Description = body.Description
+	}
+	if body.Response != nil && body.Response.Error != nil {
+		task.ErrorDescription = body.Response.Error.Description
+	}
+	if body.Response != nil && len(body.Response.Resource) > 0 {
+		task.Resource = body.Response.Resource
+	}
+}
+
+// TaskTransport is an http.RoundTripper recording the tasks seen in responses into the TaskLog of the request's
+// context, if it has one.
+type TaskTransport struct {
+	wrapped http.RoundTripper
+}
+
+// NewTaskTransport wraps a RoundTripper, recording tasks.
+func NewTaskTransport(wrapped http.RoundTripper) *TaskTransport {
+	return &TaskTransport{wrapped: wrapped}
+}
+
+func (t *TaskTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	response, err := t.wrapped.RoundTrip(request)
+	log, ok := request.Context().Value(taskLogKey{}).(*TaskLog)
+	if err != nil || !ok || response.StatusCode >= http.StatusBadRequest {
+		return response, err
+	}
+	if request.Method == http.MethodGet && !strings.Contains(request.URL.Path, "/tasks/") {
+		return response, nil
+	}
+
+	body, err := io.ReadAll(response.Body)
+	_ = response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	response.Body = io.NopCloser(bytes.NewReader(body))
+
+	var task taskBody
+	if json.Unmarshal(body, &task) == nil && task.ID != "" {
+		log.observe(request, task)
+	}
+	return response, nil
+}
@@ -0,0 +1,128 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTaskTestServer serves a task being started by a POST, and then reports the given task bodies in turn.
+func newTaskTestServer(t *testing.T, taskBodies ...string) *httptest.Server {
+	t.Helper()
+	reads := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost:
+			w.WriteHeader(http.StatusAccepted)
+			_, _ = io.WriteString(w, `{"taskId":"task-1","commandType":"subscriptionUpdateRequest","status":"received"}`)
+		case strings.HasPrefix(r.URL.Path, "/tasks/"):
+			_, _ = io.WriteString(w, taskBodies[min(reads, len(taskBodies)-1)])
+			reads++
+		default:
+			_, _ = io.WriteString(w, `{"taskId":"not-a-task"}`)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func doTaskTestRequest(t *testing.T, ctx context.Context, method string, url string) string {
+	t.Helper()
+	request, err := http.NewRequestWithContext(ctx, method, url, nil)
+	require.NoError(t, err)
+	response, err := (&http.Client{Transport: NewTaskTransport(http.DefaultTransport)}).Do(request)
+	require.NoError(t, err)
+	defer response.Body.Close()
+	body, err := io.ReadAll(response.Body)
+	require.NoError(t, err)
+	return string(body)
+}
+
+func TestUnitTaskTransport(t *testing.T) {
+	tests := []struct {
+		name      string
+		taskBody  string
+		expected  Task
+		completed bool
+	}{
+		{
+			name:     "completed",
+			taskBody: `{"taskId":"task-1","status":"processing-completed","description":"Request processing completed successfully","response":{"resourceId":1}}`,
+			expected: Task{
+				ID:          "task-1",
+				CommandType: "subscriptionUpdateRequest",
+				Status:      TaskStatusCompleted,
+				Description: "Request processing completed successfully",
+			},
+			completed: true,
+		},
+		{
+			name:     "completed with a resource",
+			taskBody: `{"taskId":"task-1","status":"processing-completed","description":"Request processing completed successfully","response":{"resource":{"pricing":[]}}}`,
+			expected: Task{
+				ID:          "task-1",
+				CommandType: "subscriptionUpdateRequest",
+				Status:      TaskStatusCompleted,
+				Description: "Request processing completed successfully",
+				Resource:    json.RawMessage(`{"pricing":[]}`),
+			},
+			completed: true,
+		},
+		{
+			name:     "failed",
+			taskBody: `{"taskId":"task-1","status":"processing-error","description":"Task request failed during processing","response":{"error":{"type":"SUBSCRIPTION_NOT_ACTIVE","status":"400 BAD_REQUEST","description":"Subscription is not active"}}}`,
+			expected: Task{
+				ID:               "task-1",
+				CommandType:      "subscriptionUpdateRequest",
+				Status:           TaskStatusError,
+				Description:      "Task request failed during processing",
+				ErrorDescription: "Subscription is not active",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := newTaskTestServer(t, `{"taskId":"task-1","status":"processing-in-progress"}`, test.taskBody)
+			ctx, tasks := TrackTasks(context.Background())
+
+			// The body is still readable after the transport has read it.
+			assert.Contains(t, doTaskTestRequest(t, ctx, http.MethodPost, server.URL+"/subscriptions/1"), "task-1")
+			assert.Equal(t, []Task{{ID: "task-1", CommandType: "subscriptionUpdateRequest", Status: "received"}}, tasks.Tasks())
+			assert.False(t, tasks.Completed())
+
+			doTaskTestRequest(t, ctx, http.MethodGet, server.URL+"/tasks/task-1")
+			assert.Equal(t, "processing-in-progress", tasks.Last().Status)
+
+			doTaskTestRequest(t, ctx, http.MethodGet, server.URL+"/tasks/task-1")
+			require.Len(t, tasks.Tasks(), 1)
+			assert.Equal(t, test.expected, *tasks.Last())
+			assert.Equal(t, !test.completed, tasks.Last().Failed())
+			assert.Equal(t, test.completed, tasks.Completed())
+		})
+	}
+}
+
+func TestUnitTaskTransportIgnoresUntrackedRequests(t *testing.T) {
+	server := newTaskTestServer(t, `{"taskId":"task-2","status":"processing-completed"}`)
+
+	// Without a TaskLog nothing is recorded, and responses pass through unchanged.
+	assert.Contains(t, doTaskTestRequest(t, context.Background(), http.MethodPost, server.URL+"/subscriptions/1"), "task-1")
+
+	ctx, tasks := TrackTasks(context.Background())
+	// Ordinary reads don't start tasks, even if their body happens to look like one.
+	doTaskTestRequest(t, ctx, http.MethodGet, server.URL+"/subscriptions/1")
+	// Reading a task which wasn't started with this context doesn't record it.
+	doTaskTestRequest(t, ctx, http.MethodGet, server.URL+"/tasks/task-2")
+
+	assert.Empty(t, tasks.Tasks())
+	assert.Nil(t, tasks.Last())
+	assert.False(t, tasks.Completed())
+}
@@ -0,0 +1,398 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// VCREnvVar records the provider's interactions with the API into cassettes, or replays them from cassettes instead
+// of calling the API, so that acceptance tests can be run offline. It is either VCRModeRecord or VCRModeReplay, and
+// must never be set outside of tests.
+const VCREnvVar = "REDISCLOUD_VCR"
+
+const (
+	VCRModeRecord = "record"
+	VCRModeReplay = "replay"
+)
+
+// VCRMode returns the mode set through VCREnvVar, or an empty string if record/replay is disabled.
+func VCRMode() (string, error) {
+	switch mode := os.Getenv(VCREnvVar); mode {
+	case "", VCRModeRecord, VCRModeReplay:
+		return mode, nil
+	default:
+		return "", fmt.Errorf("invalid %s %q, expected %q or %q", VCREnvVar, mode, VCRModeRecord, VCRModeReplay)
+	}
+}
+
+// Interaction is a single request to the API and its response, as stored in a cassette.
+type Interaction struct {
+	Method       string          `json:"method"`
+	Path         string          `json:"path"`
+	RequestBody  json.RawMessage `json:"request_body,omitempty"`
+	Status       int             `json:"status"`
+	ResponseBody json.RawMessage `json:"response_body,omitempty"`
+}
+
+// Cassette holds the interactions recorded for a single test, with their secrets scrubbed.
+type Cassette struct {
+	Interactions []*Interaction `json:"interactions"`
+
+	path string
+	// secrets maps each secret recorded to the placeholder replacing it.
+	secrets map[string]string
+	// used marks the interactions already replayed.
+	used []bool
+	// substitutions maps values in the cassette to the values used by the test replaying it, such as random names
+	// and secrets.
+	substitutions map[string]string
+}
+
+func newCassette(path string) *Cassette {
+	return &Cassette{path: path, secrets: map[string]string{}, substitutions: map[string]string{}}
+}
+
+// LoadCassette reads a recorded cassette.
+func LoadCassette(path string) (*Cassette, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	cassette := newCassette(path)
+	if err := json.Unmarshal(data, cassette); err != nil {
+		return nil, fmt.Errorf("invalid cassette %s: %w", path, err)
+	}
+	cassette.used = make([]bool, len(cassette.Interactions))
+	return cassette, nil
+}
+
+// Save writes a recorded cassette.
+func (c *Cassette) Save() error {
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(c.path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(c.path, append(data, '\n'), 0o644)
+}
+
+// VCRTransport is an http.RoundTripper recording requests to, or replaying them from, the cassette currently inserted.
+// A single VCRTransport is shared by every client in the process, so that the clients built by the provider and by
+// the tests themselves use the same cassette.
+type VCRTransport struct {
+	mode    string
+	wrapped http.RoundTripper
+
+	mu       sync.Mutex
+	cassette *Cassette
+}
+
+// NewVCRTransport returns a VCRTransport in the given mode. The wrapped RoundTripper is only used while recording.
+func NewVCRTransport(mode string, wrapped http.RoundTripper) *VCRTransport {
+	return &VCRTransport{mode: mode, wrapped: wrapped}
+}
+
+var (
+	sharedVCRTransport     *VCRTransport
+	sharedVCRTransportOnce sync.Once
+)
+
+// SharedVCRTransport returns the VCRTransport used by every client created through NewTransport.
+func SharedVCRTransport(mode string) *VCRTransport {
+	sharedVCRTransportOnce.Do(func() {
+		sharedVCRTransport = NewVCRTransport(mode, http.DefaultTransport)
+	})
+	return sharedVCRTransport
+}
+
+// Insert makes the cassette at path the one recorded to or replayed from. While replaying, the cassette must already
+// exist. The returned function ejects the cassette, saving it if it was recorded and save is true.
+func (t *VCRTransport) Insert(path string) (func(save bool) error, error) {
+	cassette := newCassette(path)
+	if t.mode == VCRModeReplay {
+		var err error
+		if cassette, err = LoadCassette(path); err != nil {
+			return nil, err
+		}
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.cassette != nil {
+		return nil, fmt.Errorf("cassette %s is already inserted", t.cassette.path)
+	}
+	t.cassette = cassette
+
+	return func(save bool) error {
+		t.mu.Lock()
+		defer t.mu.Unlock()
+		t.cassette = nil
+		if save && t.mode == VCRModeRecord {
+			return cassette.Save()
+		}
+		return nil
+	}, nil
+}
+
+func (t *VCRTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	var requestBody []byte
+	if request.Body != nil {
+		var err error
+		requestBody, err = io.ReadAll(request.Body)
+		_ = request.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		request.Body = io.NopCloser(bytes.NewReader(requestBody))
+	}
+
+	t.mu.Lock()
+	cassette := t.cassette
+	t.mu.Unlock()
+	if cassette == nil {
+		return nil, fmt.Errorf("%s is set but no cassette is inserted for %s %s", VCREnvVar, request.Method, request.URL.Path)
+	}
+
+	if t.mode == VCRModeReplay {
+		return t.replay(cassette, request, requestBody)
+	}
+	return t.record(cassette, request, requestBody)
+}
+
+func (t *VCRTransport) record(cassette *Cassette, request *http.Request, requestBody []byte) (*http.Response, error) {
+	response, err := t.wrapped.RoundTrip(request)
+	if err != nil {
+		return nil, err
+	}
+	// The API client retries throttled requests itself, so there is nothing to replay.
+	if response.StatusCode == http.StatusTooManyRequests {
+		return response, nil
+	}
+
+	responseBody, err := io.ReadAll(response.Body)
+	_ = response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	response.Body = io.NopCloser(bytes.NewReader(responseBody))
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	cassette.Interactions = append(cassette.Interactions, &Interaction{
+		Method:       request.Method,
+		Path:         cassette.recordedPath(request.URL),
+		RequestBody:  cassette.scrub(requestBody),
+		Status:       response.StatusCode,
+		ResponseBody: cassette.scrub(responseBody),
+	})
+	return response, nil
+}
+
+func (t *VCRTransport) replay(cassette *Cassette, request *http.Request, requestBody []byte) (*http.Response, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	path := cassette.recordedPath(request.URL)
+	interaction := cassette.next(request.Method, path)
+	if interaction == nil {
+		return nil, fmt.Errorf("no interaction recorded in %s for %s %s", cassette.path, request.Method, path)
+	}
+
+	if len(requestBody) > 0 && len(interaction.RequestBody) > 0 {
+		var recorded, live interface{}
+		if json.Unmarshal(interaction.RequestBody, &recorded) == nil && json.Unmarshal(requestBody, &live) == nil {
+			cassette.learn(recorded, live)
+		}
+	}
+
+	body := []byte(interaction.ResponseBody)
+	var decoded interface{}
+	if len(cassette.substitutions) > 0 && json.Unmarshal(body, &decoded) == nil {
+		if substituted, err := json.Marshal(cassette.substitute(decoded)); err == nil {
+			body = substituted
+		}
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", interaction.Status, http.StatusText(interaction.Status)),
+		StatusCode:    interaction.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": []string{"application/json"}},
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       request,
+	}, nil
+}
+
+// next returns the interaction to replay for a request, in the order they were recorded. A task being polled skips
+// straight to its final recorded state, and a GET with nothing left to replay gets its last recorded response again.
+// It must be called with the transport's mutex held.
+func (c *Cassette) next(method string, path string) *Interaction {
+	var last *Interaction
+	for i, interaction := range c.Interactions {
+		if interaction.Method != method || interaction.Path != path {
+			continue
+		}
+		if c.used[i] {
+			last = interaction
+			continue
+		}
+		c.used[i] = true
+		last = interaction
+		if method != http.MethodGet || !strings.Contains(path, "/tasks/") {
+			return interaction
+		}
+	}
+	if method != http.MethodGet {
+		return nil
+	}
+	return last
+}
+
+// learn compares a recorded request body with the one sent while replaying, remembering which recorded values
+// stand for which values in the test, such as random names and scrubbed secrets.
+func (c *Cassette) learn(recorded interface{}, live interface{}) {
+	switch r := recorded.(type) {
+	case map[string]interface{}:
+		if l, ok := live.(map[string]interface{}); ok {
+			for key, value := range r {
+				c.learn(value, l[key])
+			}
+		}
+	case []interface{}:
+		if l, ok := live.([]interface{}); ok {
+			for i := 0; i < len(r) && i < len(l); i++ {
+				c.learn(r[i], l[i])
+			}
+		}
+	case string:
+		if l, ok := live.(string); ok && l != r && r != "" && l != "" {
+			c.substitutions[r] = l
+		}
+	}
+}
+
+// substitute replaces the recorded values the test has been seen to use differently.
+func (c *Cassette) substitute(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, child := range v {
+			v[key] = c.substitute(child)
+		}
+	case []interface{}:
+		for i, child := range v {
+			v[i] = c.substitute(child)
+		}
+	case string:
+		if live, ok := c.substitutions[v]; ok {
+			return live
+		}
+	}
+	return value
+}
+
+// recordedPath returns a request's path and query as they were recorded, by replacing every segment and query value
+// standing for a recorded value. Query parameters are sorted, so that their order doesn't matter.
+func (c *Cassette) recordedPath(u *url.URL) string {
+	recorded := make(map[string]string, len(c.substitutions))
+	for r, live := range c.substitutions {
+		recorded[live] = r
+	}
+	unsubstitute := func(value string) string {
+		if r, ok := recorded[value]; ok {
+			return r
+		}
+		return value
+	}
+
+	segments := strings.Split(u.EscapedPath(), "/")
+	for i, segment := range segments {
+		if unescaped, err := url.PathUnescape(segment); err == nil {
+			segments[i] = url.PathEscape(unsubstitute(unescaped))
+		}
+	}
+	path := strings.Join(segments, "/")
+
+	if u.RawQuery != "" {
+		query := u.Query()
+		for key, values := range query {
+			for i, value := range values {
+				values[i] = unsubstitute(value)
+			}
+			query[key] = values
+		}
+		path += "?" + query.Encode()
+	}
+	return path
+}
+
+// scrub replaces the secrets in a body with placeholders. Each secret always gets the same placeholder, so that
+// replaying can map it back to the secret used by the test. Bodies which aren't JSON are recorded as a string.
+func (c *Cassette) scrub(body []byte) json.RawMessage {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return nil
+	}
+	var decoded interface{}
+	if err := json.Unmarshal(body, &decoded); err != nil {
+		decoded = string(body)
+	}
+	scrubbed, err := json.Marshal(c.scrubValue("", decoded))
+	if err != nil {
+		return nil
+	}
+	return scrubbed
+}
+
+func (c *Cassette) scrubValue(key string, value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		// Visit keys in a fixed order, so that recording the same interactions numbers the placeholders the same way.
+		sort.Strings(keys)
+		for _, k := range keys {
+			v[k] = c.scrubValue(k, v[k])
+		}
+	case []interface{}:
+		for i, child := range v {
+			v[i] = c.scrubValue(key, child)
+		}
+	case string:
+		if v != "" && isSecretKey(key) {
+			placeholder, ok := c.secrets[v]
+			if !ok {
+				placeholder = fmt.Sprintf("REDACTED-%d", len(c.secrets)+1)
+				c.secrets[v] = placeholder
+			}
+			return placeholder
+		}
+	}
+	return value
+}
+
+var secretKeyParts = []string{"password", "secret", "accesskey", "apikey", "token", "privatekey"}
+
+func isSecretKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, part := range secretKeyParts {
+		if strings.Contains(key, part) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,157 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitVCRMode(t *testing.T) {
+	for _, mode := range []string{"", VCRModeRecord, VCRModeReplay} {
+		t.Setenv(VCREnvVar, mode)
+		actual, err := VCRMode()
+		require.NoError(t, err)
+		assert.Equal(t, mode, actual)
+	}
+
+	t.Setenv(VCREnvVar, "rewind")
+	_, err := VCRMode()
+	assert.EqualError(t, err, `invalid REDISCLOUD_VCR "rewind", expected "record" or "replay"`)
+}
+
+func vcrRequest(t *testing.T, transport http.RoundTripper, method string, url string, body string) (int, map[string]interface{}) {
+	t.Helper()
+	var reader io.Reader
+	if body != "" {
+		reader = strings.NewReader(body)
+	}
+	request, err := http.NewRequest(method, url, reader)
+	require.NoError(t, err)
+	response, err := transport.RoundTrip(request)
+	require.NoError(t, err)
+	defer response.Body.Close()
+
+	var decoded map[string]interface{}
+	require.NoError(t, json.NewDecoder(response.Body).Decode(&decoded))
+	return response.StatusCode, decoded
+}
+
+// recordVCRTestCassette records a subscription being created and its task polled, returning the cassette's path.
+func recordVCRTestCassette(t *testing.T) string {
+	taskPolls := 0
+	throttled := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost:
+			body, _ := io.ReadAll(r.Body)
+			_, _ = w.Write(bytes.Replace(body, []byte("{"), []byte(`{"id":1,`), 1))
+		case strings.HasPrefix(r.URL.Path, "/tasks/"):
+			if !throttled {
+				throttled = true
+				w.WriteHeader(http.StatusTooManyRequests)
+				_, _ = io.WriteString(w, `{}`)
+				return
+			}
+			taskPolls++
+			status := "processing-in-progress"
+			if taskPolls == 3 {
+				status = "processing-completed"
+			}
+			_, _ = io.WriteString(w, `{"taskId":"t-1","status":"`+status+`"}`)
+		default:
+			_, _ = io.WriteString(w, `{"id":1,"name":"tf-test-recorded","query":"`+r.URL.RawQuery+`"}`)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	path := filepath.Join(t.TempDir(), "cassettes", "TestExample.json")
+	transport := NewVCRTransport(VCRModeRecord, http.DefaultTransport)
+	eject, err := transport.Insert(path)
+	require.NoError(t, err)
+
+	_, body := vcrRequest(t, transport, http.MethodPost, server.URL+"/subscriptions", `{"name":"tf-test-recorded","password":"hunter2"}`)
+	assert.Equal(t, "hunter2", body["password"], "secrets are only scrubbed from the cassette")
+
+	status, _ := vcrRequest(t, transport, http.MethodGet, server.URL+"/tasks/t-1", "")
+	assert.Equal(t, http.StatusTooManyRequests, status)
+	for i := 0; i < 3; i++ {
+		vcrRequest(t, transport, http.MethodGet, server.URL+"/tasks/t-1", "")
+	}
+	vcrRequest(t, transport, http.MethodGet, server.URL+"/subscriptions/1?b=2&a=1", "")
+
+	require.NoError(t, eject(true))
+	return path
+}
+
+func TestUnitVCRTransportRecord(t *testing.T) {
+	path := recordVCRTestCassette(t)
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "hunter2")
+
+	cassette, err := LoadCassette(path)
+	require.NoError(t, err)
+	require.Len(t, cassette.Interactions, 5, "throttled requests aren't recorded")
+	assert.JSONEq(t, `{"name":"tf-test-recorded","password":"REDACTED-1"}`, string(cassette.Interactions[0].RequestBody))
+	assert.JSONEq(t, `{"id":1,"name":"tf-test-recorded","password":"REDACTED-1"}`, string(cassette.Interactions[0].ResponseBody))
+	assert.Equal(t, "/subscriptions/1?a=1&b=2", cassette.Interactions[4].Path)
+}
+
+func TestUnitVCRTransportReplay(t *testing.T) {
+	path := recordVCRTestCassette(t)
+
+	transport := NewVCRTransport(VCRModeReplay, nil)
+	eject, err := transport.Insert(path)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, eject(true)) }()
+
+	// The test replaying the cassette uses a different random name and secret to the one recording it.
+	_, body := vcrRequest(t, transport, http.MethodPost, "https://api.example.com/subscriptions", `{"name":"tf-test-replayed","password":"swordfish"}`)
+	assert.Equal(t, map[string]interface{}{"id": float64(1), "name": "tf-test-replayed", "password": "swordfish"}, body)
+
+	_, body = vcrRequest(t, transport, http.MethodGet, "https://api.example.com/tasks/t-1", "")
+	assert.Equal(t, "processing-completed", body["status"], "polling a task skips to its final state")
+	_, body = vcrRequest(t, transport, http.MethodGet, "https://api.example.com/tasks/t-1", "")
+	assert.Equal(t, "processing-completed", body["status"])
+
+	_, body = vcrRequest(t, transport, http.MethodGet, "https://api.example.com/subscriptions/1?a=1&b=2", "")
+	assert.Equal(t, "tf-test-replayed", body["name"])
+	assert.Equal(t, "b=2&a=1", body["query"], "the response is the one recorded")
+
+	request, err := http.NewRequest(http.MethodDelete, "https://api.example.com/subscriptions/1", nil)
+	require.NoError(t, err)
+	_, err = transport.RoundTrip(request)
+	assert.ErrorContains(t, err, "no interaction recorded")
+}
+
+func TestUnitVCRTransportCassettes(t *testing.T) {
+	transport := NewVCRTransport(VCRModeReplay, nil)
+
+	request, err := http.NewRequest(http.MethodGet, "https://api.example.com/subscriptions", nil)
+	require.NoError(t, err)
+	_, err = transport.RoundTrip(request)
+	assert.ErrorContains(t, err, "no cassette is inserted")
+
+	_, err = transport.Insert(filepath.Join(t.TempDir(), "missing.json"))
+	assert.Error(t, err, "replaying needs a recorded cassette")
+
+	recorder := NewVCRTransport(VCRModeRecord, http.DefaultTransport)
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	eject, err := recorder.Insert(path)
+	require.NoError(t, err)
+	_, err = recorder.Insert(path)
+	assert.ErrorContains(t, err, "is already inserted")
+
+	require.NoError(t, eject(false))
+	assert.NoFileExists(t, path, "failed tests don't save their cassette")
+}
@@ -123,3 +123,32 @@ func filterRole(rule *roles.GetRoleResponse, filters []func(*roles.GetRoleRespon
 	}
 	return true
 }
+
+func flattenRules(rules []*roles.GetRuleInRoleResponse) []map[string]interface{} {
+	var tfs = make([]map[string]interface{}, 0)
+
+	for _, rule := range rules {
+		tf := map[string]interface{}{
+			"name":     redis.StringValue(rule.RuleName),
+			"database": flattenDatabases(rule.Databases),
+		}
+		tfs = append(tfs, tf)
+	}
+
+	return tfs
+}
+
+func flattenDatabases(databases []*roles.GetDatabaseInRuleInRoleResponse) []map[string]interface{} {
+	var tfs = make([]map[string]interface{}, 0)
+
+	for _, database := range databases {
+		tf := map[string]interface{}{
+			"subscription": redis.IntValue(database.SubscriptionId),
+			"database":     redis.IntValue(database.DatabaseId),
+			"regions":      redis.StringSliceValue(database.Regions...),
+		}
+		tfs = append(tfs, tf)
+	}
+
+	return tfs
+}
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -81,3 +82,7 @@ func dataSourceActiveActivePrivateServiceConnectRead(ctx context.Context, d *sch
 
 	return diags
 }
+
+func buildPrivateServiceConnectActiveActiveId(subId int, regionId int, pscServiceId int) string {
+	return fmt.Sprintf("%d/%d/%d", subId, regionId, pscServiceId)
+}
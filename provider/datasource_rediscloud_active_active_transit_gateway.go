@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"strconv"
 	"time"
@@ -9,7 +10,6 @@ import (
 	"github.com/RedisLabs/rediscloud-go-api/redis"
 	"github.com/RedisLabs/rediscloud-go-api/service/transit_gateway/attachments"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/RedisLabs/terraform-provider-rediscloud/provider/client"
@@ -114,7 +114,8 @@ func dataSourceActiveActiveTransitGatewayRead(ctx context.Context, d *schema.Res
 
 	if waitTimeoutSeconds > 0 {
 		// Wait for a matching TGW to appear
-		wait := &retry.StateChangeConf{
+		wait := &utils.Waiter{
+			Description:  fmt.Sprintf("Transit Gateway for subscription %d, region %d", subId, regionId),
 			Pending:      []string{"waiting"},
 			Target:       []string{"found"},
 			Timeout:      time.Duration(waitTimeoutSeconds) * time.Second,
@@ -142,7 +143,7 @@ func dataSourceActiveActiveTransitGatewayRead(ctx context.Context, d *schema.Res
 			},
 		}
 
-		result, err := wait.WaitForStateContext(ctx)
+		result, err := wait.Wait(ctx)
 		if err != nil {
 			return diag.Errorf("Timeout waiting for Active-Active Transit Gateway to appear for subscription %d, region %d: %s", subId, regionId, err)
 		}
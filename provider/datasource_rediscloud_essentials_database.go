@@ -588,3 +588,33 @@ func filterFixedDatabase(db *fixedDatabases.FixedDatabase, filters []func(db *fi
 	}
 	return true
 }
+
+func writeReplica(replica fixedDatabases.ReplicaOf) []map[string]interface{} {
+	tf := map[string]interface{}{}
+	syncSources := make([]map[string]interface{}, 0)
+
+	for _, ss := range replica.SyncSources {
+		syncSources = append(syncSources, map[string]interface{}{
+			"endpoint":    redis.StringValue(ss.Endpoint),
+			"encryption":  redis.BoolValue(ss.Encryption),
+			"server_cert": redis.StringValue(ss.ServerCert),
+		})
+	}
+
+	tf["sync_source"] = syncSources
+	return []map[string]interface{}{tf}
+}
+
+func readFixedTags(ctx context.Context, api *client.ApiClient, subId int, databaseId int, d *schema.ResourceData) error {
+	t := make(map[string]string)
+	tagResponse, err := api.Client.Tags.GetFixed(ctx, subId, databaseId)
+	if err != nil {
+		return err
+	}
+	if tagResponse.Tags != nil {
+		for _, tag := range *tagResponse.Tags {
+			t[redis.StringValue(tag.Key)] = redis.StringValue(tag.Value)
+		}
+	}
+	return d.Set("tags", t)
+}
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 
 	"github.com/RedisLabs/terraform-provider-rediscloud/provider/client"
@@ -75,3 +76,7 @@ func dataSourcePrivateServiceConnectRead(ctx context.Context, d *schema.Resource
 
 	return diags
 }
+
+func buildPrivateServiceConnectId(subId int, pscServiceId int) string {
+	return fmt.Sprintf("%d/%d", subId, pscServiceId)
+}
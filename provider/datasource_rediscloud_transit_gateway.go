@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"strconv"
 	"time"
@@ -9,7 +10,6 @@ import (
 	"github.com/RedisLabs/rediscloud-go-api/redis"
 	"github.com/RedisLabs/rediscloud-go-api/service/transit_gateway/attachments"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/RedisLabs/terraform-provider-rediscloud/provider/client"
@@ -108,7 +108,8 @@ func dataSourceTransitGatewayRead(ctx context.Context, d *schema.ResourceData, m
 
 	if waitTimeoutSeconds > 0 {
 		// Wait for a matching TGW to appear
-		wait := &retry.StateChangeConf{
+		wait := &utils.Waiter{
+			Description:  fmt.Sprintf("Transit Gateway for subscription %d", subId),
 			Pending:      []string{"waiting"},
 			Target:       []string{"found"},
 			Timeout:      time.Duration(waitTimeoutSeconds) * time.Second,
@@ -136,7 +137,7 @@ func dataSourceTransitGatewayRead(ctx context.Context, d *schema.ResourceData, m
 			},
 		}
 
-		result, err := wait.WaitForStateContext(ctx)
+		result, err := wait.Wait(ctx)
 		if err != nil {
 			return diag.Errorf("Timeout waiting for Transit Gateway to appear for subscription %d: %s", subId, err)
 		}
@@ -224,3 +225,11 @@ func filterTgwAttachment(tgwa *attachments.TransitGatewayAttachment, filters []f
 	}
 	return true
 }
+
+func flattenCidrs(cidrs []*attachments.Cidr) []string {
+	cidrStrings := make([]string, 0)
+	for _, cidr := range cidrs {
+		cidrStrings = append(cidrStrings, redis.StringValue(cidr.CidrAddress))
+	}
+	return cidrStrings
+}
@@ -0,0 +1,128 @@
+package essentials
+
+import (
+	"context"
+
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/utils"
+)
+
+var (
+	_ list.ListResource              = &essentialsDatabaseListResource{}
+	_ list.ListResourceWithConfigure = &essentialsDatabaseListResource{}
+)
+
+// essentialsDatabaseListResource lists the databases of the account's Essentials subscriptions. It shares the type
+// name, client and read logic of the managed resource.
+type essentialsDatabaseListResource struct {
+	essentialsDatabaseResource
+}
+
+// essentialsDatabaseListModel describes the list resource configuration.
+type essentialsDatabaseListModel struct {
+	SubscriptionID types.Int64 `tfsdk:"subscription_id"`
+}
+
+// NewEssentialsDatabaseListResource returns a new list resource instance.
+func NewEssentialsDatabaseListResource() list.ListResource {
+	return &essentialsDatabaseListResource{}
+}
+
+// ListResourceConfigSchema defines the filters accepted by the list resource.
+func (r *essentialsDatabaseListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		Description: "Lists the databases of the account's Essentials subscriptions",
+		Attributes: map[string]listschema.Attribute{
+			"subscription_id": listschema.Int64Attribute{
+				Description: "Only list the databases of this subscription. When omitted, every Essentials subscription is listed",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// List streams one result per Essentials database.
+func (r *essentialsDatabaseListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config essentialsDatabaseListModel
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		var subIds []int
+		if subId := int(config.SubscriptionID.ValueInt64()); subId != 0 {
+			subIds = append(subIds, subId)
+		} else {
+			subs, err := r.client.Client.FixedSubscriptions.List(ctx)
+			if err != nil {
+				result := list.ListResult{}
+				result.Diagnostics.AddError("Failed to list subscriptions", err.Error())
+				push(result)
+				return
+			}
+			for _, sub := range subs {
+				subIds = append(subIds, redis.IntValue(sub.ID))
+			}
+		}
+
+		var count int64
+		for _, subId := range subIds {
+			dbs := r.client.Client.FixedDatabases.List(ctx, subId)
+			for dbs.Next() {
+				if req.Limit > 0 && count >= req.Limit {
+					return
+				}
+				count++
+
+				db := dbs.Value()
+				model := listedEssentialsDatabaseModel(subId, redis.IntValue(db.DatabaseId))
+
+				result := req.NewListResult(ctx)
+				result.DisplayName = redis.StringValue(db.Name)
+				setDatabaseIdentity(ctx, result.Identity, &model, &result.Diagnostics)
+
+				if req.IncludeResource && !result.Diagnostics.HasError() {
+					if removed := r.readDatabase(ctx, &model, &result.Diagnostics); !removed && !result.Diagnostics.HasError() {
+						result.Diagnostics.Append(result.Resource.Set(ctx, model)...)
+					}
+				}
+
+				if !push(result) {
+					return
+				}
+			}
+			if err := dbs.Err(); err != nil {
+				result := list.ListResult{}
+				result.Diagnostics.AddError("Failed to list databases", err.Error())
+				push(result)
+				return
+			}
+		}
+	}
+}
+
+// listedEssentialsDatabaseModel returns the model of a listed database, before it is read. The attributes which
+// aren't read from the API are null.
+func listedEssentialsDatabaseModel(subId int, dbId int) EssentialsDatabaseModel {
+	return EssentialsDatabaseModel{
+		ID:                    types.StringValue(utils.BuildResourceId(subId, dbId)),
+		SubscriptionID:        types.Int64Value(int64(subId)),
+		DbID:                  types.Int64Value(int64(dbId)),
+		PeriodicBackupPath:    types.StringNull(),
+		SourceIPs:             types.ListNull(types.StringType),
+		Replica:               types.ListNull(types.ObjectType{AttrTypes: replicaAttrTypes()}),
+		ClientTLSCertificates: types.ListNull(types.StringType),
+		Password:              types.StringNull(),
+		Alert:                 types.SetNull(types.ObjectType{AttrTypes: utils.AlertAttrTypes()}),
+		Modules:               types.SetNull(types.ObjectType{AttrTypes: moduleAttrTypes()}),
+		RegexRules:            types.ListNull(types.StringType),
+		Tags:                  types.MapNull(types.StringType),
+		Timeouts:              nullTimeouts(),
+	}
+}
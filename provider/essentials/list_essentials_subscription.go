@@ -0,0 +1,72 @@
+package essentials
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ list.ListResource              = &essentialsSubscriptionListResource{}
+	_ list.ListResourceWithConfigure = &essentialsSubscriptionListResource{}
+)
+
+// essentialsSubscriptionListResource lists the Essentials subscriptions of the account. It shares the type name,
+// client and read logic of the managed resource.
+type essentialsSubscriptionListResource struct {
+	essentialsSubscriptionResource
+}
+
+// NewEssentialsSubscriptionListResource returns a new list resource instance.
+func NewEssentialsSubscriptionListResource() list.ListResource {
+	return &essentialsSubscriptionListResource{}
+}
+
+// ListResourceConfigSchema defines the filters accepted by the list resource.
+func (r *essentialsSubscriptionListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		Description: "Lists the account's Essentials subscriptions",
+	}
+}
+
+// List streams one result per Essentials subscription.
+func (r *essentialsSubscriptionListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	stream.Results = func(push func(list.ListResult) bool) {
+		subs, err := r.client.Client.FixedSubscriptions.List(ctx)
+		if err != nil {
+			result := list.ListResult{}
+			result.Diagnostics.AddError("Failed to list subscriptions", err.Error())
+			push(result)
+			return
+		}
+
+		for i, sub := range subs {
+			if req.Limit > 0 && int64(i) >= req.Limit {
+				return
+			}
+
+			model := EssentialsSubscriptionModel{
+				ID:       types.StringValue(strconv.Itoa(redis.IntValue(sub.ID))),
+				Timeouts: nullTimeouts(),
+			}
+
+			result := req.NewListResult(ctx)
+			result.DisplayName = redis.StringValue(sub.Name)
+			setSubscriptionIdentity(ctx, result.Identity, &model, &result.Diagnostics)
+
+			if req.IncludeResource && !result.Diagnostics.HasError() {
+				if removed := r.readSubscription(ctx, &model, &result.Diagnostics); !removed && !result.Diagnostics.HasError() {
+					result.Diagnostics.Append(result.Resource.Set(ctx, model)...)
+				}
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package essentials
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/RedisLabs/rediscloud-go-api/service/fixed/plans"
+	fixedSubscriptions "github.com/RedisLabs/rediscloud-go-api/service/fixed/subscriptions"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/utils"
+)
+
+// subscriptionPlan returns the plan of an Essentials subscription, or nil when the subscription or its plan can't
+// be found.
+func (r *essentialsDatabaseResource) subscriptionPlan(ctx context.Context, subId int) (*plans.GetPlanResponse, error) {
+	subscription, err := r.client.Client.FixedSubscriptions.Get(ctx, subId)
+	if err != nil {
+		notFound := &fixedSubscriptions.NotFound{}
+		if errors.As(err, &notFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	allPlans, err := r.client.Client.FixedPlans.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	planId := redis.IntValue(subscription.PlanId)
+	for _, plan := range allPlans {
+		if redis.IntValue(plan.ID) == planId {
+			return plan, nil
+		}
+	}
+
+	log.Printf("[DEBUG] Plan %d of Essentials subscription %d not found, skipping the plan's validation", planId, subId)
+	return nil, nil
+}
+
+// validatePlanCapabilities checks that the planned database only uses features the subscription's plan supports.
+// Values which aren't known yet, and capabilities the plan doesn't report, aren't checked.
+func validatePlanCapabilities(ctx context.Context, plan *plans.GetPlanResponse, db *EssentialsDatabaseModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	planName := fmt.Sprintf("%q (%d)", redis.StringValue(plan.Name), redis.IntValue(plan.ID))
+	unsupported := func(attribute string, feature string) {
+		diags.AddAttributeError(
+			path.Root(attribute),
+			"Feature not supported by plan",
+			fmt.Sprintf("The subscription's plan %s doesn't support %s. Change the subscription to a plan which supports it, or remove it from the database.", planName, feature),
+		)
+	}
+
+	if plan.SupportReplication != nil && !*plan.SupportReplication && db.Replication.ValueBool() {
+		unsupported("replication", "replication")
+	}
+
+	if plan.SupportDataPersistence != nil && !*plan.SupportDataPersistence &&
+		utils.IsConfigured(db.DataPersistence) && db.DataPersistence.ValueString() != "none" {
+		unsupported("data_persistence", fmt.Sprintf("data persistence %q", db.DataPersistence.ValueString()))
+	}
+
+	if plan.SupportInstantAndDailyBackups != nil && !*plan.SupportInstantAndDailyBackups && db.PeriodicBackupPath.ValueString() != "" {
+		unsupported("periodic_backup_path", "backups")
+	}
+
+	// Clustering is only applied with the PAYG features
+	if plan.SupportClustering != nil && !*plan.SupportClustering && db.EnablePaygFeatures.ValueBool() {
+		if db.EnableDatabaseClustering.ValueBool() {
+			unsupported("enable_database_clustering", "clustering")
+		}
+		if utils.IsConfigured(db.RegexRules) && len(db.RegexRules.Elements()) > 0 {
+			unsupported("regex_rules", "clustering, which regex rules configure")
+		}
+	}
+
+	if plan.CidrAllowRules != nil && *plan.CidrAllowRules > 0 && utils.IsConfigured(db.SourceIPs) && len(db.SourceIPs.Elements()) > *plan.CidrAllowRules {
+		diags.AddAttributeError(
+			path.Root("source_ips"),
+			"Too many source IPs for plan",
+			fmt.Sprintf("The subscription's plan %s allows at most %d source IPs, but %d are configured.", planName, *plan.CidrAllowRules, len(db.SourceIPs.Elements())),
+		)
+	}
+
+	if plan.SupportedAlerts != nil && utils.IsConfigured(db.Alert) {
+		var alerts []utils.AlertModel
+		diags.Append(db.Alert.ElementsAs(ctx, &alerts, true)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		supported := redis.StringSliceValue(plan.SupportedAlerts...)
+		for _, alert := range alerts {
+			if !utils.IsConfigured(alert.Name) || slices.Contains(supported, alert.Name.ValueString()) {
+				continue
+			}
+			diags.AddAttributeError(
+				path.Root("alert"),
+				"Alert not supported by plan",
+				fmt.Sprintf("The subscription's plan %s doesn't support the %q alert. Supported alerts: %s.", planName, alert.Name.ValueString(), strings.Join(supported, ", ")),
+			)
+		}
+	}
+
+	return diags
+}
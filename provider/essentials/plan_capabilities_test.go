@@ -0,0 +1,161 @@
+package essentials
+
+import (
+	"context"
+	"testing"
+
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/RedisLabs/rediscloud-go-api/service/fixed/plans"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/utils"
+)
+
+func TestUnitValidatePlanCapabilities(t *testing.T) {
+	ctx := context.Background()
+
+	freePlan := func() *plans.GetPlanResponse {
+		return &plans.GetPlanResponse{
+			ID:                            redis.Int(1),
+			Name:                          redis.String("Free 30MB"),
+			SupportReplication:            redis.Bool(false),
+			SupportDataPersistence:        redis.Bool(false),
+			SupportInstantAndDailyBackups: redis.Bool(false),
+			SupportClustering:             redis.Bool(false),
+			CidrAllowRules:                redis.Int(1),
+			SupportedAlerts:               redis.StringSlice("throughput-higher-than"),
+		}
+	}
+	strings := func(values ...string) types.List {
+		elements := make([]attr.Value, 0, len(values))
+		for _, v := range values {
+			elements = append(elements, types.StringValue(v))
+		}
+		return types.ListValueMust(types.StringType, elements)
+	}
+	alerts := func(names ...string) types.Set {
+		elements := make([]attr.Value, 0, len(names))
+		for _, name := range names {
+			elements = append(elements, types.ObjectValueMust(utils.AlertAttrTypes(), map[string]attr.Value{
+				"name":  types.StringValue(name),
+				"value": types.Int64Value(80),
+			}))
+		}
+		return types.SetValueMust(types.ObjectType{AttrTypes: utils.AlertAttrTypes()}, elements)
+	}
+	database := func() EssentialsDatabaseModel {
+		return EssentialsDatabaseModel{
+			Replication:              types.BoolValue(false),
+			DataPersistence:          types.StringValue("none"),
+			PeriodicBackupPath:       types.StringNull(),
+			EnablePaygFeatures:       types.BoolValue(false),
+			EnableDatabaseClustering: types.BoolUnknown(),
+			RegexRules:               types.ListUnknown(types.StringType),
+			SourceIPs:                types.ListNull(types.StringType),
+			Alert:                    alerts(),
+		}
+	}
+
+	tests := []struct {
+		name     string
+		plan     func(plan *plans.GetPlanResponse)
+		modify   func(db *EssentialsDatabaseModel)
+		expected []string
+	}{
+		{
+			name:   "supported database",
+			plan:   func(plan *plans.GetPlanResponse) {},
+			modify: func(db *EssentialsDatabaseModel) {},
+		},
+		{
+			name: "unsupported features",
+			plan: func(plan *plans.GetPlanResponse) {},
+			modify: func(db *EssentialsDatabaseModel) {
+				db.Replication = types.BoolValue(true)
+				db.DataPersistence = types.StringValue("aof-every-1-second")
+				db.PeriodicBackupPath = types.StringValue("s3://bucket")
+			},
+			expected: []string{"replication", "data_persistence", "periodic_backup_path"},
+		},
+		{
+			name: "clustering is ignored without the PAYG features",
+			plan: func(plan *plans.GetPlanResponse) {},
+			modify: func(db *EssentialsDatabaseModel) {
+				db.EnableDatabaseClustering = types.BoolValue(true)
+				db.RegexRules = strings(".*")
+			},
+		},
+		{
+			name: "clustering with the PAYG features",
+			plan: func(plan *plans.GetPlanResponse) {},
+			modify: func(db *EssentialsDatabaseModel) {
+				db.EnablePaygFeatures = types.BoolValue(true)
+				db.EnableDatabaseClustering = types.BoolValue(true)
+				db.RegexRules = strings(".*")
+			},
+			expected: []string{"enable_database_clustering", "regex_rules"},
+		},
+		{
+			name: "too many source IPs",
+			plan: func(plan *plans.GetPlanResponse) {},
+			modify: func(db *EssentialsDatabaseModel) {
+				db.SourceIPs = strings("10.0.0.0/16", "10.1.0.0/16")
+			},
+			expected: []string{"source_ips"},
+		},
+		{
+			name: "unsupported alert",
+			plan: func(plan *plans.GetPlanResponse) {},
+			modify: func(db *EssentialsDatabaseModel) {
+				db.Alert = alerts("throughput-higher-than", "dataset-size")
+			},
+			expected: []string{"alert"},
+		},
+		{
+			name: "capabilities the plan doesn't report aren't checked",
+			plan: func(plan *plans.GetPlanResponse) {
+				plan.SupportReplication = nil
+				plan.CidrAllowRules = nil
+				plan.SupportedAlerts = nil
+			},
+			modify: func(db *EssentialsDatabaseModel) {
+				db.Replication = types.BoolValue(true)
+				db.SourceIPs = strings("10.0.0.0/16", "10.1.0.0/16")
+				db.Alert = alerts("dataset-size")
+			},
+		},
+		{
+			name: "unknown values aren't checked",
+			plan: func(plan *plans.GetPlanResponse) {},
+			modify: func(db *EssentialsDatabaseModel) {
+				db.Replication = types.BoolUnknown()
+				db.DataPersistence = types.StringUnknown()
+				db.SourceIPs = types.ListUnknown(types.StringType)
+				db.Alert = types.SetUnknown(types.ObjectType{AttrTypes: utils.AlertAttrTypes()})
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			plan := freePlan()
+			tc.plan(plan)
+			db := database()
+			tc.modify(&db)
+
+			diags := validatePlanCapabilities(ctx, plan, &db)
+
+			var attributes []string
+			for _, d := range diags.Errors() {
+				if withPath, ok := d.(diag.DiagnosticWithPath); ok {
+					attributes = append(attributes, withPath.Path().String())
+				}
+			}
+			assert.Len(t, diags.Errors(), len(tc.expected))
+			assert.Equal(t, tc.expected, attributes)
+		})
+	}
+}
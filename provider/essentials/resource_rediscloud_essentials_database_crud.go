@@ -0,0 +1,590 @@
+package essentials
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/RedisLabs/rediscloud-go-api/service/databases"
+	fixedDatabases "github.com/RedisLabs/rediscloud-go-api/service/fixed/databases"
+	redisTags "github.com/RedisLabs/rediscloud-go-api/service/tags"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/client"
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/pro"
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/utils"
+)
+
+// createDatabase implements the Create operation for the Essentials database resource.
+func (r *essentialsDatabaseResource) createDatabase(ctx context.Context, plan *EssentialsDatabaseModel, diagnostics *diag.Diagnostics) {
+	subId := int(plan.SubscriptionID.ValueInt64())
+
+	unlock, err := utils.LockSubscription(ctx, subId)
+	if err != nil {
+		diagnostics.AddError("Failed to lock subscription", err.Error())
+		return
+	}
+	defer unlock()
+
+	replica, diags := buildReplica(ctx, plan.Replica)
+	diagnostics.Append(diags...)
+	alerts, diags := utils.BuildAlerts(ctx, plan.Alert)
+	diagnostics.Append(diags...)
+	if diagnostics.HasError() {
+		return
+	}
+
+	createModules := make([]*databases.Module, 0)
+	if utils.IsConfigured(plan.Modules) {
+		var modules []ModuleModel
+		diagnostics.Append(plan.Modules.ElementsAs(ctx, &modules, false)...)
+		if diagnostics.HasError() {
+			return
+		}
+		for _, module := range modules {
+			createModules = append(createModules, &databases.Module{Name: redis.String(module.Name.ValueString())})
+		}
+	}
+
+	createDatabaseRequest := fixedDatabases.CreateFixedDatabase{
+		Name:                  redis.String(plan.Name.ValueString()),
+		DataPersistence:       redis.String(plan.DataPersistence.ValueString()),
+		DataEvictionPolicy:    redis.String(plan.DataEviction.ValueString()),
+		Replication:           redis.Bool(plan.Replication.ValueBool()),
+		PeriodicBackupPath:    redis.String(plan.PeriodicBackupPath.ValueString()),
+		SourceIPs:             buildSourceIPs(ctx, plan.SourceIPs, diagnostics),
+		Replica:               replica,
+		ClientTlsCertificates: buildClientTLSCertificates(ctx, plan.ClientTLSCertificates, diagnostics),
+		Alerts:                &alerts,
+		Modules:               &createModules,
+	}
+	if diagnostics.HasError() {
+		return
+	}
+
+	if utils.IsConfigured(plan.Protocol) && plan.Protocol.ValueString() != "" {
+		createDatabaseRequest.Protocol = redis.String(plan.Protocol.ValueString())
+	}
+
+	if utils.IsConfigured(plan.RedisVersion) && plan.RedisVersion.ValueString() != "" {
+		createDatabaseRequest.RedisVersion = redis.String(plan.RedisVersion.ValueString())
+	}
+
+	if utils.IsConfigured(plan.RespVersion) && plan.RespVersion.ValueString() != "" {
+		createDatabaseRequest.RespVersion = redis.String(plan.RespVersion.ValueString())
+	}
+
+	if utils.IsConfigured(plan.Password) && plan.Password.ValueString() != "" {
+		createDatabaseRequest.Password = redis.String(plan.Password.ValueString())
+	}
+
+	if plan.EnablePaygFeatures.ValueBool() {
+		createDatabaseRequest.MemoryLimitInGB = redis.Float64(plan.MemoryLimitInGB.ValueFloat64())
+		createDatabaseRequest.SupportOSSClusterAPI = redis.Bool(plan.SupportOSSClusterAPI.ValueBool())
+		createDatabaseRequest.UseExternalEndpointForOSSClusterAPI = redis.Bool(plan.ExternalEndpointForOSSClusterAPI.ValueBool())
+		createDatabaseRequest.EnableDatabaseClustering = redis.Bool(plan.EnableDatabaseClustering.ValueBool())
+		createDatabaseRequest.RegexRules = buildStrings(ctx, plan.RegexRules, diagnostics)
+		createDatabaseRequest.EnableTls = redis.Bool(plan.EnableTLS.ValueBool())
+		if diagnostics.HasError() {
+			return
+		}
+	}
+
+	dbId, err := r.client.Client.FixedDatabases.Create(ctx, subId, createDatabaseRequest)
+	if err != nil {
+		log.Printf("[ERROR] FixedDatabases.Create failed for subscription %d: %v", subId, err)
+		diagnostics.AddError("Failed to create database", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(utils.BuildResourceId(subId, dbId))
+	plan.DbID = types.Int64Value(int64(dbId))
+
+	if err := waitForDatabaseToBeActive(ctx, subId, dbId, r.client); err != nil {
+		diagnostics.AddError("Database failed to become active", err.Error())
+		return
+	}
+
+	// Some attributes on a database are not accessible by the create API, such as enable_default_user and the tags.
+	// Run the update function to apply them. The database already has the planned version.
+	unlock()
+	r.updateDatabase(ctx, plan, plan.RedisVersion, diagnostics)
+	if diagnostics.HasError() {
+		return
+	}
+
+	// Read back the state to get computed values
+	r.readDatabase(ctx, plan, diagnostics)
+}
+
+// readDatabase implements the Read operation for the Essentials database resource.
+// Returns true if the resource was removed (not found).
+func (r *essentialsDatabaseResource) readDatabase(ctx context.Context, state *EssentialsDatabaseModel, diagnostics *diag.Diagnostics) bool {
+	subId, dbId, err := pro.ToDatabaseId(state.ID.ValueString())
+	if err != nil {
+		diagnostics.AddError("Invalid resource ID", err.Error())
+		return false
+	}
+
+	// Handle case where subscription_id is 0 (not importing)
+	if subId == 0 {
+		subId = int(state.SubscriptionID.ValueInt64())
+	}
+
+	db, err := r.client.Client.FixedDatabases.Get(ctx, subId, dbId)
+	if err != nil {
+		notFound := &fixedDatabases.NotFound{}
+		if errors.As(err, &notFound) {
+			log.Printf("[DEBUG] Essentials database %d not found, removing from state", dbId)
+			return true
+		}
+		diagnostics.AddError("Failed to read database", err.Error())
+		return false
+	}
+
+	state.ID = types.StringValue(utils.BuildResourceId(subId, dbId))
+	state.SubscriptionID = types.Int64Value(int64(subId))
+	state.DbID = types.Int64Value(int64(redis.IntValue(db.DatabaseId)))
+	state.Name = types.StringValue(redis.StringValue(db.Name))
+	state.DeletionProtection = utils.DeletionProtectionValue(state.DeletionProtection)
+	state.Protocol = types.StringValue(redis.StringValue(db.Protocol))
+	state.RedisVersion = types.StringValue(redis.StringValue(db.RedisVersion))
+	state.CloudProvider = types.StringValue(redis.StringValue(db.Provider))
+	state.Region = types.StringValue(redis.StringValue(db.Region))
+	state.RedisVersionCompliance = types.StringValue(redis.StringValue(db.RedisVersionCompliance))
+	state.RespVersion = types.StringValue(redis.StringValue(db.RespVersion))
+	state.DataPersistence = types.StringValue(redis.StringValue(db.DataPersistence))
+	state.DataEviction = types.StringValue(redis.StringValue(db.DataEvictionPolicy))
+	state.Replication = types.BoolValue(redis.BoolValue(db.Replication))
+	state.ActivatedOn = types.StringValue(redis.TimeValue(db.ActivatedOn).String())
+	state.PublicEndpoint = types.StringValue(redis.StringValue(db.PublicEndpoint))
+	state.PrivateEndpoint = types.StringValue(redis.StringValue(db.PrivateEndpoint))
+
+	// Periodic Backup Path is not returned by the API directly, it might be in the backup object
+	if db.Backup != nil && redis.BoolValue(db.Backup.Enabled) {
+		state.PeriodicBackupPath = types.StringValue(redis.StringValue(db.Backup.Destination))
+	}
+
+	replica, diags := flattenReplica(db.Replica)
+	diagnostics.Append(diags...)
+	state.Replica = replica
+
+	// Client TLS Certificates are not returned
+
+	var apiAlerts []*databases.Alert
+	if db.Alerts != nil {
+		apiAlerts = *db.Alerts
+	}
+	alerts, diags := utils.FlattenAlerts(apiAlerts)
+	diagnostics.Append(diags...)
+	if alerts.IsNull() {
+		// The alert block is never null in the configuration
+		alerts = types.SetValueMust(types.ObjectType{AttrTypes: utils.AlertAttrTypes()}, []attr.Value{})
+	}
+	state.Alert = alerts
+
+	modules := make([]attr.Value, 0)
+	if db.Modules != nil {
+		for _, module := range *db.Modules {
+			modules = append(modules, types.ObjectValueMust(moduleAttrTypes(), map[string]attr.Value{
+				"name": types.StringValue(redis.StringValue(module.Name)),
+			}))
+		}
+	}
+	state.Modules = types.SetValueMust(types.ObjectType{AttrTypes: moduleAttrTypes()}, modules)
+
+	if db.Security != nil {
+		state.Password = types.StringValue(redis.StringValue(db.Security.Password))
+		state.EnableDefaultUser = types.BoolValue(redis.BoolValue(db.Security.EnableDefaultUser))
+	} else if state.Password.IsUnknown() {
+		state.Password = types.StringNull()
+	}
+	if state.EnableDefaultUser.IsNull() {
+		state.EnableDefaultUser = types.BoolValue(true)
+	}
+	if state.EnablePaygFeatures.IsNull() {
+		state.EnablePaygFeatures = types.BoolValue(false)
+	}
+
+	r.readPaygAttributes(ctx, db, state, diagnostics)
+	if diagnostics.HasError() {
+		return false
+	}
+
+	tagResponse, err := r.client.Client.Tags.GetFixed(ctx, subId, dbId)
+	if err != nil {
+		diagnostics.AddError("Failed to read tags", err.Error())
+		return false
+	}
+	tags := make(map[string]string)
+	if tagResponse.Tags != nil {
+		for _, t := range *tagResponse.Tags {
+			tags[redis.StringValue(t.Key)] = redis.StringValue(t.Value)
+		}
+	}
+	if len(tags) == 0 && !utils.IsConfigured(state.Tags) {
+		state.Tags = types.MapNull(types.StringType)
+	} else {
+		tagsValue, diags := types.MapValueFrom(ctx, types.StringType, tags)
+		diagnostics.Append(diags...)
+		state.Tags = tagsValue
+	}
+
+	return false
+}
+
+// readPaygAttributes reads the attributes which are only applied with the PAYG features. Without them, the values
+// in the state are kept, so that configuring the attributes doesn't cause a diff, as the SDK implementation of the
+// resource suppressed their diff.
+func (r *essentialsDatabaseResource) readPaygAttributes(ctx context.Context, db *fixedDatabases.FixedDatabase, state *EssentialsDatabaseModel, diagnostics *diag.Diagnostics) {
+	refresh := func(value attr.Value) bool {
+		return state.EnablePaygFeatures.ValueBool() || !utils.IsConfigured(value)
+	}
+
+	var apiSourceIPs []*string
+	if db.Security != nil {
+		apiSourceIPs = db.Security.SourceIPs
+	}
+	if state.EnablePaygFeatures.ValueBool() || state.SourceIPs.IsNull() {
+		sourceIPs := redis.StringSliceValue(apiSourceIPs...)
+		// The API handles an empty list as ["0.0.0.0/0"] but need to be careful to match the input to avoid Terraform
+		// detecting drift
+		if len(sourceIPs) == 0 || (len(sourceIPs) == 1 && sourceIPs[0] == utils.DefaultPublicSourceIP && !isDefaultSourceIPs(state.SourceIPs)) {
+			state.SourceIPs = types.ListNull(types.StringType)
+		} else {
+			value, diags := types.ListValueFrom(ctx, types.StringType, sourceIPs)
+			diagnostics.Append(diags...)
+			state.SourceIPs = value
+		}
+	}
+
+	if refresh(state.MemoryLimitInGB) {
+		state.MemoryLimitInGB = types.Float64Value(redis.Float64Value(db.MemoryLimitInGb))
+	}
+	if refresh(state.SupportOSSClusterAPI) {
+		state.SupportOSSClusterAPI = types.BoolValue(redis.BoolValue(db.SupportOSSClusterAPI))
+	}
+	if refresh(state.ExternalEndpointForOSSClusterAPI) {
+		state.ExternalEndpointForOSSClusterAPI = types.BoolValue(redis.BoolValue(db.UseExternalEndpointForOSSClusterAPI))
+	}
+
+	if refresh(state.EnableDatabaseClustering) {
+		enabled := db.Clustering != nil && redis.BoolValue(db.Clustering.Enabled)
+		state.EnableDatabaseClustering = types.BoolValue(enabled)
+	}
+	if refresh(state.RegexRules) {
+		regexRules := []string{}
+		if db.Clustering != nil {
+			regexRules = pro.FlattenRegexRules(db.Clustering.RegexRules)
+		}
+		value, diags := types.ListValueFrom(ctx, types.StringType, regexRules)
+		diagnostics.Append(diags...)
+		state.RegexRules = value
+	}
+
+	if refresh(state.EnableTLS) {
+		enabled := db.Security != nil && redis.BoolValue(db.Security.EnableTls)
+		state.EnableTLS = types.BoolValue(enabled)
+	}
+}
+
+// isDefaultSourceIPs returns whether the source IPs only allow every address, as the API does by default.
+func isDefaultSourceIPs(sourceIPs types.List) bool {
+	elements := sourceIPs.Elements()
+	return len(elements) == 1 && elements[0].Equal(types.StringValue(utils.DefaultPublicSourceIP))
+}
+
+// updateDatabase implements the Update operation for the Essentials database resource. The Redis version is upgraded
+// first if it differs from the prior one.
+func (r *essentialsDatabaseResource) updateDatabase(ctx context.Context, plan *EssentialsDatabaseModel, priorRedisVersion types.String, diagnostics *diag.Diagnostics) {
+	subId, dbId, err := pro.ToDatabaseId(plan.ID.ValueString())
+	if err != nil {
+		diagnostics.AddError("Invalid resource ID", err.Error())
+		return
+	}
+	if subId == 0 {
+		subId = int(plan.SubscriptionID.ValueInt64())
+	}
+
+	unlock, err := utils.LockSubscription(ctx, subId)
+	if err != nil {
+		diagnostics.AddError("Failed to lock subscription", err.Error())
+		return
+	}
+	defer unlock()
+
+	// If redis_version has changed, then upgrade first. Only upgrade when a known version goes to another known
+	// version, as either being blank could attempt to upgrade unnecessarily.
+	newVersion := plan.RedisVersion.ValueString()
+	if priorRedisVersion.ValueString() != "" && newVersion != "" && priorRedisVersion.ValueString() != newVersion {
+		if err := r.upgradeRedisVersion(ctx, subId, dbId, newVersion); err != nil {
+			diagnostics.AddError("Failed to upgrade Redis version", err.Error())
+			return
+		}
+	}
+
+	replica, diags := buildReplica(ctx, plan.Replica)
+	diagnostics.Append(diags...)
+	// BuildAlerts returns a non-nil (but zero length) slice when there are no alerts, so that they are removed
+	alerts, diags := utils.BuildAlerts(ctx, plan.Alert)
+	diagnostics.Append(diags...)
+	if diagnostics.HasError() {
+		return
+	}
+
+	updateDatabaseRequest := fixedDatabases.UpdateFixedDatabase{
+		Name:                  redis.String(plan.Name.ValueString()),
+		DataPersistence:       redis.String(plan.DataPersistence.ValueString()),
+		DataEvictionPolicy:    redis.String(plan.DataEviction.ValueString()),
+		Replication:           redis.Bool(plan.Replication.ValueBool()),
+		PeriodicBackupPath:    redis.String(plan.PeriodicBackupPath.ValueString()),
+		EnableDefaultUser:     redis.Bool(plan.EnableDefaultUser.ValueBool()),
+		SourceIPs:             buildSourceIPs(ctx, plan.SourceIPs, diagnostics),
+		Replica:               replica,
+		ClientTlsCertificates: buildClientTLSCertificates(ctx, plan.ClientTLSCertificates, diagnostics),
+		Alerts:                &alerts,
+	}
+	if diagnostics.HasError() {
+		return
+	}
+
+	if utils.IsConfigured(plan.RespVersion) && plan.RespVersion.ValueString() != "" {
+		updateDatabaseRequest.RespVersion = redis.String(plan.RespVersion.ValueString())
+	}
+
+	// can't update the password and disable the default user at once
+	if utils.IsConfigured(plan.Password) && plan.Password.ValueString() != "" && plan.EnableDefaultUser.ValueBool() {
+		updateDatabaseRequest.Password = redis.String(plan.Password.ValueString())
+	}
+
+	if plan.EnablePaygFeatures.ValueBool() {
+		updateDatabaseRequest.MemoryLimitInGB = redis.Float64(plan.MemoryLimitInGB.ValueFloat64())
+		updateDatabaseRequest.SupportOSSClusterAPI = redis.Bool(plan.SupportOSSClusterAPI.ValueBool())
+		updateDatabaseRequest.UseExternalEndpointForOSSClusterAPI = redis.Bool(plan.ExternalEndpointForOSSClusterAPI.ValueBool())
+		updateDatabaseRequest.EnableDatabaseClustering = redis.Bool(plan.EnableDatabaseClustering.ValueBool())
+		updateDatabaseRequest.RegexRules = buildStrings(ctx, plan.RegexRules, diagnostics)
+		updateDatabaseRequest.EnableTls = redis.Bool(plan.EnableTLS.ValueBool())
+		if diagnostics.HasError() {
+			return
+		}
+	}
+
+	if err := r.client.Client.FixedDatabases.Update(ctx, subId, dbId, updateDatabaseRequest); err != nil {
+		diagnostics.AddError("Failed to update database", err.Error())
+		return
+	}
+
+	if err := waitForDatabaseToBeActive(ctx, subId, dbId, r.client); err != nil {
+		diagnostics.AddError("Database not active", err.Error())
+		return
+	}
+
+	if err := waitForSubscriptionToBeActive(ctx, subId, r.client); err != nil {
+		diagnostics.AddError("Subscription not active", err.Error())
+		return
+	}
+
+	// The Tags API is synchronous so we shouldn't have to wait for anything.
+	// When tags is null (not in config), all tags are deleted by sending an empty list.
+	tagList := make([]*redisTags.Tag, 0)
+	if utils.IsConfigured(plan.Tags) {
+		var tags map[string]string
+		diagnostics.Append(plan.Tags.ElementsAs(ctx, &tags, false)...)
+		if diagnostics.HasError() {
+			return
+		}
+		for k, v := range tags {
+			tagList = append(tagList, &redisTags.Tag{
+				Key:   redis.String(k),
+				Value: redis.String(v),
+			})
+		}
+	}
+	if err := r.client.Client.Tags.PutFixed(ctx, subId, dbId, redisTags.AllTags{Tags: &tagList}); err != nil {
+		diagnostics.AddError("Failed to update tags", err.Error())
+		return
+	}
+}
+
+// upgradeRedisVersion changes the Redis version of the database, waiting for the database and subscription to be
+// active again.
+func (r *essentialsDatabaseResource) upgradeRedisVersion(ctx context.Context, subId int, dbId int, newVersion string) error {
+	log.Printf("[INFO] Requesting Redis version change to %s...", newVersion)
+
+	upgrade := fixedDatabases.UpgradeRedisVersion{
+		TargetRedisVersion: redis.String(newVersion),
+	}
+
+	if err := r.client.Client.FixedDatabases.UpgradeRedisVersion(ctx, subId, dbId, upgrade); err != nil {
+		return fmt.Errorf("failed to change Redis version to %s: %w", newVersion, err)
+	}
+
+	log.Printf("[INFO] Redis version change request to %s accepted by API", newVersion)
+
+	if err := waitForDatabaseToBeActive(ctx, subId, dbId, r.client); err != nil {
+		return err
+	}
+	return waitForSubscriptionToBeActive(ctx, subId, r.client)
+}
+
+// deleteDatabase implements the Delete operation for the Essentials database resource.
+func (r *essentialsDatabaseResource) deleteDatabase(ctx context.Context, state *EssentialsDatabaseModel, diagnostics *diag.Diagnostics) {
+	subId := int(state.SubscriptionID.ValueInt64())
+	_, dbId, err := pro.ToDatabaseId(state.ID.ValueString())
+	if err != nil {
+		diagnostics.AddError("Invalid resource ID", err.Error())
+		return
+	}
+
+	utils.CheckDeletionProtection(state.DeletionProtection, fmt.Sprintf("Database %d", dbId), diagnostics)
+	if diagnostics.HasError() {
+		return
+	}
+
+	unlock, err := utils.LockSubscription(ctx, subId)
+	if err != nil {
+		diagnostics.AddError("Failed to lock subscription", err.Error())
+		return
+	}
+	defer unlock()
+
+	if err := waitForDatabaseToBeActive(ctx, subId, dbId, r.client); err != nil {
+		diagnostics.AddError("Database not active", err.Error())
+		return
+	}
+
+	if err := r.client.Client.FixedDatabases.Delete(ctx, subId, dbId); err != nil {
+		diagnostics.AddError("Failed to delete database", err.Error())
+		return
+	}
+}
+
+// buildSourceIPs converts the source IPs to the API request format. Without any, every address is allowed.
+func buildSourceIPs(ctx context.Context, sourceIPs types.List, diagnostics *diag.Diagnostics) []*string {
+	values := buildStrings(ctx, sourceIPs, diagnostics)
+	if len(values) == 0 {
+		return []*string{redis.String(utils.DefaultPublicSourceIP)}
+	}
+	return values
+}
+
+// buildClientTLSCertificates converts the client TLS certificates to the API request format.
+func buildClientTLSCertificates(ctx context.Context, certificates types.List, diagnostics *diag.Diagnostics) []*fixedDatabases.DatabaseCertificate {
+	var result []*fixedDatabases.DatabaseCertificate
+	for _, cert := range buildStrings(ctx, certificates, diagnostics) {
+		result = append(result, &fixedDatabases.DatabaseCertificate{
+			PublicCertificatePEMString: cert,
+		})
+	}
+	return result
+}
+
+// buildStrings converts a list of strings to the API request format.
+func buildStrings(ctx context.Context, list types.List, diagnostics *diag.Diagnostics) []*string {
+	if !utils.IsConfigured(list) {
+		return nil
+	}
+	var values []string
+	diagnostics.Append(list.ElementsAs(ctx, &values, false)...)
+	return redis.StringSlice(values...)
+}
+
+// buildReplica converts the replica block to the API request format.
+func buildReplica(ctx context.Context, replicaList types.List) (*fixedDatabases.ReplicaOf, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if !utils.IsConfigured(replicaList) {
+		return nil, diags
+	}
+
+	var replicas []ReplicaModel
+	diags.Append(replicaList.ElementsAs(ctx, &replicas, false)...)
+	if diags.HasError() || len(replicas) != 1 {
+		return nil, diags
+	}
+
+	var sources []SyncSourceModel
+	diags.Append(replicas[0].SyncSource.ElementsAs(ctx, &sources, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	syncSources := make([]*fixedDatabases.SyncSource, 0, len(sources))
+	for _, source := range sources {
+		syncSources = append(syncSources, &fixedDatabases.SyncSource{
+			Endpoint:   redis.String(source.Endpoint.ValueString()),
+			Encryption: redis.Bool(source.Encryption.ValueBool()),
+			ServerCert: redis.String(source.ServerCert.ValueString()),
+		})
+	}
+	return &fixedDatabases.ReplicaOf{SyncSources: syncSources}, diags
+}
+
+// flattenReplica converts the database's replica to the replica block, which is empty rather than null when the
+// database isn't a replica.
+func flattenReplica(replica *fixedDatabases.ReplicaOf) (types.List, diag.Diagnostics) {
+	replicaType := types.ObjectType{AttrTypes: replicaAttrTypes()}
+	syncSourceType := types.ObjectType{AttrTypes: syncSourceAttrTypes()}
+
+	if replica == nil {
+		return types.ListValueMust(replicaType, []attr.Value{}), nil
+	}
+
+	syncSources := make([]attr.Value, 0, len(replica.SyncSources))
+	for _, source := range replica.SyncSources {
+		syncSources = append(syncSources, types.ObjectValueMust(syncSourceAttrTypes(), map[string]attr.Value{
+			"endpoint":    types.StringValue(redis.StringValue(source.Endpoint)),
+			"encryption":  types.BoolValue(redis.BoolValue(source.Encryption)),
+			"server_cert": types.StringValue(redis.StringValue(source.ServerCert)),
+		}))
+	}
+	syncSourceSet, diags := types.SetValue(syncSourceType, syncSources)
+	if diags.HasError() {
+		return types.ListNull(replicaType), diags
+	}
+
+	return types.ListValue(replicaType, []attr.Value{
+		types.ObjectValueMust(replicaAttrTypes(), map[string]attr.Value{"sync_source": syncSourceSet}),
+	})
+}
+
+func waitForDatabaseToBeActive(ctx context.Context, subId, id int, api *client.ApiClient) error {
+	wait := &utils.Waiter{
+		Description: fmt.Sprintf("Essentials database %d in subscription %d", id, subId),
+		Delay:       30 * time.Second,
+		Pending: []string{
+			databases.StatusDraft,
+			databases.StatusPending,
+			databases.StatusActiveChangePending,
+			databases.StatusRCPActiveChangeDraft,
+			databases.StatusActiveChangeDraft,
+			databases.StatusRCPDraft,
+			databases.StatusRCPChangePending,
+			databases.StatusProxyPolicyChangePending,
+			databases.StatusProxyPolicyChangeDraft,
+			databases.StatusDynamicEndpointsCreationPending,
+		},
+		Target:       []string{databases.StatusActive},
+		PollInterval: 30 * time.Second,
+
+		Refresh: func() (result interface{}, state string, err error) {
+			log.Printf("[DEBUG] Waiting for fixed database %d to be active", id)
+
+			database, err := api.Client.FixedDatabases.Get(ctx, subId, id)
+			if err != nil {
+				return nil, "", err
+			}
+
+			return redis.StringValue(database.Status), redis.StringValue(database.Status), nil
+		},
+	}
+	if _, err := wait.Wait(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package essentials
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// EssentialsDatabaseModel describes the resource data model for the Essentials database.
+type EssentialsDatabaseModel struct {
+	ID                               types.String   `tfsdk:"id"`
+	SubscriptionID                   types.Int64    `tfsdk:"subscription_id"`
+	DbID                             types.Int64    `tfsdk:"db_id"`
+	Name                             types.String   `tfsdk:"name"`
+	Protocol                         types.String   `tfsdk:"protocol"`
+	RedisVersion                     types.String   `tfsdk:"redis_version"`
+	CloudProvider                    types.String   `tfsdk:"cloud_provider"`
+	Region                           types.String   `tfsdk:"region"`
+	RedisVersionCompliance           types.String   `tfsdk:"redis_version_compliance"`
+	RespVersion                      types.String   `tfsdk:"resp_version"`
+	DataPersistence                  types.String   `tfsdk:"data_persistence"`
+	DataEviction                     types.String   `tfsdk:"data_eviction"`
+	Replication                      types.Bool     `tfsdk:"replication"`
+	ActivatedOn                      types.String   `tfsdk:"activated_on"`
+	PeriodicBackupPath               types.String   `tfsdk:"periodic_backup_path"`
+	PublicEndpoint                   types.String   `tfsdk:"public_endpoint"`
+	PrivateEndpoint                  types.String   `tfsdk:"private_endpoint"`
+	SourceIPs                        types.List     `tfsdk:"source_ips"`
+	Replica                          types.List     `tfsdk:"replica"`
+	ClientTLSCertificates            types.List     `tfsdk:"client_tls_certificates"`
+	Password                         types.String   `tfsdk:"password"`
+	EnableDefaultUser                types.Bool     `tfsdk:"enable_default_user"`
+	Alert                            types.Set      `tfsdk:"alert"`
+	Modules                          types.Set      `tfsdk:"modules"`
+	EnablePaygFeatures               types.Bool     `tfsdk:"enable_payg_features"`
+	MemoryLimitInGB                  types.Float64  `tfsdk:"memory_limit_in_gb"`
+	SupportOSSClusterAPI             types.Bool     `tfsdk:"support_oss_cluster_api"`
+	ExternalEndpointForOSSClusterAPI types.Bool     `tfsdk:"external_endpoint_for_oss_cluster_api"`
+	EnableDatabaseClustering         types.Bool     `tfsdk:"enable_database_clustering"`
+	RegexRules                       types.List     `tfsdk:"regex_rules"`
+	EnableTLS                        types.Bool     `tfsdk:"enable_tls"`
+	Tags                             types.Map      `tfsdk:"tags"`
+	DeletionProtection               types.Bool     `tfsdk:"deletion_protection"`
+	Timeouts                         timeouts.Value `tfsdk:"timeouts"`
+}
+
+// ReplicaModel describes the replica nested block.
+type ReplicaModel struct {
+	SyncSource types.Set `tfsdk:"sync_source"`
+}
+
+// SyncSourceModel describes the sync_source nested block within replica.
+type SyncSourceModel struct {
+	Endpoint   types.String `tfsdk:"endpoint"`
+	Encryption types.Bool   `tfsdk:"encryption"`
+	ServerCert types.String `tfsdk:"server_cert"`
+}
+
+// ModuleModel describes a module of the database.
+type ModuleModel struct {
+	Name types.String `tfsdk:"name"`
+}
+
+// replicaAttrTypes returns the attribute types for replica objects.
+func replicaAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"sync_source": types.SetType{ElemType: types.ObjectType{AttrTypes: syncSourceAttrTypes()}},
+	}
+}
+
+// syncSourceAttrTypes returns the attribute types for sync_source objects.
+func syncSourceAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"endpoint":    types.StringType,
+		"encryption":  types.BoolType,
+		"server_cert": types.StringType,
+	}
+}
+
+// moduleAttrTypes returns the attribute types for module objects.
+func moduleAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"name": types.StringType,
+	}
+}
@@ -0,0 +1,49 @@
+package essentials
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// UpgradeState upgrades the state of databases managed by the SDK implementation of the resource, version 0.
+func (r *essentialsDatabaseResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	// The SDK implementation's state has the same shape, so is read with the current schema.
+	priorSchema := r.schema(ctx)
+	priorSchema.Version = 0
+
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &priorSchema,
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var state EssentialsDatabaseModel
+				resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				upgradeEssentialsDatabaseStateV0(&state)
+
+				resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+			},
+		},
+	}
+}
+
+// upgradeEssentialsDatabaseStateV0 replaces the empty values the SDK recorded for unconfigured attributes with null,
+// so that existing databases refresh without changes.
+func upgradeEssentialsDatabaseStateV0(state *EssentialsDatabaseModel) {
+	if len(state.SourceIPs.Elements()) == 0 {
+		state.SourceIPs = types.ListNull(types.StringType)
+	}
+	if len(state.ClientTLSCertificates.Elements()) == 0 {
+		state.ClientTLSCertificates = types.ListNull(types.StringType)
+	}
+	if len(state.Tags.Elements()) == 0 {
+		state.Tags = types.MapNull(types.StringType)
+	}
+	if state.PeriodicBackupPath.ValueString() == "" {
+		state.PeriodicBackupPath = types.StringNull()
+	}
+}
@@ -0,0 +1,77 @@
+package essentials
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitUpgradeEssentialsDatabaseStateV0(t *testing.T) {
+	strings := func(values ...string) types.List {
+		elements := make([]attr.Value, 0, len(values))
+		for _, v := range values {
+			elements = append(elements, types.StringValue(v))
+		}
+		return types.ListValueMust(types.StringType, elements)
+	}
+	sdkState := func() EssentialsDatabaseModel {
+		return EssentialsDatabaseModel{
+			SourceIPs:             strings(),
+			ClientTLSCertificates: strings(),
+			Tags:                  types.MapValueMust(types.StringType, map[string]attr.Value{}),
+			PeriodicBackupPath:    types.StringValue(""),
+			RegexRules:            strings(),
+		}
+	}
+	upgradedState := func() EssentialsDatabaseModel {
+		return EssentialsDatabaseModel{
+			SourceIPs:             types.ListNull(types.StringType),
+			ClientTLSCertificates: types.ListNull(types.StringType),
+			Tags:                  types.MapNull(types.StringType),
+			PeriodicBackupPath:    types.StringNull(),
+			RegexRules:            strings(),
+		}
+	}
+
+	tests := []struct {
+		name     string
+		modify   func(state *EssentialsDatabaseModel)
+		expected func(state *EssentialsDatabaseModel)
+	}{
+		{
+			name:     "empty values become null",
+			modify:   func(state *EssentialsDatabaseModel) {},
+			expected: func(state *EssentialsDatabaseModel) {},
+		},
+		{
+			name: "configured values are kept",
+			modify: func(state *EssentialsDatabaseModel) {
+				state.SourceIPs = strings("10.0.0.0/16")
+				state.ClientTLSCertificates = strings("cert")
+				state.Tags = types.MapValueMust(types.StringType, map[string]attr.Value{"env": types.StringValue("dev")})
+				state.PeriodicBackupPath = types.StringValue("s3://bucket")
+			},
+			expected: func(state *EssentialsDatabaseModel) {
+				state.SourceIPs = strings("10.0.0.0/16")
+				state.ClientTLSCertificates = strings("cert")
+				state.Tags = types.MapValueMust(types.StringType, map[string]attr.Value{"env": types.StringValue("dev")})
+				state.PeriodicBackupPath = types.StringValue("s3://bucket")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			state := sdkState()
+			tc.modify(&state)
+			expected := upgradedState()
+			tc.expected(&expected)
+
+			upgradeEssentialsDatabaseStateV0(&state)
+
+			assert.Equal(t, expected, state)
+		})
+	}
+}
@@ -0,0 +1,366 @@
+package essentials
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/client"
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/utils"
+)
+
+var (
+	_ resource.Resource                   = &essentialsSubscriptionResource{}
+	_ resource.ResourceWithConfigure      = &essentialsSubscriptionResource{}
+	_ resource.ResourceWithImportState    = &essentialsSubscriptionResource{}
+	_ resource.ResourceWithValidateConfig = &essentialsSubscriptionResource{}
+	_ resource.ResourceWithIdentity       = &essentialsSubscriptionResource{}
+	_ resource.ResourceWithUpgradeState   = &essentialsSubscriptionResource{}
+)
+
+// essentialsSubscriptionResource is the resource implementation.
+type essentialsSubscriptionResource struct {
+	client *client.ApiClient
+}
+
+// NewEssentialsSubscriptionResource returns a new resource instance.
+func NewEssentialsSubscriptionResource() resource.Resource {
+	return &essentialsSubscriptionResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *essentialsSubscriptionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_essentials_subscription"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *essentialsSubscriptionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.ApiClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.ApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// useStateAfterCreateStringModifier is a plan modifier for attributes which are only used when creating the
+// subscription. Changes to them are ignored after this.
+type useStateAfterCreateStringModifier struct{}
+
+var _ planmodifier.String = useStateAfterCreateStringModifier{}
+
+func (m useStateAfterCreateStringModifier) Description(_ context.Context) string {
+	return "Uses the prior state value for existing resources. Changes to this attribute are ignored after creation."
+}
+
+func (m useStateAfterCreateStringModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m useStateAfterCreateStringModifier) PlanModifyString(_ context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.State.Raw.IsNull() {
+		return
+	}
+	resp.PlanValue = req.StateValue
+}
+
+// Schema defines the schema for the resource.
+func (r *essentialsSubscriptionResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = r.schema(ctx)
+}
+
+// schema returns the schema of the resource. Version 0 was implemented with the SDK, and is read with the same
+// schema before being upgraded.
+func (r *essentialsSubscriptionResource) schema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Description: "Manages an Essentials Subscription within your Redis Enterprise Cloud Account.",
+		Version:     1,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the subscription",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "A meaningful name to identify the subscription",
+				Required:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "The status of this subscription",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"plan_id": schema.Int64Attribute{
+				Description: "The identifier of the plan to template the subscription",
+				Required:    true,
+			},
+			"payment_method": schema.StringAttribute{
+				Description: "Payment method for the requested subscription. If credit-card is specified, the payment method id must be defined. This information is only used when creating a new subscription and any changes will be ignored after this.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("credit-card"),
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile("^(credit-card|marketplace)$"), "must be 'credit-card' or 'marketplace'"),
+				},
+				PlanModifiers: []planmodifier.String{
+					useStateAfterCreateStringModifier{},
+				},
+			},
+			"payment_method_id": schema.Int64Attribute{
+				Description: "The identifier of the method which will be charged for this subscription. Not required for free plans",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"creation_date": schema.StringAttribute{
+				Description: "The date/time this subscription was created",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			utils.DeletionProtectionKey: utils.DeletionProtectionAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// IdentitySchema defines the identity schema for the resource.
+func (r *essentialsSubscriptionResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			utils.IdentitySubscriptionId: identityschema.Int64Attribute{
+				Description:       "Identifier of the subscription",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that only credit card payments have a payment method ID.
+func (r *essentialsSubscriptionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config EssentialsSubscriptionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// payment_method_id only matters if it is a credit card
+	if utils.IsConfigured(config.PaymentMethod) && config.PaymentMethod.ValueString() != "credit-card" &&
+		utils.IsConfigured(config.PaymentMethodID) && config.PaymentMethodID.ValueInt64() != 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("payment_method_id"),
+			"Invalid payment method",
+			"payment methods aside from credit-card cannot have a payment ID",
+		)
+	}
+}
+
+// ImportState imports an existing resource.
+func (r *essentialsSubscriptionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id := req.ID
+	if req.ID == "" && req.Identity != nil {
+		// Importing with an identity block rather than an import ID
+		var identity EssentialsSubscriptionIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		id = strconv.FormatInt(identity.SubscriptionID.ValueInt64(), 10)
+	}
+
+	subId, err := strconv.Atoi(id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("Expected a subscription ID, got: %s. Error: %s", req.ID, err.Error()),
+		)
+		return
+	}
+
+	// Let the READ operation do the heavy lifting for importing values from the API.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), strconv.Itoa(subId))...)
+	if resp.Identity != nil {
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, EssentialsSubscriptionIdentityModel{
+			SubscriptionID: types.Int64Value(int64(subId)),
+		})...)
+	}
+}
+
+// Create implements resource creation.
+func (r *essentialsSubscriptionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan EssentialsSubscriptionModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Create(ctx, 30*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Call the CRUD implementation
+	r.createSubscription(ctx, &plan, &resp.Diagnostics)
+	if plan.ID.IsUnknown() {
+		return
+	}
+
+	// Set the state, even if the create failed once the subscription exists, so that it is tainted rather than lost.
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if err := utils.NullUnknownValues(&resp.State); err != nil {
+		resp.Diagnostics.AddError("Failed to save subscription", err.Error())
+		return
+	}
+	setSubscriptionIdentity(ctx, resp.Identity, &plan, &resp.Diagnostics)
+}
+
+// Read implements resource reading.
+func (r *essentialsSubscriptionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state EssentialsSubscriptionModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Read(ctx, 10*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Call the CRUD implementation
+	removed := r.readSubscription(ctx, &state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if removed {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Set the state
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	setSubscriptionIdentity(ctx, resp.Identity, &state, &resp.Diagnostics)
+}
+
+// Update implements resource updating.
+func (r *essentialsSubscriptionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan EssentialsSubscriptionModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state EssentialsSubscriptionModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Update(ctx, 30*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Preserve the ID from state
+	plan.ID = state.ID
+
+	// Call the CRUD implementation
+	r.updateSubscription(ctx, &plan, &state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read back the state to get computed values
+	r.readSubscription(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set the state
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	setSubscriptionIdentity(ctx, resp.Identity, &plan, &resp.Diagnostics)
+}
+
+// Delete implements resource deletion.
+func (r *essentialsSubscriptionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state EssentialsSubscriptionModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Delete(ctx, 10*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Call the CRUD implementation
+	r.deleteSubscription(ctx, &state, &resp.Diagnostics)
+}
+
+// setSubscriptionIdentity records the resource identity from the model, when the identity is supported.
+func setSubscriptionIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, model *EssentialsSubscriptionModel, diagnostics *diag.Diagnostics) {
+	if identity == nil || diagnostics.HasError() {
+		return
+	}
+	subId, err := strconv.Atoi(model.ID.ValueString())
+	if err != nil {
+		diagnostics.AddError("Invalid subscription ID", err.Error())
+		return
+	}
+	diagnostics.Append(identity.Set(ctx, EssentialsSubscriptionIdentityModel{
+		SubscriptionID: types.Int64Value(int64(subId)),
+	})...)
+}
@@ -0,0 +1,220 @@
+package essentials
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	fixedSubscriptions "github.com/RedisLabs/rediscloud-go-api/service/fixed/subscriptions"
+	"github.com/RedisLabs/rediscloud-go-api/service/subscriptions"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/client"
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/utils"
+)
+
+// createSubscription implements the Create operation for the Essentials subscription resource.
+func (r *essentialsSubscriptionResource) createSubscription(ctx context.Context, plan *EssentialsSubscriptionModel, diagnostics *diag.Diagnostics) {
+	createSubscriptionRequest := fixedSubscriptions.FixedSubscriptionRequest{
+		Name:   redis.String(plan.Name.ValueString()),
+		PlanId: redis.Int(int(plan.PlanID.ValueInt64())),
+	}
+
+	if plan.PaymentMethod.ValueString() != "" {
+		createSubscriptionRequest.PaymentMethod = redis.String(plan.PaymentMethod.ValueString())
+	}
+
+	if utils.IsConfigured(plan.PaymentMethodID) && plan.PaymentMethodID.ValueInt64() != 0 {
+		createSubscriptionRequest.PaymentMethodID = redis.Int(int(plan.PaymentMethodID.ValueInt64()))
+	}
+
+	subId, err := r.client.Client.FixedSubscriptions.Create(ctx, createSubscriptionRequest)
+	if err != nil {
+		diagnostics.AddError("Failed to create subscription", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(strconv.Itoa(subId))
+
+	// Confirm Subscription Active status
+	if err := waitForSubscriptionToBeActive(ctx, subId, r.client); err != nil {
+		diagnostics.AddError("Subscription failed to become active", err.Error())
+		return
+	}
+
+	// Read back the state to get computed values
+	r.readSubscription(ctx, plan, diagnostics)
+}
+
+// readSubscription implements the Read operation for the Essentials subscription resource.
+// Returns true if the resource was removed (not found).
+func (r *essentialsSubscriptionResource) readSubscription(ctx context.Context, state *EssentialsSubscriptionModel, diagnostics *diag.Diagnostics) bool {
+	subId, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		diagnostics.AddError("Invalid subscription ID", err.Error())
+		return false
+	}
+
+	subscription, err := r.client.Client.FixedSubscriptions.Get(ctx, subId)
+	if err != nil {
+		notFound := &fixedSubscriptions.NotFound{}
+		if errors.As(err, &notFound) {
+			log.Printf("[DEBUG] Essentials subscription %d not found, removing from state", subId)
+			return true
+		}
+		diagnostics.AddError("Failed to read subscription", err.Error())
+		return false
+	}
+
+	state.Name = types.StringValue(redis.StringValue(subscription.Name))
+	state.DeletionProtection = utils.DeletionProtectionValue(state.DeletionProtection)
+	state.Status = types.StringValue(redis.StringValue(subscription.Status))
+	state.PlanID = types.Int64Value(int64(redis.IntValue(subscription.PlanId)))
+	state.PaymentMethod = types.StringValue(redis.StringValue(subscription.PaymentMethod))
+	state.CreationDate = types.StringValue(redis.TimeValue(subscription.CreationDate).String())
+
+	// Free plans have no payment method
+	if subscription.PaymentMethodID != nil {
+		state.PaymentMethodID = types.Int64Value(int64(redis.IntValue(subscription.PaymentMethodID)))
+	} else {
+		state.PaymentMethodID = types.Int64Null()
+	}
+
+	return false
+}
+
+// updateSubscription implements the Update operation for the Essentials subscription resource. The payment method
+// is only sent when the subscription is changed.
+func (r *essentialsSubscriptionResource) updateSubscription(ctx context.Context, plan *EssentialsSubscriptionModel, state *EssentialsSubscriptionModel, diagnostics *diag.Diagnostics) {
+	if plan.Name.Equal(state.Name) && plan.PlanID.Equal(state.PlanID) && plan.PaymentMethodID.Equal(state.PaymentMethodID) {
+		return
+	}
+
+	subId, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		diagnostics.AddError("Invalid subscription ID", err.Error())
+		return
+	}
+
+	unlock, err := utils.LockSubscription(ctx, subId)
+	if err != nil {
+		diagnostics.AddError("Failed to lock subscription", err.Error())
+		return
+	}
+	defer unlock()
+
+	updateSubscriptionRequest := fixedSubscriptions.FixedSubscriptionRequest{
+		Name:   redis.String(plan.Name.ValueString()),
+		PlanId: redis.Int(int(plan.PlanID.ValueInt64())),
+	}
+
+	if plan.PaymentMethod.ValueString() != "" {
+		updateSubscriptionRequest.PaymentMethod = redis.String(plan.PaymentMethod.ValueString())
+	}
+
+	if utils.IsConfigured(plan.PaymentMethodID) && plan.PaymentMethodID.ValueInt64() != 0 {
+		updateSubscriptionRequest.PaymentMethodID = redis.Int(int(plan.PaymentMethodID.ValueInt64()))
+	}
+
+	if err := r.client.Client.FixedSubscriptions.Update(ctx, subId, updateSubscriptionRequest); err != nil {
+		diagnostics.AddError("Failed to update subscription", err.Error())
+		return
+	}
+}
+
+// deleteSubscription implements the Delete operation for the Essentials subscription resource.
+func (r *essentialsSubscriptionResource) deleteSubscription(ctx context.Context, state *EssentialsSubscriptionModel, diagnostics *diag.Diagnostics) {
+	subId, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		diagnostics.AddError("Invalid subscription ID", err.Error())
+		return
+	}
+
+	utils.CheckDeletionProtection(state.DeletionProtection, fmt.Sprintf("Subscription %d", subId), diagnostics)
+	if diagnostics.HasError() {
+		return
+	}
+
+	unlock, err := utils.LockSubscription(ctx, subId)
+	if err != nil {
+		diagnostics.AddError("Failed to lock subscription", err.Error())
+		return
+	}
+	defer unlock()
+
+	// Wait for the subscription to be active before deleting it.
+	if err := waitForSubscriptionToBeActive(ctx, subId, r.client); err != nil {
+		diagnostics.AddError("Subscription not active", err.Error())
+		return
+	}
+
+	// Delete subscription once all databases are deleted
+	if err := r.client.Client.FixedSubscriptions.Delete(ctx, subId); err != nil {
+		diagnostics.AddError("Failed to delete subscription", err.Error())
+		return
+	}
+
+	if err := waitForSubscriptionToBeDeleted(ctx, subId, r.client); err != nil {
+		diagnostics.AddError("Subscription failed to be deleted", err.Error())
+		return
+	}
+}
+
+func waitForSubscriptionToBeActive(ctx context.Context, id int, api *client.ApiClient) error {
+	wait := &utils.Waiter{
+		Description: fmt.Sprintf("Essentials subscription %d", id),
+		Delay:       10 * time.Second,
+		Pending:     []string{subscriptions.SubscriptionStatusPending},
+		Target:      []string{subscriptions.SubscriptionStatusActive},
+
+		Refresh: func() (result interface{}, state string, err error) {
+			log.Printf("[DEBUG] Waiting for fixed subscription %d to be active", id)
+
+			subscription, err := api.Client.FixedSubscriptions.Get(ctx, id)
+			if err != nil {
+				return nil, "", err
+			}
+
+			return redis.StringValue(subscription.Status), redis.StringValue(subscription.Status), nil
+		},
+	}
+	if _, err := wait.Wait(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func waitForSubscriptionToBeDeleted(ctx context.Context, id int, api *client.ApiClient) error {
+	wait := &utils.Waiter{
+		Description: fmt.Sprintf("Essentials subscription %d", id),
+		Delay:       10 * time.Second,
+		Pending:     []string{subscriptions.SubscriptionStatusDeleting},
+		Target:      []string{"deleted"},
+
+		Refresh: func() (result interface{}, state string, err error) {
+			log.Printf("[DEBUG] Waiting for fixed subscription %d to be deleted", id)
+
+			subscription, err := api.Client.FixedSubscriptions.Get(ctx, id)
+			if err != nil {
+				notFound := &fixedSubscriptions.NotFound{}
+				if errors.As(err, &notFound) {
+					return "deleted", "deleted", nil
+				}
+				return nil, "", err
+			}
+
+			return redis.StringValue(subscription.Status), redis.StringValue(subscription.Status), nil
+		},
+	}
+	if _, err := wait.Wait(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package essentials
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// EssentialsSubscriptionModel describes the resource data model for the Essentials subscription.
+type EssentialsSubscriptionModel struct {
+	ID                 types.String   `tfsdk:"id"`
+	Name               types.String   `tfsdk:"name"`
+	Status             types.String   `tfsdk:"status"`
+	PlanID             types.Int64    `tfsdk:"plan_id"`
+	PaymentMethod      types.String   `tfsdk:"payment_method"`
+	PaymentMethodID    types.Int64    `tfsdk:"payment_method_id"`
+	CreationDate       types.String   `tfsdk:"creation_date"`
+	DeletionProtection types.Bool     `tfsdk:"deletion_protection"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+}
+
+// EssentialsSubscriptionIdentityModel describes the resource identity of the Essentials subscription.
+type EssentialsSubscriptionIdentityModel struct {
+	SubscriptionID types.Int64 `tfsdk:"subscription_id"`
+}
+
+// nullTimeouts returns the timeouts of a resource read without a configuration, such as a listed resource.
+func nullTimeouts() timeouts.Value {
+	return timeouts.Value{
+		Object: types.ObjectNull(map[string]attr.Type{
+			"create": types.StringType,
+			"read":   types.StringType,
+			"update": types.StringType,
+			"delete": types.StringType,
+		}),
+	}
+}
@@ -0,0 +1,41 @@
+package essentials
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// UpgradeState upgrades the state of subscriptions managed by the SDK implementation of the resource, version 0.
+func (r *essentialsSubscriptionResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	// The SDK implementation's state has the same shape, so is read with the current schema.
+	priorSchema := r.schema(ctx)
+	priorSchema.Version = 0
+
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &priorSchema,
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var state EssentialsSubscriptionModel
+				resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				upgradeEssentialsSubscriptionStateV0(&state)
+
+				resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+			},
+		},
+	}
+}
+
+// upgradeEssentialsSubscriptionStateV0 replaces the values the SDK recorded for a subscription without a payment
+// method, so that existing subscriptions refresh without changes: the SDK recorded the payment_method_id of a free
+// plan as 0, which is now null.
+func upgradeEssentialsSubscriptionStateV0(state *EssentialsSubscriptionModel) {
+	if state.PaymentMethodID.ValueInt64() == 0 {
+		state.PaymentMethodID = types.Int64Null()
+	}
+}
@@ -0,0 +1,41 @@
+package essentials
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitUpgradeEssentialsSubscriptionStateV0(t *testing.T) {
+	tests := []struct {
+		name     string
+		sdkValue types.Int64
+		expected types.Int64
+	}{
+		{
+			name:     "zero payment method id becomes null",
+			sdkValue: types.Int64Value(0),
+			expected: types.Int64Null(),
+		},
+		{
+			name:     "payment method id is kept",
+			sdkValue: types.Int64Value(1234),
+			expected: types.Int64Value(1234),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			state := EssentialsSubscriptionModel{
+				Name:            types.StringValue("example"),
+				PaymentMethodID: tc.sdkValue,
+			}
+
+			upgradeEssentialsSubscriptionStateV0(&state)
+
+			assert.Equal(t, tc.expected, state.PaymentMethodID)
+			assert.Equal(t, types.StringValue("example"), state.Name)
+		})
+	}
+}
@@ -0,0 +1,160 @@
+package fakeapi
+
+import (
+	"net/http"
+
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/RedisLabs/rediscloud-go-api/service/account"
+	"github.com/RedisLabs/rediscloud-go-api/service/cloud_accounts"
+)
+
+// PaymentMethodId is the identifier of the credit card the fake account is seeded with.
+const PaymentMethodId = 1000
+
+var (
+	accountPaymentMethods = []*account.PaymentMethod{
+		{
+			ID:                 redis.Int(PaymentMethodId),
+			Type:               redis.String("Visa"),
+			CreditCardEndsWith: redis.Int(4242),
+			ExpirationMonth:    redis.Int(12),
+			ExpirationYear:     redis.Int(2099),
+		},
+	}
+
+	accountRegions = []*account.Region{
+		{ID: redis.Int(1), Name: redis.String("us-east-1"), Provider: redis.String("AWS")},
+		{ID: redis.Int(2), Name: redis.String("us-west-2"), Provider: redis.String("AWS")},
+		{ID: redis.Int(3), Name: redis.String("eu-west-1"), Provider: redis.String("AWS")},
+		{ID: redis.Int(4), Name: redis.String("eu-west-2"), Provider: redis.String("AWS")},
+		{ID: redis.Int(5), Name: redis.String("ap-southeast-1"), Provider: redis.String("AWS")},
+		{ID: redis.Int(6), Name: redis.String("us-central1"), Provider: redis.String("GCP")},
+		{ID: redis.Int(7), Name: redis.String("europe-west1"), Provider: redis.String("GCP")},
+		{ID: redis.Int(8), Name: redis.String("asia-east1"), Provider: redis.String("GCP")},
+	}
+
+	accountDataPersistence = []*account.DataPersistence{
+		{Name: redis.String("none"), Description: redis.String("None")},
+		{Name: redis.String("aof-every-1-second"), Description: redis.String("Append only file (AOF) - fsync every 1 second")},
+		{Name: redis.String("aof-every-write"), Description: redis.String("Append only file (AOF) - fsync every write")},
+		{Name: redis.String("snapshot-every-1-hour"), Description: redis.String("Snapshot every 1 hour")},
+		{Name: redis.String("snapshot-every-6-hours"), Description: redis.String("Snapshot every 6 hours")},
+		{Name: redis.String("snapshot-every-12-hours"), Description: redis.String("Snapshot every 12 hours")},
+	}
+
+	accountModules = []*account.DatabaseModule{
+		{Name: redis.String("RedisBloom"), Description: redis.String("Probabilistic data structures")},
+		{Name: redis.String("RedisJSON"), Description: redis.String("Native JSON data type")},
+		{Name: redis.String("RediSearch"), Description: redis.String("Querying, indexing and full-text search")},
+		{Name: redis.String("RedisTimeSeries"), Description: redis.String("Time series data structure")},
+	}
+)
+
+type cloudAccount struct {
+	provisioning
+	account cloud_accounts.CloudAccount
+}
+
+func (s *Server) seedAccount() {
+	// Cloud account 1 is the Redis internal cloud account, which every account has.
+	s.cloudAccounts[1] = &cloudAccount{account: cloud_accounts.CloudAccount{
+		ID:       redis.Int(1),
+		Name:     redis.String("Redis Internal Resources"),
+		Provider: redis.String("AWS"),
+		Status:   redis.String(cloud_accounts.StatusActive),
+	}}
+	s.lastId = PaymentMethodId
+}
+
+func (s *Server) registerAccountRoutes() {
+	s.handle(http.MethodGet, "/payment-methods", func(w http.ResponseWriter, _ *http.Request, _ params) {
+		writeJSON(w, http.StatusOK, map[string]interface{}{"paymentMethods": accountPaymentMethods})
+	})
+	s.handle(http.MethodGet, "/regions", func(w http.ResponseWriter, _ *http.Request, _ params) {
+		writeJSON(w, http.StatusOK, map[string]interface{}{"regions": accountRegions})
+	})
+	s.handle(http.MethodGet, "/data-persistence", func(w http.ResponseWriter, _ *http.Request, _ params) {
+		writeJSON(w, http.StatusOK, map[string]interface{}{"dataPersistence": accountDataPersistence})
+	})
+	s.handle(http.MethodGet, "/database-modules", func(w http.ResponseWriter, _ *http.Request, _ params) {
+		writeJSON(w, http.StatusOK, map[string]interface{}{"modules": accountModules})
+	})
+
+	s.handle(http.MethodGet, "/cloud-accounts", s.listCloudAccounts)
+	s.handle(http.MethodPost, "/cloud-accounts", s.createCloudAccount)
+	s.handle(http.MethodGet, "/cloud-accounts/{id}", s.getCloudAccount)
+	s.handle(http.MethodPut, "/cloud-accounts/{id}", s.updateCloudAccount)
+	s.handle(http.MethodDelete, "/cloud-accounts/{id}", s.deleteCloudAccount)
+}
+
+func (c *cloudAccount) view() cloud_accounts.CloudAccount {
+	view := c.account
+	view.Status = c.status(cloud_accounts.StatusDraft, cloud_accounts.StatusActive)
+	return view
+}
+
+func (s *Server) listCloudAccounts(w http.ResponseWriter, _ *http.Request, _ params) {
+	var list []cloud_accounts.CloudAccount
+	for _, id := range sortedIds(s.cloudAccounts) {
+		list = append(list, s.cloudAccounts[id].view())
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"cloudAccounts": list})
+}
+
+func (s *Server) createCloudAccount(w http.ResponseWriter, r *http.Request, _ params) {
+	var request cloud_accounts.CreateCloudAccount
+	if !readJSON(w, r, &request) {
+		return
+	}
+
+	id := s.nextId()
+	c := &cloudAccount{account: cloud_accounts.CloudAccount{
+		ID:          redis.Int(id),
+		Name:        request.Name,
+		Provider:    request.Provider,
+		AccessKeyID: request.AccessKeyID,
+	}}
+	s.provision(&c.provisioning)
+	s.cloudAccounts[id] = c
+	s.accept(w, "cloudAccountCreateRequest", redis.Int(id), nil)
+}
+
+func (s *Server) getCloudAccount(w http.ResponseWriter, _ *http.Request, p params) {
+	c, ok := s.cloudAccounts[p.int("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "CLOUD_ACCOUNT_NOT_FOUND", "cloud account %s was not found", p["id"])
+		return
+	}
+	writeJSON(w, http.StatusOK, c.view())
+}
+
+func (s *Server) updateCloudAccount(w http.ResponseWriter, r *http.Request, p params) {
+	c, ok := s.cloudAccounts[p.int("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "CLOUD_ACCOUNT_NOT_FOUND", "cloud account %s was not found", p["id"])
+		return
+	}
+
+	var request cloud_accounts.UpdateCloudAccount
+	if !readJSON(w, r, &request) {
+		return
+	}
+	if request.Name != nil {
+		c.account.Name = request.Name
+	}
+	if request.AccessKeyID != nil {
+		c.account.AccessKeyID = request.AccessKeyID
+	}
+	s.provision(&c.provisioning)
+	s.accept(w, "cloudAccountUpdateRequest", c.account.ID, nil)
+}
+
+func (s *Server) deleteCloudAccount(w http.ResponseWriter, _ *http.Request, p params) {
+	id := p.int("id")
+	if _, ok := s.cloudAccounts[id]; !ok {
+		writeError(w, http.StatusNotFound, "CLOUD_ACCOUNT_NOT_FOUND", "cloud account %s was not found", p["id"])
+		return
+	}
+	delete(s.cloudAccounts, id)
+	s.accept(w, "cloudAccountDeleteRequest", redis.Int(id), nil)
+}
@@ -0,0 +1,405 @@
+package fakeapi
+
+import (
+	"net/http"
+
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/RedisLabs/rediscloud-go-api/service/access_control_lists/redis_rules"
+	"github.com/RedisLabs/rediscloud-go-api/service/access_control_lists/roles"
+	"github.com/RedisLabs/rediscloud-go-api/service/access_control_lists/users"
+)
+
+// defaultAclRules are the rules every account starts with. They can't be modified or deleted.
+var defaultAclRules = []struct {
+	name string
+	acl  string
+}{
+	{name: "Full-Access", acl: "+@all ~*"},
+	{name: "Read-Write", acl: "+@all -@dangerous ~*"},
+	{name: "Read-Only", acl: "+@read ~*"},
+}
+
+type aclRule struct {
+	provisioning
+	rule redis_rules.GetRedisRuleResponse
+}
+
+// aclRole stores the rules it grants by name, as they are sent, and resolves them to IDs when read.
+type aclRole struct {
+	provisioning
+	id    int
+	name  string
+	rules []*roles.CreateRuleInRoleRequest
+}
+
+type aclUser struct {
+	provisioning
+	user users.GetUserResponse
+}
+
+func (s *Server) seedAcl() {
+	for _, rule := range defaultAclRules {
+		id := s.nextId()
+		s.aclRules[id] = &aclRule{rule: redis_rules.GetRedisRuleResponse{
+			ID:        redis.Int(id),
+			Name:      redis.String(rule.name),
+			ACL:       redis.String(rule.acl),
+			IsDefault: redis.Bool(true),
+		}}
+	}
+}
+
+func (s *Server) registerAclRoutes() {
+	s.handle(http.MethodGet, "/acl/redisRules", s.listAclRules)
+	s.handle(http.MethodPost, "/acl/redisRules", s.createAclRule)
+	s.handle(http.MethodPut, "/acl/redisRules/{id}", s.updateAclRule)
+	s.handle(http.MethodDelete, "/acl/redisRules/{id}", s.deleteAclRule)
+
+	s.handle(http.MethodGet, "/acl/roles", s.listAclRoles)
+	s.handle(http.MethodPost, "/acl/roles", s.createAclRole)
+	s.handle(http.MethodPut, "/acl/roles/{id}", s.updateAclRole)
+	s.handle(http.MethodDelete, "/acl/roles/{id}", s.deleteAclRole)
+
+	s.handle(http.MethodGet, "/acl/users", s.listAclUsers)
+	s.handle(http.MethodPost, "/acl/users", s.createAclUser)
+	s.handle(http.MethodGet, "/acl/users/{id}", s.getAclUser)
+	s.handle(http.MethodPut, "/acl/users/{id}", s.updateAclUser)
+	s.handle(http.MethodDelete, "/acl/users/{id}", s.deleteAclUser)
+}
+
+func (rule *aclRule) view() *redis_rules.GetRedisRuleResponse {
+	view := rule.rule
+	view.Status = rule.status(redis_rules.StatusPending, redis_rules.StatusActive)
+	return &view
+}
+
+func (s *Server) aclRuleByName(name string) (*aclRule, bool) {
+	for _, rule := range s.aclRules {
+		if redis.StringValue(rule.rule.Name) == name {
+			return rule, true
+		}
+	}
+	return nil, false
+}
+
+func (s *Server) listAclRules(w http.ResponseWriter, _ *http.Request, _ params) {
+	list := []*redis_rules.GetRedisRuleResponse{}
+	for _, id := range sortedIds(s.aclRules) {
+		list = append(list, s.aclRules[id].view())
+	}
+	writeJSON(w, http.StatusOK, redis_rules.ListRedisRulesResponse{AccountId: redis.Int(1), RedisRules: list})
+}
+
+func (s *Server) createAclRule(w http.ResponseWriter, r *http.Request, _ params) {
+	var request redis_rules.CreateRedisRuleRequest
+	if !readJSON(w, r, &request) {
+		return
+	}
+	if redis.StringValue(request.Name) == "" || redis.StringValue(request.RedisRule) == "" {
+		s.reject(w, "aclRedisRuleCreateRequest", http.StatusBadRequest, "ACL_REDIS_RULE_INVALID", "a name and a rule are required")
+		return
+	}
+	if _, ok := s.aclRuleByName(*request.Name); ok {
+		s.reject(w, "aclRedisRuleCreateRequest", http.StatusConflict, "ACL_REDIS_RULE_NAME_ALREADY_EXISTS", "redis rule %s already exists", *request.Name)
+		return
+	}
+
+	id := s.nextId()
+	rule := &aclRule{rule: redis_rules.GetRedisRuleResponse{
+		ID:        redis.Int(id),
+		Name:      request.Name,
+		ACL:       request.RedisRule,
+		IsDefault: redis.Bool(false),
+	}}
+	s.provision(&rule.provisioning)
+	s.aclRules[id] = rule
+	s.accept(w, "aclRedisRuleCreateRequest", redis.Int(id), nil)
+}
+
+func (s *Server) updateAclRule(w http.ResponseWriter, r *http.Request, p params) {
+	var request redis_rules.CreateRedisRuleRequest
+	if !readJSON(w, r, &request) {
+		return
+	}
+	rule, ok := s.aclRules[p.int("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "ACL_REDIS_RULE_NOT_FOUND", "redis rule %s was not found", p["id"])
+		return
+	}
+	if redis.BoolValue(rule.rule.IsDefault) {
+		s.reject(w, "aclRedisRuleUpdateRequest", http.StatusBadRequest, "ACL_REDIS_RULE_IS_DEFAULT", "default redis rule %s can't be modified", p["id"])
+		return
+	}
+	if request.Name != nil && *request.Name != redis.StringValue(rule.rule.Name) {
+		if _, taken := s.aclRuleByName(*request.Name); taken {
+			s.reject(w, "aclRedisRuleUpdateRequest", http.StatusConflict, "ACL_REDIS_RULE_NAME_ALREADY_EXISTS", "redis rule %s already exists", *request.Name)
+			return
+		}
+		s.renameAclRuleInRoles(redis.StringValue(rule.rule.Name), *request.Name)
+		rule.rule.Name = request.Name
+	}
+	if request.RedisRule != nil {
+		rule.rule.ACL = request.RedisRule
+	}
+	s.provision(&rule.provisioning)
+	s.accept(w, "aclRedisRuleUpdateRequest", rule.rule.ID, nil)
+}
+
+func (s *Server) deleteAclRule(w http.ResponseWriter, _ *http.Request, p params) {
+	rule, ok := s.aclRules[p.int("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "ACL_REDIS_RULE_NOT_FOUND", "redis rule %s was not found", p["id"])
+		return
+	}
+	for _, role := range s.aclRoles {
+		for _, granted := range role.rules {
+			if redis.StringValue(granted.RuleName) == redis.StringValue(rule.rule.Name) {
+				s.reject(w, "aclRedisRuleDeleteRequest", http.StatusConflict, "ACL_REDIS_RULE_IN_USE", "redis rule %s is used by role %s", p["id"], role.name)
+				return
+			}
+		}
+	}
+	delete(s.aclRules, p.int("id"))
+	s.accept(w, "aclRedisRuleDeleteRequest", rule.rule.ID, nil)
+}
+
+func (s *Server) renameAclRuleInRoles(from string, to string) {
+	for _, role := range s.aclRoles {
+		for _, granted := range role.rules {
+			if redis.StringValue(granted.RuleName) == from {
+				granted.RuleName = redis.String(to)
+			}
+		}
+	}
+}
+
+func (s *Server) aclRoleView(role *aclRole) *roles.GetRoleResponse {
+	view := &roles.GetRoleResponse{
+		ID:     redis.Int(role.id),
+		Name:   redis.String(role.name),
+		Status: role.status(roles.StatusPending, roles.StatusActive),
+	}
+	for _, granted := range role.rules {
+		rule := &roles.GetRuleInRoleResponse{RuleName: granted.RuleName}
+		if r, ok := s.aclRuleByName(redis.StringValue(granted.RuleName)); ok {
+			rule.RuleId = r.rule.ID
+		}
+		for _, db := range granted.Databases {
+			database := &roles.GetDatabaseInRuleInRoleResponse{
+				SubscriptionId: db.SubscriptionId,
+				DatabaseId:     db.DatabaseId,
+				Regions:        db.Regions,
+			}
+			if sub, ok := s.subscriptions[redis.IntValue(db.SubscriptionId)]; ok {
+				if d, ok := sub.databases[redis.IntValue(db.DatabaseId)]; ok {
+					database.DatabaseName = redis.String(d.name())
+				}
+			}
+			rule.Databases = append(rule.Databases, database)
+		}
+		view.RedisRules = append(view.RedisRules, rule)
+	}
+	for _, id := range sortedIds(s.aclUsers) {
+		if user := s.aclUsers[id]; redis.StringValue(user.user.Role) == role.name {
+			view.Users = append(view.Users, &roles.GetUserInRoleResponse{ID: user.user.ID, Name: user.user.Name})
+		}
+	}
+	return view
+}
+
+func (s *Server) aclRoleByName(name string) (*aclRole, bool) {
+	for _, role := range s.aclRoles {
+		if role.name == name {
+			return role, true
+		}
+	}
+	return nil, false
+}
+
+// validateAclRoleRules rejects the request unless every rule granted by a role exists, and every database it is
+// granted on exists.
+func (s *Server) validateAclRoleRules(w http.ResponseWriter, commandType string, rules []*roles.CreateRuleInRoleRequest) bool {
+	for _, granted := range rules {
+		if _, ok := s.aclRuleByName(redis.StringValue(granted.RuleName)); !ok {
+			s.reject(w, commandType, http.StatusBadRequest, "ACL_REDIS_RULE_NOT_FOUND", "redis rule %s was not found", redis.StringValue(granted.RuleName))
+			return false
+		}
+		for _, db := range granted.Databases {
+			sub, ok := s.subscriptions[redis.IntValue(db.SubscriptionId)]
+			if ok {
+				_, ok = sub.databases[redis.IntValue(db.DatabaseId)]
+			}
+			if !ok {
+				s.reject(w, commandType, http.StatusBadRequest, "DATABASE_NOT_FOUND", "database %d was not found in subscription %d", redis.IntValue(db.DatabaseId), redis.IntValue(db.SubscriptionId))
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func (s *Server) listAclRoles(w http.ResponseWriter, _ *http.Request, _ params) {
+	list := []*roles.GetRoleResponse{}
+	for _, id := range sortedIds(s.aclRoles) {
+		list = append(list, s.aclRoleView(s.aclRoles[id]))
+	}
+	writeJSON(w, http.StatusOK, roles.ListRolesResponse{AccountId: redis.Int(1), Roles: list})
+}
+
+func (s *Server) createAclRole(w http.ResponseWriter, r *http.Request, _ params) {
+	var request roles.CreateRoleRequest
+	if !readJSON(w, r, &request) {
+		return
+	}
+	if redis.StringValue(request.Name) == "" {
+		s.reject(w, "aclRoleCreateRequest", http.StatusBadRequest, "ACL_ROLE_INVALID", "a name is required")
+		return
+	}
+	if _, ok := s.aclRoleByName(*request.Name); ok {
+		s.reject(w, "aclRoleCreateRequest", http.StatusConflict, "ACL_ROLE_NAME_ALREADY_EXISTS", "role %s already exists", *request.Name)
+		return
+	}
+	if !s.validateAclRoleRules(w, "aclRoleCreateRequest", request.RedisRules) {
+		return
+	}
+
+	role := &aclRole{id: s.nextId(), name: *request.Name, rules: request.RedisRules}
+	s.provision(&role.provisioning)
+	s.aclRoles[role.id] = role
+	s.accept(w, "aclRoleCreateRequest", redis.Int(role.id), nil)
+}
+
+func (s *Server) updateAclRole(w http.ResponseWriter, r *http.Request, p params) {
+	var request roles.CreateRoleRequest
+	if !readJSON(w, r, &request) {
+		return
+	}
+	role, ok := s.aclRoles[p.int("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "ACL_ROLE_NOT_FOUND", "role %s was not found", p["id"])
+		return
+	}
+	if !s.validateAclRoleRules(w, "aclRoleUpdateRequest", request.RedisRules) {
+		return
+	}
+	if request.Name != nil && *request.Name != role.name {
+		if _, taken := s.aclRoleByName(*request.Name); taken {
+			s.reject(w, "aclRoleUpdateRequest", http.StatusConflict, "ACL_ROLE_NAME_ALREADY_EXISTS", "role %s already exists", *request.Name)
+			return
+		}
+		for _, user := range s.aclUsers {
+			if redis.StringValue(user.user.Role) == role.name {
+				user.user.Role = request.Name
+			}
+		}
+		role.name = *request.Name
+	}
+	if request.RedisRules != nil {
+		role.rules = request.RedisRules
+	}
+	s.provision(&role.provisioning)
+	s.accept(w, "aclRoleUpdateRequest", redis.Int(role.id), nil)
+}
+
+func (s *Server) deleteAclRole(w http.ResponseWriter, _ *http.Request, p params) {
+	role, ok := s.aclRoles[p.int("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "ACL_ROLE_NOT_FOUND", "role %s was not found", p["id"])
+		return
+	}
+	for _, user := range s.aclUsers {
+		if redis.StringValue(user.user.Role) == role.name {
+			s.reject(w, "aclRoleDeleteRequest", http.StatusConflict, "ACL_ROLE_IN_USE", "role %s is assigned to user %s", role.name, redis.StringValue(user.user.Name))
+			return
+		}
+	}
+	delete(s.aclRoles, role.id)
+	s.accept(w, "aclRoleDeleteRequest", redis.Int(role.id), nil)
+}
+
+func (user *aclUser) view() *users.GetUserResponse {
+	view := user.user
+	view.Status = user.status(users.StatusPending, users.StatusActive)
+	return &view
+}
+
+func (s *Server) listAclUsers(w http.ResponseWriter, _ *http.Request, _ params) {
+	list := []*users.GetUserResponse{}
+	for _, id := range sortedIds(s.aclUsers) {
+		list = append(list, s.aclUsers[id].view())
+	}
+	writeJSON(w, http.StatusOK, users.ListUsersResponse{AccountId: redis.Int(1), Users: list})
+}
+
+func (s *Server) createAclUser(w http.ResponseWriter, r *http.Request, _ params) {
+	var request users.CreateUserRequest
+	if !readJSON(w, r, &request) {
+		return
+	}
+	if redis.StringValue(request.Name) == "" || redis.StringValue(request.Password) == "" {
+		s.reject(w, "aclUserCreateRequest", http.StatusBadRequest, "ACL_USER_INVALID", "a name and a password are required")
+		return
+	}
+	for _, user := range s.aclUsers {
+		if redis.StringValue(user.user.Name) == *request.Name {
+			s.reject(w, "aclUserCreateRequest", http.StatusConflict, "ACL_USER_NAME_ALREADY_EXISTS", "user %s already exists", *request.Name)
+			return
+		}
+	}
+	if _, ok := s.aclRoleByName(redis.StringValue(request.Role)); !ok {
+		s.reject(w, "aclUserCreateRequest", http.StatusBadRequest, "ACL_ROLE_NOT_FOUND", "role %s was not found", redis.StringValue(request.Role))
+		return
+	}
+
+	id := s.nextId()
+	user := &aclUser{
+		user: users.GetUserResponse{
+			ID:   redis.Int(id),
+			Name: request.Name,
+			Role: request.Role,
+		},
+	}
+	s.provision(&user.provisioning)
+	s.aclUsers[id] = user
+	s.accept(w, "aclUserCreateRequest", redis.Int(id), nil)
+}
+
+func (s *Server) getAclUser(w http.ResponseWriter, _ *http.Request, p params) {
+	user, ok := s.aclUsers[p.int("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "ACL_USER_NOT_FOUND", "user %s was not found", p["id"])
+		return
+	}
+	writeJSON(w, http.StatusOK, user.view())
+}
+
+func (s *Server) updateAclUser(w http.ResponseWriter, r *http.Request, p params) {
+	var request users.UpdateUserRequest
+	if !readJSON(w, r, &request) {
+		return
+	}
+	user, ok := s.aclUsers[p.int("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "ACL_USER_NOT_FOUND", "user %s was not found", p["id"])
+		return
+	}
+	if request.Role != nil {
+		if _, ok := s.aclRoleByName(*request.Role); !ok {
+			s.reject(w, "aclUserUpdateRequest", http.StatusBadRequest, "ACL_ROLE_NOT_FOUND", "role %s was not found", *request.Role)
+			return
+		}
+		user.user.Role = request.Role
+	}
+	s.provision(&user.provisioning)
+	s.accept(w, "aclUserUpdateRequest", user.user.ID, nil)
+}
+
+func (s *Server) deleteAclUser(w http.ResponseWriter, _ *http.Request, p params) {
+	user, ok := s.aclUsers[p.int("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "ACL_USER_NOT_FOUND", "user %s was not found", p["id"])
+		return
+	}
+	delete(s.aclUsers, redis.IntValue(user.user.ID))
+	s.accept(w, "aclUserDeleteRequest", user.user.ID, nil)
+}
@@ -0,0 +1,637 @@
+package fakeapi
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/RedisLabs/rediscloud-go-api/service/databases"
+	"github.com/RedisLabs/rediscloud-go-api/service/pricing"
+	"github.com/RedisLabs/rediscloud-go-api/service/subscriptions"
+	"github.com/RedisLabs/rediscloud-go-api/service/tags"
+)
+
+const firstDatabasePort = 10000
+
+// database is either a Pro database or an Active-Active database, depending on its subscription.
+type database struct {
+	provisioning
+	pro  *databases.Database
+	aa   *databases.ActiveActiveDatabase
+	tags []*tags.Tag
+}
+
+func (db *database) name() string {
+	if db.aa != nil {
+		return redis.StringValue(db.aa.Name)
+	}
+	return redis.StringValue(db.pro.Name)
+}
+
+func (db *database) view() interface{} {
+	if db.aa != nil {
+		view := *db.aa
+		view.Status = db.status(databases.StatusPending, databases.StatusActive)
+		return view
+	}
+	view := *db.pro
+	view.Status = db.status(databases.StatusPending, databases.StatusActive)
+	return view
+}
+
+func (s *Server) registerDatabaseRoutes() {
+	s.handle(http.MethodGet, "/subscriptions/{subId}/databases", s.withSubscription(s.listDatabases))
+	s.handle(http.MethodPost, "/subscriptions/{subId}/databases", s.withSubscription(s.createDatabase))
+	s.handle(http.MethodGet, "/subscriptions/{subId}/databases/{dbId}", s.withDatabase(s.getDatabase))
+	s.handle(http.MethodPut, "/subscriptions/{subId}/databases/{dbId}", s.withDatabase(s.updateDatabase))
+	s.handle(http.MethodDelete, "/subscriptions/{subId}/databases/{dbId}", s.withDatabase(s.deleteDatabase))
+	s.handle(http.MethodPut, "/subscriptions/{subId}/databases/{dbId}/regions", s.withDatabase(s.updateActiveActiveDatabase))
+	s.handle(http.MethodGet, "/subscriptions/{subId}/databases/{dbId}/tags", s.withDatabase(s.getTags))
+	s.handle(http.MethodPut, "/subscriptions/{subId}/databases/{dbId}/tags", s.withDatabase(s.updateTags))
+	s.handle(http.MethodPost, "/subscriptions/{subId}/databases/{dbId}/backup", s.withDatabase(s.backupDatabase))
+	s.handle(http.MethodPost, "/subscriptions/{subId}/databases/{dbId}/import", s.withDatabase(s.importDatabase))
+	s.handle(http.MethodPost, "/subscriptions/{subId}/databases/{dbId}/upgrade", s.withDatabase(s.upgradeDatabase))
+	s.handle(http.MethodGet, "/subscriptions/{subId}/databases/{dbId}/certificate", s.withDatabase(s.getCertificate))
+}
+
+type databaseHandlerFunc func(w http.ResponseWriter, r *http.Request, sub *subscription, db *database)
+
+// withDatabase looks up the subscription and database named by the subId and dbId path parameters, responding with
+// a 404 if either doesn't exist.
+func (s *Server) withDatabase(handle databaseHandlerFunc) handlerFunc {
+	return s.withSubscription(func(w http.ResponseWriter, r *http.Request, p params, sub *subscription) {
+		db, ok := sub.databases[p.int("dbId")]
+		if !ok {
+			writeError(w, http.StatusNotFound, "DATABASE_NOT_FOUND", "database %s was not found in subscription %s", p["dbId"], p["subId"])
+			return
+		}
+		handle(w, r, sub, db)
+	})
+}
+
+type databaseListEntry struct {
+	SubscriptionId int           `json:"subscriptionId"`
+	Databases      []interface{} `json:"databases"`
+}
+
+func (s *Server) listDatabases(w http.ResponseWriter, r *http.Request, _ params, sub *subscription) {
+	offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+	limit, err := strconv.Atoi(r.URL.Query().Get("limit"))
+	if err != nil || limit <= 0 {
+		limit = 100
+	}
+
+	ids := sortedIds(sub.databases)
+	page := []interface{}{}
+	for i := offset; i < len(ids) && i < offset+limit; i++ {
+		page = append(page, sub.databases[ids[i]].view())
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"subscription": []databaseListEntry{{SubscriptionId: redis.IntValue(sub.sub.ID), Databases: page}},
+	})
+}
+
+func (s *Server) createDatabase(w http.ResponseWriter, r *http.Request, _ params, sub *subscription) {
+	if sub.isActiveActive() {
+		var request databases.CreateActiveActiveDatabase
+		if !readJSON(w, r, &request) {
+			return
+		}
+		if !s.validateDatabaseName(w, sub, request.Name) {
+			return
+		}
+		if redis.BoolValue(request.DryRun) {
+			s.accept(w, "databaseCreateRequest", nil, nil)
+			return
+		}
+		db := s.addActiveActiveDatabase(sub, request)
+		s.accept(w, "databaseCreateRequest", db.aa.ID, nil)
+		return
+	}
+
+	var request databases.CreateDatabase
+	if !readJSON(w, r, &request) {
+		return
+	}
+	if !s.validateDatabaseName(w, sub, request.Name) {
+		return
+	}
+	if redis.BoolValue(request.DryRun) {
+		// A dry run reports the pricing of the subscription with the database.
+		dbs := append(pricedDatabases(sub), pricedDatabase{name: redis.StringValue(request.Name),
+			shards: shards(request.MemoryLimitInGB, request.DatasetSizeInGB)})
+		s.accept(w, "databaseCreateRequest", nil, pricing.ListPricingResponse{Pricing: subscriptionPricing(sub, dbs)})
+		return
+	}
+	db := s.addProDatabase(sub, request)
+	s.accept(w, "databaseCreateRequest", db.pro.ID, nil)
+}
+
+func (s *Server) validateDatabaseName(w http.ResponseWriter, sub *subscription, name *string) bool {
+	if redis.StringValue(name) == "" {
+		writeError(w, http.StatusBadRequest, "DATABASE_NAME_REQUIRED", "a database name is required")
+		return false
+	}
+	for _, db := range sub.databases {
+		if db.name() == *name {
+			writeError(w, http.StatusConflict, "DATABASE_NAME_ALREADY_EXISTS",
+				"a database named %s already exists in subscription %d", *name, redis.IntValue(sub.sub.ID))
+			return false
+		}
+	}
+	return true
+}
+
+func (sub *subscription) nextPort() int {
+	if sub.lastDatabasePort == 0 {
+		sub.lastDatabasePort = firstDatabasePort
+	}
+	sub.lastDatabasePort++
+	return sub.lastDatabasePort
+}
+
+func endpoint(sub *subscription, id int, port int, private bool) *string {
+	host := fmt.Sprintf("redis-%d.c%d.fake.rediscloud.test", id, redis.IntValue(sub.sub.ID))
+	if private {
+		host = "internal." + host
+	}
+	return redis.String(fmt.Sprintf("%s:%d", host, port))
+}
+
+// addPlannedDatabase deploys a database of a subscription's creation plan.
+func (s *Server) addPlannedDatabase(sub *subscription, name string, planned *subscriptions.CreateDatabase) {
+	var modules []*databases.Module
+	for _, module := range planned.Modules {
+		modules = append(modules, &databases.Module{Name: module.Name})
+	}
+
+	if sub.isActiveActive() {
+		create := databases.CreateActiveActiveDatabase{
+			Name:                 redis.String(name),
+			Protocol:             planned.Protocol,
+			MemoryLimitInGB:      planned.MemoryLimitInGB,
+			DatasetSizeInGB:      planned.DatasetSizeInGB,
+			SupportOSSClusterAPI: planned.SupportOSSClusterAPI,
+			GlobalModules:        modules,
+		}
+		for _, local := range planned.LocalThroughputMeasurement {
+			create.LocalThroughputMeasurement = append(create.LocalThroughputMeasurement, &databases.LocalThroughput{
+				Region:                   local.Region,
+				ReadOperationsPerSecond:  local.ReadOperationsPerSecond,
+				WriteOperationsPerSecond: local.WriteOperationsPerSecond,
+			})
+		}
+		s.addActiveActiveDatabase(sub, create)
+		return
+	}
+
+	create := databases.CreateDatabase{
+		Name:                 redis.String(name),
+		Protocol:             planned.Protocol,
+		MemoryLimitInGB:      planned.MemoryLimitInGB,
+		DatasetSizeInGB:      planned.DatasetSizeInGB,
+		SupportOSSClusterAPI: planned.SupportOSSClusterAPI,
+		DataPersistence:      planned.DataPersistence,
+		Replication:          planned.Replication,
+		Modules:              modules,
+	}
+	if planned.ThroughputMeasurement != nil {
+		create.ThroughputMeasurement = &databases.CreateThroughputMeasurement{
+			By:    planned.ThroughputMeasurement.By,
+			Value: planned.ThroughputMeasurement.Value,
+		}
+	}
+	s.addProDatabase(sub, create)
+}
+
+func (s *Server) addProDatabase(sub *subscription, request databases.CreateDatabase) *database {
+	id := s.nextId()
+	port := sub.nextPort()
+	if request.PortNumber != nil {
+		port = *request.PortNumber
+	}
+
+	db := &databases.Database{
+		ID:                      redis.Int(id),
+		Name:                    request.Name,
+		Protocol:                stringOr(request.Protocol, "redis"),
+		MemoryLimitInGB:         request.MemoryLimitInGB,
+		DatasetSizeInGB:         request.DatasetSizeInGB,
+		MemoryUsedInMB:          redis.Float64(0),
+		SupportOSSClusterAPI:    boolOr(request.SupportOSSClusterAPI, false),
+		RespVersion:             stringOr(request.RespVersion, "resp3"),
+		DataPersistence:         stringOr(request.DataPersistence, "none"),
+		Replication:             boolOr(request.Replication, true),
+		DataEvictionPolicy:      stringOr(request.DataEvictionPolicy, "volatile-lru"),
+		Clustering:              &databases.Clustering{NumberOfShards: redis.Int(1)},
+		Modules:                 request.Modules,
+		Alerts:                  request.Alerts,
+		MemoryStorage:           sub.sub.MemoryStorage,
+		PrivateEndpoint:         endpoint(sub, id, port, true),
+		PublicEndpoint:          endpoint(sub, id, port, false),
+		RedisVersionCompliance:  stringOr(request.RedisVersion, defaultRedisVersion),
+		RedisVersion:            stringOr(request.RedisVersion, defaultRedisVersion),
+		QueryPerformanceFactor:  request.QueryPerformanceFactor,
+		AutoMinorVersionUpgrade: boolOr(request.AutoMinorVersionUpgrade, true),
+		Security: &databases.Security{
+			EnableDefaultUser: redis.Bool(true),
+			SourceIPs:         sourceIPsOr(request.SourceIP),
+			Password:          stringOr(request.Password, "fake-default-password"),
+			EnableTls:         boolOr(request.EnableTls, false),
+		},
+	}
+	if rg, ok := sub.region(0); ok {
+		db.Provider = redis.String(rg.provider)
+		db.Region = redis.String(rg.name)
+	}
+	if request.ThroughputMeasurement != nil {
+		db.ThroughputMeasurement = &databases.Throughput{By: request.ThroughputMeasurement.By, Value: request.ThroughputMeasurement.Value}
+	} else {
+		db.ThroughputMeasurement = &databases.Throughput{By: redis.String("operations-per-second"), Value: redis.Int(1000)}
+	}
+	if len(request.ReplicaOf) > 0 {
+		db.ReplicaOf = &databases.ReplicaOf{Endpoints: request.ReplicaOf}
+	}
+	if request.RemoteBackup != nil {
+		db.Backup = backupFromConfig(request.RemoteBackup)
+	}
+
+	d := &database{pro: db}
+	s.provision(&d.provisioning)
+	sub.databases[id] = d
+	return d
+}
+
+func (s *Server) addActiveActiveDatabase(sub *subscription, request databases.CreateActiveActiveDatabase) *database {
+	id := s.nextId()
+	port := sub.nextPort()
+	if request.PortNumber != nil {
+		port = *request.PortNumber
+	}
+
+	db := &databases.ActiveActiveDatabase{
+		ID:                                  redis.Int(id),
+		Name:                                request.Name,
+		Protocol:                            stringOr(request.Protocol, "redis"),
+		RedisVersion:                        stringOr(request.RedisVersion, defaultRedisVersion),
+		MemoryStorage:                       sub.sub.MemoryStorage,
+		ActiveActiveRedis:                   redis.Bool(true),
+		SupportOSSClusterAPI:                boolOr(request.SupportOSSClusterAPI, false),
+		UseExternalEndpointForOSSClusterAPI: boolOr(request.UseExternalEndpointForOSSClusterAPI, false),
+		Replication:                         redis.Bool(true),
+		DataEvictionPolicy:                  stringOr(request.DataEvictionPolicy, "noeviction"),
+		Modules:                             request.GlobalModules,
+		GlobalDataPersistence:               stringOr(request.GlobalDataPersistence, "none"),
+		GlobalSourceIP:                      sourceIPsOr(request.GlobalSourceIP),
+		GlobalPassword:                      stringOr(request.GlobalPassword, "fake-default-password"),
+		GlobalAlerts:                        request.GlobalAlerts,
+		GlobalEnableDefaultUser:             redis.Bool(true),
+		AutoMinorVersionUpgrade:             boolOr(request.AutoMinorVersionUpgrade, true),
+		Security:                            &databases.Security{EnableTls: redis.Bool(false)},
+	}
+	for _, regionId := range sub.regionIds {
+		rg := sub.regions[regionId]
+		crdb := newCrdbDatabase(db, rg)
+		crdb.MemoryLimitInGB = request.MemoryLimitInGB
+		crdb.DatasetSizeInGB = request.DatasetSizeInGB
+		crdb.QueryPerformanceFactor = request.QueryPerformanceFactor
+		for _, local := range request.LocalThroughputMeasurement {
+			if redis.StringValue(local.Region) == rg.name {
+				crdb.ReadOperationsPerSecond = local.ReadOperationsPerSecond
+				crdb.WriteOperationsPerSecond = local.WriteOperationsPerSecond
+			}
+		}
+		crdb.PublicEndpoint = endpoint(sub, id, port, false)
+		crdb.PrivateEndpoint = endpoint(sub, id, port, true)
+		db.CrdbDatabases = append(db.CrdbDatabases, crdb)
+	}
+
+	d := &database{aa: db}
+	s.provision(&d.provisioning)
+	sub.databases[id] = d
+	return d
+}
+
+// newCrdbDatabase returns the regional part of an Active-Active database, inheriting the global settings.
+func newCrdbDatabase(db *databases.ActiveActiveDatabase, rg *region) *databases.CrdbDatabase {
+	return &databases.CrdbDatabase{
+		Provider:                 redis.String(rg.provider),
+		Region:                   redis.String(rg.name),
+		RedisVersionCompliance:   db.RedisVersion,
+		MemoryUsedInMB:           redis.Float64(0),
+		ReadOperationsPerSecond:  redis.Int(1000),
+		WriteOperationsPerSecond: redis.Int(1000),
+		DataPersistence:          db.GlobalDataPersistence,
+		Alerts:                   db.GlobalAlerts,
+		Security: &databases.Security{
+			EnableDefaultUser: db.GlobalEnableDefaultUser,
+			SourceIPs:         db.GlobalSourceIP,
+			Password:          db.GlobalPassword,
+			EnableTls:         redis.Bool(false),
+		},
+		Backup: &databases.Backup{Enabled: redis.Bool(false)},
+	}
+}
+
+func (s *Server) getDatabase(w http.ResponseWriter, _ *http.Request, _ *subscription, db *database) {
+	writeJSON(w, http.StatusOK, db.view())
+}
+
+func (s *Server) updateDatabase(w http.ResponseWriter, r *http.Request, sub *subscription, db *database) {
+	if db.aa != nil {
+		// Active-Active databases are updated through their regions.
+		s.updateActiveActiveDatabase(w, r, sub, db)
+		return
+	}
+
+	var request databases.UpdateDatabase
+	if !readJSON(w, r, &request) {
+		return
+	}
+	if redis.BoolValue(request.DryRun) {
+		// A dry run reports the pricing of the subscription with the database changed.
+		dbs := pricedDatabases(sub)
+		for i := range dbs {
+			if dbs[i].id != redis.IntValue(db.pro.ID) {
+				continue
+			}
+			if request.MemoryLimitInGB != nil || request.DatasetSizeInGB != nil {
+				dbs[i].shards = shards(request.MemoryLimitInGB, request.DatasetSizeInGB)
+			}
+			if request.Name != nil {
+				dbs[i].name = *request.Name
+			}
+		}
+		s.accept(w, "databaseUpdateRequest", db.pro.ID, pricing.ListPricingResponse{Pricing: subscriptionPricing(sub, dbs)})
+		return
+	}
+
+	pro := db.pro
+	if request.Name != nil {
+		pro.Name = request.Name
+	}
+	if request.MemoryLimitInGB != nil {
+		pro.MemoryLimitInGB = request.MemoryLimitInGB
+	}
+	if request.DatasetSizeInGB != nil {
+		pro.DatasetSizeInGB = request.DatasetSizeInGB
+	}
+	if request.SupportOSSClusterAPI != nil {
+		pro.SupportOSSClusterAPI = request.SupportOSSClusterAPI
+	}
+	if request.RespVersion != nil {
+		pro.RespVersion = request.RespVersion
+	}
+	if request.DataEvictionPolicy != nil {
+		pro.DataEvictionPolicy = request.DataEvictionPolicy
+	}
+	if request.Replication != nil {
+		pro.Replication = request.Replication
+	}
+	if request.ThroughputMeasurement != nil {
+		pro.ThroughputMeasurement = &databases.Throughput{By: request.ThroughputMeasurement.By, Value: request.ThroughputMeasurement.Value}
+	}
+	if request.DataPersistence != nil {
+		pro.DataPersistence = request.DataPersistence
+	}
+	if request.ReplicaOf != nil {
+		if len(request.ReplicaOf) == 0 {
+			pro.ReplicaOf = nil
+		} else {
+			pro.ReplicaOf = &databases.ReplicaOf{Endpoints: request.ReplicaOf}
+		}
+	}
+	if request.SourceIP != nil {
+		pro.Security.SourceIPs = sourceIPsOr(request.SourceIP)
+	}
+	if request.Password != nil {
+		pro.Security.Password = request.Password
+	}
+	if request.EnableTls != nil {
+		pro.Security.EnableTls = request.EnableTls
+	}
+	if request.EnableDefaultUser != nil {
+		pro.Security.EnableDefaultUser = request.EnableDefaultUser
+	}
+	if request.Alerts != nil {
+		pro.Alerts = *request.Alerts
+	}
+	if request.RemoteBackup != nil {
+		pro.Backup = backupFromConfig(request.RemoteBackup)
+	}
+	if request.QueryPerformanceFactor != nil {
+		pro.QueryPerformanceFactor = request.QueryPerformanceFactor
+	}
+	if request.AutoMinorVersionUpgrade != nil {
+		pro.AutoMinorVersionUpgrade = request.AutoMinorVersionUpgrade
+	}
+	if request.RamPercentage != nil {
+		pro.RamPercentage = request.RamPercentage
+	}
+
+	s.provision(&db.provisioning)
+	s.accept(w, "databaseUpdateRequest", pro.ID, nil)
+}
+
+func (s *Server) updateActiveActiveDatabase(w http.ResponseWriter, r *http.Request, _ *subscription, db *database) {
+	if db.aa == nil {
+		writeError(w, http.StatusBadRequest, "DATABASE_NOT_ACTIVE_ACTIVE", "database %d is not Active-Active", redis.IntValue(db.pro.ID))
+		return
+	}
+
+	var request databases.UpdateActiveActiveDatabase
+	if !readJSON(w, r, &request) {
+		return
+	}
+	if redis.BoolValue(request.DryRun) {
+		s.accept(w, "databaseUpdateRequest", db.aa.ID, nil)
+		return
+	}
+
+	aa := db.aa
+	if request.SupportOSSClusterAPI != nil {
+		aa.SupportOSSClusterAPI = request.SupportOSSClusterAPI
+	}
+	if request.UseExternalEndpointForOSSClusterAPI != nil {
+		aa.UseExternalEndpointForOSSClusterAPI = request.UseExternalEndpointForOSSClusterAPI
+	}
+	if request.EnableTls != nil {
+		aa.Security.EnableTls = request.EnableTls
+	}
+	if request.GlobalDataPersistence != nil {
+		aa.GlobalDataPersistence = request.GlobalDataPersistence
+	}
+	if request.GlobalPassword != nil {
+		aa.GlobalPassword = request.GlobalPassword
+	}
+	if request.GlobalEnableDefaultUser != nil {
+		aa.GlobalEnableDefaultUser = request.GlobalEnableDefaultUser
+	}
+	if request.GlobalSourceIP != nil {
+		aa.GlobalSourceIP = sourceIPsOr(request.GlobalSourceIP)
+	}
+	if request.GlobalAlerts != nil {
+		aa.GlobalAlerts = *request.GlobalAlerts
+	}
+	if request.DataEvictionPolicy != nil {
+		aa.DataEvictionPolicy = request.DataEvictionPolicy
+	}
+	if request.AutoMinorVersionUpgrade != nil {
+		aa.AutoMinorVersionUpgrade = request.AutoMinorVersionUpgrade
+	}
+
+	for _, crdb := range aa.CrdbDatabases {
+		if request.MemoryLimitInGB != nil {
+			crdb.MemoryLimitInGB = request.MemoryLimitInGB
+		}
+		if request.DatasetSizeInGB != nil {
+			crdb.DatasetSizeInGB = request.DatasetSizeInGB
+		}
+		if request.QueryPerformanceFactor != nil {
+			crdb.QueryPerformanceFactor = request.QueryPerformanceFactor
+		}
+		// Regions without an override of their own follow the global settings.
+		if request.GlobalDataPersistence != nil {
+			crdb.DataPersistence = request.GlobalDataPersistence
+		}
+		if request.GlobalPassword != nil {
+			crdb.Security.Password = request.GlobalPassword
+		}
+		if request.GlobalEnableDefaultUser != nil {
+			crdb.Security.EnableDefaultUser = request.GlobalEnableDefaultUser
+		}
+		if request.GlobalSourceIP != nil {
+			crdb.Security.SourceIPs = aa.GlobalSourceIP
+		}
+		if request.GlobalAlerts != nil {
+			crdb.Alerts = aa.GlobalAlerts
+		}
+
+		for _, local := range request.Regions {
+			if redis.StringValue(local.Region) != redis.StringValue(crdb.Region) {
+				continue
+			}
+			if local.LocalThroughputMeasurement != nil {
+				crdb.ReadOperationsPerSecond = local.LocalThroughputMeasurement.ReadOperationsPerSecond
+				crdb.WriteOperationsPerSecond = local.LocalThroughputMeasurement.WriteOperationsPerSecond
+			}
+			if local.DataPersistence != nil {
+				crdb.DataPersistence = local.DataPersistence
+			}
+			if local.Password != nil {
+				crdb.Security.Password = local.Password
+			}
+			if local.SourceIP != nil {
+				crdb.Security.SourceIPs = sourceIPsOr(local.SourceIP)
+			}
+			if local.EnableDefaultUser != nil {
+				crdb.Security.EnableDefaultUser = local.EnableDefaultUser
+			}
+			if local.Alerts != nil {
+				crdb.Alerts = *local.Alerts
+			}
+			if local.RemoteBackup != nil {
+				crdb.Backup = backupFromConfig(local.RemoteBackup)
+			}
+		}
+	}
+
+	s.provision(&db.provisioning)
+	s.accept(w, "databaseUpdateRequest", aa.ID, nil)
+}
+
+func (s *Server) deleteDatabase(w http.ResponseWriter, _ *http.Request, sub *subscription, db *database) {
+	var id int
+	if db.aa != nil {
+		id = redis.IntValue(db.aa.ID)
+	} else {
+		id = redis.IntValue(db.pro.ID)
+	}
+	delete(sub.databases, id)
+	s.accept(w, "databaseDeleteRequest", redis.Int(id), nil)
+}
+
+func (s *Server) getTags(w http.ResponseWriter, _ *http.Request, _ *subscription, db *database) {
+	list := db.tags
+	if list == nil {
+		list = []*tags.Tag{}
+	}
+	writeJSON(w, http.StatusOK, tags.AllTags{Tags: &list})
+}
+
+func (s *Server) updateTags(w http.ResponseWriter, r *http.Request, _ *subscription, db *database) {
+	var request tags.AllTags
+	if !readJSON(w, r, &request) {
+		return
+	}
+	db.tags = nil
+	if request.Tags != nil {
+		db.tags = *request.Tags
+	}
+	s.getTags(w, r, nil, db)
+}
+
+func (s *Server) backupDatabase(w http.ResponseWriter, _ *http.Request, _ *subscription, db *database) {
+	s.accept(w, "databaseBackupRequest", nil, nil)
+}
+
+func (s *Server) importDatabase(w http.ResponseWriter, r *http.Request, _ *subscription, db *database) {
+	var request databases.Import
+	if !readJSON(w, r, &request) {
+		return
+	}
+	s.accept(w, "databaseImportRequest", nil, nil)
+}
+
+func (s *Server) upgradeDatabase(w http.ResponseWriter, r *http.Request, _ *subscription, db *database) {
+	var request databases.UpgradeRedisVersion
+	if !readJSON(w, r, &request) {
+		return
+	}
+	if db.aa != nil {
+		db.aa.RedisVersion = request.TargetRedisVersion
+	} else {
+		db.pro.RedisVersion = request.TargetRedisVersion
+		db.pro.RedisVersionCompliance = request.TargetRedisVersion
+	}
+	s.provision(&db.provisioning)
+	s.accept(w, "databaseUpgradeRequest", nil, nil)
+}
+
+func (s *Server) getCertificate(w http.ResponseWriter, _ *http.Request, _ *subscription, _ *database) {
+	writeJSON(w, http.StatusOK, databases.DatabaseCertificate{
+		PublicCertificatePEMString: "-----BEGIN CERTIFICATE-----\nZmFrZQ==\n-----END CERTIFICATE-----\n",
+	})
+}
+
+func backupFromConfig(config *databases.DatabaseBackupConfig) *databases.Backup {
+	return &databases.Backup{
+		Enabled:     config.Active,
+		Interval:    config.Interval,
+		TimeUTC:     config.TimeUTC,
+		Destination: config.StoragePath,
+	}
+}
+
+func stringOr(value *string, fallback string) *string {
+	if value != nil {
+		return value
+	}
+	return redis.String(fallback)
+}
+
+func boolOr(value *bool, fallback bool) *bool {
+	if value != nil {
+		return value
+	}
+	return redis.Bool(fallback)
+}
+
+func sourceIPsOr(sourceIPs []*string) []*string {
+	if len(sourceIPs) > 0 {
+		return sourceIPs
+	}
+	return []*string{redis.String("0.0.0.0/0")}
+}
@@ -0,0 +1,813 @@
+package fakeapi
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/RedisLabs/rediscloud-go-api/service/privatelink"
+	"github.com/RedisLabs/rediscloud-go-api/service/psc"
+	"github.com/RedisLabs/rediscloud-go-api/service/subscriptions"
+	"github.com/RedisLabs/rediscloud-go-api/service/transit_gateway/attachments"
+)
+
+const (
+	peeringStatusInitiatingRequest = "initiating-request"
+	peeringStatusPendingAcceptance = "pending-acceptance"
+	peeringStatusActive            = "active"
+
+	transitGatewayStatusPending           = "pending"
+	transitGatewayStatusPendingAcceptance = "pendingAcceptance"
+	transitGatewayStatusAvailable         = "available"
+
+	invitationStatusPending  = "pending"
+	invitationStatusAccepted = "accepted"
+	invitationStatusRejected = "rejected"
+
+	privateLinkStatusInitializing = "initializing"
+	privateLinkStatusActive       = "active"
+	principalStatusAssociated     = "associated"
+)
+
+// peering is a VPC peering from one of a subscription's regions. AWS peerings wait for the customer to accept them,
+// so they settle in pending-acceptance, while GCP peerings become active.
+type peering struct {
+	provisioning
+	id                int
+	regionId          int
+	provider          string
+	destinationRegion string
+	awsAccountId      string
+	vpcId             string
+	vpcCidrs          []string
+	gcpProjectId      string
+	networkName       string
+}
+
+type transitGateway struct {
+	provisioning
+	id           int
+	awsTgwUid    string
+	awsAccountId string
+	attached     bool
+	cidrs        []string
+	// forcedStatus overrides the attachment's status, see SetTransitGatewayStatus.
+	forcedStatus string
+}
+
+type transitGatewayInvitation struct {
+	invitation attachments.TransitGatewayInvitation
+}
+
+type pscService struct {
+	provisioning
+	id        int
+	endpoints map[int]*pscEndpoint
+}
+
+// pscEndpoint reports pendingStatus until provisioned, then settledStatus. Accepting or rejecting the endpoint
+// provisions it again towards the new status.
+type pscEndpoint struct {
+	provisioning
+	endpoint      psc.PrivateServiceConnectEndpoint
+	pendingStatus string
+	settledStatus string
+}
+
+type privateLink struct {
+	provisioning
+	link       privatelink.PrivateLink
+	principals []*privateLinkPrincipal
+}
+
+type privateLinkPrincipal struct {
+	provisioning
+	principal privatelink.PrivateLinkPrincipal
+}
+
+func (s *Server) registerNetworkingRoutes() {
+	// Active-Active peerings are addressed without a region, so they must be registered before the region routes.
+	s.handle(http.MethodGet, "/subscriptions/{subId}/peerings", s.withSubscription(s.listPeerings))
+	s.handle(http.MethodPost, "/subscriptions/{subId}/peerings", s.withSubscription(s.createPeering))
+	s.handle(http.MethodDelete, "/subscriptions/{subId}/peerings/{peeringId}", s.withSubscription(s.deletePeering))
+	s.handle(http.MethodGet, "/subscriptions/{subId}/regions/peerings", s.withSubscription(s.listActiveActivePeerings))
+	s.handle(http.MethodPost, "/subscriptions/{subId}/regions/peerings", s.withSubscription(s.createActiveActivePeering))
+	s.handle(http.MethodDelete, "/subscriptions/{subId}/regions/peerings/{peeringId}", s.withSubscription(s.deletePeering))
+
+	// Pro subscriptions address their only region implicitly; Active-Active subscriptions name it.
+	for _, base := range []string{"/subscriptions/{subId}", "/subscriptions/{subId}/regions/{regionId}"} {
+		s.handle(http.MethodGet, base+"/transitGateways", s.withRegion(s.listTransitGateways))
+		s.handle(http.MethodGet, base+"/transitGateways/invitations", s.withRegion(s.listInvitations))
+		s.handle(http.MethodPut, base+"/transitGateways/invitations/{invitationId}/accept", s.withRegion(s.acceptInvitation))
+		s.handle(http.MethodPut, base+"/transitGateways/invitations/{invitationId}/reject", s.withRegion(s.rejectInvitation))
+		s.handle(http.MethodPost, base+"/transitGateways/{tgwId}/attachment", s.withRegion(s.createAttachment))
+		s.handle(http.MethodPut, base+"/transitGateways/{tgwId}/attachment", s.withRegion(s.updateAttachmentCidrs))
+		s.handle(http.MethodDelete, base+"/transitGateways/{tgwId}/attachment", s.withRegion(s.deleteAttachment))
+
+		s.handle(http.MethodGet, base+"/private-service-connect", s.withRegion(s.getPscService))
+		s.handle(http.MethodPost, base+"/private-service-connect", s.withRegion(s.createPscService))
+		s.handle(http.MethodDelete, base+"/private-service-connect", s.withRegion(s.deletePscService))
+		s.handle(http.MethodGet, base+"/private-service-connect/{pscId}", s.withRegion(s.getPscEndpoints))
+		s.handle(http.MethodPost, base+"/private-service-connect/{pscId}", s.withRegion(s.createPscEndpoint))
+		s.handle(http.MethodPut, base+"/private-service-connect/{pscId}/endpoints/{endpointId}", s.withRegion(s.updatePscEndpoint))
+		s.handle(http.MethodDelete, base+"/private-service-connect/{pscId}/endpoints/{endpointId}", s.withRegion(s.deletePscEndpoint))
+		s.handle(http.MethodGet, base+"/private-service-connect/{pscId}/endpoints/{endpointId}/creationScripts", s.withRegion(s.getPscCreationScript))
+		s.handle(http.MethodGet, base+"/private-service-connect/{pscId}/endpoints/{endpointId}/deletionScripts", s.withRegion(s.getPscDeletionScript))
+
+		s.handle(http.MethodGet, base+"/private-link", s.withRegion(s.getPrivateLink))
+		s.handle(http.MethodPost, base+"/private-link", s.withRegion(s.createPrivateLink))
+		s.handle(http.MethodDelete, base+"/private-link", s.withRegion(s.deletePrivateLink))
+		s.handle(http.MethodPost, base+"/private-link/principals", s.withRegion(s.createPrivateLinkPrincipal))
+		s.handle(http.MethodDelete, base+"/private-link/principals", s.withRegion(s.deletePrivateLinkPrincipal))
+		s.handle(http.MethodGet, base+"/private-link/endpoint-script", s.withRegion(s.getPrivateLinkEndpointScript))
+	}
+}
+
+type regionHandlerFunc func(w http.ResponseWriter, r *http.Request, p params, sub *subscription, rg *region)
+
+// withRegion looks up the subscription and region named by the subId and regionId path parameters, where a missing
+// regionId means the only region of a Pro subscription.
+func (s *Server) withRegion(handle regionHandlerFunc) handlerFunc {
+	return s.withSubscription(func(w http.ResponseWriter, r *http.Request, p params, sub *subscription) {
+		rg, ok := sub.region(p.int("regionId"))
+		if !ok {
+			writeError(w, http.StatusNotFound, "REGION_NOT_FOUND", "region %s was not found in subscription %s", p["regionId"], p["subId"])
+			return
+		}
+		handle(w, r, p, sub, rg)
+	})
+}
+
+// AddTransitGatewayInvitation shares a Transit Gateway with a subscription region, as its owning AWS account would.
+// A regionId of 0 means the region of a Pro subscription. Accepting the invitation makes the Transit Gateway
+// available for attachment. It returns the ID of the invitation.
+func (s *Server) AddTransitGatewayInvitation(subscriptionId int, regionId int, name string) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rg, err := s.lookupRegion(subscriptionId, regionId)
+	if err != nil {
+		return 0, err
+	}
+	id := s.nextId()
+	rg.invitations[id] = &transitGatewayInvitation{invitation: attachments.TransitGatewayInvitation{
+		Id:               redis.Int(id),
+		Name:             redis.String(name),
+		ResourceShareUid: redis.String(fmt.Sprintf("rs-fake%08d", id)),
+		AwsAccountId:     redis.String("210987654321"),
+		Status:           redis.String(invitationStatusPending),
+		SharedDate:       redis.String(time.Now().UTC().Format(time.RFC3339)),
+	}}
+	return id, nil
+}
+
+// AddTransitGateway makes an unattached Transit Gateway available to a subscription region, as though an invitation
+// to it had already been accepted. A regionId of 0 means the region of a Pro subscription. It returns the ID of the
+// Transit Gateway.
+func (s *Server) AddTransitGateway(subscriptionId int, regionId int) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rg, err := s.lookupRegion(subscriptionId, regionId)
+	if err != nil {
+		return 0, err
+	}
+	return s.addTransitGateway(rg, "210987654321").id, nil
+}
+
+// SetTransitGatewayStatus forces the status of a Transit Gateway attachment, as AWS would when an attachment fails or
+// is rejected by its owner. A regionId of 0 means the region of a Pro subscription, and an empty status restores the
+// normal progression.
+func (s *Server) SetTransitGatewayStatus(subscriptionId int, regionId int, tgwId int, status string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rg, err := s.lookupRegion(subscriptionId, regionId)
+	if err != nil {
+		return err
+	}
+	tgw, ok := rg.transitGateways[tgwId]
+	if !ok {
+		return fmt.Errorf("transit gateway %d was not found", tgwId)
+	}
+	tgw.forcedStatus = status
+	return nil
+}
+
+func (s *Server) lookupRegion(subscriptionId int, regionId int) (*region, error) {
+	sub, ok := s.subscriptions[subscriptionId]
+	if !ok {
+		return nil, fmt.Errorf("subscription %d was not found", subscriptionId)
+	}
+	rg, ok := sub.region(regionId)
+	if !ok {
+		return nil, fmt.Errorf("region %d was not found in subscription %d", regionId, subscriptionId)
+	}
+	return rg, nil
+}
+
+func (s *Server) addTransitGateway(rg *region, awsAccountId string) *transitGateway {
+	id := s.nextId()
+	tgw := &transitGateway{
+		id:           id,
+		awsTgwUid:    fmt.Sprintf("tgw-fake%08d", id),
+		awsAccountId: awsAccountId,
+	}
+	rg.transitGateways[id] = tgw
+	return tgw
+}
+
+func (p *peering) status() *string {
+	settled := peeringStatusActive
+	if p.provider == "AWS" {
+		settled = peeringStatusPendingAcceptance
+	}
+	return p.provisioning.status(peeringStatusInitiatingRequest, settled)
+}
+
+func (p *peering) cidrs() []*subscriptions.CIDR {
+	var cidrs []*subscriptions.CIDR
+	for _, cidr := range p.vpcCidrs {
+		cidrs = append(cidrs, &subscriptions.CIDR{VPCCidr: redis.String(cidr), Status: redis.String("active")})
+	}
+	return cidrs
+}
+
+func (p *peering) view(rg *region) *subscriptions.VPCPeering {
+	view := &subscriptions.VPCPeering{
+		ID:     redis.Int(p.id),
+		Status: p.status(),
+		Region: redis.String(p.destinationRegion),
+	}
+	if p.provider == "AWS" {
+		view.AWSAccountID = redis.String(p.awsAccountId)
+		view.AWSPeeringID = redis.String(fmt.Sprintf("pcx-fake%08d", p.id))
+		view.VPCId = redis.String(p.vpcId)
+		if len(p.vpcCidrs) > 0 {
+			view.VPCCidr = redis.String(p.vpcCidrs[0])
+		}
+		view.VPCCidrs = p.cidrs()
+	} else {
+		view.GCPProjectUID = redis.String(p.gcpProjectId)
+		view.NetworkName = redis.String(p.networkName)
+		view.RedisProjectUID = redis.String("fake-redis-project")
+		view.RedisNetworkName = redis.String(fmt.Sprintf("fake-redis-network-%d", rg.id))
+		view.CloudPeeringID = redis.String(fmt.Sprintf("fake-peering-%d", p.id))
+	}
+	return view
+}
+
+func (p *peering) activeActiveView(rg *region) *subscriptions.ActiveActiveVPCPeering {
+	view := p.view(rg)
+	return &subscriptions.ActiveActiveVPCPeering{
+		ID:                view.ID,
+		Status:            view.Status,
+		RegionId:          redis.Int(rg.id),
+		RegionName:        redis.String(rg.name),
+		AWSAccountID:      view.AWSAccountID,
+		AWSPeeringID:      view.AWSPeeringID,
+		VPCId:             view.VPCId,
+		VPCCidr:           view.VPCCidr,
+		VPCCidrs:          view.VPCCidrs,
+		GCPProjectUID:     view.GCPProjectUID,
+		NetworkName:       view.NetworkName,
+		RedisProjectUID:   view.RedisProjectUID,
+		RedisNetworkName:  view.RedisNetworkName,
+		CloudPeeringID:    view.CloudPeeringID,
+		SourceRegion:      redis.String(rg.name),
+		DestinationRegion: redis.String(p.destinationRegion),
+	}
+}
+
+func (s *Server) listPeerings(w http.ResponseWriter, _ *http.Request, _ params, sub *subscription) {
+	list := []*subscriptions.VPCPeering{}
+	for _, id := range sortedIds(sub.peerings) {
+		p := sub.peerings[id]
+		list = append(list, p.view(sub.regions[p.regionId]))
+	}
+	s.accept(w, "vpcPeeringGetRequest", sub.sub.ID, map[string]interface{}{"peerings": list})
+}
+
+func (s *Server) listActiveActivePeerings(w http.ResponseWriter, _ *http.Request, _ params, sub *subscription) {
+	var list []*subscriptions.ActiveActiveVpcRegion
+	for _, regionId := range sub.regionIds {
+		rg := sub.regions[regionId]
+		entry := &subscriptions.ActiveActiveVpcRegion{
+			ID:           redis.Int(rg.id),
+			SourceRegion: redis.String(rg.name),
+		}
+		for _, id := range sortedIds(sub.peerings) {
+			if p := sub.peerings[id]; p.regionId == rg.id {
+				entry.VPCPeerings = append(entry.VPCPeerings, p.activeActiveView(rg))
+			}
+		}
+		list = append(list, entry)
+	}
+	s.accept(w, "vpcPeeringGetRequest", sub.sub.ID, map[string]interface{}{
+		"subscriptionId": sub.sub.ID,
+		"regions":        list,
+	})
+}
+
+func (s *Server) createPeering(w http.ResponseWriter, r *http.Request, _ params, sub *subscription) {
+	var request subscriptions.CreateVPCPeering
+	if !readJSON(w, r, &request) {
+		return
+	}
+	rg, ok := sub.region(0)
+	if !ok {
+		s.reject(w, "vpcPeeringCreateRequest", http.StatusBadRequest, "SUBSCRIPTION_NOT_ACTIVE", "subscription %d has no region", redis.IntValue(sub.sub.ID))
+		return
+	}
+
+	p := s.newPeering(sub, rg, redis.StringValue(request.Provider), redis.StringValue(request.Region))
+	p.awsAccountId = redis.StringValue(request.AWSAccountID)
+	p.vpcId = redis.StringValue(request.VPCId)
+	p.vpcCidrs = peeringCidrs(request.VPCCidr, request.VPCCidrs)
+	p.gcpProjectId = redis.StringValue(request.VPCProjectUID)
+	p.networkName = redis.StringValue(request.VPCNetworkName)
+	s.accept(w, "vpcPeeringCreateRequest", redis.Int(p.id), nil)
+}
+
+func (s *Server) createActiveActivePeering(w http.ResponseWriter, r *http.Request, _ params, sub *subscription) {
+	var request subscriptions.CreateActiveActiveVPCPeering
+	if !readJSON(w, r, &request) {
+		return
+	}
+	rg, ok := sub.regionByName(redis.StringValue(request.SourceRegion))
+	if !ok {
+		s.reject(w, "vpcPeeringCreateRequest", http.StatusBadRequest, "REGION_NOT_FOUND", "source region %s is not part of subscription %d", redis.StringValue(request.SourceRegion), redis.IntValue(sub.sub.ID))
+		return
+	}
+
+	p := s.newPeering(sub, rg, redis.StringValue(request.Provider), redis.StringValue(request.DestinationRegion))
+	p.awsAccountId = redis.StringValue(request.AWSAccountID)
+	p.vpcId = redis.StringValue(request.VPCId)
+	p.vpcCidrs = peeringCidrs(request.VPCCidr, request.VPCCidrs)
+	p.gcpProjectId = redis.StringValue(request.VPCProjectUID)
+	p.networkName = redis.StringValue(request.VPCNetworkName)
+	s.accept(w, "vpcPeeringCreateRequest", redis.Int(p.id), nil)
+}
+
+func (s *Server) newPeering(sub *subscription, rg *region, provider string, destinationRegion string) *peering {
+	if provider == "" {
+		provider = rg.provider
+	}
+	p := &peering{
+		id:                s.nextId(),
+		regionId:          rg.id,
+		provider:          provider,
+		destinationRegion: destinationRegion,
+	}
+	s.provision(&p.provisioning)
+	sub.peerings[p.id] = p
+	return p
+}
+
+func peeringCidrs(cidr *string, cidrs []*string) []string {
+	var list []string
+	if cidr != nil {
+		list = append(list, *cidr)
+	}
+	for _, c := range cidrs {
+		if c != nil && (cidr == nil || *c != *cidr) {
+			list = append(list, *c)
+		}
+	}
+	return list
+}
+
+func (s *Server) deletePeering(w http.ResponseWriter, _ *http.Request, p params, sub *subscription) {
+	id := p.int("peeringId")
+	if _, ok := sub.peerings[id]; !ok {
+		writeError(w, http.StatusNotFound, "VPC_PEERING_NOT_FOUND", "peering %s was not found in subscription %s", p["peeringId"], p["subId"])
+		return
+	}
+	delete(sub.peerings, id)
+	s.accept(w, "vpcPeeringDeleteRequest", redis.Int(id), nil)
+}
+
+func (tgw *transitGateway) view() *attachments.TransitGatewayAttachment {
+	view := &attachments.TransitGatewayAttachment{
+		Id:           redis.Int(tgw.id),
+		AwsTgwUid:    redis.String(tgw.awsTgwUid),
+		AwsAccountId: redis.String(tgw.awsAccountId),
+		Status:       redis.String(transitGatewayStatusAvailable),
+	}
+	if !tgw.attached {
+		return view
+	}
+
+	view.AttachmentUid = redis.String(fmt.Sprintf("tgw-attach-fake%08d", tgw.id))
+	view.Status = tgw.status(transitGatewayStatusPending, transitGatewayStatusAvailable)
+	if tgw.forcedStatus != "" {
+		view.Status = redis.String(tgw.forcedStatus)
+		view.AttachmentStatus = redis.String(tgw.forcedStatus)
+	} else if *view.Status == transitGatewayStatusPending {
+		view.AttachmentStatus = redis.String(transitGatewayStatusPendingAcceptance)
+	} else {
+		view.AttachmentStatus = redis.String(transitGatewayStatusAvailable)
+	}
+	for _, cidr := range tgw.cidrs {
+		view.Cidrs = append(view.Cidrs, &attachments.Cidr{CidrAddress: redis.String(cidr), Status: redis.String("active")})
+	}
+	return view
+}
+
+func (s *Server) listTransitGateways(w http.ResponseWriter, _ *http.Request, _ params, _ *subscription, rg *region) {
+	list := []*attachments.TransitGatewayAttachment{}
+	for _, id := range sortedIds(rg.transitGateways) {
+		list = append(list, rg.transitGateways[id].view())
+	}
+	s.accept(w, "tgwGetRequest", nil, attachments.Resource{TransitGatewayAttachment: list})
+}
+
+func (s *Server) listInvitations(w http.ResponseWriter, _ *http.Request, _ params, _ *subscription, rg *region) {
+	list := []*attachments.TransitGatewayInvitation{}
+	for _, id := range sortedIds(rg.invitations) {
+		invitation := rg.invitations[id].invitation
+		list = append(list, &invitation)
+	}
+	s.accept(w, "tgwListInvitationsRequest", nil, attachments.InvitationsResource{Resources: list})
+}
+
+func (s *Server) acceptInvitation(w http.ResponseWriter, _ *http.Request, p params, _ *subscription, rg *region) {
+	invitation, ok := rg.invitations[p.int("invitationId")]
+	if !ok {
+		s.reject(w, "tgwAcceptInvitationRequest", http.StatusNotFound, "TGW_INVITATION_NOT_FOUND", "invitation %s was not found", p["invitationId"])
+		return
+	}
+	if redis.StringValue(invitation.invitation.Status) == invitationStatusPending {
+		invitation.invitation.Status = redis.String(invitationStatusAccepted)
+		s.addTransitGateway(rg, redis.StringValue(invitation.invitation.AwsAccountId))
+	}
+	s.accept(w, "tgwAcceptInvitationRequest", invitation.invitation.Id, nil)
+}
+
+func (s *Server) rejectInvitation(w http.ResponseWriter, _ *http.Request, p params, _ *subscription, rg *region) {
+	invitation, ok := rg.invitations[p.int("invitationId")]
+	if !ok {
+		s.reject(w, "tgwRejectInvitationRequest", http.StatusNotFound, "TGW_INVITATION_NOT_FOUND", "invitation %s was not found", p["invitationId"])
+		return
+	}
+	if redis.StringValue(invitation.invitation.Status) == invitationStatusPending {
+		invitation.invitation.Status = redis.String(invitationStatusRejected)
+	}
+	s.accept(w, "tgwRejectInvitationRequest", invitation.invitation.Id, nil)
+}
+
+func (s *Server) createAttachment(w http.ResponseWriter, _ *http.Request, p params, _ *subscription, rg *region) {
+	tgw, ok := rg.transitGateways[p.int("tgwId")]
+	if !ok {
+		s.reject(w, "tgwCreateAttachmentRequest", http.StatusNotFound, "TGW_NOT_FOUND", "transit gateway %s was not found", p["tgwId"])
+		return
+	}
+	if tgw.attached {
+		s.reject(w, "tgwCreateAttachmentRequest", http.StatusConflict, "TGW_ATTACHMENT_ALREADY_EXISTS", "transit gateway %s is already attached", p["tgwId"])
+		return
+	}
+	tgw.attached = true
+	s.provision(&tgw.provisioning)
+	s.accept(w, "tgwCreateAttachmentRequest", redis.Int(tgw.id), nil)
+}
+
+func (s *Server) updateAttachmentCidrs(w http.ResponseWriter, r *http.Request, p params, _ *subscription, rg *region) {
+	tgw, ok := rg.transitGateways[p.int("tgwId")]
+	if !ok || !tgw.attached {
+		s.reject(w, "tgwUpdateCidrsRequest", http.StatusNotFound, "TGW_ATTACHMENT_NOT_FOUND", "transit gateway %s is not attached", p["tgwId"])
+		return
+	}
+	var request struct {
+		Cidrs []*string `json:"cidrs"`
+	}
+	if !readJSON(w, r, &request) {
+		return
+	}
+	tgw.cidrs = redis.StringSliceValue(request.Cidrs...)
+	s.accept(w, "tgwUpdateCidrsRequest", redis.Int(tgw.id), nil)
+}
+
+func (s *Server) deleteAttachment(w http.ResponseWriter, _ *http.Request, p params, _ *subscription, rg *region) {
+	tgw, ok := rg.transitGateways[p.int("tgwId")]
+	if !ok || !tgw.attached {
+		s.reject(w, "tgwDeleteAttachmentRequest", http.StatusNotFound, "TGW_ATTACHMENT_NOT_FOUND", "transit gateway %s is not attached", p["tgwId"])
+		return
+	}
+	tgw.attached = false
+	tgw.cidrs = nil
+	tgw.pendingReads = 0
+	tgw.forcedStatus = ""
+	s.accept(w, "tgwDeleteAttachmentRequest", redis.Int(tgw.id), nil)
+}
+
+func (service *pscService) view(rg *region) *psc.PrivateServiceConnectService {
+	return &psc.PrivateServiceConnectService{
+		ID:                    redis.Int(service.id),
+		ConnectionHostName:    redis.String(fmt.Sprintf("psc-%d.%s.fake.rediscloud.test", service.id, rg.name)),
+		ServiceAttachmentName: redis.String(fmt.Sprintf("projects/fake-redis-project/regions/%s/serviceAttachments/psc-%d", rg.name, service.id)),
+		Status:                service.status(psc.ServiceStatusCreateQueued, psc.ServiceStatusActive),
+	}
+}
+
+func (e *pscEndpoint) view() *psc.PrivateServiceConnectEndpoint {
+	view := e.endpoint
+	view.Status = e.status(e.pendingStatus, e.settledStatus)
+	return &view
+}
+
+// pscEndpoint looks up the endpoint named by the pscId and endpointId path parameters. Missing services and endpoints are reported as failed tasks, as
+// the API does.
+func (s *Server) pscEndpoint(w http.ResponseWriter, commandType string, p params, rg *region) (*pscEndpoint, bool) {
+	if rg.psc == nil || rg.psc.id != p.int("pscId") {
+		s.reject(w, commandType, http.StatusNotFound, "PSC_SERVICE_NOT_FOUND", "private service connect service %s was not found", p["pscId"])
+		return nil, false
+	}
+	e, ok := rg.psc.endpoints[p.int("endpointId")]
+	if !ok {
+		s.reject(w, commandType, http.StatusNotFound, "PSC_ENDPOINT_NOT_FOUND", "private service connect endpoint %s was not found", p["endpointId"])
+		return nil, false
+	}
+	return e, true
+}
+
+func (s *Server) getPscService(w http.ResponseWriter, _ *http.Request, _ params, _ *subscription, rg *region) {
+	if rg.psc == nil {
+		s.reject(w, "pscServiceGetRequest", http.StatusNotFound, "PSC_SERVICE_NOT_FOUND", "region %d has no private service connect service", rg.id)
+		return
+	}
+	s.accept(w, "pscServiceGetRequest", redis.Int(rg.psc.id), rg.psc.view(rg))
+}
+
+func (s *Server) createPscService(w http.ResponseWriter, _ *http.Request, _ params, _ *subscription, rg *region) {
+	if rg.provider != "GCP" {
+		s.reject(w, "pscServiceCreateRequest", http.StatusBadRequest, "PSC_NOT_SUPPORTED", "private service connect is only available in GCP regions")
+		return
+	}
+	if rg.psc == nil {
+		rg.psc = &pscService{id: s.nextId(), endpoints: map[int]*pscEndpoint{}}
+		s.provision(&rg.psc.provisioning)
+	}
+	s.accept(w, "pscServiceCreateRequest", redis.Int(rg.psc.id), nil)
+}
+
+func (s *Server) deletePscService(w http.ResponseWriter, _ *http.Request, _ params, _ *subscription, rg *region) {
+	if rg.psc == nil {
+		s.reject(w, "pscServiceDeleteRequest", http.StatusNotFound, "PSC_SERVICE_NOT_FOUND", "region %d has no private service connect service", rg.id)
+		return
+	}
+	if len(rg.psc.endpoints) > 0 {
+		s.reject(w, "pscServiceDeleteRequest", http.StatusConflict, "PSC_SERVICE_HAS_ENDPOINTS", "private service connect service %d still has endpoints", rg.psc.id)
+		return
+	}
+	id := rg.psc.id
+	rg.psc = nil
+	s.accept(w, "pscServiceDeleteRequest", redis.Int(id), nil)
+}
+
+func (s *Server) getPscEndpoints(w http.ResponseWriter, _ *http.Request, p params, _ *subscription, rg *region) {
+	if rg.psc == nil || rg.psc.id != p.int("pscId") {
+		s.reject(w, "pscEndpointsGetRequest", http.StatusNotFound, "PSC_SERVICE_NOT_FOUND", "private service connect service %s was not found", p["pscId"])
+		return
+	}
+	endpoints := &psc.PrivateServiceConnectEndpoints{PSCServiceID: redis.Int(rg.psc.id)}
+	for _, id := range sortedIds(rg.psc.endpoints) {
+		endpoints.Endpoints = append(endpoints.Endpoints, rg.psc.endpoints[id].view())
+	}
+	s.accept(w, "pscEndpointsGetRequest", redis.Int(rg.psc.id), endpoints)
+}
+
+func (s *Server) createPscEndpoint(w http.ResponseWriter, r *http.Request, p params, _ *subscription, rg *region) {
+	var request psc.CreatePrivateServiceConnectEndpoint
+	if !readJSON(w, r, &request) {
+		return
+	}
+	if rg.psc == nil || rg.psc.id != p.int("pscId") {
+		s.reject(w, "pscEndpointCreateRequest", http.StatusNotFound, "PSC_SERVICE_NOT_FOUND", "private service connect service %s was not found", p["pscId"])
+		return
+	}
+
+	id := s.nextId()
+	e := &pscEndpoint{
+		endpoint: psc.PrivateServiceConnectEndpoint{
+			ID:                     redis.Int(id),
+			GCPProjectID:           request.GCPProjectID,
+			GCPVPCName:             request.GCPVPCName,
+			GCPVPCSubnetName:       request.GCPVPCSubnetName,
+			EndpointConnectionName: request.EndpointConnectionName,
+		},
+		pendingStatus: psc.EndpointStatusProcessing,
+		settledStatus: psc.EndpointStatusPending,
+	}
+	s.provision(&e.provisioning)
+	rg.psc.endpoints[id] = e
+	s.accept(w, "pscEndpointCreateRequest", redis.Int(id), nil)
+}
+
+func (s *Server) updatePscEndpoint(w http.ResponseWriter, r *http.Request, p params, _ *subscription, rg *region) {
+	var request psc.UpdatePrivateServiceConnectEndpoint
+	if !readJSON(w, r, &request) {
+		return
+	}
+	e, ok := s.pscEndpoint(w, "pscEndpointUpdateRequest", p, rg)
+	if !ok {
+		return
+	}
+
+	if request.GCPProjectID != nil {
+		e.endpoint.GCPProjectID = request.GCPProjectID
+	}
+	if request.GCPVPCName != nil {
+		e.endpoint.GCPVPCName = request.GCPVPCName
+	}
+	if request.GCPVPCSubnetName != nil {
+		e.endpoint.GCPVPCSubnetName = request.GCPVPCSubnetName
+	}
+	if request.EndpointConnectionName != nil {
+		e.endpoint.EndpointConnectionName = request.EndpointConnectionName
+	}
+	switch redis.StringValue(request.Action) {
+	case psc.EndpointActionAccept:
+		e.pendingStatus, e.settledStatus = psc.EndpointStatusAcceptPending, psc.EndpointStatusActive
+		s.provision(&e.provisioning)
+	case psc.EndpointActionReject:
+		e.pendingStatus, e.settledStatus = psc.EndpointStatusRejectPending, psc.EndpointStatusRejected
+		s.provision(&e.provisioning)
+	}
+	s.accept(w, "pscEndpointUpdateRequest", e.endpoint.ID, nil)
+}
+
+func (s *Server) deletePscEndpoint(w http.ResponseWriter, _ *http.Request, p params, _ *subscription, rg *region) {
+	e, ok := s.pscEndpoint(w, "pscEndpointDeleteRequest", p, rg)
+	if !ok {
+		return
+	}
+	delete(rg.psc.endpoints, redis.IntValue(e.endpoint.ID))
+	s.accept(w, "pscEndpointDeleteRequest", e.endpoint.ID, nil)
+}
+
+func (s *Server) getPscCreationScript(w http.ResponseWriter, r *http.Request, p params, _ *subscription, rg *region) {
+	e, ok := s.pscEndpoint(w, "pscEndpointCreationScriptGetRequest", p, rg)
+	if !ok {
+		return
+	}
+	script := &psc.GCPCreationScript{
+		Bash:       redis.String(fmt.Sprintf("#!/bin/bash\n# creates endpoint %d\n", redis.IntValue(e.endpoint.ID))),
+		Powershell: redis.String(fmt.Sprintf("# creates endpoint %d\n", redis.IntValue(e.endpoint.ID))),
+	}
+	if r.URL.Query().Get("includeTerraformGcpScript") == "true" {
+		name := redis.StringValue(e.endpoint.EndpointConnectionName)
+		script.TerraformGcp = &psc.TerraformGCP{ServiceAttachments: []psc.TerraformGCPServiceAttachment{{
+			Name:               redis.String(fmt.Sprintf("projects/fake-redis-project/regions/%s/serviceAttachments/psc-%d", rg.name, rg.psc.id)),
+			DNSRecord:          redis.String(fmt.Sprintf("psc-%d.%s.fake.rediscloud.test.", rg.psc.id, rg.name)),
+			IPAddressName:      redis.String(name + "-ip"),
+			ForwardingRuleName: redis.String(name),
+		}}}
+	}
+	s.accept(w, "pscEndpointCreationScriptGetRequest", e.endpoint.ID, psc.CreationScript{Script: script})
+}
+
+func (s *Server) getPscDeletionScript(w http.ResponseWriter, _ *http.Request, p params, _ *subscription, rg *region) {
+	e, ok := s.pscEndpoint(w, "pscEndpointDeletionScriptGetRequest", p, rg)
+	if !ok {
+		return
+	}
+	s.accept(w, "pscEndpointDeletionScriptGetRequest", e.endpoint.ID, psc.DeletionScript{Script: &psc.GCPDeletionScript{
+		Bash:       redis.String(fmt.Sprintf("#!/bin/bash\n# deletes endpoint %d\n", redis.IntValue(e.endpoint.ID))),
+		Powershell: redis.String(fmt.Sprintf("# deletes endpoint %d\n", redis.IntValue(e.endpoint.ID))),
+	}})
+}
+
+func (link *privateLink) view(sub *subscription, rg *region) *privatelink.PrivateLink {
+	view := link.link
+	view.Status = link.status(privateLinkStatusInitializing, privateLinkStatusActive)
+	view.Principals = nil
+	for _, principal := range link.principals {
+		p := principal.principal
+		p.Status = principal.status(privateLinkStatusInitializing, principalStatusAssociated)
+		view.Principals = append(view.Principals, &p)
+	}
+	view.Databases = nil
+	for _, id := range sortedIds(sub.databases) {
+		db := sub.databases[id]
+		if db.pro == nil {
+			continue
+		}
+		view.Databases = append(view.Databases, &privatelink.PrivateLinkDatabase{
+			DatabaseId:           redis.Int(id),
+			ResourceLinkEndpoint: redis.String(fmt.Sprintf("rl-%d.%s.fake.rediscloud.test", id, rg.name)),
+		})
+	}
+	return &view
+}
+
+func (s *Server) getPrivateLink(w http.ResponseWriter, _ *http.Request, _ params, sub *subscription, rg *region) {
+	if rg.privateLink == nil {
+		s.reject(w, "privateLinkGetRequest", http.StatusNotFound, "PRIVATE_LINK_NOT_FOUND", "region %d has no private link", rg.id)
+		return
+	}
+	s.accept(w, "privateLinkGetRequest", sub.sub.ID, rg.privateLink.view(sub, rg))
+}
+
+func (s *Server) createPrivateLink(w http.ResponseWriter, r *http.Request, _ params, sub *subscription, rg *region) {
+	var request privatelink.CreatePrivateLink
+	if !readJSON(w, r, &request) {
+		return
+	}
+	if rg.provider != "AWS" {
+		s.reject(w, "privateLinkCreateRequest", http.StatusBadRequest, "PRIVATE_LINK_NOT_SUPPORTED", "private link is only available in AWS regions")
+		return
+	}
+	if rg.privateLink != nil {
+		s.reject(w, "privateLinkCreateRequest", http.StatusConflict, "PRIVATE_LINK_ALREADY_EXISTS", "region %d already has a private link", rg.id)
+		return
+	}
+
+	id := s.nextId()
+	link := &privateLink{link: privatelink.PrivateLink{
+		ResourceConfigurationId:  redis.String(fmt.Sprintf("rcfg-fake%08d", id)),
+		ResourceConfigurationArn: redis.String(fmt.Sprintf("arn:aws:vpc-lattice:%s:123456789012:resourceconfiguration/rcfg-fake%08d", rg.name, id)),
+		ShareArn:                 redis.String(fmt.Sprintf("arn:aws:ram:%s:123456789012:resource-share/fake-%d", rg.name, id)),
+		ShareName:                request.ShareName,
+		SubscriptionId:           sub.sub.ID,
+		RegionId:                 redis.Int(rg.id),
+	}}
+	s.provision(&link.provisioning)
+	if request.Principal != nil {
+		link.addPrincipal(s, privatelink.CreatePrivateLinkPrincipal{
+			Principal:      request.Principal,
+			PrincipalType:  request.PrincipalType,
+			PrincipalAlias: request.PrincipalAlias,
+		})
+	}
+	rg.privateLink = link
+	s.accept(w, "privateLinkCreateRequest", sub.sub.ID, nil)
+}
+
+func (link *privateLink) addPrincipal(s *Server, request privatelink.CreatePrivateLinkPrincipal) {
+	principal := &privateLinkPrincipal{principal: privatelink.PrivateLinkPrincipal{
+		Principal: request.Principal,
+		Type:      request.PrincipalType,
+		Alias:     request.PrincipalAlias,
+	}}
+	s.provision(&principal.provisioning)
+	link.principals = append(link.principals, principal)
+}
+
+func (s *Server) deletePrivateLink(w http.ResponseWriter, _ *http.Request, _ params, sub *subscription, rg *region) {
+	if rg.privateLink == nil {
+		s.reject(w, "privateLinkDeleteRequest", http.StatusNotFound, "PRIVATE_LINK_NOT_FOUND", "region %d has no private link", rg.id)
+		return
+	}
+	rg.privateLink = nil
+	s.accept(w, "privateLinkDeleteRequest", sub.sub.ID, nil)
+}
+
+func (s *Server) createPrivateLinkPrincipal(w http.ResponseWriter, r *http.Request, _ params, sub *subscription, rg *region) {
+	var request privatelink.CreatePrivateLinkPrincipal
+	if !readJSON(w, r, &request) {
+		return
+	}
+	if rg.privateLink == nil {
+		s.reject(w, "privateLinkPrincipalCreateRequest", http.StatusNotFound, "PRIVATE_LINK_NOT_FOUND", "region %d has no private link", rg.id)
+		return
+	}
+	for _, principal := range rg.privateLink.principals {
+		if redis.StringValue(principal.principal.Principal) == redis.StringValue(request.Principal) {
+			s.reject(w, "privateLinkPrincipalCreateRequest", http.StatusConflict, "PRINCIPAL_ALREADY_EXISTS", "principal %s is already associated", redis.StringValue(request.Principal))
+			return
+		}
+	}
+	rg.privateLink.addPrincipal(s, request)
+	s.accept(w, "privateLinkPrincipalCreateRequest", sub.sub.ID, nil)
+}
+
+func (s *Server) deletePrivateLinkPrincipal(w http.ResponseWriter, r *http.Request, _ params, sub *subscription, rg *region) {
+	var request privatelink.CreatePrivateLinkPrincipal
+	if !readJSON(w, r, &request) {
+		return
+	}
+	if rg.privateLink == nil {
+		s.reject(w, "privateLinkPrincipalDeleteRequest", http.StatusNotFound, "PRIVATE_LINK_NOT_FOUND", "region %d has no private link", rg.id)
+		return
+	}
+	for i, principal := range rg.privateLink.principals {
+		if redis.StringValue(principal.principal.Principal) == redis.StringValue(request.Principal) {
+			rg.privateLink.principals = append(rg.privateLink.principals[:i], rg.privateLink.principals[i+1:]...)
+			s.accept(w, "privateLinkPrincipalDeleteRequest", sub.sub.ID, nil)
+			return
+		}
+	}
+	s.reject(w, "privateLinkPrincipalDeleteRequest", http.StatusNotFound, "PRINCIPAL_NOT_FOUND", "principal %s is not associated", redis.StringValue(request.Principal))
+}
+
+func (s *Server) getPrivateLinkEndpointScript(w http.ResponseWriter, _ *http.Request, _ params, sub *subscription, rg *region) {
+	if rg.privateLink == nil {
+		s.reject(w, "privateLinkEndpointScriptGetRequest", http.StatusNotFound, "PRIVATE_LINK_NOT_FOUND", "region %d has no private link", rg.id)
+		return
+	}
+	s.accept(w, "privateLinkEndpointScriptGetRequest", sub.sub.ID, privatelink.PrivateLinkEndpointScript{
+		ResourceEndpointScript: redis.String(fmt.Sprintf("#!/bin/bash\n# connects to %s\n", redis.StringValue(rg.privateLink.link.ResourceConfigurationArn))),
+		TerraformAwsScript:     redis.String(fmt.Sprintf("# connects to %s\n", redis.StringValue(rg.privateLink.link.ResourceConfigurationArn))),
+	})
+}
@@ -0,0 +1,265 @@
+// Package fakeapi is an in-process fake of the Redis Cloud v1 API, for use by tests only.
+//
+// The fake keeps subscriptions, databases, Active-Active regions, VPC peerings, Transit Gateways, Private Service
+// Connect, PrivateLink and ACL objects in memory. Mutations are accepted as asynchronous tasks which progress from
+// received, through processing, to completed, and new or modified objects report a pending status for a number of
+// reads before becoming active, so the provider's waiters are exercised as they would be against the real API.
+//
+// Point the provider at the fake by setting REDISCLOUD_URL to Server.URL, or use Start to do so for a single test.
+// Nothing outside of _test.go files should import this package.
+package fakeapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	rediscloudApi "github.com/RedisLabs/rediscloud-go-api"
+)
+
+// Options controls how quickly the fake converges.
+type Options struct {
+	// TaskPolls is the number of times a task reports being in progress before it completes.
+	TaskPolls int
+	// ProvisioningPolls is the number of reads for which a new or modified object reports a pending status
+	// before it becomes active.
+	ProvisioningPolls int
+}
+
+// DefaultOptions makes every task and object go through a single pending poll, which is enough to exercise the
+// provider's waiters without slowing tests down.
+var DefaultOptions = Options{TaskPolls: 1, ProvisioningPolls: 1}
+
+// Server is a running fake Redis Cloud API.
+type Server struct {
+	// URL is the base URL of the fake, suitable for REDISCLOUD_URL.
+	URL string
+
+	httpServer *httptest.Server
+	options    Options
+	routes     []route
+
+	mu            sync.Mutex
+	lastId        int
+	lastTaskId    int
+	tasks         map[string]*task
+	subscriptions map[int]*subscription
+	cloudAccounts map[int]*cloudAccount
+	aclRules      map[int]*aclRule
+	aclRoles      map[int]*aclRole
+	aclUsers      map[int]*aclUser
+}
+
+// New starts a fake Redis Cloud API. Close must be called once it is no longer needed.
+func New(options Options) *Server {
+	s := &Server{
+		options:       options,
+		tasks:         map[string]*task{},
+		subscriptions: map[int]*subscription{},
+		cloudAccounts: map[int]*cloudAccount{},
+		aclRules:      map[int]*aclRule{},
+		aclRoles:      map[int]*aclRole{},
+		aclUsers:      map[int]*aclUser{},
+	}
+	s.seedAccount()
+	s.seedAcl()
+
+	s.registerTaskRoutes()
+	s.registerAccountRoutes()
+	s.registerSubscriptionRoutes()
+	s.registerDatabaseRoutes()
+	s.registerNetworkingRoutes()
+	s.registerAclRoutes()
+
+	s.httpServer = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	s.URL = s.httpServer.URL
+	return s
+}
+
+// Start starts a fake Redis Cloud API for the duration of the test, pointing REDISCLOUD_URL and the API credentials
+// at it. As it sets environment variables, it cannot be used by parallel tests.
+func Start(t testing.TB, options Options) *Server {
+	t.Helper()
+	s := New(options)
+	t.Cleanup(s.Close)
+	t.Setenv("REDISCLOUD_URL", s.URL)
+	t.Setenv(rediscloudApi.AccessKeyEnvVar, "fake-access-key")
+	t.Setenv(rediscloudApi.SecretKeyEnvVar, "fake-secret-key")
+	return s
+}
+
+// Close shuts the fake down.
+func (s *Server) Close() {
+	s.httpServer.Close()
+}
+
+// Client returns a Redis Cloud API client talking to the fake, configured with any further options given.
+func (s *Server) Client(options ...rediscloudApi.Option) (*rediscloudApi.Client, error) {
+	return rediscloudApi.NewClient(append([]rediscloudApi.Option{
+		rediscloudApi.BaseURL(s.URL),
+		rediscloudApi.Auth("fake-access-key", "fake-secret-key"),
+	}, options...)...)
+}
+
+func (s *Server) nextId() int {
+	s.lastId++
+	return s.lastId
+}
+
+type params map[string]string
+
+func (p params) int(name string) int {
+	n, _ := strconv.Atoi(p[name])
+	return n
+}
+
+type handlerFunc func(w http.ResponseWriter, r *http.Request, p params)
+
+type route struct {
+	method   string
+	segments []string
+	handle   handlerFunc
+}
+
+// handle registers a handler for a path pattern such as /subscriptions/{subId}/databases/{dbId}. Routes are matched
+// in registration order, so more specific patterns must be registered first.
+func (s *Server) handle(method string, pattern string, handle handlerFunc) {
+	s.routes = append(s.routes, route{
+		method:   method,
+		segments: splitPath(pattern),
+		handle:   handle,
+	})
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	// The API client appends paths with a query string (such as redis-versions?subscriptionId=) to the base URL's
+	// path, so the query arrives escaped as part of the path.
+	path := r.URL.Path
+	if i := strings.Index(path, "?"); i >= 0 {
+		if extra, err := url.ParseQuery(path[i+1:]); err == nil {
+			query := r.URL.Query()
+			for k, v := range extra {
+				query[k] = v
+			}
+			r.URL.RawQuery = query.Encode()
+		}
+		path = path[:i]
+	}
+	segments := splitPath(path)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	methodMismatch := false
+	for _, rt := range s.routes {
+		p, ok := matchRoute(rt.segments, segments)
+		if !ok {
+			continue
+		}
+		if rt.method != r.Method {
+			methodMismatch = true
+			continue
+		}
+		rt.handle(w, r, p)
+		return
+	}
+
+	if methodMismatch {
+		writeError(w, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", "%s is not supported on %s", r.Method, path)
+		return
+	}
+	writeError(w, http.StatusNotFound, "NOT_FOUND", "no such endpoint: %s %s", r.Method, path)
+}
+
+func splitPath(path string) []string {
+	path = strings.Trim(path, "/")
+	if path == "" {
+		return nil
+	}
+	// Be tolerant of the base URL carrying a version prefix.
+	path = strings.TrimPrefix(path, "v1/")
+	return strings.Split(path, "/")
+}
+
+func matchRoute(pattern []string, segments []string) (params, bool) {
+	if len(pattern) != len(segments) {
+		return nil, false
+	}
+	p := params{}
+	for i, part := range pattern {
+		if strings.HasPrefix(part, "{") && strings.HasSuffix(part, "}") {
+			p[part[1:len(part)-1]] = segments[i]
+			continue
+		}
+		if part != segments[i] {
+			return nil, false
+		}
+	}
+	return p, true
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+type errorBody struct {
+	Status      int    `json:"status"`
+	Type        string `json:"type"`
+	Description string `json:"description"`
+}
+
+func writeError(w http.ResponseWriter, status int, errorType string, format string, args ...interface{}) {
+	writeJSON(w, status, errorBody{
+		Status:      status,
+		Type:        errorType,
+		Description: fmt.Sprintf(format, args...),
+	})
+}
+
+func readJSON(w http.ResponseWriter, r *http.Request, body interface{}) bool {
+	if r.Body == nil || r.ContentLength == 0 {
+		return true
+	}
+	if err := json.NewDecoder(r.Body).Decode(body); err != nil {
+		writeError(w, http.StatusBadRequest, "BAD_REQUEST", "invalid request body: %s", err)
+		return false
+	}
+	return true
+}
+
+func sortedIds[V any](m map[int]V) []int {
+	ids := make([]int, 0, len(m))
+	for id := range m {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	return ids
+}
+
+// provisioning tracks how many more reads an object reports a pending status for.
+type provisioning struct {
+	pendingReads int
+}
+
+func (s *Server) provision(p *provisioning) {
+	p.pendingReads = s.options.ProvisioningPolls
+}
+
+// status returns the pending status while the object is still being provisioned, and the settled status afterwards.
+// Every call counts as a read.
+func (p *provisioning) status(pending string, settled string) *string {
+	if p.pendingReads > 0 {
+		p.pendingReads--
+		return &pending
+	}
+	return &settled
+}
@@ -0,0 +1,350 @@
+package fakeapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	rediscloudApi "github.com/RedisLabs/rediscloud-go-api"
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/RedisLabs/rediscloud-go-api/service/access_control_lists/redis_rules"
+	"github.com/RedisLabs/rediscloud-go-api/service/access_control_lists/roles"
+	"github.com/RedisLabs/rediscloud-go-api/service/access_control_lists/users"
+	"github.com/RedisLabs/rediscloud-go-api/service/databases"
+	"github.com/RedisLabs/rediscloud-go-api/service/privatelink"
+	"github.com/RedisLabs/rediscloud-go-api/service/psc"
+	"github.com/RedisLabs/rediscloud-go-api/service/subscriptions"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testOptions completes tasks on their first poll, so the API client's task waiter never sleeps, while still making
+// objects report a pending status once.
+var testOptions = Options{TaskPolls: 0, ProvisioningPolls: 1}
+
+func newTestClient(t *testing.T) (*Server, *rediscloudApi.Client) {
+	t.Helper()
+	s := New(testOptions)
+	t.Cleanup(s.Close)
+	client, err := s.Client()
+	require.NoError(t, err)
+	return s, client
+}
+
+func createTestSubscription(t *testing.T, client *rediscloudApi.Client, provider string, region string) int {
+	t.Helper()
+	id, err := client.Subscription.Create(context.Background(), subscriptions.CreateSubscription{
+		Name:            redis.String("fake-" + strings.ToLower(provider)),
+		PaymentMethodID: redis.Int(PaymentMethodId),
+		CloudProviders: []*subscriptions.CreateCloudProvider{{
+			Provider:       redis.String(provider),
+			CloudAccountID: redis.Int(1),
+			Regions: []*subscriptions.CreateRegion{{
+				Region:     redis.String(region),
+				Networking: &subscriptions.CreateNetworking{DeploymentCIDR: redis.String("10.0.0.0/24")},
+			}},
+		}},
+		Databases: []*subscriptions.CreateDatabase{{
+			Name:            redis.String("planned"),
+			MemoryLimitInGB: redis.Float64(1),
+			Quantity:        redis.Int(1),
+		}},
+	})
+	require.NoError(t, err)
+	return id
+}
+
+func TestUnitServerTaskProgression(t *testing.T) {
+	s := New(Options{TaskPolls: 2})
+	t.Cleanup(s.Close)
+
+	response, err := http.Post(s.URL+"/acl/redisRules", "application/json", strings.NewReader(`{"name":"r","redisRule":"+@read ~*"}`))
+	require.NoError(t, err)
+	defer response.Body.Close()
+	require.Equal(t, http.StatusAccepted, response.StatusCode)
+
+	var reference taskReference
+	require.NoError(t, json.NewDecoder(response.Body).Decode(&reference))
+
+	for _, expected := range []string{taskStatusReceived, taskStatusProcessing, taskStatusCompleted} {
+		poll, err := http.Get(s.URL + "/tasks/" + reference.TaskId)
+		require.NoError(t, err)
+
+		var body taskBody
+		require.NoError(t, json.NewDecoder(poll.Body).Decode(&body))
+		_ = poll.Body.Close()
+		assert.Equal(t, expected, body.Status)
+		if expected == taskStatusCompleted {
+			require.NotNil(t, body.Response)
+			assert.NotNil(t, body.Response.ResourceId)
+		}
+	}
+}
+
+func TestUnitServerSubscriptionLifecycle(t *testing.T) {
+	_, client := newTestClient(t)
+	ctx := context.Background()
+
+	id := createTestSubscription(t, client, "AWS", "us-east-1")
+
+	sub, err := client.Subscription.Get(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, subscriptions.SubscriptionStatusPending, redis.StringValue(sub.Status))
+
+	sub, err = client.Subscription.Get(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, subscriptions.SubscriptionStatusActive, redis.StringValue(sub.Status))
+	require.Len(t, sub.CloudDetails, 1)
+	assert.Equal(t, "us-east-1", redis.StringValue(sub.CloudDetails[0].Regions[0].Region))
+	assert.Equal(t, "10.0.0.0/24", redis.StringValue(sub.CloudDetails[0].Regions[0].Networking[0].DeploymentCIDR))
+
+	dbId, err := client.Database.Create(ctx, id, databases.CreateDatabase{
+		Name:            redis.String("created"),
+		DatasetSizeInGB: redis.Float64(1),
+	})
+	require.NoError(t, err)
+
+	var names []string
+	list := client.Database.List(ctx, id)
+	for list.Next() {
+		names = append(names, redis.StringValue(list.Value().Name))
+	}
+	require.NoError(t, list.Err())
+	assert.Equal(t, []string{"planned", "created"}, names)
+
+	err = client.Subscription.Delete(ctx, id)
+	assert.Error(t, err, "a subscription with databases can't be deleted")
+
+	require.NoError(t, client.Database.Delete(ctx, id, dbId))
+	_, err = client.Database.Get(ctx, id, dbId)
+	assert.IsType(t, &databases.NotFound{}, err)
+}
+
+func TestUnitServerNotFound(t *testing.T) {
+	_, client := newTestClient(t)
+	ctx := context.Background()
+
+	_, err := client.Subscription.Get(ctx, 999)
+	assert.IsType(t, &subscriptions.NotFound{}, err)
+
+	_, err = client.Database.Get(ctx, 999, 1)
+	assert.IsType(t, &databases.NotFound{}, err)
+
+	_, err = client.Users.Get(ctx, 999)
+	assert.IsType(t, &users.NotFound{}, err)
+}
+
+func TestUnitServerPeeringsAndTransitGateways(t *testing.T) {
+	s, client := newTestClient(t)
+	ctx := context.Background()
+
+	id := createTestSubscription(t, client, "AWS", "us-east-1")
+
+	peeringId, err := client.Subscription.CreateVPCPeering(ctx, id, subscriptions.CreateVPCPeering{
+		Region:       redis.String("us-west-2"),
+		AWSAccountID: redis.String("210987654321"),
+		VPCId:        redis.String("vpc-customer"),
+		VPCCidrs:     redis.StringSlice("172.16.0.0/16"),
+	})
+	require.NoError(t, err)
+
+	for _, expected := range []string{"initiating-request", "pending-acceptance"} {
+		peerings, err := client.Subscription.ListVPCPeering(ctx, id)
+		require.NoError(t, err)
+		require.Len(t, peerings, 1)
+		assert.Equal(t, peeringId, redis.IntValue(peerings[0].ID))
+		assert.Equal(t, expected, redis.StringValue(peerings[0].Status))
+	}
+	require.NoError(t, client.Subscription.DeleteVPCPeering(ctx, id, peeringId))
+
+	invitationId, err := s.AddTransitGatewayInvitation(id, 0, "shared-tgw")
+	require.NoError(t, err)
+	invitations, err := client.TransitGatewayAttachments.ListInvitations(ctx, id)
+	require.NoError(t, err)
+	require.Len(t, invitations, 1)
+	assert.Equal(t, "pending", redis.StringValue(invitations[0].Status))
+	require.NoError(t, client.TransitGatewayAttachments.AcceptInvitation(ctx, id, invitationId))
+
+	tgws, err := client.TransitGatewayAttachments.Get(ctx, id)
+	require.NoError(t, err)
+	require.Len(t, tgws.Response.Resource.TransitGatewayAttachment, 1)
+	tgw := tgws.Response.Resource.TransitGatewayAttachment[0]
+	assert.Nil(t, tgw.AttachmentUid)
+
+	_, err = client.TransitGatewayAttachments.Create(ctx, id, redis.IntValue(tgw.Id))
+	require.NoError(t, err)
+	require.NoError(t, client.TransitGatewayAttachments.Update(ctx, id, redis.IntValue(tgw.Id), redis.StringSlice("172.16.0.0/16")))
+
+	for _, expected := range []string{"pendingAcceptance", "available"} {
+		tgws, err = client.TransitGatewayAttachments.Get(ctx, id)
+		require.NoError(t, err)
+		tgw = tgws.Response.Resource.TransitGatewayAttachment[0]
+		assert.NotNil(t, tgw.AttachmentUid)
+		assert.Equal(t, expected, redis.StringValue(tgw.AttachmentStatus))
+	}
+	require.Len(t, tgw.Cidrs, 1)
+	assert.Equal(t, "172.16.0.0/16", redis.StringValue(tgw.Cidrs[0].CidrAddress))
+
+	require.NoError(t, client.TransitGatewayAttachments.Delete(ctx, id, redis.IntValue(tgw.Id)))
+}
+
+func TestUnitServerPrivateServiceConnect(t *testing.T) {
+	_, client := newTestClient(t)
+	ctx := context.Background()
+
+	id := createTestSubscription(t, client, "GCP", "us-central1")
+
+	_, err := client.PrivateServiceConnect.GetService(ctx, id)
+	assert.IsType(t, &psc.NotFound{}, err)
+
+	serviceId, err := client.PrivateServiceConnect.CreateService(ctx, id)
+	require.NoError(t, err)
+	service, err := client.PrivateServiceConnect.GetService(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, psc.ServiceStatusCreateQueued, redis.StringValue(service.Status))
+
+	endpointId, err := client.PrivateServiceConnect.CreateEndpoint(ctx, id, serviceId, psc.CreatePrivateServiceConnectEndpoint{
+		GCPProjectID:           redis.String("customer-project"),
+		GCPVPCName:             redis.String("customer-vpc"),
+		GCPVPCSubnetName:       redis.String("customer-subnet"),
+		EndpointConnectionName: redis.String("redis-endpoint"),
+	})
+	require.NoError(t, err)
+	require.NoError(t, client.PrivateServiceConnect.UpdateEndpoint(ctx, id, serviceId, endpointId, &psc.UpdatePrivateServiceConnectEndpoint{
+		Action: redis.String(psc.EndpointActionAccept),
+	}))
+
+	for _, expected := range []string{psc.EndpointStatusAcceptPending, psc.EndpointStatusActive} {
+		endpoints, err := client.PrivateServiceConnect.GetEndpoints(ctx, id, serviceId)
+		require.NoError(t, err)
+		require.Len(t, endpoints.Endpoints, 1)
+		assert.Equal(t, expected, redis.StringValue(endpoints.Endpoints[0].Status))
+	}
+
+	script, err := client.PrivateServiceConnect.GetEndpointCreationScripts(ctx, id, serviceId, endpointId, true)
+	require.NoError(t, err)
+	require.NotNil(t, script.Script.TerraformGcp)
+	assert.Len(t, script.Script.TerraformGcp.ServiceAttachments, 1)
+
+	err = client.PrivateServiceConnect.DeleteService(ctx, id)
+	assert.Error(t, err, "a service with endpoints can't be deleted")
+	require.NoError(t, client.PrivateServiceConnect.DeleteEndpoint(ctx, id, serviceId, endpointId))
+	require.NoError(t, client.PrivateServiceConnect.DeleteService(ctx, id))
+}
+
+func TestUnitServerPrivateLink(t *testing.T) {
+	_, client := newTestClient(t)
+	ctx := context.Background()
+
+	id := createTestSubscription(t, client, "AWS", "us-east-1")
+
+	_, err := client.PrivateLink.GetPrivateLink(ctx, id)
+	assert.IsType(t, &privatelink.NotFound{}, err)
+
+	require.NoError(t, client.PrivateLink.CreatePrivateLink(ctx, id, privatelink.CreatePrivateLink{
+		ShareName:     redis.String("share"),
+		Principal:     redis.String("210987654321"),
+		PrincipalType: redis.String("aws_account"),
+	}))
+
+	link, err := client.PrivateLink.GetPrivateLink(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, "initializing", redis.StringValue(link.Status))
+	link, err = client.PrivateLink.GetPrivateLink(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, "active", redis.StringValue(link.Status))
+	require.Len(t, link.Principals, 1)
+	assert.Equal(t, "associated", redis.StringValue(link.Principals[0].Status))
+
+	require.NoError(t, client.PrivateLink.CreatePrincipal(ctx, id, privatelink.CreatePrivateLinkPrincipal{
+		Principal:     redis.String("109876543210"),
+		PrincipalType: redis.String("aws_account"),
+	}))
+	require.NoError(t, client.PrivateLink.DeletePrincipal(ctx, id, "210987654321"))
+	link, err = client.PrivateLink.GetPrivateLink(ctx, id)
+	require.NoError(t, err)
+	require.Len(t, link.Principals, 1)
+	assert.Equal(t, "109876543210", redis.StringValue(link.Principals[0].Principal))
+
+	require.NoError(t, client.PrivateLink.DeletePrivateLink(ctx, id))
+	_, err = client.PrivateLink.GetPrivateLink(ctx, id)
+	assert.IsType(t, &privatelink.NotFound{}, err)
+}
+
+func TestUnitServerAcl(t *testing.T) {
+	_, client := newTestClient(t)
+	ctx := context.Background()
+
+	subId := createTestSubscription(t, client, "AWS", "us-east-1")
+	var dbId int
+	list := client.Database.List(ctx, subId)
+	for list.Next() {
+		dbId = redis.IntValue(list.Value().ID)
+	}
+	require.NoError(t, list.Err())
+
+	ruleId, err := client.RedisRules.Create(ctx, redis_rules.CreateRedisRuleRequest{
+		Name:      redis.String("cache-reader"),
+		RedisRule: redis.String("+@read ~cache:*"),
+	})
+	require.NoError(t, err)
+
+	roleId, err := client.Roles.Create(ctx, roles.CreateRoleRequest{
+		Name: redis.String("readers"),
+		RedisRules: []*roles.CreateRuleInRoleRequest{{
+			RuleName: redis.String("cache-reader"),
+			Databases: []*roles.CreateDatabaseInRuleInRoleRequest{{
+				SubscriptionId: redis.Int(subId),
+				DatabaseId:     redis.Int(dbId),
+			}},
+		}},
+	})
+	require.NoError(t, err)
+
+	_, err = client.Users.Create(ctx, users.CreateUserRequest{
+		Name:     redis.String("nobody"),
+		Role:     redis.String("no-such-role"),
+		Password: redis.String("Secret-1234"),
+	})
+	assert.Error(t, err, "a user's role must exist")
+
+	userId, err := client.Users.Create(ctx, users.CreateUserRequest{
+		Name:     redis.String("reader"),
+		Role:     redis.String("readers"),
+		Password: redis.String("Secret-1234"),
+	})
+	require.NoError(t, err)
+
+	allRoles, err := client.Roles.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, allRoles, 1)
+	role := allRoles[0]
+	assert.Equal(t, roleId, redis.IntValue(role.ID))
+	require.Len(t, role.RedisRules, 1)
+	assert.Equal(t, ruleId, redis.IntValue(role.RedisRules[0].RuleId))
+	require.Len(t, role.RedisRules[0].Databases, 1)
+	assert.Equal(t, "planned", redis.StringValue(role.RedisRules[0].Databases[0].DatabaseName))
+	require.Len(t, role.Users, 1)
+	assert.Equal(t, userId, redis.IntValue(role.Users[0].ID))
+
+	rules, err := client.RedisRules.List(ctx)
+	require.NoError(t, err)
+	var defaults int
+	for _, rule := range rules {
+		if redis.BoolValue(rule.IsDefault) {
+			defaults++
+		}
+	}
+	assert.Equal(t, len(defaultAclRules), defaults)
+
+	assert.Error(t, client.RedisRules.Delete(ctx, ruleId), "a rule granted by a role can't be deleted")
+	assert.Error(t, client.Roles.Delete(ctx, roleId), "a role assigned to a user can't be deleted")
+
+	require.NoError(t, client.Users.Delete(ctx, userId))
+	_, err = client.Users.Get(ctx, userId)
+	assert.IsType(t, &users.NotFound{}, err)
+	require.NoError(t, client.Roles.Delete(ctx, roleId))
+	require.NoError(t, client.RedisRules.Delete(ctx, ruleId))
+}
@@ -0,0 +1,594 @@
+package fakeapi
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/RedisLabs/rediscloud-go-api/service/databases"
+	"github.com/RedisLabs/rediscloud-go-api/service/maintenance"
+	"github.com/RedisLabs/rediscloud-go-api/service/pricing"
+	"github.com/RedisLabs/rediscloud-go-api/service/regions"
+	"github.com/RedisLabs/rediscloud-go-api/service/subscriptions"
+)
+
+const customerManagedKey = "customer-managed-key"
+
+var redisVersions = []*subscriptions.RedisVersion{
+	{Version: redis.String("6.2"), IsPreview: redis.Bool(false), IsDefault: redis.Bool(false)},
+	{Version: redis.String("7.2"), IsPreview: redis.Bool(false), IsDefault: redis.Bool(false)},
+	{Version: redis.String("7.4"), IsPreview: redis.Bool(false), IsDefault: redis.Bool(true)},
+	{Version: redis.String("8.0"), IsPreview: redis.Bool(false), IsDefault: redis.Bool(false)},
+}
+
+const defaultRedisVersion = "7.4"
+
+type subscription struct {
+	provisioning
+	sub              subscriptions.Subscription
+	awaitingKeys     bool
+	cidrAllowlist    subscriptions.CIDRAllowlist
+	maintenance      maintenance.Maintenance
+	regionIds        []int
+	regions          map[int]*region
+	databases        map[int]*database
+	peerings         map[int]*peering
+	lastDatabasePort int
+}
+
+// region is a cloud region a subscription is deployed to: the single region of a Pro subscription, or one of the
+// regions of an Active-Active subscription. Connectivity is managed per region.
+type region struct {
+	id                         int
+	provider                   string
+	cloudAccountId             int
+	name                       string
+	deploymentCIDR             string
+	vpcId                      string
+	multipleAvailabilityZones  bool
+	preferredAvailabilityZones []*string
+
+	transitGateways map[int]*transitGateway
+	invitations     map[int]*transitGatewayInvitation
+	psc             *pscService
+	privateLink     *privateLink
+}
+
+func (sub *subscription) isActiveActive() bool {
+	return redis.StringValue(sub.sub.DeploymentType) == subscriptions.SubscriptionDeploymentTypeActiveActive
+}
+
+// region returns the region with the given ID, or the subscription's first region when the ID is 0, as Pro
+// subscription endpoints don't name a region.
+func (sub *subscription) region(id int) (*region, bool) {
+	if id == 0 {
+		if len(sub.regionIds) == 0 {
+			return nil, false
+		}
+		return sub.regions[sub.regionIds[0]], true
+	}
+	r, ok := sub.regions[id]
+	return r, ok
+}
+
+func (sub *subscription) regionByName(name string) (*region, bool) {
+	for _, id := range sub.regionIds {
+		if sub.regions[id].name == name {
+			return sub.regions[id], true
+		}
+	}
+	return nil, false
+}
+
+func (s *Server) addRegion(sub *subscription, provider string, cloudAccountId int, name string, deploymentCIDR string) *region {
+	id := s.nextId()
+	r := &region{
+		id:              id,
+		provider:        provider,
+		cloudAccountId:  cloudAccountId,
+		name:            name,
+		deploymentCIDR:  deploymentCIDR,
+		vpcId:           fmt.Sprintf("vpc-fake%08d", id),
+		transitGateways: map[int]*transitGateway{},
+		invitations:     map[int]*transitGatewayInvitation{},
+	}
+	sub.regionIds = append(sub.regionIds, id)
+	sub.regions[id] = r
+	return r
+}
+
+func (s *Server) removeRegion(sub *subscription, id int) {
+	delete(sub.regions, id)
+	for i, regionId := range sub.regionIds {
+		if regionId == id {
+			sub.regionIds = append(sub.regionIds[:i], sub.regionIds[i+1:]...)
+			break
+		}
+	}
+}
+
+func (sub *subscription) view() subscriptions.Subscription {
+	view := sub.sub
+	if sub.awaitingKeys {
+		view.Status = sub.status(subscriptions.SubscriptionStatusPending, subscriptions.SubscriptionStatusEncryptionKeyPending)
+	} else {
+		view.Status = sub.status(subscriptions.SubscriptionStatusPending, subscriptions.SubscriptionStatusActive)
+	}
+	view.NumberOfDatabases = redis.Int(len(sub.databases))
+
+	var details []*subscriptions.CloudDetail
+	byProvider := map[string]*subscriptions.CloudDetail{}
+	for _, id := range sub.regionIds {
+		r := sub.regions[id]
+		detail, ok := byProvider[r.provider]
+		if !ok {
+			detail = &subscriptions.CloudDetail{
+				Provider:       redis.String(r.provider),
+				CloudAccountID: redis.Int(r.cloudAccountId),
+				TotalSizeInGB:  redis.Float64(0),
+			}
+			if r.provider == "AWS" {
+				detail.AWSAccountID = redis.String("123456789012")
+			}
+			byProvider[r.provider] = detail
+			details = append(details, detail)
+		}
+		detail.Regions = append(detail.Regions, &subscriptions.Region{
+			Region: redis.String(r.name),
+			Networking: []*subscriptions.Networking{{
+				DeploymentCIDR: redis.String(r.deploymentCIDR),
+				VPCId:          redis.String(r.vpcId),
+				SubnetID:       redis.String(fmt.Sprintf("subnet-fake%08d", r.id)),
+			}},
+			MultipleAvailabilityZones:  redis.Bool(r.multipleAvailabilityZones),
+			PreferredAvailabilityZones: r.preferredAvailabilityZones,
+		})
+	}
+	view.CloudDetails = details
+	return view
+}
+
+func (s *Server) registerSubscriptionRoutes() {
+	s.handle(http.MethodGet, "/subscriptions", s.listSubscriptions)
+	s.handle(http.MethodPost, "/subscriptions", s.createSubscription)
+	s.handle(http.MethodGet, "/subscriptions/redis-versions", s.getRedisVersions)
+	s.handle(http.MethodGet, "/subscriptions/{subId}", s.withSubscription(s.getSubscription))
+	s.handle(http.MethodPut, "/subscriptions/{subId}", s.withSubscription(s.updateSubscription))
+	s.handle(http.MethodDelete, "/subscriptions/{subId}", s.withSubscription(s.deleteSubscription))
+
+	s.handle(http.MethodGet, "/subscriptions/{subId}/cidr", s.withSubscription(s.getCidrAllowlist))
+	s.handle(http.MethodPut, "/subscriptions/{subId}/cidr", s.withSubscription(s.updateCidrAllowlist))
+	s.handle(http.MethodGet, "/subscriptions/{subId}/maintenance-windows", s.withSubscription(s.getMaintenance))
+	s.handle(http.MethodPut, "/subscriptions/{subId}/maintenance-windows", s.withSubscription(s.updateMaintenance))
+	s.handle(http.MethodGet, "/subscriptions/{subId}/pricing", s.withSubscription(s.getPricing))
+
+	s.handle(http.MethodGet, "/subscriptions/{subId}/regions", s.withSubscription(s.listRegions))
+	s.handle(http.MethodPost, "/subscriptions/{subId}/regions", s.withSubscription(s.createRegion))
+	s.handle(http.MethodDelete, "/subscriptions/{subId}/regions", s.withSubscription(s.deleteRegions))
+}
+
+type subscriptionHandlerFunc func(w http.ResponseWriter, r *http.Request, p params, sub *subscription)
+
+// withSubscription looks up the subscription named by the subId path parameter, responding with a 404 if there is
+// no such subscription.
+func (s *Server) withSubscription(handle subscriptionHandlerFunc) handlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, p params) {
+		sub, ok := s.subscriptions[p.int("subId")]
+		if !ok {
+			writeError(w, http.StatusNotFound, "SUBSCRIPTION_NOT_FOUND", "subscription %s was not found", p["subId"])
+			return
+		}
+		handle(w, r, p, sub)
+	}
+}
+
+func (s *Server) listSubscriptions(w http.ResponseWriter, _ *http.Request, _ params) {
+	list := []subscriptions.Subscription{}
+	for _, id := range sortedIds(s.subscriptions) {
+		list = append(list, s.subscriptions[id].view())
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"subscriptions": list})
+}
+
+func (s *Server) createSubscription(w http.ResponseWriter, r *http.Request, _ params) {
+	var request subscriptions.CreateSubscription
+	if !readJSON(w, r, &request) {
+		return
+	}
+
+	if redis.StringValue(request.Name) == "" {
+		writeError(w, http.StatusBadRequest, "SUBSCRIPTION_NAME_REQUIRED", "a subscription name is required")
+		return
+	}
+	if len(request.CloudProviders) == 0 {
+		writeError(w, http.StatusBadRequest, "SUBSCRIPTION_CLOUD_PROVIDER_REQUIRED", "at least one cloud provider is required")
+		return
+	}
+	if redis.BoolValue(request.DryRun) {
+		s.accept(w, "subscriptionCreateRequest", nil, pricing.ListPricingResponse{Pricing: dryRunPricing(request)})
+		return
+	}
+
+	deploymentType := subscriptions.SubscriptionDeploymentTypeSingleRegion
+	if request.DeploymentType != nil {
+		deploymentType = *request.DeploymentType
+	}
+	paymentMethod := "credit-card"
+	if request.PaymentMethod != nil {
+		paymentMethod = *request.PaymentMethod
+	}
+	memoryStorage := "ram"
+	if request.MemoryStorage != nil {
+		memoryStorage = *request.MemoryStorage
+	}
+	encryptionType := "cloud-provider-managed-key"
+	if request.PersistentStorageEncryptionType != nil {
+		encryptionType = *request.PersistentStorageEncryptionType
+	}
+	publicEndpointAccess := true
+	if request.PublicEndpointAccess != nil {
+		publicEndpointAccess = *request.PublicEndpointAccess
+	}
+
+	id := s.nextId()
+	sub := &subscription{
+		sub: subscriptions.Subscription{
+			ID:                              redis.Int(id),
+			Name:                            request.Name,
+			DeploymentType:                  redis.String(deploymentType),
+			PaymentMethod:                   redis.String(paymentMethod),
+			PaymentMethodID:                 request.PaymentMethodID,
+			MemoryStorage:                   redis.String(memoryStorage),
+			StorageEncryption:               redis.Bool(true),
+			PersistentStorageEncryptionType: redis.String(encryptionType),
+			PublicEndpointAccess:            redis.Bool(publicEndpointAccess),
+		},
+		awaitingKeys: encryptionType == customerManagedKey,
+		maintenance:  maintenance.Maintenance{Mode: redis.String("automatic")},
+		regions:      map[int]*region{},
+		databases:    map[int]*database{},
+		peerings:     map[int]*peering{},
+	}
+	if sub.awaitingKeys {
+		sub.sub.CustomerManagedKeyAccessDetails = &subscriptions.CustomerManagedKeyAccessDetails{
+			RedisServiceAccount: redis.String("fake-service-account@redis.test"),
+		}
+	}
+
+	for _, provider := range request.CloudProviders {
+		providerName := "AWS"
+		if provider.Provider != nil {
+			providerName = *provider.Provider
+		}
+		cloudAccountId := 1
+		if provider.CloudAccountID != nil {
+			cloudAccountId = *provider.CloudAccountID
+		}
+		for _, createRegion := range provider.Regions {
+			deploymentCIDR := ""
+			if createRegion.Networking != nil {
+				deploymentCIDR = redis.StringValue(createRegion.Networking.DeploymentCIDR)
+			}
+			rg := s.addRegion(sub, providerName, cloudAccountId, redis.StringValue(createRegion.Region), deploymentCIDR)
+			rg.multipleAvailabilityZones = redis.BoolValue(createRegion.MultipleAvailabilityZones)
+			rg.preferredAvailabilityZones = createRegion.PreferredAvailabilityZones
+			if createRegion.Networking != nil && createRegion.Networking.VPCId != nil {
+				rg.vpcId = *createRegion.Networking.VPCId
+			}
+		}
+	}
+
+	s.provision(&sub.provisioning)
+	s.subscriptions[id] = sub
+
+	// The creation plan is deployed as real databases, which the provider removes once the subscription is active.
+	for _, planned := range request.Databases {
+		quantity := 1
+		if planned.Quantity != nil && *planned.Quantity > 1 {
+			quantity = *planned.Quantity
+		}
+		for i := 0; i < quantity; i++ {
+			name := redis.StringValue(planned.Name)
+			if quantity > 1 {
+				name = fmt.Sprintf("%s-%d", name, i+1)
+			}
+			s.addPlannedDatabase(sub, name, planned)
+		}
+	}
+
+	s.accept(w, "subscriptionCreateRequest", redis.Int(id), nil)
+}
+
+func (s *Server) getRedisVersions(w http.ResponseWriter, _ *http.Request, _ params) {
+	writeJSON(w, http.StatusOK, subscriptions.RedisVersions{RedisVersions: redisVersions})
+}
+
+func (s *Server) getSubscription(w http.ResponseWriter, _ *http.Request, _ params, sub *subscription) {
+	writeJSON(w, http.StatusOK, sub.view())
+}
+
+// updateSubscriptionRequest covers both of the subscription update requests, which share an endpoint.
+type updateSubscriptionRequest struct {
+	subscriptions.UpdateSubscription
+	subscriptions.UpdateSubscriptionCMKs
+}
+
+func (s *Server) updateSubscription(w http.ResponseWriter, r *http.Request, _ params, sub *subscription) {
+	var request updateSubscriptionRequest
+	if !readJSON(w, r, &request) {
+		return
+	}
+
+	if request.Name != nil {
+		sub.sub.Name = request.Name
+	}
+	if request.PaymentMethodID != nil {
+		sub.sub.PaymentMethodID = request.PaymentMethodID
+	}
+	if request.PublicEndpointAccess != nil {
+		sub.sub.PublicEndpointAccess = request.PublicEndpointAccess
+	}
+	if request.DeletionGracePeriod != nil {
+		sub.sub.DeletionGracePeriod = request.DeletionGracePeriod
+	}
+	if request.CustomerManagedKeys != nil {
+		sub.awaitingKeys = false
+	}
+
+	s.provision(&sub.provisioning)
+	s.accept(w, "subscriptionUpdateRequest", sub.sub.ID, nil)
+}
+
+func (s *Server) deleteSubscription(w http.ResponseWriter, _ *http.Request, _ params, sub *subscription) {
+	if len(sub.databases) > 0 {
+		s.reject(w, "subscriptionDeleteRequest", http.StatusConflict, "SUBSCRIPTION_NOT_EMPTY",
+			"subscription %d still has %d databases", redis.IntValue(sub.sub.ID), len(sub.databases))
+		return
+	}
+	delete(s.subscriptions, redis.IntValue(sub.sub.ID))
+	s.accept(w, "subscriptionDeleteRequest", sub.sub.ID, nil)
+}
+
+func (s *Server) getCidrAllowlist(w http.ResponseWriter, _ *http.Request, _ params, sub *subscription) {
+	allowlist := sub.cidrAllowlist
+	if allowlist.CIDRIPs == nil {
+		allowlist.CIDRIPs = []*string{}
+	}
+	if allowlist.SecurityGroupIDs == nil {
+		allowlist.SecurityGroupIDs = []*string{}
+	}
+	s.accept(w, "subscriptionCidrGetRequest", sub.sub.ID, allowlist)
+}
+
+func (s *Server) updateCidrAllowlist(w http.ResponseWriter, r *http.Request, _ params, sub *subscription) {
+	var request subscriptions.UpdateCIDRAllowlist
+	if !readJSON(w, r, &request) {
+		return
+	}
+	sub.cidrAllowlist = subscriptions.CIDRAllowlist{
+		CIDRIPs:          request.CIDRIPs,
+		SecurityGroupIDs: request.SecurityGroupIDs,
+	}
+	s.accept(w, "subscriptionCidrUpdateRequest", sub.sub.ID, nil)
+}
+
+func (s *Server) getMaintenance(w http.ResponseWriter, _ *http.Request, _ params, sub *subscription) {
+	writeJSON(w, http.StatusOK, sub.maintenance)
+}
+
+func (s *Server) updateMaintenance(w http.ResponseWriter, r *http.Request, _ params, sub *subscription) {
+	var request maintenance.Maintenance
+	if !readJSON(w, r, &request) {
+		return
+	}
+	sub.maintenance = request
+	s.accept(w, "subscriptionMaintenanceWindowsUpdateRequest", sub.sub.ID, nil)
+}
+
+// pricePerShardHour is nominal: it exists so that pricing can be read, not to mirror Redis Cloud's rates.
+const pricePerShardHour = 0.124
+
+// shardSizeInGB is nominal too: a Pro database is priced as a shard for each started shardSizeInGB of its size.
+const shardSizeInGB = 25
+
+// shards returns the number of shards a Pro database of the given memory limit or dataset size is priced as.
+func shards(memoryLimitInGB *float64, datasetSizeInGB *float64) int {
+	size := math.Max(redis.Float64Value(memoryLimitInGB), redis.Float64Value(datasetSizeInGB))
+	return max(1, int(math.Ceil(size/shardSizeInGB)))
+}
+
+// pricedDatabase is a database as the pricing of its subscription lists it.
+type pricedDatabase struct {
+	id     int
+	name   string
+	shards int
+}
+
+// pricedDatabases returns the databases of the subscription, as they are priced.
+func pricedDatabases(sub *subscription) []pricedDatabase {
+	var list []pricedDatabase
+	for _, id := range sortedIds(sub.databases) {
+		db := sub.databases[id]
+		priced := pricedDatabase{id: id, name: db.name(), shards: 1}
+		if db.pro != nil {
+			priced.shards = shards(db.pro.MemoryLimitInGB, db.pro.DatasetSizeInGB)
+		}
+		list = append(list, priced)
+	}
+	return list
+}
+
+// subscriptionPricing prices the databases of the subscription: their shards in its first region, or in every region
+// of an Active-Active subscription.
+func subscriptionPricing(sub *subscription, dbs []pricedDatabase) []*pricing.Pricing {
+	list := []*pricing.Pricing{}
+	for _, db := range dbs {
+		for _, regionId := range sub.regionIds {
+			rg := sub.regions[regionId]
+			list = append(list, &pricing.Pricing{
+				DatabaseName:        redis.String(db.name),
+				Type:                redis.String("Shards"),
+				TypeDetails:         redis.String("high-throughput"),
+				Quantity:            redis.Int(db.shards),
+				QuantityMeasurement: redis.String("shards"),
+				PricePerUnit:        redis.Float64(pricePerShardHour),
+				PriceCurrency:       redis.String("USD"),
+				PricePeriod:         redis.String("hour"),
+				Region:              redis.String(rg.name),
+			})
+			if !sub.isActiveActive() {
+				break
+			}
+		}
+	}
+	return list
+}
+
+func (s *Server) getPricing(w http.ResponseWriter, _ *http.Request, _ params, sub *subscription) {
+	writeJSON(w, http.StatusOK, pricing.ListPricingResponse{Pricing: subscriptionPricing(sub, pricedDatabases(sub))})
+}
+
+// dryRunPricing prices the creation plan of a subscription create request, as a dry run reports it: the shards of
+// each database, in the first region of a single-region subscription and in every region of an Active-Active one.
+func dryRunPricing(request subscriptions.CreateSubscription) []*pricing.Pricing {
+	var regionNames []string
+	for _, provider := range request.CloudProviders {
+		for _, rg := range provider.Regions {
+			regionNames = append(regionNames, redis.StringValue(rg.Region))
+		}
+	}
+	if redis.StringValue(request.DeploymentType) != subscriptions.SubscriptionDeploymentTypeActiveActive && len(regionNames) > 1 {
+		regionNames = regionNames[:1]
+	}
+
+	list := []*pricing.Pricing{}
+	for _, db := range request.Databases {
+		quantity := redis.IntValue(db.Quantity)
+		if quantity == 0 {
+			quantity = 1
+		}
+		for _, regionName := range regionNames {
+			list = append(list, &pricing.Pricing{
+				DatabaseName:        db.Name,
+				Type:                redis.String("Shards"),
+				TypeDetails:         redis.String("high-throughput"),
+				Quantity:            redis.Int(quantity),
+				QuantityMeasurement: redis.String("shards"),
+				PricePerUnit:        redis.Float64(pricePerShardHour),
+				PriceCurrency:       redis.String("USD"),
+				PricePeriod:         redis.String("hour"),
+				Region:              redis.String(regionName),
+			})
+		}
+	}
+	return list
+}
+
+func (s *Server) listRegions(w http.ResponseWriter, _ *http.Request, _ params, sub *subscription) {
+	list := []*regions.Region{}
+	for _, id := range sub.regionIds {
+		rg := sub.regions[id]
+		view := &regions.Region{
+			RegionId:       redis.Int(rg.id),
+			Region:         redis.String(rg.name),
+			DeploymentCIDR: redis.String(rg.deploymentCIDR),
+			VpcId:          redis.String(rg.vpcId),
+		}
+		for _, dbId := range sortedIds(sub.databases) {
+			db := sub.databases[dbId]
+			if db.aa == nil {
+				continue
+			}
+			for _, crdb := range db.aa.CrdbDatabases {
+				if redis.StringValue(crdb.Region) == rg.name {
+					view.Databases = append(view.Databases, &regions.Database{
+						DatabaseId:               db.aa.ID,
+						DatabaseName:             db.aa.Name,
+						ReadOperationsPerSecond:  crdb.ReadOperationsPerSecond,
+						WriteOperationsPerSecond: crdb.WriteOperationsPerSecond,
+					})
+				}
+			}
+		}
+		list = append(list, view)
+	}
+	writeJSON(w, http.StatusOK, regions.Regions{SubscriptionId: sub.sub.ID, Regions: list})
+}
+
+func (s *Server) createRegion(w http.ResponseWriter, r *http.Request, _ params, sub *subscription) {
+	if !sub.isActiveActive() {
+		writeError(w, http.StatusBadRequest, "SUBSCRIPTION_NOT_ACTIVE_ACTIVE", "subscription %d is not Active-Active", redis.IntValue(sub.sub.ID))
+		return
+	}
+
+	var request regions.CreateRegion
+	if !readJSON(w, r, &request) {
+		return
+	}
+	name := redis.StringValue(request.Region)
+	if _, ok := sub.regionByName(name); ok {
+		s.reject(w, "activeActiveRegionCreateRequest", http.StatusConflict, "REGION_ALREADY_EXISTS",
+			"region %s already exists in subscription %d", name, redis.IntValue(sub.sub.ID))
+		return
+	}
+	if redis.BoolValue(request.DryRun) {
+		s.accept(w, "activeActiveRegionCreateRequest", nil, nil)
+		return
+	}
+
+	provider, cloudAccountId := "AWS", 1
+	if first, ok := sub.region(0); ok {
+		provider, cloudAccountId = first.provider, first.cloudAccountId
+	}
+	rg := s.addRegion(sub, provider, cloudAccountId, name, redis.StringValue(request.DeploymentCIDR))
+
+	for _, createDb := range request.Databases {
+		for _, db := range sub.databases {
+			if db.aa == nil || redis.StringValue(db.aa.Name) != redis.StringValue(createDb.Name) {
+				continue
+			}
+			crdb := newCrdbDatabase(db.aa, rg)
+			if createDb.LocalThroughputMeasurement != nil {
+				crdb.ReadOperationsPerSecond = createDb.LocalThroughputMeasurement.ReadOperationsPerSecond
+				crdb.WriteOperationsPerSecond = createDb.LocalThroughputMeasurement.WriteOperationsPerSecond
+			}
+			db.aa.CrdbDatabases = append(db.aa.CrdbDatabases, crdb)
+		}
+	}
+
+	s.provision(&sub.provisioning)
+	s.accept(w, "activeActiveRegionCreateRequest", redis.Int(rg.id), nil)
+}
+
+func (s *Server) deleteRegions(w http.ResponseWriter, r *http.Request, _ params, sub *subscription) {
+	var request regions.DeleteRegions
+	if !readJSON(w, r, &request) {
+		return
+	}
+
+	for _, deleteRegion := range request.Regions {
+		rg, ok := sub.regionByName(redis.StringValue(deleteRegion.Region))
+		if !ok {
+			s.reject(w, "activeActiveRegionDeleteRequest", http.StatusNotFound, "REGION_NOT_FOUND",
+				"region %s was not found in subscription %d", redis.StringValue(deleteRegion.Region), redis.IntValue(sub.sub.ID))
+			return
+		}
+		s.removeRegion(sub, rg.id)
+		for _, db := range sub.databases {
+			if db.aa == nil {
+				continue
+			}
+			var kept []*databases.CrdbDatabase
+			for _, crdb := range db.aa.CrdbDatabases {
+				if redis.StringValue(crdb.Region) != rg.name {
+					kept = append(kept, crdb)
+				}
+			}
+			db.aa.CrdbDatabases = kept
+		}
+	}
+
+	s.provision(&sub.provisioning)
+	s.accept(w, "activeActiveRegionDeleteRequest", sub.sub.ID, nil)
+}
@@ -0,0 +1,129 @@
+package fakeapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const (
+	taskStatusReceived   = "received"
+	taskStatusProcessing = "processing-in-progress"
+	taskStatusCompleted  = "processing-completed"
+	taskStatusError      = "processing-error"
+)
+
+// task is an asynchronous request, as returned by every mutation and by some reads. It reports being in progress
+// for Options.TaskPolls polls before completing.
+type task struct {
+	id          string
+	commandType string
+	pollsLeft   int
+	resourceId  *int
+	resource    interface{}
+	err         *taskError
+}
+
+type taskError struct {
+	Type        string `json:"type,omitempty"`
+	Description string `json:"description,omitempty"`
+	Status      string `json:"status,omitempty"`
+}
+
+type taskReference struct {
+	TaskId string `json:"taskId"`
+}
+
+type taskBody struct {
+	TaskId      string        `json:"taskId"`
+	CommandType string        `json:"commandType,omitempty"`
+	Status      string        `json:"status"`
+	Description string        `json:"description,omitempty"`
+	Response    *taskResponse `json:"response,omitempty"`
+}
+
+type taskResponse struct {
+	ResourceId *int             `json:"resourceId,omitempty"`
+	Resource   *json.RawMessage `json:"resource,omitempty"`
+	Error      *taskError       `json:"error,omitempty"`
+}
+
+func (s *Server) registerTaskRoutes() {
+	s.handle(http.MethodGet, "/tasks/{taskId}", s.getTask)
+}
+
+// accept records a task which completes successfully with the given resource ID and resource (either may be nil),
+// and responds with its reference.
+func (s *Server) accept(w http.ResponseWriter, commandType string, resourceId *int, resource interface{}) {
+	t := s.newTask(commandType)
+	t.resourceId = resourceId
+	t.resource = resource
+	writeJSON(w, http.StatusAccepted, taskReference{TaskId: t.id})
+}
+
+// reject records a task which fails once processed, as the API does for requests it only validates asynchronously.
+func (s *Server) reject(w http.ResponseWriter, commandType string, status int, errorType string, format string, args ...interface{}) {
+	t := s.newTask(commandType)
+	t.err = &taskError{
+		Type:        errorType,
+		Description: fmt.Sprintf(format, args...),
+		Status:      fmt.Sprintf("%d %s", status, strings.ToUpper(strings.ReplaceAll(http.StatusText(status), " ", "_"))),
+	}
+	writeJSON(w, http.StatusAccepted, taskReference{TaskId: t.id})
+}
+
+func (s *Server) newTask(commandType string) *task {
+	s.lastTaskId++
+	t := &task{
+		id:          fmt.Sprintf("fake-task-%d", s.lastTaskId),
+		commandType: commandType,
+		pollsLeft:   s.options.TaskPolls,
+	}
+	s.tasks[t.id] = t
+	return t
+}
+
+func (s *Server) getTask(w http.ResponseWriter, _ *http.Request, p params) {
+	t, ok := s.tasks[p["taskId"]]
+	if !ok {
+		writeError(w, http.StatusNotFound, "TASK_NOT_FOUND", "task %s was not found", p["taskId"])
+		return
+	}
+
+	body := taskBody{
+		TaskId:      t.id,
+		CommandType: t.commandType,
+	}
+
+	if t.pollsLeft > 0 {
+		if t.pollsLeft == s.options.TaskPolls {
+			body.Status = taskStatusReceived
+		} else {
+			body.Status = taskStatusProcessing
+		}
+		t.pollsLeft--
+		writeJSON(w, http.StatusOK, body)
+		return
+	}
+
+	body.Response = &taskResponse{ResourceId: t.resourceId}
+	if t.err != nil {
+		body.Status = taskStatusError
+		body.Description = "Task request failed during processing"
+		body.Response.Error = t.err
+	} else {
+		body.Status = taskStatusCompleted
+		body.Description = "Request processing completed successfully"
+		if t.resource != nil {
+			raw, err := json.Marshal(t.resource)
+			if err != nil {
+				writeError(w, http.StatusInternalServerError, "INTERNAL", "failed to encode task resource: %s", err)
+				return
+			}
+			message := json.RawMessage(raw)
+			body.Response.Resource = &message
+		}
+	}
+	writeJSON(w, http.StatusOK, body)
+}
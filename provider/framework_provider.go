@@ -4,25 +4,36 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strconv"
 
 	rediscloudApi "github.com/RedisLabs/rediscloud-go-api"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/acl"
 	"github.com/RedisLabs/terraform-provider-rediscloud/provider/activeactive"
 	"github.com/RedisLabs/terraform-provider-rediscloud/provider/client"
 	"github.com/RedisLabs/terraform-provider-rediscloud/provider/cloudaccount"
 	"github.com/RedisLabs/terraform-provider-rediscloud/provider/datapersistence"
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/essentials"
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/networking"
 	"github.com/RedisLabs/terraform-provider-rediscloud/provider/paymentmethod"
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/pro"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var _ provider.Provider = &redisCloudFrameworkProvider{}
+var _ provider.ProviderWithFunctions = &redisCloudFrameworkProvider{}
+var _ provider.ProviderWithListResources = &redisCloudFrameworkProvider{}
 
 // redisCloudFrameworkProvider is the Plugin Framework implementation of the provider.
 type redisCloudFrameworkProvider struct {
@@ -31,9 +42,12 @@ type redisCloudFrameworkProvider struct {
 
 // redisCloudProviderModel describes the provider data model.
 type redisCloudProviderModel struct {
-	Url       types.String `tfsdk:"url"`
-	ApiKey    types.String `tfsdk:"api_key"`
-	SecretKey types.String `tfsdk:"secret_key"`
+	Url                      types.String  `tfsdk:"url"`
+	ApiKey                   types.String  `tfsdk:"api_key"`
+	SecretKey                types.String  `tfsdk:"secret_key"`
+	BatchDatabaseChanges     types.Bool    `tfsdk:"batch_database_changes"`
+	MaxMonthlyCost           types.Float64 `tfsdk:"max_monthly_cost"`
+	CheckAccountCIDROverlaps types.Bool    `tfsdk:"check_account_cidr_overlaps"`
 }
 
 // NewFrameworkProvider returns a new Plugin Framework provider instance.
@@ -68,6 +82,21 @@ func (p *redisCloudFrameworkProvider) Schema(_ context.Context, _ provider.Schem
 				MarkdownDescription: fmt.Sprintf("This is the Redis Cloud API secret key. It must be provided but can also be set by the `%s` environment variable.", rediscloudApi.SecretKeyEnvVar),
 				Optional:            true,
 			},
+			"batch_database_changes": schema.BoolAttribute{
+				MarkdownDescription: batchDatabaseChangesDescription,
+				Optional:            true,
+			},
+			"max_monthly_cost": schema.Float64Attribute{
+				MarkdownDescription: maxMonthlyCostDescription,
+				Optional:            true,
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+				},
+			},
+			"check_account_cidr_overlaps": schema.BoolAttribute{
+				MarkdownDescription: checkAccountCIDROverlapsDescription,
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -99,6 +128,23 @@ func (p *redisCloudFrameworkProvider) Configure(ctx context.Context, req provide
 		secretKey = config.SecretKey.ValueString()
 	}
 
+	batchDatabaseChanges := false
+	if env := os.Getenv(BatchDatabaseChangesEnvVar); env != "" {
+		value, err := strconv.ParseBool(env)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("batch_database_changes"),
+				"Invalid batch_database_changes",
+				fmt.Sprintf("The %s environment variable must be true or false: %s", BatchDatabaseChangesEnvVar, err),
+			)
+			return
+		}
+		batchDatabaseChanges = value
+	}
+	if !config.BatchDatabaseChanges.IsNull() {
+		batchDatabaseChanges = config.BatchDatabaseChanges.ValueBool()
+	}
+
 	// Validate required credentials
 	if apiKey == "" {
 		resp.Diagnostics.AddAttributeError(
@@ -136,6 +182,13 @@ func (p *redisCloudFrameworkProvider) Configure(ctx context.Context, req provide
 	clientConfig = append(clientConfig, rediscloudApi.LogRequests(true))
 	clientConfig = append(clientConfig, rediscloudApi.Logger(&frameworkDebugLogger{}))
 
+	transport, err := client.NewTransport()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create Redis Cloud API Client", err.Error())
+		return
+	}
+	clientConfig = append(clientConfig, rediscloudApi.Transporter(transport))
+
 	// Create the API client
 	apiClient, err := rediscloudApi.NewClient(clientConfig...)
 	if err != nil {
@@ -150,12 +203,16 @@ func (p *redisCloudFrameworkProvider) Configure(ctx context.Context, req provide
 
 	// Wrap in ApiClient for compatibility with existing code
 	wrappedClient := &client.ApiClient{
-		Client: apiClient,
+		Client:                   apiClient,
+		BatchDatabaseChanges:     batchDatabaseChanges,
+		MaxMonthlyCost:           config.MaxMonthlyCost.ValueFloat64(),
+		CheckAccountCIDROverlaps: config.CheckAccountCIDROverlaps.ValueBool(),
 	}
 
 	// Make the client available during DataSource and Resource type Configure methods.
 	resp.DataSourceData = wrappedClient
 	resp.ResourceData = wrappedClient
+	resp.ListResourceData = wrappedClient
 
 	tflog.Info(ctx, "Configured Redis Cloud client", map[string]any{"success": true})
 }
@@ -164,9 +221,36 @@ func (p *redisCloudFrameworkProvider) Configure(ctx context.Context, req provide
 func (p *redisCloudFrameworkProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		activeactive.NewActiveActiveDatabaseResource,
+		pro.NewProSubscriptionResource,
+		pro.NewProDatabaseResource,
+		essentials.NewEssentialsSubscriptionResource,
+		essentials.NewEssentialsDatabaseResource,
+		acl.NewAclRuleResource,
+		acl.NewAclRoleResource,
+		acl.NewAclUserResource,
+		networking.NewSubscriptionPeeringResource,
+		networking.NewActiveActiveSubscriptionPeeringResource,
+		networking.NewTransitGatewayAttachmentResource,
+		networking.NewActiveActiveTransitGatewayAttachmentResource,
+		networking.NewTransitGatewayRouteResource,
+		networking.NewActiveActiveTransitGatewayRouteResource,
+		networking.NewPrivateServiceConnectResource,
+		networking.NewActiveActivePrivateServiceConnectResource,
+		networking.NewPrivateServiceConnectEndpointResource,
+		networking.NewActiveActivePrivateServiceConnectEndpointResource,
+		networking.NewPrivateServiceConnectEndpointAccepterResource,
+		networking.NewActiveActivePrivateServiceConnectEndpointAccepterResource,
+		networking.NewPrivateLinkResource,
+		networking.NewActiveActivePrivateLinkResource,
+		networking.NewCidrAllocationResource,
 	}
 }
 
+// ListResources defines the list resources implemented in the provider.
+func (p *redisCloudFrameworkProvider) ListResources(_ context.Context) []func() list.ListResource {
+	return listResources()
+}
+
 // DataSources defines the data sources implemented in the provider.
 func (p *redisCloudFrameworkProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
@@ -176,6 +260,13 @@ func (p *redisCloudFrameworkProvider) DataSources(_ context.Context) []func() da
 	}
 }
 
+// Functions defines the provider-defined functions implemented in the provider.
+func (p *redisCloudFrameworkProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		acl.NewNormalizeAclRuleFunction,
+	}
+}
+
 // frameworkDebugLogger implements the rediscloud-go-api Logger interface for Plugin Framework.
 type frameworkDebugLogger struct{}
 
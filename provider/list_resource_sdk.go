@@ -0,0 +1,207 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	fwdiag "github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/client"
+)
+
+var (
+	_ list.ListResource                 = &sdkListResource{}
+	_ list.ListResourceWithConfigure    = &sdkListResource{}
+	_ list.ListResourceWithRawV5Schemas = &sdkListResource{}
+)
+
+// sdkListEntry is a single resource found by an sdkListFunc.
+type sdkListEntry struct {
+	// id is the SDK resource ID, as it would be stored in state.
+	id string
+	// name is displayed to the user alongside the identity.
+	name string
+	// identity holds the values of the resource identity attributes.
+	identity map[string]interface{}
+}
+
+// sdkListFunc enumerates resources, passing each one to yield until it returns false. subscriptionId is zero
+// unless the list resource accepts a subscription_id filter and it has been configured.
+type sdkListFunc func(ctx context.Context, api *client.ApiClient, subscriptionId int, yield func(sdkListEntry) bool) error
+
+// sdkListResource exposes a resource still implemented with the SDK as a Plugin Framework list resource, so it
+// can be used with `terraform query`. The resource and identity schemas are taken from the SDK resource and the
+// resource attributes are filled in with its read operation.
+type sdkListResource struct {
+	typeName    string
+	description string
+	resource    *schema.Resource
+	list        sdkListFunc
+
+	// subscriptionFilter adds an optional subscription_id attribute to the list configuration.
+	subscriptionFilter bool
+
+	client *client.ApiClient
+}
+
+// Metadata returns the resource type name.
+func (r *sdkListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + r.typeName
+}
+
+// Configure adds the provider configured client to the list resource.
+func (r *sdkListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.ApiClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *client.ApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// RawV5Schemas returns the schemas of the SDK resource being listed.
+func (r *sdkListResource) RawV5Schemas(ctx context.Context, _ list.RawV5SchemaRequest, resp *list.RawV5SchemaResponse) {
+	resp.ProtoV5Schema = r.resource.ProtoSchema(ctx)()
+	resp.ProtoV5IdentitySchema = r.resource.ProtoIdentitySchema(ctx)()
+}
+
+// ListResourceConfigSchema defines the filters accepted by the list resource.
+func (r *sdkListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		Description: r.description,
+		Attributes:  map[string]listschema.Attribute{},
+	}
+	if r.subscriptionFilter {
+		resp.Schema.Attributes["subscription_id"] = listschema.Int64Attribute{
+			Description: "Only list the resources of this subscription. When omitted, every subscription is listed",
+			Optional:    true,
+		}
+	}
+}
+
+// List streams one result per resource returned by the list function.
+func (r *sdkListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var subscriptionId types.Int64
+	if r.subscriptionFilter {
+		diags := req.Config.GetAttribute(ctx, path.Root("subscription_id"), &subscriptionId)
+		if diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		var count int64
+		err := r.list(ctx, r.client, int(subscriptionId.ValueInt64()), func(entry sdkListEntry) bool {
+			if req.Limit > 0 && count >= req.Limit {
+				return false
+			}
+			count++
+
+			result := req.NewListResult(ctx)
+			result.DisplayName = entry.name
+
+			identity, err := sdkIdentityValue(result.Identity.Raw.Type(), entry.identity)
+			if err != nil {
+				result.Diagnostics.AddError("Failed to build resource identity", err.Error())
+				return push(result)
+			}
+			result.Identity.Raw = identity
+
+			if req.IncludeResource {
+				state, diags := r.read(ctx, entry.id, result.Resource.Raw.Type())
+				result.Diagnostics.Append(diags...)
+				if !diags.HasError() {
+					result.Resource.Raw = state
+				}
+			}
+
+			return push(result)
+		})
+		if err != nil {
+			result := list.ListResult{}
+			result.Diagnostics.AddError(fmt.Sprintf("Failed to list %s resources", r.typeName), err.Error())
+			push(result)
+		}
+	}
+}
+
+// read refreshes the SDK resource with the given ID and converts the resulting state into a Terraform value.
+func (r *sdkListResource) read(ctx context.Context, id string, typ tftypes.Type) (tftypes.Value, fwdiag.Diagnostics) {
+	var diags fwdiag.Diagnostics
+
+	state, sdkDiags := r.resource.RefreshWithoutUpgrade(ctx, &terraform.InstanceState{
+		ID:         id,
+		Attributes: map[string]string{"id": id},
+	}, r.client)
+	diags.Append(convertSdkDiagnostics(sdkDiags)...)
+	if diags.HasError() {
+		return tftypes.Value{}, diags
+	}
+	if state == nil || state.ID == "" {
+		diags.AddError("Resource not found", fmt.Sprintf("%s %q was listed but could not be read", r.typeName, id))
+		return tftypes.Value{}, diags
+	}
+
+	value, err := sdkStateValue(r.resource, state, typ)
+	if err != nil {
+		diags.AddError("Failed to convert resource state", err.Error())
+	}
+	return value, diags
+}
+
+// sdkStateValue converts an SDK instance state into a Terraform value of the resource's schema type.
+func sdkStateValue(res *schema.Resource, state *terraform.InstanceState, typ tftypes.Type) (tftypes.Value, error) {
+	ty := res.CoreConfigSchema().ImpliedType()
+	val, err := state.AttrsAsObjectValue(ty)
+	if err != nil {
+		return tftypes.Value{}, err
+	}
+	raw, err := ctyjson.Marshal(val, ty)
+	if err != nil {
+		return tftypes.Value{}, err
+	}
+	return tfprotov5.DynamicValue{JSON: raw}.Unmarshal(typ)
+}
+
+// sdkIdentityValue converts identity attribute values into a Terraform value of the identity schema type.
+func sdkIdentityValue(typ tftypes.Type, values map[string]interface{}) (tftypes.Value, error) {
+	raw, err := json.Marshal(values)
+	if err != nil {
+		return tftypes.Value{}, err
+	}
+	return tfprotov5.DynamicValue{JSON: raw}.Unmarshal(typ)
+}
+
+// convertSdkDiagnostics converts SDK diagnostics into Plugin Framework diagnostics.
+func convertSdkDiagnostics(sdkDiags diag.Diagnostics) fwdiag.Diagnostics {
+	var diags fwdiag.Diagnostics
+	for _, d := range sdkDiags {
+		if d.Severity == diag.Error {
+			diags.AddError(d.Summary, d.Detail)
+		} else {
+			diags.AddWarning(d.Summary, d.Detail)
+		}
+	}
+	return diags
+}
@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/utils"
+)
+
+func TestUnitListResources_Schemas(t *testing.T) {
+	ctx := context.Background()
+
+	server, err := protoV5ProviderFactories["rediscloud"]()
+	require.NoError(t, err)
+
+	resp, err := server.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	require.NoError(t, err)
+	for _, d := range resp.Diagnostics {
+		assert.NotEqual(t, tfprotov5.DiagnosticSeverityError, d.Severity, "%s: %s", d.Summary, d.Detail)
+	}
+
+	identities, err := server.GetResourceIdentitySchemas(ctx, &tfprotov5.GetResourceIdentitySchemasRequest{})
+	require.NoError(t, err)
+
+	for _, typeName := range []string{
+		"rediscloud_subscription",
+		"rediscloud_subscription_database",
+		"rediscloud_active_active_subscription",
+		"rediscloud_active_active_subscription_database",
+		"rediscloud_essentials_subscription",
+		"rediscloud_essentials_database",
+		"rediscloud_acl_rule",
+		"rediscloud_acl_role",
+		"rediscloud_acl_user",
+	} {
+		assert.Contains(t, resp.ListResourceSchemas, typeName)
+		assert.Contains(t, identities.IdentitySchemas, typeName)
+	}
+}
+
+func TestUnitSdkStateValue(t *testing.T) {
+	res := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {Type: schema.TypeString, Required: true},
+			"tags": {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+			"port": {Type: schema.TypeInt, Computed: true},
+		},
+		Identity: utils.DatabaseIdentity(),
+	}
+	schemaType := res.ProtoSchema(context.Background())().ValueType()
+	identityType := res.ProtoIdentitySchema(context.Background())().ValueType()
+
+	state := &terraform.InstanceState{
+		ID: "1/2",
+		Attributes: map[string]string{
+			"id":     "1/2",
+			"name":   "example",
+			"tags.#": "1",
+			"tags.0": "blue",
+			"port":   "12000",
+		},
+	}
+
+	value, err := sdkStateValue(res, state, schemaType)
+	require.NoError(t, err)
+
+	var attrs map[string]tftypes.Value
+	require.NoError(t, value.As(&attrs))
+	var name string
+	require.NoError(t, attrs["name"].As(&name))
+	assert.Equal(t, "example", name)
+	var tags []tftypes.Value
+	require.NoError(t, attrs["tags"].As(&tags))
+	assert.Len(t, tags, 1)
+
+	identity, err := sdkIdentityValue(identityType, map[string]interface{}{
+		utils.IdentitySubscriptionId: 1,
+		utils.IdentityDatabaseId:     2,
+	})
+	require.NoError(t, err)
+	assert.False(t, identity.IsFullyNull())
+
+	_, err = sdkIdentityValue(identityType, map[string]interface{}{"unknown": 1})
+	assert.Error(t, err)
+}
@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/RedisLabs/rediscloud-go-api/service/subscriptions"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/acl"
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/activeactive"
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/client"
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/essentials"
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/pro"
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/utils"
+)
+
+// listResources returns the list resources served by the Plugin Framework provider.
+func listResources() []func() list.ListResource {
+	return []func() list.ListResource{
+		pro.NewProSubscriptionListResource,
+		pro.NewProDatabaseListResource,
+		func() list.ListResource {
+			return &sdkListResource{
+				typeName:    "_active_active_subscription",
+				description: "Lists the account's Active-Active subscriptions",
+				resource:    resourceRedisCloudActiveActiveSubscription(),
+				list:        listSubscriptions(subscriptions.SubscriptionDeploymentTypeActiveActive),
+			}
+		},
+		activeactive.NewActiveActiveDatabaseListResource,
+		essentials.NewEssentialsSubscriptionListResource,
+		essentials.NewEssentialsDatabaseListResource,
+		acl.NewAclRuleListResource,
+		acl.NewAclRoleListResource,
+		acl.NewAclUserListResource,
+	}
+}
+
+func listSubscriptions(deploymentType string) sdkListFunc {
+	return func(ctx context.Context, api *client.ApiClient, _ int, yield func(sdkListEntry) bool) error {
+		subs, err := utils.ListSubscriptions(ctx, api, deploymentType, 0)
+		if err != nil {
+			return err
+		}
+		for _, sub := range subs {
+			if !yield(subscriptionListEntry(redis.IntValue(sub.ID), redis.StringValue(sub.Name))) {
+				return nil
+			}
+		}
+		return nil
+	}
+}
+
+func subscriptionListEntry(subId int, name string) sdkListEntry {
+	return sdkListEntry{
+		id:       strconv.Itoa(subId),
+		name:     name,
+		identity: map[string]interface{}{utils.IdentitySubscriptionId: subId},
+	}
+}
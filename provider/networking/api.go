@@ -0,0 +1,292 @@
+package networking
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	pl "github.com/RedisLabs/rediscloud-go-api/service/privatelink"
+	"github.com/RedisLabs/rediscloud-go-api/service/psc"
+	"github.com/RedisLabs/rediscloud-go-api/service/subscriptions"
+	"github.com/RedisLabs/rediscloud-go-api/service/transit_gateway/attachments"
+
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/client"
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/utils"
+)
+
+// The Pro and Active-Active networking APIs differ only by the region the Active-Active calls address. These
+// functions make the call for the deployment type, ignoring the region ID of Pro subscriptions.
+
+// findPeering returns the VPC peering with the given ID, or nil if there is none. Active-Active peerings are returned
+// in the shape of Pro peerings, with the region they are made from.
+func findPeering(ctx context.Context, api *client.ApiClient, d deploymentType, subId int, id int) (*subscriptions.VPCPeering, string, error) {
+	if !d.isActiveActive() {
+		peerings, err := api.Client.Subscription.ListVPCPeering(ctx, subId)
+		if err != nil {
+			return nil, "", err
+		}
+		for _, peering := range peerings {
+			if redis.IntValue(peering.ID) == id {
+				return peering, "", nil
+			}
+		}
+		return nil, "", nil
+	}
+
+	regions, err := api.Client.Subscription.ListActiveActiveVPCPeering(ctx, subId)
+	if err != nil {
+		return nil, "", err
+	}
+	for _, region := range regions {
+		for _, peering := range region.VPCPeerings {
+			if redis.IntValue(peering.ID) != id {
+				continue
+			}
+			return &subscriptions.VPCPeering{
+				ID:               peering.ID,
+				Status:           peering.Status,
+				AWSAccountID:     peering.AWSAccountID,
+				AWSPeeringID:     peering.AWSPeeringID,
+				VPCId:            peering.VPCId,
+				VPCCidr:          peering.VPCCidr,
+				VPCCidrs:         peering.VPCCidrs,
+				GCPProjectUID:    peering.GCPProjectUID,
+				NetworkName:      peering.NetworkName,
+				RedisProjectUID:  peering.RedisProjectUID,
+				RedisNetworkName: peering.RedisNetworkName,
+				CloudPeeringID:   peering.CloudPeeringID,
+				Region:           peering.RegionName,
+			}, redis.StringValue(region.SourceRegion), nil
+		}
+	}
+	return nil, "", nil
+}
+
+func deletePeering(ctx context.Context, api *client.ApiClient, d deploymentType, subId int, id int) error {
+	if d.isActiveActive() {
+		return api.Client.Subscription.DeleteActiveActiveVPCPeering(ctx, subId, id)
+	}
+	return api.Client.Subscription.DeleteVPCPeering(ctx, subId, id)
+}
+
+// findTransitGatewayAttachment returns the attachment of the subscription or region to the given Transit Gateway, or
+// nil if there is none.
+func findTransitGatewayAttachment(ctx context.Context, api *client.ApiClient, d deploymentType, subId int, regionId int, tgwId int) (*attachments.TransitGatewayAttachment, error) {
+	var task *attachments.GetAttachmentsTask
+	var err error
+	if d.isActiveActive() {
+		// Wait for the Transit Gateway resource to become available (handles subscription provisioning delays)
+		task, err = utils.WaitForActiveActiveTransitGatewayResourceToBeAvailable(ctx, subId, regionId, api)
+	} else {
+		task, err = api.Client.TransitGatewayAttachments.Get(ctx, subId)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if task == nil || task.Response == nil || task.Response.Resource == nil {
+		return nil, nil
+	}
+
+	var found []*attachments.TransitGatewayAttachment
+	for _, tgw := range task.Response.Resource.TransitGatewayAttachment {
+		if redis.IntValue(tgw.Id) == tgwId {
+			found = append(found, tgw)
+		}
+	}
+
+	switch len(found) {
+	case 0:
+		return nil, nil
+	case 1:
+		return found[0], nil
+	default:
+		return nil, fmt.Errorf("more than one Transit Gateway identified! %s: %s", strings.Join(d.idAttributes(utils.IdentitySubscriptionId, utils.IdentityTgwId), "/"), transitGatewayId(d, subId, regionId, tgwId))
+	}
+}
+
+func createTransitGatewayAttachment(ctx context.Context, api *client.ApiClient, d deploymentType, subId int, regionId int, tgwId int) error {
+	if d.isActiveActive() {
+		if _, err := api.Client.TransitGatewayAttachments.CreateActiveActive(ctx, subId, regionId, tgwId); err != nil {
+			return err
+		}
+		_, err := utils.WaitForActiveActiveTransitGatewayAttachmentToBeAvailable(ctx, subId, regionId, tgwId, api)
+		return err
+	}
+
+	if _, err := api.Client.TransitGatewayAttachments.Create(ctx, subId, tgwId); err != nil {
+		return err
+	}
+	_, err := utils.WaitForTransitGatewayAttachmentToBeAvailable(ctx, subId, tgwId, api)
+	return err
+}
+
+func updateTransitGatewayAttachmentCidrs(ctx context.Context, api *client.ApiClient, d deploymentType, subId int, regionId int, tgwId int, cidrs []*string) error {
+	if d.isActiveActive() {
+		return api.Client.TransitGatewayAttachments.UpdateActiveActive(ctx, subId, regionId, tgwId, cidrs)
+	}
+	return api.Client.TransitGatewayAttachments.Update(ctx, subId, tgwId, cidrs)
+}
+
+func deleteTransitGatewayAttachment(ctx context.Context, api *client.ApiClient, d deploymentType, subId int, regionId int, tgwId int) error {
+	if d.isActiveActive() {
+		return api.Client.TransitGatewayAttachments.DeleteActiveActive(ctx, subId, regionId, tgwId)
+	}
+	return api.Client.TransitGatewayAttachments.Delete(ctx, subId, tgwId)
+}
+
+// isTransitGatewayAttachmentNotFound reports whether the error is the API's for an attachment which doesn't exist.
+func isTransitGatewayAttachmentNotFound(err error) bool {
+	return strings.Contains(err.Error(), "TGW_ATTACHMENT_DOES_NOT_EXIST")
+}
+
+// transitGatewayId returns the ID of a Transit Gateway attachment or route.
+func transitGatewayId(d deploymentType, subId int, regionId int, tgwId int) string {
+	if d.isActiveActive() {
+		return buildID(subId, regionId, tgwId)
+	}
+	return buildID(subId, tgwId)
+}
+
+func getPrivateServiceConnectService(ctx context.Context, api *client.ApiClient, d deploymentType, subId int, regionId int) (*psc.PrivateServiceConnectService, error) {
+	if d.isActiveActive() {
+		return api.Client.PrivateServiceConnect.GetActiveActiveService(ctx, subId, regionId)
+	}
+	return api.Client.PrivateServiceConnect.GetService(ctx, subId)
+}
+
+func createPrivateServiceConnectService(ctx context.Context, api *client.ApiClient, d deploymentType, subId int, regionId int) (int, error) {
+	if d.isActiveActive() {
+		return api.Client.PrivateServiceConnect.CreateActiveActiveService(ctx, subId, regionId)
+	}
+	return api.Client.PrivateServiceConnect.CreateService(ctx, subId)
+}
+
+func deletePrivateServiceConnectService(ctx context.Context, api *client.ApiClient, d deploymentType, subId int, regionId int) error {
+	if d.isActiveActive() {
+		return api.Client.PrivateServiceConnect.DeleteActiveActiveService(ctx, subId, regionId)
+	}
+	return api.Client.PrivateServiceConnect.DeleteService(ctx, subId)
+}
+
+// findPrivateServiceConnectEndpoint returns the endpoint of the Private Service Connect service with the given ID, or
+// nil if there is none.
+func findPrivateServiceConnectEndpoint(ctx context.Context, api *client.ApiClient, d deploymentType, subId int, regionId int, serviceId int, endpointId int) (*psc.PrivateServiceConnectEndpoint, error) {
+	var endpoints *psc.PrivateServiceConnectEndpoints
+	var err error
+	if d.isActiveActive() {
+		endpoints, err = api.Client.PrivateServiceConnect.GetActiveActiveEndpoints(ctx, subId, regionId, serviceId)
+	} else {
+		endpoints, err = api.Client.PrivateServiceConnect.GetEndpoints(ctx, subId, serviceId)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return FindPrivateServiceConnectEndpoint(endpointId, endpoints.Endpoints), nil
+}
+
+// FindPrivateServiceConnectEndpoint returns the endpoint with the given ID, or nil if there is none.
+func FindPrivateServiceConnectEndpoint(id int, endpoints []*psc.PrivateServiceConnectEndpoint) *psc.PrivateServiceConnectEndpoint {
+	for _, endpoint := range endpoints {
+		if redis.IntValue(endpoint.ID) == id {
+			return endpoint
+		}
+	}
+	return nil
+}
+
+func createPrivateServiceConnectEndpoint(ctx context.Context, api *client.ApiClient, d deploymentType, subId int, regionId int, serviceId int, endpoint psc.CreatePrivateServiceConnectEndpoint) (int, error) {
+	if d.isActiveActive() {
+		return api.Client.PrivateServiceConnect.CreateActiveActiveEndpoint(ctx, subId, regionId, serviceId, endpoint)
+	}
+	return api.Client.PrivateServiceConnect.CreateEndpoint(ctx, subId, serviceId, endpoint)
+}
+
+func updatePrivateServiceConnectEndpoint(ctx context.Context, api *client.ApiClient, d deploymentType, subId int, regionId int, serviceId int, endpointId int, action string) error {
+	update := &psc.UpdatePrivateServiceConnectEndpoint{Action: redis.String(action)}
+	if d.isActiveActive() {
+		return api.Client.PrivateServiceConnect.UpdateActiveActiveEndpoint(ctx, subId, regionId, serviceId, endpointId, update)
+	}
+	return api.Client.PrivateServiceConnect.UpdateEndpoint(ctx, subId, serviceId, endpointId, update)
+}
+
+func deletePrivateServiceConnectEndpoint(ctx context.Context, api *client.ApiClient, d deploymentType, subId int, regionId int, serviceId int, endpointId int) error {
+	if d.isActiveActive() {
+		return api.Client.PrivateServiceConnect.DeleteActiveActiveEndpoint(ctx, subId, regionId, serviceId, endpointId)
+	}
+	return api.Client.PrivateServiceConnect.DeleteEndpoint(ctx, subId, serviceId, endpointId)
+}
+
+func getPrivateServiceConnectEndpointServiceAttachments(ctx context.Context, api *client.ApiClient, d deploymentType, subId int, regionId int, serviceId int, endpointId int) ([]psc.TerraformGCPServiceAttachment, error) {
+	var script *psc.CreationScript
+	var err error
+	if d.isActiveActive() {
+		script, err = api.Client.PrivateServiceConnect.GetActiveActiveEndpointCreationScripts(ctx, subId, regionId, serviceId, endpointId, true)
+	} else {
+		script, err = api.Client.PrivateServiceConnect.GetEndpointCreationScripts(ctx, subId, serviceId, endpointId, true)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if script.Script == nil || script.Script.TerraformGcp == nil {
+		return nil, nil
+	}
+	return script.Script.TerraformGcp.ServiceAttachments, nil
+}
+
+// isPrivateServiceConnectNotFound reports whether the error is the API's for a subscription or region without a
+// Private Service Connect service.
+func isPrivateServiceConnectNotFound(err error) bool {
+	var notFound *psc.NotFound
+	var notFoundActiveActive *psc.NotFoundActiveActive
+	return errors.As(err, &notFound) || errors.As(err, &notFoundActiveActive)
+}
+
+func getPrivateLink(ctx context.Context, api *client.ApiClient, d deploymentType, subId int, regionId int) (*pl.PrivateLink, error) {
+	if d.isActiveActive() {
+		return api.Client.PrivateLink.GetActiveActivePrivateLink(ctx, subId, regionId)
+	}
+	return api.Client.PrivateLink.GetPrivateLink(ctx, subId)
+}
+
+func createPrivateLink(ctx context.Context, api *client.ApiClient, d deploymentType, subId int, regionId int, link pl.CreatePrivateLink) error {
+	if d.isActiveActive() {
+		return api.Client.PrivateLink.CreateActiveActivePrivateLink(ctx, subId, regionId, link)
+	}
+	return api.Client.PrivateLink.CreatePrivateLink(ctx, subId, link)
+}
+
+func deletePrivateLink(ctx context.Context, api *client.ApiClient, d deploymentType, subId int, regionId int) error {
+	if d.isActiveActive() {
+		return api.Client.PrivateLink.DeleteActiveActivePrivateLink(ctx, subId, regionId)
+	}
+	return api.Client.PrivateLink.DeletePrivateLink(ctx, subId)
+}
+
+func createPrivateLinkPrincipal(ctx context.Context, api *client.ApiClient, d deploymentType, subId int, regionId int, principal pl.CreatePrivateLinkPrincipal) error {
+	if d.isActiveActive() {
+		return api.Client.PrivateLink.CreateActiveActivePrincipal(ctx, subId, regionId, principal)
+	}
+	return api.Client.PrivateLink.CreatePrincipal(ctx, subId, principal)
+}
+
+func deletePrivateLinkPrincipal(ctx context.Context, api *client.ApiClient, d deploymentType, subId int, regionId int, principal string) error {
+	if d.isActiveActive() {
+		return api.Client.PrivateLink.DeleteActiveActivePrincipal(ctx, subId, regionId, principal)
+	}
+	return api.Client.PrivateLink.DeletePrincipal(ctx, subId, principal)
+}
+
+// isPrivateLinkNotFound reports whether the error is the API's for a subscription or region without a PrivateLink.
+func isPrivateLinkNotFound(err error) bool {
+	var notFound *pl.NotFound
+	var notFoundActiveActive *pl.NotFoundActiveActive
+	return errors.As(err, &notFound) || errors.As(err, &notFoundActiveActive)
+}
+
+// isSubscriptionNotFound reports whether the error is the API's for a subscription which doesn't exist.
+func isSubscriptionNotFound(err error) bool {
+	var notFound *subscriptions.NotFound
+	return errors.As(err, &notFound)
+}
@@ -0,0 +1,58 @@
+package networking
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/utils"
+)
+
+// checkCIDROverlaps checks the CIDRs of the attribute, which are routed to the subscription, don't overlap the
+// deployment CIDRs of its regions, or of the Active-Active region accepted by inRegion when it isn't nil. Traffic to
+// addresses in both ranges would stay in the subscription's VPC. Nothing is checked while the subscription ID is
+// unknown, and a subscription which can't be read is only a warning, as the create reports it anyway.
+func (r *networkingResource) checkCIDROverlaps(ctx context.Context, subscriptionId types.String, inRegion func(utils.DeploymentCIDR) bool, attribute string, cidrs []string, diagnostics *diag.Diagnostics) {
+	if r.client == nil || len(cidrs) == 0 || !utils.IsConfigured(subscriptionId) {
+		return
+	}
+	subId, err := strconv.Atoi(subscriptionId.ValueString())
+	if err != nil {
+		return
+	}
+
+	deploymentCIDRs, err := utils.GetSubscriptionDeploymentCIDRs(ctx, r.client, subId)
+	if err != nil {
+		diagnostics.AddWarning("Couldn't check the subscription's deployment CIDRs", err.Error())
+		return
+	}
+	if inRegion != nil {
+		deploymentCIDRs = slices.DeleteFunc(deploymentCIDRs, func(c utils.DeploymentCIDR) bool { return !inRegion(c) })
+	}
+
+	for _, cidr := range cidrs {
+		if err := utils.CheckCIDROverlaps(fmt.Sprintf("%s %s", attribute, cidr), cidr, deploymentCIDRs); err != nil {
+			diagnostics.AddAttributeError(path.Root(attribute), "Overlapping CIDR", err.Error())
+		}
+	}
+}
+
+// addedCIDRs returns the known CIDRs planned which the prior state doesn't have, given the elements of lists or sets
+// of CIDRs. The CIDRs of a resource being created are all added.
+func addedCIDRs(planned []attr.Value, prior []attr.Value) []string {
+	var cidrs []string
+	for _, value := range planned {
+		cidr, ok := value.(types.String)
+		if !ok || !utils.IsConfigured(cidr) || slices.ContainsFunc(prior, cidr.Equal) {
+			continue
+		}
+		cidrs = append(cidrs, cidr.ValueString())
+	}
+	return cidrs
+}
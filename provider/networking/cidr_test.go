@@ -0,0 +1,199 @@
+package networking
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/RedisLabs/rediscloud-go-api/service/subscriptions"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/client"
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/fakeapi"
+)
+
+func TestUnitAddedCIDRs(t *testing.T) {
+	first, second := types.StringValue("10.0.0.0/24"), types.StringValue("10.0.1.0/24")
+
+	tests := []struct {
+		name     string
+		planned  []attr.Value
+		prior    []attr.Value
+		expected []string
+	}{
+		{name: "created", planned: []attr.Value{first, second}, expected: []string{"10.0.0.0/24", "10.0.1.0/24"}},
+		{name: "added", planned: []attr.Value{first, second}, prior: []attr.Value{first}, expected: []string{"10.0.1.0/24"}},
+		{name: "unchanged", planned: []attr.Value{first}, prior: []attr.Value{first}},
+		{name: "unknown", planned: []attr.Value{types.StringUnknown(), types.StringNull()}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, addedCIDRs(test.planned, test.prior))
+		})
+	}
+}
+
+func TestUnitAllocateCidrs(t *testing.T) {
+	ctx := context.Background()
+	fake := fakeapi.New(fakeapi.Options{})
+	t.Cleanup(fake.Close)
+
+	// Each provider instance has its own client, and shares nothing with the others but the account.
+	newResource := func() *cidrAllocationResource {
+		t.Helper()
+		c, err := fake.Client()
+		require.NoError(t, err)
+		return &cidrAllocationResource{networkingResource{client: &client.ApiClient{Client: c}}}
+	}
+	stringValues := func(values ...string) []attr.Value {
+		result := make([]attr.Value, len(values))
+		for i, value := range values {
+			result[i] = types.StringValue(value)
+		}
+		return result
+	}
+	allocate := func(r *cidrAllocationResource, prior map[string]string, names []string, exclude ...string) map[string]string {
+		t.Helper()
+		plan := CidrAllocationModel{
+			Pool:         types.StringValue("10.0.0.0/16"),
+			PrefixLength: types.Int64Value(24),
+			Names:        types.SetValueMust(types.StringType, stringValues(names...)),
+			Exclude:      types.SetValueMust(types.StringType, stringValues(exclude...)),
+		}
+
+		var diags diag.Diagnostics
+		r.allocateCidrs(ctx, &plan, prior, &diags)
+		require.False(t, diags.HasError(), "%v", diags)
+
+		cidrs := map[string]string{}
+		require.False(t, plan.CIDRs.ElementsAs(ctx, &cidrs, false).HasError())
+		return cidrs
+	}
+
+	subId, err := newResource().client.Client.Subscription.Create(ctx, subscriptions.CreateSubscription{
+		Name:            redis.String("peered"),
+		PaymentMethodID: redis.Int(fakeapi.PaymentMethodId),
+		CloudProviders: []*subscriptions.CreateCloudProvider{{
+			Provider: redis.String("AWS"),
+			Regions:  []*subscriptions.CreateRegion{{Region: redis.String("us-east-1")}},
+		}},
+	})
+	require.NoError(t, err)
+	_, err = newResource().client.Client.Subscription.CreateVPCPeering(ctx, subId, subscriptions.CreateVPCPeering{
+		Provider:     redis.String("AWS"),
+		Region:       redis.String("us-east-1"),
+		AWSAccountID: redis.String("123456789012"),
+		VPCId:        redis.String("vpc-1"),
+		VPCCidr:      redis.String("10.0.1.0/24"),
+	})
+	require.NoError(t, err)
+
+	// The exclusions and the peered VPC are skipped, and the names don't share a block.
+	created := allocate(newResource(), nil, []string{"queue", "cache"}, "10.0.0.0/24")
+	assert.Equal(t, map[string]string{"cache": "10.0.2.0/24", "queue": "10.0.3.0/24"}, created)
+
+	// Another provider instance keeps the blocks of the state, and allocates past them.
+	updated := allocate(newResource(), created, []string{"queue", "cache", "search"}, "10.0.0.0/24")
+	assert.Equal(t, map[string]string{"cache": "10.0.2.0/24", "queue": "10.0.3.0/24", "search": "10.0.4.0/24"}, updated)
+
+	// A removed name releases its block, and an excluded block is allocated again.
+	updated = allocate(newResource(), updated, []string{"cache", "search", "index"}, "10.0.0.0/24", "10.0.4.0/24")
+	assert.Equal(t, map[string]string{"cache": "10.0.2.0/24", "index": "10.0.3.0/24", "search": "10.0.5.0/24"}, updated)
+
+	// The blocks used by subscriptions are skipped by the allocations of other resources.
+	_, err = newResource().client.Client.Subscription.Create(ctx, subscriptions.CreateSubscription{
+		Name:            redis.String("cache"),
+		PaymentMethodID: redis.Int(fakeapi.PaymentMethodId),
+		CloudProviders: []*subscriptions.CreateCloudProvider{{
+			Provider: redis.String("AWS"),
+			Regions: []*subscriptions.CreateRegion{{
+				Region:     redis.String("us-east-1"),
+				Networking: &subscriptions.CreateNetworking{DeploymentCIDR: redis.String(updated["cache"])},
+			}},
+		}},
+	})
+	require.NoError(t, err)
+	other := allocate(newResource(), nil, []string{"cache"}, "10.0.0.0/24")
+	assert.Equal(t, map[string]string{"cache": "10.0.3.0/24"}, other)
+}
+
+func TestUnitKeptCidrs(t *testing.T) {
+	prior := map[string]string{"cache": "10.0.2.0/24", "queue": "10.0.3.0/24"}
+
+	kept, excluded := keptCidrs(prior, []string{"cache", "queue", "search"}, []string{"10.0.3.0/25"})
+	assert.Equal(t, map[string]string{"cache": "10.0.2.0/24"}, kept)
+	assert.Equal(t, []string{"queue"}, excluded)
+}
+
+func TestUnitCidrAllocationOverlappingPools(t *testing.T) {
+	ctx := context.Background()
+	fake := fakeapi.New(fakeapi.Options{})
+	t.Cleanup(fake.Close)
+	c, err := fake.Client()
+	require.NoError(t, err)
+	api := &client.ApiClient{Client: c}
+	t.Cleanup(func() { delete(cidrPools.pools, api) })
+
+	// The allocations share the provider configuration, as the resources of an apply do.
+	r := &cidrAllocationResource{networkingResource{client: api}}
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError())
+	null := tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)
+
+	create := func(pool string) (tfsdk.State, diag.Diagnostics) {
+		t.Helper()
+		plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: null}
+		diags := plan.Set(ctx, CidrAllocationModel{
+			ID:           types.StringUnknown(),
+			Pool:         types.StringValue(pool),
+			PrefixLength: types.Int64Value(24),
+			Names:        types.SetValueMust(types.StringType, []attr.Value{types.StringValue("cache")}),
+			Exclude:      types.SetNull(types.StringType),
+			CIDRs:        types.MapUnknown(types.StringType),
+			Timeouts:     timeouts.Value{Object: types.ObjectNull(timeoutsAttributeTypes(schemaResp))},
+		})
+		require.False(t, diags.HasError(), "%v", diags)
+
+		resp := resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: null}}
+		r.Create(ctx, resource.CreateRequest{Plan: plan}, &resp)
+		return resp.State, resp.Diagnostics
+	}
+
+	first, diags := create("10.0.0.0/16")
+	require.False(t, diags.HasError(), "%v", diags)
+	var allocation CidrAllocationModel
+	require.False(t, first.Get(ctx, &allocation).HasError())
+	assert.True(t, strings.HasPrefix(allocation.ID.ValueString(), "cidr-allocation-"))
+
+	// A second allocation from the same or an overlapping pool would hand out the same blocks.
+	for _, pool := range []string{"10.0.0.0/16", "10.0.128.0/17", "10.0.0.0/8"} {
+		_, diags = create(pool)
+		require.True(t, diags.HasError(), pool)
+		assert.Contains(t, diags.Errors()[0].Detail(), "overlaps the pool 10.0.0.0/16 of the CIDR allocation "+allocation.ID.ValueString())
+	}
+
+	// A separate pool has its own allocation, with its own ID.
+	second, diags := create("10.1.0.0/16")
+	require.False(t, diags.HasError(), "%v", diags)
+	var other CidrAllocationModel
+	require.False(t, second.Get(ctx, &other).HasError())
+	assert.NotEqual(t, allocation.ID, other.ID)
+
+	// Destroying the allocation releases its pool.
+	var deleteResp resource.DeleteResponse
+	r.Delete(ctx, resource.DeleteRequest{State: first}, &deleteResp)
+	require.False(t, deleteResp.Diagnostics.HasError())
+	_, diags = create("10.0.0.0/16")
+	assert.False(t, diags.HasError(), "%v", diags)
+}
@@ -0,0 +1,67 @@
+package networking
+
+import (
+	"context"
+	"slices"
+
+	"github.com/RedisLabs/rediscloud-go-api/service/subscriptions"
+
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/client"
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/utils"
+)
+
+// deploymentType is the kind of subscription a networking resource belongs to. Each connectivity type has a single
+// implementation, registered once per deployment type. The Active-Active variants address the subscription's
+// regions, so their IDs and identities have a region ID after the subscription ID.
+type deploymentType string
+
+const (
+	pro          deploymentType = subscriptions.SubscriptionDeploymentTypeSingleRegion
+	activeActive deploymentType = subscriptions.SubscriptionDeploymentTypeActiveActive
+)
+
+func (d deploymentType) isActiveActive() bool {
+	return d == activeActive
+}
+
+// typeName returns the resource type name suffix, such as "_transit_gateway_route" or
+// "_active_active_transit_gateway_route".
+func (d deploymentType) typeName(name string) string {
+	if d.isActiveActive() {
+		return "_active_active_" + name
+	}
+	return "_" + name
+}
+
+// subscriptionName names the subscription in descriptions, e.g. "an Active-Active subscription".
+func (d deploymentType) subscriptionName() string {
+	if d.isActiveActive() {
+		return "an Active-Active subscription"
+	}
+	return "a Pro subscription"
+}
+
+// idAttributes returns the attributes identifying a resource of this deployment type, given those of the Pro
+// resource: Active-Active resources have a region ID after the subscription ID.
+func (d deploymentType) idAttributes(attributes ...string) []string {
+	if !d.isActiveActive() {
+		return attributes
+	}
+	return slices.Insert(slices.Clone(attributes), 1, utils.IdentityRegionId)
+}
+
+// lock takes the lock serialising changes to the subscription, or to the Active-Active region.
+func (d deploymentType) lock(ctx context.Context, api *client.ApiClient, subId int, regionId int) (func(), error) {
+	if d.isActiveActive() {
+		return utils.LockActiveActiveRegion(ctx, api, subId, regionId)
+	}
+	return utils.LockSubscription(ctx, subId)
+}
+
+// rlock takes the lock for a change which may run alongside others of the subscription or Active-Active region.
+func (d deploymentType) rlock(ctx context.Context, api *client.ApiClient, subId int, regionId int) (func(), error) {
+	if d.isActiveActive() {
+		return utils.RLockActiveActiveRegion(ctx, api, subId, regionId)
+	}
+	return utils.RLockSubscription(ctx, subId)
+}
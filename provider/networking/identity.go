@@ -0,0 +1,73 @@
+package networking
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/utils"
+)
+
+// The resource IDs of the networking resources are made of the values of their identity attributes, in order. The
+// Active-Active VPC peering is the exception, as peerings are identified without their region.
+
+// identitySchema returns a resource identity made of the given numeric attributes.
+func identitySchema(attributes []string) identityschema.Schema {
+	s := identityschema.Schema{
+		Attributes: make(map[string]identityschema.Attribute, len(attributes)),
+	}
+	for _, attribute := range attributes {
+		s.Attributes[attribute] = identityschema.Int64Attribute{
+			Description:       utils.IdentityDescription(attribute),
+			RequiredForImport: true,
+		}
+	}
+	return s
+}
+
+// setIdentity records the resource identity from the resource ID, when the identity is supported.
+func setIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, id string, attributes []string, diagnostics *diag.Diagnostics) {
+	if identity == nil || diagnostics.HasError() {
+		return
+	}
+
+	values, err := ParseID(id, attributes...)
+	if err != nil {
+		diagnostics.AddError("Invalid resource ID", err.Error())
+		return
+	}
+	for i, attribute := range attributes {
+		diagnostics.Append(identity.SetAttribute(ctx, path.Root(attribute), types.Int64Value(int64(values[i])))...)
+	}
+}
+
+// importState imports a resource from its ID, or from an identity block. Read fills in the rest of the state from the
+// ID.
+func importState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, attributes []string) {
+	id := req.ID
+	if id == "" && req.Identity != nil {
+		values := make([]int, len(attributes))
+		for i, attribute := range attributes {
+			var value types.Int64
+			resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root(attribute), &value)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			values[i] = int(value.ValueInt64())
+		}
+		id = buildID(values...)
+	}
+
+	if _, err := ParseID(id, attributes...); err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	setIdentity(ctx, resp.Identity, id, attributes, &resp.Diagnostics)
+}
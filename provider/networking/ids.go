@@ -0,0 +1,35 @@
+package networking
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ParseID splits a resource ID into its numeric parts, one per attribute, e.g. the ID of an Active-Active Transit
+// Gateway attachment is <subscription_id>/<region_id>/<tgw_id>.
+func ParseID(id string, attributes ...string) ([]int, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != len(attributes) {
+		return nil, fmt.Errorf("unexpected format of ID (%q), expected <%s>", id, strings.Join(attributes, ">/<"))
+	}
+
+	values := make([]int, len(parts))
+	for i, part := range parts {
+		value, err := strconv.Atoi(part)
+		if err != nil {
+			return nil, fmt.Errorf("unexpected format of ID (%q), %s must be a number", id, attributes[i])
+		}
+		values[i] = value
+	}
+	return values, nil
+}
+
+// buildID joins the numeric parts of a resource ID.
+func buildID(values ...int) string {
+	parts := make([]string, len(values))
+	for i, value := range values {
+		parts[i] = strconv.Itoa(value)
+	}
+	return strings.Join(parts, "/")
+}
@@ -0,0 +1,73 @@
+package networking
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/utils"
+)
+
+func TestUnitParseID(t *testing.T) {
+	tests := []struct {
+		name       string
+		id         string
+		attributes []string
+		expected   []int
+		err        string
+	}{
+		{
+			name:       "subscription",
+			id:         "123",
+			attributes: []string{utils.IdentitySubscriptionId},
+			expected:   []int{123},
+		},
+		{
+			name:       "Active-Active Transit Gateway attachment",
+			id:         "123/2/45",
+			attributes: activeActive.idAttributes(utils.IdentitySubscriptionId, utils.IdentityTgwId),
+			expected:   []int{123, 2, 45},
+		},
+		{
+			name:       "too few parts",
+			id:         "123",
+			attributes: []string{utils.IdentitySubscriptionId, utils.IdentityPeeringId},
+			err:        `unexpected format of ID ("123"), expected <subscription_id>/<peering_id>`,
+		},
+		{
+			name:       "Pro ID given to an Active-Active resource",
+			id:         "123/45",
+			attributes: activeActive.idAttributes(utils.IdentitySubscriptionId, utils.IdentityTgwId),
+			err:        `unexpected format of ID ("123/45"), expected <subscription_id>/<region_id>/<tgw_id>`,
+		},
+		{
+			name:       "not a number",
+			id:         "123/abc",
+			attributes: []string{utils.IdentitySubscriptionId, utils.IdentityPscServiceId},
+			err:        `unexpected format of ID ("123/abc"), psc_service_id must be a number`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			values, err := ParseID(test.id, test.attributes...)
+			if test.err != "" {
+				assert.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, values)
+			assert.Equal(t, test.id, buildID(values...))
+		})
+	}
+}
+
+func TestUnitIdAttributes(t *testing.T) {
+	assert.Equal(t,
+		[]string{utils.IdentitySubscriptionId, utils.IdentityPscServiceId, utils.IdentityPscEndpointId},
+		pro.idAttributes(utils.IdentitySubscriptionId, utils.IdentityPscServiceId, utils.IdentityPscEndpointId))
+	assert.Equal(t,
+		[]string{utils.IdentitySubscriptionId, utils.IdentityRegionId, utils.IdentityPscServiceId, utils.IdentityPscEndpointId},
+		activeActive.idAttributes(utils.IdentitySubscriptionId, utils.IdentityPscServiceId, utils.IdentityPscEndpointId))
+}
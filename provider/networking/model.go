@@ -0,0 +1,94 @@
+package networking
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Each connectivity type has a single resource model, holding the attributes of both deployment types, such as the
+// region ID of Active-Active resources. The attributes a deployment type's schema doesn't have are absent: they are
+// null when reading the model, and dropped when writing it.
+
+// modelSource is where a resource model is read from: its configuration, plan or state.
+type modelSource interface {
+	Get(ctx context.Context, target interface{}) diag.Diagnostics
+}
+
+// getModel reads the resource model from the configuration, plan or state, leaving the absent attributes null.
+func getModel(ctx context.Context, source modelSource, absent map[string]attr.Type, target any) diag.Diagnostics {
+	var object types.Object
+	diags := source.Get(ctx, &object)
+	if diags.HasError() {
+		return diags
+	}
+
+	if len(absent) > 0 {
+		attributeTypes := object.AttributeTypes(ctx)
+		attributes := object.Attributes()
+		for name, typ := range absent {
+			null, err := typ.ValueFromTerraform(ctx, tftypes.NewValue(typ.TerraformType(ctx), nil))
+			if err != nil {
+				diags.AddError("Failed to read resource model", err.Error())
+				return diags
+			}
+			attributeTypes[name] = typ
+			attributes[name] = null
+		}
+
+		var d diag.Diagnostics
+		object, d = types.ObjectValue(attributeTypes, attributes)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	diags.Append(object.As(ctx, target, basetypes.ObjectAsOptions{})...)
+	return diags
+}
+
+// setModel writes the resource model to the state, without the absent attributes.
+func setModel(ctx context.Context, state *tfsdk.State, absent map[string]attr.Type, model any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	schemaType, ok := state.Schema.Type().(attr.TypeWithAttributeTypes)
+	if !ok {
+		diags.AddError("Failed to write resource model", "the resource schema isn't an object")
+		return diags
+	}
+
+	attributeTypes := map[string]attr.Type{}
+	for name, typ := range schemaType.AttributeTypes() {
+		attributeTypes[name] = typ
+	}
+	for name, typ := range absent {
+		attributeTypes[name] = typ
+	}
+
+	object, d := types.ObjectValueFrom(ctx, attributeTypes, model)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	attributes := object.Attributes()
+	for name := range absent {
+		delete(attributes, name)
+		delete(attributeTypes, name)
+	}
+
+	object, d = types.ObjectValue(attributeTypes, attributes)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(state.Set(ctx, object)...)
+	return diags
+}
@@ -0,0 +1,77 @@
+package networking
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitModelRoundTrip(t *testing.T) {
+	tests := []struct {
+		name       string
+		deployment deploymentType
+		model      SubscriptionPeeringModel
+	}{
+		{
+			name:       "Pro",
+			deployment: pro,
+			model: SubscriptionPeeringModel{
+				ID:             types.StringValue("12/34"),
+				SubscriptionID: types.StringValue("12"),
+				Region:         types.StringValue("us-east-1"),
+			},
+		},
+		{
+			name:       "Active-Active",
+			deployment: activeActive,
+			model: SubscriptionPeeringModel{
+				ID:                types.StringValue("12/34"),
+				SubscriptionID:    types.StringValue("12"),
+				SourceRegion:      types.StringValue("us-east-1"),
+				DestinationRegion: types.StringValue("eu-west-1"),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := context.Background()
+			r := &subscriptionPeeringResource{networkingResource{deployment: test.deployment}}
+
+			var schemaResp resource.SchemaResponse
+			r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+			require.False(t, schemaResp.Diagnostics.HasError())
+
+			state := tfsdk.State{
+				Schema: schemaResp.Schema,
+				Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+			}
+
+			// Unset attributes of the model are null, but the timeouts need their attribute types.
+			model := test.model
+			model.VpcCidrs = types.SetNull(types.StringType)
+			model.Timeouts = timeouts.Value{Object: types.ObjectNull(timeoutsAttributeTypes(schemaResp))}
+
+			diags := setModel(ctx, &state, r.absent(), model)
+			require.False(t, diags.HasError(), "%v", diags)
+
+			var got SubscriptionPeeringModel
+			diags = getModel(ctx, state, r.absent(), &got)
+			require.False(t, diags.HasError(), "%v", diags)
+
+			assert.Equal(t, model, got)
+		})
+	}
+}
+
+func timeoutsAttributeTypes(resp resource.SchemaResponse) map[string]attr.Type {
+	return resp.Schema.Blocks["timeouts"].Type().(attr.TypeWithAttributeTypes).AttributeTypes()
+}
@@ -0,0 +1,120 @@
+package networking
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/RedisLabs/rediscloud-go-api/service/psc"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/utils"
+)
+
+// privateServiceConnectKey identifies a Private Service Connect service of a subscription, or of an Active-Active
+// subscription's region, and one of its endpoints. The region ID of Pro subscriptions is 0.
+type privateServiceConnectKey struct {
+	subId      int
+	regionId   int
+	serviceId  int
+	endpointId int
+}
+
+// privateServiceConnectIdentityAttributes are the attributes of the resource identity and ID of the Private Service
+// Connect services, or with the endpoint ID, of their endpoints.
+func (r *networkingResource) privateServiceConnectIdentityAttributes(endpoint bool) []string {
+	if endpoint {
+		return r.deployment.idAttributes(utils.IdentitySubscriptionId, utils.IdentityPscServiceId, utils.IdentityPscEndpointId)
+	}
+	return r.deployment.idAttributes(utils.IdentitySubscriptionId, utils.IdentityPscServiceId)
+}
+
+// privateServiceConnectKeyFromID reads the key of the Private Service Connect service, or endpoint, from the resource
+// ID.
+func (r *networkingResource) privateServiceConnectKeyFromID(id string, endpoint bool, diagnostics *diag.Diagnostics) privateServiceConnectKey {
+	ids, err := ParseID(id, r.privateServiceConnectIdentityAttributes(endpoint)...)
+	if err != nil {
+		diagnostics.AddError("Invalid Private Service Connect ID", err.Error())
+		return privateServiceConnectKey{}
+	}
+
+	var key privateServiceConnectKey
+	key.subId, ids = ids[0], ids[1:]
+	if r.deployment.isActiveActive() {
+		key.regionId, ids = ids[0], ids[1:]
+	}
+	key.serviceId = ids[0]
+	if endpoint {
+		key.endpointId = ids[1]
+	}
+	return key
+}
+
+// id returns the resource ID of the Private Service Connect service, or endpoint.
+func (k privateServiceConnectKey) id(d deploymentType, endpoint bool) string {
+	values := []int{k.subId}
+	if d.isActiveActive() {
+		values = append(values, k.regionId)
+	}
+	values = append(values, k.serviceId)
+	if endpoint {
+		values = append(values, k.endpointId)
+	}
+	return buildID(values...)
+}
+
+// regionIdValue returns the region_id attribute of Active-Active resources, or null for Pro ones.
+func (k privateServiceConnectKey) regionIdValue(d deploymentType) types.Int64 {
+	if d.isActiveActive() {
+		return types.Int64Value(int64(k.regionId))
+	}
+	return types.Int64Null()
+}
+
+// privateServiceConnectKeyFromPlan reads the subscription and region of the Private Service Connect service from the
+// configured attributes.
+func (r *networkingResource) privateServiceConnectKeyFromPlan(subscriptionId types.String, regionId types.Int64, diagnostics *diag.Diagnostics) privateServiceConnectKey {
+	key := privateServiceConnectKey{regionId: int(regionId.ValueInt64())}
+
+	var err error
+	if key.subId, err = strconv.Atoi(subscriptionId.ValueString()); err != nil {
+		diagnostics.AddError("Invalid subscription ID", err.Error())
+	}
+
+	return key
+}
+
+// serviceAttachmentAttributeTypes are the attribute types of the service attachments of a Private Service Connect
+// endpoint.
+var serviceAttachmentAttributeTypes = map[string]attr.Type{
+	"name":                 types.StringType,
+	"dns_record":           types.StringType,
+	"ip_address_name":      types.StringType,
+	"forwarding_rule_name": types.StringType,
+}
+
+// flattenServiceAttachments returns the service attachments created for a Private Service Connect endpoint.
+func flattenServiceAttachments(ctx context.Context, serviceAttachments []psc.TerraformGCPServiceAttachment, diagnostics *diag.Diagnostics) types.List {
+	type serviceAttachmentModel struct {
+		Name               types.String `tfsdk:"name"`
+		DNSRecord          types.String `tfsdk:"dns_record"`
+		IPAddressName      types.String `tfsdk:"ip_address_name"`
+		ForwardingRuleName types.String `tfsdk:"forwarding_rule_name"`
+	}
+
+	models := make([]serviceAttachmentModel, 0, len(serviceAttachments))
+	for _, serviceAttachment := range serviceAttachments {
+		models = append(models, serviceAttachmentModel{
+			Name:               types.StringValue(redis.StringValue(serviceAttachment.Name)),
+			DNSRecord:          types.StringValue(redis.StringValue(serviceAttachment.DNSRecord)),
+			IPAddressName:      types.StringValue(redis.StringValue(serviceAttachment.IPAddressName)),
+			ForwardingRuleName: types.StringValue(redis.StringValue(serviceAttachment.ForwardingRuleName)),
+		})
+	}
+
+	list, d := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: serviceAttachmentAttributeTypes}, models)
+	diagnostics.Append(d...)
+	return list
+}
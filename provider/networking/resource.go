@@ -0,0 +1,123 @@
+package networking
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/client"
+)
+
+// networkingResource is what every networking resource has: the provider configured client, and the deployment type
+// of the subscriptions it manages.
+type networkingResource struct {
+	client     *client.ApiClient
+	deployment deploymentType
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *networkingResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.ApiClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.ApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// regionIdAbsent lists the region ID as absent from the model of Pro resources. The region ID of the Active-Active
+// region is a string for Transit Gateways and a number otherwise, as it always has been.
+func (r *networkingResource) regionIdAbsent(typ attr.Type) map[string]attr.Type {
+	if r.deployment.isActiveActive() {
+		return nil
+	}
+	return map[string]attr.Type{"region_id": typ}
+}
+
+// subscriptionAttributes returns the schema of the attributes identifying the subscription, or the Active-Active
+// subscription's region, of a resource. The region ID is a number.
+func (r *networkingResource) subscriptionAttributes(regionDescription string) map[string]schema.Attribute {
+	subscriptionDescription := "The ID of the Pro subscription to attach"
+	if r.deployment.isActiveActive() {
+		subscriptionDescription = "The ID of the Active-Active subscription to attach"
+	}
+
+	attributes := map[string]schema.Attribute{
+		"id": idAttribute(),
+		"subscription_id": schema.StringAttribute{
+			Description: subscriptionDescription,
+			Required:    true,
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(regexp.MustCompile(`^\d+$`), "must be a number"),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+	}
+
+	if r.deployment.isActiveActive() {
+		attributes["region_id"] = requiredInt64(regionDescription)
+	}
+
+	return attributes
+}
+
+// requiredInt64 is a number attribute which replaces the resource when changed.
+func requiredInt64(description string) schema.Int64Attribute {
+	return schema.Int64Attribute{
+		Description: description,
+		Required:    true,
+		PlanModifiers: []planmodifier.Int64{
+			int64planmodifier.RequiresReplace(),
+		},
+	}
+}
+
+// requiredString is a string attribute which replaces the resource when changed.
+func requiredString(description string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: description,
+		Required:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+}
+
+// idAttribute is the schema of the resource ID.
+func idAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: "The ID of the resource",
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+// nullString returns the string, or null if it's empty.
+func nullString(s string) types.String {
+	if s == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(s)
+}
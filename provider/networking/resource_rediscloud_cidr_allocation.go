@@ -0,0 +1,266 @@
+package networking
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/utils"
+)
+
+var (
+	_ resource.Resource               = &cidrAllocationResource{}
+	_ resource.ResourceWithConfigure  = &cidrAllocationResource{}
+	_ resource.ResourceWithModifyPlan = &cidrAllocationResource{}
+)
+
+// cidrAllocationResource allocates named blocks from a pool of CIDRs which no subscription or peering of the account
+// uses. The allocations only live in the state: each block is kept until its name is removed or the resource is
+// destroyed, and new blocks are allocated past the ones the state holds. A pool may only have one allocation resource.
+type cidrAllocationResource struct {
+	networkingResource
+}
+
+// NewCidrAllocationResource returns a new resource instance for CIDR allocations.
+func NewCidrAllocationResource() resource.Resource {
+	return &cidrAllocationResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *cidrAllocationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cidr_allocation"
+}
+
+// Schema defines the schema for the resource.
+func (r *cidrAllocationResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Allocates a free CIDR block from a pool, for the deployment CIDR of a subscription region or a peered VPC",
+		Attributes: map[string]schema.Attribute{
+			"id": idAttribute(),
+			"pool": schema.StringAttribute{
+				Description: "The IPv4 CIDR block to allocate from",
+				Required:    true,
+				Validators: []validator.String{
+					utils.CIDRValidator(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"prefix_length": schema.Int64Attribute{
+				Description: "The prefix length of the allocated block, at least that of the pool",
+				Required:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 32),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"names": schema.SetAttribute{
+				Description: "The names to allocate a block for, such as the subscription regions using them",
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"exclude": schema.SetAttribute{
+				Description: "CIDR blocks of the pool not to allocate from, such as those used outside of Redis Cloud",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(utils.CIDRValidator()),
+				},
+			},
+			"cidrs": schema.MapAttribute{
+				Description: "The allocated CIDR blocks, by name",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+			}),
+		},
+	}
+}
+
+// ModifyPlan checks a block of the prefix length fits in the pool, and plans the blocks kept from the state, which are
+// those of the names still configured unless an exclusion overlaps them. The blocks of the other names are unknown
+// until they're allocated.
+func (r *cidrAllocationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan CidrAllocationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if utils.IsConfigured(plan.Pool) && utils.IsConfigured(plan.PrefixLength) {
+		if _, err := utils.NextFreeCIDR(plan.Pool.ValueString(), int(plan.PrefixLength.ValueInt64()), nil); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("prefix_length"), "Invalid prefix length", err.Error())
+			return
+		}
+	}
+
+	var state CidrAllocationModel
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	if utils.IsConfigured(plan.Pool) && (req.State.Raw.IsNull() || !plan.Pool.Equal(state.Pool)) {
+		if otherId, otherPool, ok := cidrPools.overlapping(r.client, state.ID.ValueString(), plan.Pool.ValueString()); ok {
+			resp.Diagnostics.AddAttributeError(path.Root("pool"), "Overlapping CIDR allocation pool",
+				overlappingPoolError(plan.Pool.ValueString(), otherId, otherPool))
+			return
+		}
+	}
+
+	if req.State.Raw.IsNull() {
+		return
+	}
+	if !plan.Pool.Equal(state.Pool) || !plan.PrefixLength.Equal(state.PrefixLength) || !utils.IsFullyKnown(ctx, plan.Names, plan.Exclude) {
+		// The resource is replaced, or the names kept aren't known yet.
+		return
+	}
+
+	var names, exclude []string
+	prior := map[string]string{}
+	resp.Diagnostics.Append(plan.Names.ElementsAs(ctx, &names, false)...)
+	resp.Diagnostics.Append(plan.Exclude.ElementsAs(ctx, &exclude, false)...)
+	resp.Diagnostics.Append(state.CIDRs.ElementsAs(ctx, &prior, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kept, excluded := keptCidrs(prior, names, exclude)
+	for _, name := range excluded {
+		resp.Diagnostics.AddAttributeWarning(path.Root("exclude"), "Excluded CIDR allocation",
+			fmt.Sprintf("the block %s allocated to %q is excluded, and will be allocated again", prior[name], name))
+	}
+	cidrs := make(map[string]attr.Value, len(names))
+	for _, name := range names {
+		if cidr, ok := kept[name]; ok {
+			cidrs[name] = types.StringValue(cidr)
+		} else {
+			cidrs[name] = types.StringUnknown()
+		}
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("cidrs"), types.MapValueMust(types.StringType, cidrs))...)
+}
+
+// Create implements resource creation.
+func (r *cidrAllocationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan CidrAllocationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Create(ctx, 5*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	plan.ID = types.StringValue(id.PrefixedUniqueId("cidr-allocation-"))
+	if otherId, otherPool, ok := cidrPools.claim(r.client, plan.ID.ValueString(), plan.Pool.ValueString()); ok {
+		resp.Diagnostics.AddAttributeError(path.Root("pool"), "Overlapping CIDR allocation pool",
+			overlappingPoolError(plan.Pool.ValueString(), otherId, otherPool))
+		return
+	}
+
+	r.allocateCidrs(ctx, &plan, nil, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		cidrPools.release(r.client, plan.ID.ValueString())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read keeps the allocations from the state, which is the only place they're recorded, and records the pool so that
+// allocations from overlapping pools are refused.
+func (r *cidrAllocationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state CidrAllocationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if otherId, otherPool, ok := cidrPools.claim(r.client, state.ID.ValueString(), state.Pool.ValueString()); ok {
+		resp.Diagnostics.AddAttributeWarning(path.Root("pool"), "Overlapping CIDR allocation pool",
+			overlappingPoolError(state.Pool.ValueString(), otherId, otherPool))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Update allocates blocks for the names added and those whose block is excluded, keeping the others, and releases the
+// blocks of the names removed.
+func (r *cidrAllocationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state CidrAllocationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Update(ctx, 5*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	prior := map[string]string{}
+	resp.Diagnostics.Append(state.CIDRs.ElementsAs(ctx, &prior, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.allocateCidrs(ctx, &plan, prior, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete releases the allocations, by removing them from the state, and their pool.
+func (r *cidrAllocationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state CidrAllocationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cidrPools.release(r.client, state.ID.ValueString())
+}
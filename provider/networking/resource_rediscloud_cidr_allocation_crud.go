@@ -0,0 +1,135 @@
+package networking
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/client"
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/utils"
+)
+
+// cidrPools are the pools of the CIDR allocations known to each provider configuration, by resource ID. Nothing is
+// reserved in Redis Cloud, so allocations from overlapping pools would hand out the same blocks: a second one is
+// refused instead.
+var cidrPools = &cidrPoolRegistry{pools: map[*client.ApiClient]map[string]string{}}
+
+// cidrPoolRegistry holds the pools of the CIDR allocations of each provider configuration.
+type cidrPoolRegistry struct {
+	mu    sync.Mutex
+	pools map[*client.ApiClient]map[string]string
+}
+
+// overlapping returns the ID and pool of an allocation other than the given one whose pool overlaps the pool, if
+// there is one.
+func (r *cidrPoolRegistry) overlapping(api *client.ApiClient, id string, pool string) (string, string, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.find(api, id, pool)
+}
+
+// claim records the pool of an allocation, unless it overlaps the pool of another allocation, which is returned.
+func (r *cidrPoolRegistry) claim(api *client.ApiClient, id string, pool string) (string, string, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if otherId, otherPool, ok := r.find(api, id, pool); ok {
+		return otherId, otherPool, true
+	}
+	if r.pools[api] == nil {
+		r.pools[api] = map[string]string{}
+	}
+	r.pools[api][id] = pool
+	return "", "", false
+}
+
+// release forgets the pool of a destroyed allocation.
+func (r *cidrPoolRegistry) release(api *client.ApiClient, id string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.pools[api], id)
+}
+
+func (r *cidrPoolRegistry) find(api *client.ApiClient, id string, pool string) (string, string, bool) {
+	for otherId, otherPool := range r.pools[api] {
+		if otherId != id && utils.CIDRsOverlap(pool, otherPool) {
+			return otherId, otherPool, true
+		}
+	}
+	return "", "", false
+}
+
+// overlappingPoolError describes an allocation refused because its pool overlaps that of another allocation.
+func overlappingPoolError(pool string, otherId string, otherPool string) string {
+	return fmt.Sprintf("the pool %s overlaps the pool %s of the CIDR allocation %s, which would allocate the same "+
+		"blocks. Allocate all the blocks of a pool from one rediscloud_cidr_allocation resource, using its names", pool, otherPool, otherId)
+}
+
+// allocateCidrs implements the Create and Update operations of the CIDR allocation resource. Each name keeps its block
+// from the prior state unless an exclusion overlaps it. The other names are allocated blocks of the pool past the
+// exclusions, the deployment CIDRs and peered VPCs of the account's subscriptions, and the blocks kept, in the order
+// of their names.
+func (r *cidrAllocationResource) allocateCidrs(ctx context.Context, plan *CidrAllocationModel, prior map[string]string, diagnostics *diag.Diagnostics) {
+	var names, exclude []string
+	diagnostics.Append(plan.Names.ElementsAs(ctx, &names, false)...)
+	diagnostics.Append(plan.Exclude.ElementsAs(ctx, &exclude, false)...)
+	if diagnostics.HasError() {
+		return
+	}
+	slices.Sort(names)
+
+	cidrs, _ := keptCidrs(prior, names, exclude)
+	if len(cidrs) < len(names) {
+		taken := slices.Clone(exclude)
+		for _, cidr := range cidrs {
+			taken = append(taken, cidr)
+		}
+
+		accountCIDRs, err := utils.AccountCIDRs(ctx, r.client)
+		if err != nil {
+			diagnostics.AddError("Failed to list the CIDRs used by the account", err.Error())
+			return
+		}
+		taken = append(taken, accountCIDRs...)
+
+		for _, name := range names {
+			if _, ok := cidrs[name]; ok {
+				continue
+			}
+			cidr, err := utils.NextFreeCIDR(plan.Pool.ValueString(), int(plan.PrefixLength.ValueInt64()), taken)
+			if err != nil {
+				diagnostics.AddError("Failed to allocate a CIDR", err.Error())
+				return
+			}
+			cidrs[name] = cidr
+			taken = append(taken, cidr)
+		}
+	}
+
+	value, d := types.MapValueFrom(ctx, types.StringType, cidrs)
+	diagnostics.Append(d...)
+	plan.CIDRs = value
+}
+
+// keptCidrs returns the blocks of the prior state kept for the names, which are those no exclusion overlaps, and the
+// names whose block is excluded.
+func keptCidrs(prior map[string]string, names []string, exclude []string) (map[string]string, []string) {
+	kept := map[string]string{}
+	var excluded []string
+	for _, name := range names {
+		cidr, ok := prior[name]
+		if !ok {
+			continue
+		}
+		if slices.ContainsFunc(exclude, func(e string) bool { return utils.CIDRsOverlap(e, cidr) }) {
+			excluded = append(excluded, name)
+			continue
+		}
+		kept[name] = cidr
+	}
+	return kept, excluded
+}
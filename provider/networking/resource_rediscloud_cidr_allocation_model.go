@@ -0,0 +1,17 @@
+package networking
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// CidrAllocationModel describes the resource data model of the named blocks allocated from a pool of CIDRs.
+type CidrAllocationModel struct {
+	ID           types.String   `tfsdk:"id"`
+	Pool         types.String   `tfsdk:"pool"`
+	PrefixLength types.Int64    `tfsdk:"prefix_length"`
+	Names        types.Set      `tfsdk:"names"`
+	Exclude      types.Set      `tfsdk:"exclude"`
+	CIDRs        types.Map      `tfsdk:"cidrs"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
+}
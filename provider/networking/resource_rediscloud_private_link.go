@@ -0,0 +1,236 @@
+package networking
+
+import (
+	"context"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/utils"
+)
+
+var (
+	_ resource.Resource                = &privateLinkResource{}
+	_ resource.ResourceWithConfigure   = &privateLinkResource{}
+	_ resource.ResourceWithImportState = &privateLinkResource{}
+	_ resource.ResourceWithIdentity    = &privateLinkResource{}
+)
+
+// privateLinkResource manages the PrivateLink of a Pro subscription, or of an Active-Active subscription's region.
+type privateLinkResource struct {
+	networkingResource
+}
+
+// NewPrivateLinkResource returns a new resource instance for the PrivateLinks of Pro subscriptions.
+func NewPrivateLinkResource() resource.Resource {
+	return &privateLinkResource{networkingResource{deployment: pro}}
+}
+
+// NewActiveActivePrivateLinkResource returns a new resource instance for the PrivateLinks of Active-Active
+// subscriptions.
+func NewActiveActivePrivateLinkResource() resource.Resource {
+	return &privateLinkResource{networkingResource{deployment: activeActive}}
+}
+
+// Metadata returns the resource type name.
+func (r *privateLinkResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + r.deployment.typeName("private_link")
+}
+
+// Schema defines the schema for the resource.
+func (r *privateLinkResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := r.subscriptionAttributes("The RedisCloud ID of the active active subscription region")
+	attributes["share_name"] = requiredString("Name of this PrivateLink share")
+	attributes["resource_configuration_id"] = computedString("ID of the resource configuration to attach to this PrivateLink")
+	attributes["resource_configuration_arn"] = computedString("ARN of the resource configuration attached to this PrivateLink")
+	attributes["share_arn"] = computedString("ARN of the share attached to this Private Link")
+	attributes["connections"] = schema.SetAttribute{
+		Description: "Connections attached to this PrivateLink",
+		ElementType: types.ObjectType{AttrTypes: privateLinkConnectionAttributeTypes},
+		Computed:    true,
+	}
+	attributes["databases"] = schema.SetAttribute{
+		Description: "The databases attached to this PrivateLink",
+		ElementType: types.ObjectType{AttrTypes: privateLinkDatabaseAttributeTypes},
+		Computed:    true,
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Manages a Private Link to " + r.deployment.subscriptionName() + " in your Redis Enterprise Cloud Account.",
+		Attributes:  attributes,
+		Blocks: map[string]schema.Block{
+			"principal": schema.SetNestedBlock{
+				Description: "List of principals attached to this PrivateLink",
+				Validators: []validator.Set{
+					setvalidator.IsRequired(),
+					setvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"principal": schema.StringAttribute{
+							Description: "The principal, such as an AWS account ID or the ARN of an IAM role",
+							Required:    true,
+						},
+						"principal_type": schema.StringAttribute{
+							Description: "The type of the principal",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(regexp.MustCompile("^(aws_account|organization|organization_unit|iam_role|iam_user|service_principal)$"),
+									"must be one of 'aws_account', 'organization', 'organization_unit', 'iam_role', 'iam_user', 'service_principal'"),
+							},
+						},
+						"principal_alias": schema.StringAttribute{
+							Description: "An alias for the principal",
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// identityAttributes are the attributes of the resource identity and ID. A subscription, or an Active-Active
+// subscription's region, has a single PrivateLink.
+func (r *privateLinkResource) identityAttributes() []string {
+	if r.deployment.isActiveActive() {
+		return []string{utils.IdentitySubscriptionId, utils.IdentityRegionId}
+	}
+	return []string{utils.IdentitySubscriptionId}
+}
+
+// IdentitySchema defines the identity schema for the resource.
+func (r *privateLinkResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identitySchema(r.identityAttributes())
+}
+
+// ImportState imports an existing resource.
+func (r *privateLinkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importState(ctx, req, resp, r.identityAttributes())
+}
+
+// Create implements resource creation.
+func (r *privateLinkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan PrivateLinkModel
+	resp.Diagnostics.Append(getModel(ctx, req.Plan, r.regionIdAbsent(types.Int64Type), &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Create(ctx, 30*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	r.createPrivateLink(ctx, &plan, &resp.Diagnostics)
+	if plan.ID.IsUnknown() {
+		return
+	}
+
+	// Set the state, even if the create failed once the PrivateLink exists, so that it is tainted rather than lost.
+	resp.Diagnostics.Append(setModel(ctx, &resp.State, r.regionIdAbsent(types.Int64Type), plan)...)
+	if err := utils.NullUnknownValues(&resp.State); err != nil {
+		resp.Diagnostics.AddError("Failed to save PrivateLink", err.Error())
+		return
+	}
+	setIdentity(ctx, resp.Identity, plan.ID.ValueString(), r.identityAttributes(), &resp.Diagnostics)
+}
+
+// Read implements resource reading.
+func (r *privateLinkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state PrivateLinkModel
+	resp.Diagnostics.Append(getModel(ctx, req.State, r.regionIdAbsent(types.Int64Type), &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Read(ctx, 10*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	removed := r.readPrivateLink(ctx, &state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if removed {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(setModel(ctx, &resp.State, r.regionIdAbsent(types.Int64Type), state)...)
+	setIdentity(ctx, resp.Identity, state.ID.ValueString(), r.identityAttributes(), &resp.Diagnostics)
+}
+
+// Update implements resource update.
+func (r *privateLinkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state PrivateLinkModel
+	resp.Diagnostics.Append(getModel(ctx, req.Plan, r.regionIdAbsent(types.Int64Type), &plan)...)
+	resp.Diagnostics.Append(getModel(ctx, req.State, r.regionIdAbsent(types.Int64Type), &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Update(ctx, 10*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if !plan.Principals.Equal(state.Principals) {
+		r.updatePrincipals(ctx, &plan, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if r.readPrivateLink(ctx, &plan, &resp.Diagnostics) {
+		resp.Diagnostics.AddError("Failed to update PrivateLink", "the PrivateLink no longer exists")
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setModel(ctx, &resp.State, r.regionIdAbsent(types.Int64Type), plan)...)
+}
+
+// Delete implements resource deletion.
+func (r *privateLinkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state PrivateLinkModel
+	resp.Diagnostics.Append(getModel(ctx, req.State, r.regionIdAbsent(types.Int64Type), &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Delete(ctx, 10*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	r.deletePrivateLink(ctx, &state, &resp.Diagnostics)
+}
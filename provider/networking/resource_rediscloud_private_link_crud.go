@@ -0,0 +1,287 @@
+package networking
+
+import (
+	"context"
+	"log"
+	"sort"
+	"strconv"
+
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	pl "github.com/RedisLabs/rediscloud-go-api/service/privatelink"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/utils"
+)
+
+// privateLinkKey identifies the PrivateLink of a subscription, or of an Active-Active subscription's region. The
+// region ID of Pro subscriptions is 0.
+type privateLinkKey struct {
+	subId    int
+	regionId int
+}
+
+// privateLinkKeyFromID reads the key of the PrivateLink from the resource ID.
+func (r *privateLinkResource) privateLinkKeyFromID(id string, diagnostics *diag.Diagnostics) privateLinkKey {
+	ids, err := ParseID(id, r.identityAttributes()...)
+	if err != nil {
+		diagnostics.AddError("Invalid PrivateLink ID", err.Error())
+		return privateLinkKey{}
+	}
+
+	if r.deployment.isActiveActive() {
+		return privateLinkKey{subId: ids[0], regionId: ids[1]}
+	}
+	return privateLinkKey{subId: ids[0]}
+}
+
+// id returns the resource ID of the PrivateLink.
+func (k privateLinkKey) id(d deploymentType) string {
+	if d.isActiveActive() {
+		return buildID(k.subId, k.regionId)
+	}
+	return buildID(k.subId)
+}
+
+// principalsFromPlan returns the configured principals, sorted by principal.
+func principalsFromPlan(ctx context.Context, set types.Set, diagnostics *diag.Diagnostics) []pl.CreatePrivateLinkPrincipal {
+	var models []PrivateLinkPrincipalModel
+	diagnostics.Append(set.ElementsAs(ctx, &models, false)...)
+
+	principals := make([]pl.CreatePrivateLinkPrincipal, 0, len(models))
+	for _, model := range models {
+		principals = append(principals, pl.CreatePrivateLinkPrincipal{
+			Principal:      redis.String(model.Principal.ValueString()),
+			PrincipalType:  redis.String(model.PrincipalType.ValueString()),
+			PrincipalAlias: redis.String(model.PrincipalAlias.ValueString()),
+		})
+	}
+
+	sort.Slice(principals, func(i, j int) bool {
+		return redis.StringValue(principals[i].Principal) < redis.StringValue(principals[j].Principal)
+	})
+
+	return principals
+}
+
+// createPrivateLink implements the Create operation for the PrivateLink resources.
+func (r *privateLinkResource) createPrivateLink(ctx context.Context, plan *PrivateLinkModel, diagnostics *diag.Diagnostics) {
+	subId, err := strconv.Atoi(plan.SubscriptionID.ValueString())
+	if err != nil {
+		diagnostics.AddError("Invalid subscription ID", err.Error())
+		return
+	}
+	key := privateLinkKey{subId: subId, regionId: int(plan.RegionID.ValueInt64())}
+
+	principals := principalsFromPlan(ctx, plan.Principals, diagnostics)
+	if diagnostics.HasError() {
+		return
+	}
+
+	unlock, err := r.deployment.lock(ctx, r.client, key.subId, key.regionId)
+	if err != nil {
+		diagnostics.AddError("Failed to lock subscription", err.Error())
+		return
+	}
+	defer unlock()
+
+	// The PrivateLink is created with its first principal, and the others are added once it's active.
+	first := principals[0]
+	err = createPrivateLink(ctx, r.client, r.deployment, key.subId, key.regionId, pl.CreatePrivateLink{
+		ShareName:      redis.String(plan.ShareName.ValueString()),
+		Principal:      first.Principal,
+		PrincipalType:  first.PrincipalType,
+		PrincipalAlias: first.PrincipalAlias,
+	})
+	if err != nil {
+		diagnostics.AddError("Failed to create PrivateLink", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(key.id(r.deployment))
+
+	if err := waitForPrivateLinkToBeActive(ctx, r.client, r.deployment, key.subId, key.regionId); err != nil {
+		diagnostics.AddError("PrivateLink failed to become active", err.Error())
+		return
+	}
+
+	for _, principal := range principals[1:] {
+		if err := createPrivateLinkPrincipal(ctx, r.client, r.deployment, key.subId, key.regionId, principal); err != nil {
+			diagnostics.AddError("Failed to create PrivateLink principal", err.Error())
+			return
+		}
+	}
+
+	if err := utils.WaitForSubscriptionToBeActive(ctx, key.subId, r.client); err != nil {
+		diagnostics.AddError("Subscription failed to become active", err.Error())
+		return
+	}
+
+	if r.readPrivateLink(ctx, plan, diagnostics) {
+		diagnostics.AddError("Failed to create PrivateLink", "the PrivateLink was not found after its creation")
+	}
+}
+
+// readPrivateLink implements the Read operation for the PrivateLink resources.
+// Returns true if the resource was removed (not found).
+func (r *privateLinkResource) readPrivateLink(ctx context.Context, state *PrivateLinkModel, diagnostics *diag.Diagnostics) bool {
+	key := r.privateLinkKeyFromID(state.ID.ValueString(), diagnostics)
+	if diagnostics.HasError() {
+		return false
+	}
+
+	privateLink, err := getPrivateLink(ctx, r.client, r.deployment, key.subId, key.regionId)
+	if err != nil {
+		if isPrivateLinkNotFound(err) {
+			log.Printf("[DEBUG] PrivateLink %s not found, removing from state", state.ID.ValueString())
+			return true
+		}
+		diagnostics.AddError("Failed to read PrivateLink", err.Error())
+		return false
+	}
+
+	state.SubscriptionID = types.StringValue(strconv.Itoa(key.subId))
+	if r.deployment.isActiveActive() {
+		state.RegionID = types.Int64Value(int64(key.regionId))
+	}
+	state.ShareName = types.StringValue(redis.StringValue(privateLink.ShareName))
+	state.ResourceConfigurationID = types.StringValue(redis.StringValue(privateLink.ResourceConfigurationId))
+	state.ResourceConfigurationArn = types.StringValue(redis.StringValue(privateLink.ResourceConfigurationArn))
+	state.ShareArn = types.StringValue(redis.StringValue(privateLink.ShareArn))
+
+	principals := make([]PrivateLinkPrincipalModel, 0, len(privateLink.Principals))
+	for _, principal := range privateLink.Principals {
+		principals = append(principals, PrivateLinkPrincipalModel{
+			Principal:      types.StringValue(redis.StringValue(principal.Principal)),
+			PrincipalType:  types.StringValue(redis.StringValue(principal.Type)),
+			PrincipalAlias: nullString(redis.StringValue(principal.Alias)),
+		})
+	}
+	var d diag.Diagnostics
+	state.Principals, d = types.SetValueFrom(ctx, types.ObjectType{AttrTypes: privateLinkPrincipalAttributeTypes}, principals)
+	diagnostics.Append(d...)
+
+	connections := make([]PrivateLinkConnectionModel, 0, len(privateLink.Connections))
+	for _, connection := range privateLink.Connections {
+		connections = append(connections, PrivateLinkConnectionModel{
+			AssociationID:   types.StringValue(redis.StringValue(connection.AssociationId)),
+			ConnectionID:    types.StringValue(redis.StringValue(connection.ConnectionId)),
+			ConnectionType:  types.StringValue(redis.StringValue(connection.Type)),
+			OwnerID:         types.StringValue(redis.StringValue(connection.OwnerId)),
+			AssociationDate: types.StringValue(redis.StringValue(connection.AssociationDate)),
+		})
+	}
+	state.Connections, d = types.SetValueFrom(ctx, types.ObjectType{AttrTypes: privateLinkConnectionAttributeTypes}, connections)
+	diagnostics.Append(d...)
+
+	databases := make([]PrivateLinkDatabaseModel, 0, len(privateLink.Databases))
+	for _, db := range privateLink.Databases {
+		databases = append(databases, PrivateLinkDatabaseModel{
+			DatabaseID:           types.Int64Value(int64(redis.IntValue(db.DatabaseId))),
+			Port:                 types.Int64Value(int64(redis.IntValue(db.Port))),
+			ResourceLinkEndpoint: types.StringValue(redis.StringValue(db.ResourceLinkEndpoint)),
+		})
+	}
+	state.Databases, d = types.SetValueFrom(ctx, types.ObjectType{AttrTypes: privateLinkDatabaseAttributeTypes}, databases)
+	diagnostics.Append(d...)
+
+	return false
+}
+
+// updatePrincipals implements the Update operation for the PrivateLink resources, which adds and removes principals
+// until those of the PrivateLink are the configured ones. A principal whose type or alias changed is removed, then
+// added again.
+func (r *privateLinkResource) updatePrincipals(ctx context.Context, plan *PrivateLinkModel, diagnostics *diag.Diagnostics) {
+	key := r.privateLinkKeyFromID(plan.ID.ValueString(), diagnostics)
+	configured := principalsFromPlan(ctx, plan.Principals, diagnostics)
+	if diagnostics.HasError() {
+		return
+	}
+
+	unlock, err := r.deployment.lock(ctx, r.client, key.subId, key.regionId)
+	if err != nil {
+		diagnostics.AddError("Failed to lock subscription", err.Error())
+		return
+	}
+	defer unlock()
+
+	privateLink, err := getPrivateLink(ctx, r.client, r.deployment, key.subId, key.regionId)
+	if err != nil {
+		diagnostics.AddError("Failed to read PrivateLink", err.Error())
+		return
+	}
+
+	existing := map[string]*pl.PrivateLinkPrincipal{}
+	for _, principal := range privateLink.Principals {
+		existing[redis.StringValue(principal.Principal)] = principal
+	}
+	wanted := map[string]pl.CreatePrivateLinkPrincipal{}
+	for _, principal := range configured {
+		wanted[redis.StringValue(principal.Principal)] = principal
+	}
+
+	// New principals are added before the removed ones are deleted, so that the PrivateLink keeps at least one.
+	var changed []pl.CreatePrivateLinkPrincipal
+	for _, principal := range configured {
+		current, ok := existing[redis.StringValue(principal.Principal)]
+		if !ok {
+			if err := createPrivateLinkPrincipal(ctx, r.client, r.deployment, key.subId, key.regionId, principal); err != nil {
+				diagnostics.AddError("Failed to create PrivateLink principal", err.Error())
+				return
+			}
+			continue
+		}
+		if redis.StringValue(current.Type) != redis.StringValue(principal.PrincipalType) ||
+			redis.StringValue(current.Alias) != redis.StringValue(principal.PrincipalAlias) {
+			changed = append(changed, principal)
+		}
+	}
+
+	for _, principal := range privateLink.Principals {
+		if _, ok := wanted[redis.StringValue(principal.Principal)]; ok {
+			continue
+		}
+		if err := deletePrivateLinkPrincipal(ctx, r.client, r.deployment, key.subId, key.regionId, redis.StringValue(principal.Principal)); err != nil {
+			diagnostics.AddError("Failed to delete PrivateLink principal", err.Error())
+			return
+		}
+	}
+
+	for _, principal := range changed {
+		if err := deletePrivateLinkPrincipal(ctx, r.client, r.deployment, key.subId, key.regionId, redis.StringValue(principal.Principal)); err != nil {
+			diagnostics.AddError("Failed to delete PrivateLink principal", err.Error())
+			return
+		}
+		if err := createPrivateLinkPrincipal(ctx, r.client, r.deployment, key.subId, key.regionId, principal); err != nil {
+			diagnostics.AddError("Failed to create PrivateLink principal", err.Error())
+			return
+		}
+	}
+}
+
+// deletePrivateLink implements the Delete operation for the PrivateLink resources.
+func (r *privateLinkResource) deletePrivateLink(ctx context.Context, state *PrivateLinkModel, diagnostics *diag.Diagnostics) {
+	key := r.privateLinkKeyFromID(state.ID.ValueString(), diagnostics)
+	if diagnostics.HasError() {
+		return
+	}
+
+	unlock, err := r.deployment.lock(ctx, r.client, key.subId, key.regionId)
+	if err != nil {
+		diagnostics.AddError("Failed to lock subscription", err.Error())
+		return
+	}
+	defer unlock()
+
+	if err := deletePrivateLink(ctx, r.client, r.deployment, key.subId, key.regionId); err != nil {
+		if isPrivateLinkNotFound(err) {
+			return
+		}
+		diagnostics.AddError("Failed to delete PrivateLink", err.Error())
+		return
+	}
+
+	if err := utils.WaitForSubscriptionToBeActive(ctx, key.subId, r.client); err != nil {
+		diagnostics.AddError("Subscription failed to become active", err.Error())
+	}
+}
@@ -0,0 +1,66 @@
+package networking
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// PrivateLinkModel describes the resource data model of a PrivateLink. Only Active-Active PrivateLinks have a region
+// ID.
+type PrivateLinkModel struct {
+	ID                       types.String   `tfsdk:"id"`
+	SubscriptionID           types.String   `tfsdk:"subscription_id"`
+	RegionID                 types.Int64    `tfsdk:"region_id"`
+	ShareName                types.String   `tfsdk:"share_name"`
+	Principals               types.Set      `tfsdk:"principal"`
+	ResourceConfigurationID  types.String   `tfsdk:"resource_configuration_id"`
+	ResourceConfigurationArn types.String   `tfsdk:"resource_configuration_arn"`
+	ShareArn                 types.String   `tfsdk:"share_arn"`
+	Connections              types.Set      `tfsdk:"connections"`
+	Databases                types.Set      `tfsdk:"databases"`
+	Timeouts                 timeouts.Value `tfsdk:"timeouts"`
+}
+
+// PrivateLinkPrincipalModel describes a principal of a PrivateLink.
+type PrivateLinkPrincipalModel struct {
+	Principal      types.String `tfsdk:"principal"`
+	PrincipalType  types.String `tfsdk:"principal_type"`
+	PrincipalAlias types.String `tfsdk:"principal_alias"`
+}
+
+// PrivateLinkConnectionModel describes a connection of a PrivateLink.
+type PrivateLinkConnectionModel struct {
+	AssociationID   types.String `tfsdk:"association_id"`
+	ConnectionID    types.String `tfsdk:"connection_id"`
+	ConnectionType  types.String `tfsdk:"connection_type"`
+	OwnerID         types.String `tfsdk:"owner_id"`
+	AssociationDate types.String `tfsdk:"association_date"`
+}
+
+// PrivateLinkDatabaseModel describes a database reachable through a PrivateLink.
+type PrivateLinkDatabaseModel struct {
+	DatabaseID           types.Int64  `tfsdk:"database_id"`
+	Port                 types.Int64  `tfsdk:"port"`
+	ResourceLinkEndpoint types.String `tfsdk:"resource_link_endpoint"`
+}
+
+var privateLinkPrincipalAttributeTypes = map[string]attr.Type{
+	"principal":       types.StringType,
+	"principal_type":  types.StringType,
+	"principal_alias": types.StringType,
+}
+
+var privateLinkConnectionAttributeTypes = map[string]attr.Type{
+	"association_id":   types.StringType,
+	"connection_id":    types.StringType,
+	"connection_type":  types.StringType,
+	"owner_id":         types.StringType,
+	"association_date": types.StringType,
+}
+
+var privateLinkDatabaseAttributeTypes = map[string]attr.Type{
+	"database_id":            types.Int64Type,
+	"port":                   types.Int64Type,
+	"resource_link_endpoint": types.StringType,
+}
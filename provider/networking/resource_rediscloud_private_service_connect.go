@@ -0,0 +1,169 @@
+package networking
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/utils"
+)
+
+var (
+	_ resource.Resource                = &privateServiceConnectResource{}
+	_ resource.ResourceWithConfigure   = &privateServiceConnectResource{}
+	_ resource.ResourceWithImportState = &privateServiceConnectResource{}
+	_ resource.ResourceWithIdentity    = &privateServiceConnectResource{}
+)
+
+// privateServiceConnectResource manages the Private Service Connect service of a Pro subscription, or of an
+// Active-Active subscription's region.
+type privateServiceConnectResource struct {
+	networkingResource
+}
+
+// NewPrivateServiceConnectResource returns a new resource instance for the Private Service Connect services of Pro
+// subscriptions.
+func NewPrivateServiceConnectResource() resource.Resource {
+	return &privateServiceConnectResource{networkingResource{deployment: pro}}
+}
+
+// NewActiveActivePrivateServiceConnectResource returns a new resource instance for the Private Service Connect
+// services of Active-Active subscriptions.
+func NewActiveActivePrivateServiceConnectResource() resource.Resource {
+	return &privateServiceConnectResource{networkingResource{deployment: activeActive}}
+}
+
+// Metadata returns the resource type name.
+func (r *privateServiceConnectResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + r.deployment.typeName("private_service_connect")
+}
+
+// Schema defines the schema for the resource.
+func (r *privateServiceConnectResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := r.subscriptionAttributes("The ID of the GCP region")
+	attributes["private_service_connect_service_id"] = schema.Int64Attribute{
+		Description: "The ID of the Private Service Connect",
+		Computed:    true,
+		PlanModifiers: []planmodifier.Int64{
+			int64planmodifier.UseStateForUnknown(),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Manages a Private Service Connect to " + r.deployment.subscriptionName() + " in your Redis Enterprise Cloud Account.",
+		Attributes:  attributes,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// IdentitySchema defines the identity schema for the resource.
+func (r *privateServiceConnectResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identitySchema(r.privateServiceConnectIdentityAttributes(false))
+}
+
+// ImportState imports an existing resource.
+func (r *privateServiceConnectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importState(ctx, req, resp, r.privateServiceConnectIdentityAttributes(false))
+}
+
+// Create implements resource creation.
+func (r *privateServiceConnectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan PrivateServiceConnectModel
+	resp.Diagnostics.Append(getModel(ctx, req.Plan, r.regionIdAbsent(types.Int64Type), &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Create(ctx, 30*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	r.createService(ctx, &plan, &resp.Diagnostics)
+	if plan.ID.IsUnknown() {
+		return
+	}
+
+	// Set the state, even if the create failed once the service exists, so that it is tainted rather than lost.
+	resp.Diagnostics.Append(setModel(ctx, &resp.State, r.regionIdAbsent(types.Int64Type), plan)...)
+	if err := utils.NullUnknownValues(&resp.State); err != nil {
+		resp.Diagnostics.AddError("Failed to save Private Service Connect", err.Error())
+		return
+	}
+	setIdentity(ctx, resp.Identity, plan.ID.ValueString(), r.privateServiceConnectIdentityAttributes(false), &resp.Diagnostics)
+}
+
+// Read implements resource reading.
+func (r *privateServiceConnectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state PrivateServiceConnectModel
+	resp.Diagnostics.Append(getModel(ctx, req.State, r.regionIdAbsent(types.Int64Type), &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Read(ctx, 10*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	removed := r.readService(ctx, &state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if removed {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(setModel(ctx, &resp.State, r.regionIdAbsent(types.Int64Type), state)...)
+	setIdentity(ctx, resp.Identity, state.ID.ValueString(), r.privateServiceConnectIdentityAttributes(false), &resp.Diagnostics)
+}
+
+// Update only records changes to the timeouts, as every other change replaces the service.
+func (r *privateServiceConnectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan PrivateServiceConnectModel
+	resp.Diagnostics.Append(getModel(ctx, req.Plan, r.regionIdAbsent(types.Int64Type), &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setModel(ctx, &resp.State, r.regionIdAbsent(types.Int64Type), plan)...)
+}
+
+// Delete implements resource deletion.
+func (r *privateServiceConnectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state PrivateServiceConnectModel
+	resp.Diagnostics.Append(getModel(ctx, req.State, r.regionIdAbsent(types.Int64Type), &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Delete(ctx, 10*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	r.deleteService(ctx, &state, &resp.Diagnostics)
+}
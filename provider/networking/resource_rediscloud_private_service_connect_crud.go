@@ -0,0 +1,100 @@
+package networking
+
+import (
+	"context"
+	"log"
+	"strconv"
+
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/utils"
+)
+
+// createService implements the Create operation for the Private Service Connect resources.
+func (r *privateServiceConnectResource) createService(ctx context.Context, plan *PrivateServiceConnectModel, diagnostics *diag.Diagnostics) {
+	key := r.privateServiceConnectKeyFromPlan(plan.SubscriptionID, plan.RegionID, diagnostics)
+	if diagnostics.HasError() {
+		return
+	}
+
+	unlock, err := r.deployment.lock(ctx, r.client, key.subId, key.regionId)
+	if err != nil {
+		diagnostics.AddError("Failed to lock subscription", err.Error())
+		return
+	}
+	defer unlock()
+
+	key.serviceId, err = createPrivateServiceConnectService(ctx, r.client, r.deployment, key.subId, key.regionId)
+	if err != nil {
+		diagnostics.AddError("Failed to create Private Service Connect", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(key.id(r.deployment, false))
+
+	if err := waitForPrivateServiceConnectServiceToBeActive(ctx, r.client, r.deployment, key.subId, key.regionId); err != nil {
+		diagnostics.AddError("Private Service Connect failed to become active", err.Error())
+		return
+	}
+
+	if err := utils.WaitForSubscriptionToBeActive(ctx, key.subId, r.client); err != nil {
+		diagnostics.AddError("Subscription failed to become active", err.Error())
+		return
+	}
+
+	r.readService(ctx, plan, diagnostics)
+}
+
+// readService implements the Read operation for the Private Service Connect resources.
+// Returns true if the resource was removed (not found).
+func (r *privateServiceConnectResource) readService(ctx context.Context, state *PrivateServiceConnectModel, diagnostics *diag.Diagnostics) bool {
+	key := r.privateServiceConnectKeyFromID(state.ID.ValueString(), false, diagnostics)
+	if diagnostics.HasError() {
+		return false
+	}
+
+	service, err := getPrivateServiceConnectService(ctx, r.client, r.deployment, key.subId, key.regionId)
+	if err != nil {
+		if isPrivateServiceConnectNotFound(err) {
+			log.Printf("[DEBUG] Private Service Connect %s not found, removing from state", state.ID.ValueString())
+			return true
+		}
+		diagnostics.AddError("Failed to read Private Service Connect", err.Error())
+		return false
+	}
+
+	state.SubscriptionID = types.StringValue(strconv.Itoa(key.subId))
+	state.RegionID = key.regionIdValue(r.deployment)
+	state.PrivateServiceConnectServiceID = types.Int64Value(int64(redis.IntValue(service.ID)))
+
+	return false
+}
+
+// deleteService implements the Delete operation for the Private Service Connect resources.
+func (r *privateServiceConnectResource) deleteService(ctx context.Context, state *PrivateServiceConnectModel, diagnostics *diag.Diagnostics) {
+	key := r.privateServiceConnectKeyFromID(state.ID.ValueString(), false, diagnostics)
+	if diagnostics.HasError() {
+		return
+	}
+
+	unlock, err := r.deployment.lock(ctx, r.client, key.subId, key.regionId)
+	if err != nil {
+		diagnostics.AddError("Failed to lock subscription", err.Error())
+		return
+	}
+	defer unlock()
+
+	if err := deletePrivateServiceConnectService(ctx, r.client, r.deployment, key.subId, key.regionId); err != nil {
+		if isPrivateServiceConnectNotFound(err) {
+			return
+		}
+		diagnostics.AddError("Failed to delete Private Service Connect", err.Error())
+		return
+	}
+
+	if err := utils.WaitForSubscriptionToBeActive(ctx, key.subId, r.client); err != nil {
+		diagnostics.AddError("Subscription failed to become active", err.Error())
+	}
+}
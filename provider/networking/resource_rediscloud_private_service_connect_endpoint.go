@@ -0,0 +1,183 @@
+package networking
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/utils"
+)
+
+var (
+	_ resource.Resource                = &privateServiceConnectEndpointResource{}
+	_ resource.ResourceWithConfigure   = &privateServiceConnectEndpointResource{}
+	_ resource.ResourceWithImportState = &privateServiceConnectEndpointResource{}
+	_ resource.ResourceWithIdentity    = &privateServiceConnectEndpointResource{}
+)
+
+// privateServiceConnectEndpointResource manages an endpoint of the Private Service Connect service of a Pro
+// subscription, or of an Active-Active subscription's region.
+type privateServiceConnectEndpointResource struct {
+	networkingResource
+}
+
+// NewPrivateServiceConnectEndpointResource returns a new resource instance for the Private Service Connect endpoints
+// of Pro subscriptions.
+func NewPrivateServiceConnectEndpointResource() resource.Resource {
+	return &privateServiceConnectEndpointResource{networkingResource{deployment: pro}}
+}
+
+// NewActiveActivePrivateServiceConnectEndpointResource returns a new resource instance for the Private Service Connect
+// endpoints of Active-Active subscriptions.
+func NewActiveActivePrivateServiceConnectEndpointResource() resource.Resource {
+	return &privateServiceConnectEndpointResource{networkingResource{deployment: activeActive}}
+}
+
+// Metadata returns the resource type name.
+func (r *privateServiceConnectEndpointResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + r.deployment.typeName("private_service_connect_endpoint")
+}
+
+// Schema defines the schema for the resource.
+func (r *privateServiceConnectEndpointResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := r.subscriptionAttributes("The ID of the GCP region")
+	attributes["private_service_connect_service_id"] = requiredInt64("The ID of the Private Service Connect")
+	attributes["private_service_connect_endpoint_id"] = schema.Int64Attribute{
+		Description: "The ID of the Private Service Connect Endpoint",
+		Computed:    true,
+		PlanModifiers: []planmodifier.Int64{
+			int64planmodifier.UseStateForUnknown(),
+		},
+	}
+	attributes["gcp_project_id"] = requiredString("The Google Cloud Project ID")
+	attributes["gcp_vpc_name"] = requiredString("The GCP VPC Network name")
+	attributes["gcp_vpc_subnet_name"] = requiredString("The GCP Subnet name")
+	attributes["endpoint_connection_name"] = requiredString("The endpoint connection name prefix. This prefix that will be used to create the Private Service Connect endpoint in your Google Cloud account")
+	attributes["service_attachments"] = schema.ListAttribute{
+		Description: "The service attachments that were created for the Private Service Connect endpoint",
+		ElementType: types.ObjectType{AttrTypes: serviceAttachmentAttributeTypes},
+		Computed:    true,
+		PlanModifiers: []planmodifier.List{
+			listplanmodifier.UseStateForUnknown(),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Manages a Private Service Connect Endpoint to " + r.deployment.subscriptionName() + " in your Redis Enterprise Cloud Account.",
+		Attributes:  attributes,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// IdentitySchema defines the identity schema for the resource.
+func (r *privateServiceConnectEndpointResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identitySchema(r.privateServiceConnectIdentityAttributes(true))
+}
+
+// ImportState imports an existing resource.
+func (r *privateServiceConnectEndpointResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importState(ctx, req, resp, r.privateServiceConnectIdentityAttributes(true))
+}
+
+// Create implements resource creation.
+func (r *privateServiceConnectEndpointResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan PrivateServiceConnectEndpointModel
+	resp.Diagnostics.Append(getModel(ctx, req.Plan, r.regionIdAbsent(types.Int64Type), &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Create(ctx, 10*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	r.createEndpoint(ctx, &plan, &resp.Diagnostics)
+	if plan.ID.IsUnknown() {
+		return
+	}
+
+	// Set the state, even if the create failed once the endpoint exists, so that it is tainted rather than lost.
+	resp.Diagnostics.Append(setModel(ctx, &resp.State, r.regionIdAbsent(types.Int64Type), plan)...)
+	if err := utils.NullUnknownValues(&resp.State); err != nil {
+		resp.Diagnostics.AddError("Failed to save Private Service Connect endpoint", err.Error())
+		return
+	}
+	setIdentity(ctx, resp.Identity, plan.ID.ValueString(), r.privateServiceConnectIdentityAttributes(true), &resp.Diagnostics)
+}
+
+// Read implements resource reading.
+func (r *privateServiceConnectEndpointResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state PrivateServiceConnectEndpointModel
+	resp.Diagnostics.Append(getModel(ctx, req.State, r.regionIdAbsent(types.Int64Type), &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Read(ctx, 10*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	removed := r.readEndpoint(ctx, &state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if removed {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(setModel(ctx, &resp.State, r.regionIdAbsent(types.Int64Type), state)...)
+	setIdentity(ctx, resp.Identity, state.ID.ValueString(), r.privateServiceConnectIdentityAttributes(true), &resp.Diagnostics)
+}
+
+// Update only records changes to the timeouts, as every other change replaces the endpoint.
+func (r *privateServiceConnectEndpointResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan PrivateServiceConnectEndpointModel
+	resp.Diagnostics.Append(getModel(ctx, req.Plan, r.regionIdAbsent(types.Int64Type), &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setModel(ctx, &resp.State, r.regionIdAbsent(types.Int64Type), plan)...)
+}
+
+// Delete implements resource deletion.
+func (r *privateServiceConnectEndpointResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state PrivateServiceConnectEndpointModel
+	resp.Diagnostics.Append(getModel(ctx, req.State, r.regionIdAbsent(types.Int64Type), &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Delete(ctx, 10*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	r.deleteEndpoint(ctx, &state, &resp.Diagnostics)
+}
@@ -0,0 +1,150 @@
+package networking
+
+import (
+	"context"
+	"time"
+
+	"github.com/RedisLabs/rediscloud-go-api/service/psc"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource              = &privateServiceConnectEndpointAccepterResource{}
+	_ resource.ResourceWithConfigure = &privateServiceConnectEndpointAccepterResource{}
+)
+
+// privateServiceConnectEndpointAccepterResource accepts or rejects an endpoint of the Private Service Connect service
+// of a Pro subscription, or of an Active-Active subscription's region.
+type privateServiceConnectEndpointAccepterResource struct {
+	networkingResource
+}
+
+// NewPrivateServiceConnectEndpointAccepterResource returns a new resource instance for accepting the Private Service
+// Connect endpoints of Pro subscriptions.
+func NewPrivateServiceConnectEndpointAccepterResource() resource.Resource {
+	return &privateServiceConnectEndpointAccepterResource{networkingResource{deployment: pro}}
+}
+
+// NewActiveActivePrivateServiceConnectEndpointAccepterResource returns a new resource instance for accepting the
+// Private Service Connect endpoints of Active-Active subscriptions.
+func NewActiveActivePrivateServiceConnectEndpointAccepterResource() resource.Resource {
+	return &privateServiceConnectEndpointAccepterResource{networkingResource{deployment: activeActive}}
+}
+
+// Metadata returns the resource type name.
+func (r *privateServiceConnectEndpointAccepterResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + r.deployment.typeName("private_service_connect_endpoint_accepter")
+}
+
+// Schema defines the schema for the resource.
+func (r *privateServiceConnectEndpointAccepterResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := r.subscriptionAttributes("The ID of the GCP region")
+	attributes["private_service_connect_service_id"] = requiredInt64("The ID of the Private Service Connect")
+	attributes["private_service_connect_endpoint_id"] = requiredInt64("The ID of the Private Service Connect Endpoint")
+	attributes["action"] = schema.StringAttribute{
+		Description: "Accept or reject the endpoint",
+		Required:    true,
+		Validators: []validator.String{
+			stringvalidator.OneOf(psc.EndpointActionAccept, psc.EndpointActionReject),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Manages the state of Private Service Connect Endpoint to " + r.deployment.subscriptionName() + " in your Redis Enterprise Cloud Account.",
+		Attributes:  attributes,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Create implements resource creation.
+func (r *privateServiceConnectEndpointAccepterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan PrivateServiceConnectEndpointAccepterModel
+	resp.Diagnostics.Append(getModel(ctx, req.Plan, r.regionIdAbsent(types.Int64Type), &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Create(ctx, 10*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	r.applyAction(ctx, &plan, &resp.Diagnostics)
+	if plan.ID.IsUnknown() {
+		return
+	}
+
+	resp.Diagnostics.Append(setModel(ctx, &resp.State, r.regionIdAbsent(types.Int64Type), plan)...)
+}
+
+// Read implements resource reading.
+func (r *privateServiceConnectEndpointAccepterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state PrivateServiceConnectEndpointAccepterModel
+	resp.Diagnostics.Append(getModel(ctx, req.State, r.regionIdAbsent(types.Int64Type), &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Read(ctx, 10*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	removed := r.readAccepter(ctx, &state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if removed {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(setModel(ctx, &resp.State, r.regionIdAbsent(types.Int64Type), state)...)
+}
+
+// Update implements resource update, which applies the new action just as a create does.
+func (r *privateServiceConnectEndpointAccepterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan PrivateServiceConnectEndpointAccepterModel
+	resp.Diagnostics.Append(getModel(ctx, req.Plan, r.regionIdAbsent(types.Int64Type), &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Update(ctx, 10*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	r.applyAction(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setModel(ctx, &resp.State, r.regionIdAbsent(types.Int64Type), plan)...)
+}
+
+// Delete only removes the resource from the state, as the endpoint is managed elsewhere.
+func (r *privateServiceConnectEndpointAccepterResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
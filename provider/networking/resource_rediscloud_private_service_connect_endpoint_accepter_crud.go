@@ -0,0 +1,113 @@
+package networking
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/RedisLabs/rediscloud-go-api/service/psc"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// applyAction implements the Create and Update operations for the Private Service Connect endpoint accepter
+// resources, which both accept or reject the endpoint.
+func (r *privateServiceConnectEndpointAccepterResource) applyAction(ctx context.Context, plan *PrivateServiceConnectEndpointAccepterModel, diagnostics *diag.Diagnostics) {
+	key := r.privateServiceConnectKeyFromPlan(plan.SubscriptionID, plan.RegionID, diagnostics)
+	if diagnostics.HasError() {
+		return
+	}
+	key.serviceId = int(plan.PrivateServiceConnectServiceID.ValueInt64())
+	key.endpointId = int(plan.PrivateServiceConnectEndpointID.ValueInt64())
+	action := plan.Action.ValueString()
+
+	unlock, err := r.deployment.rlock(ctx, r.client, key.subId, key.regionId)
+	if err != nil {
+		diagnostics.AddError("Failed to lock subscription", err.Error())
+		return
+	}
+	defer unlock()
+
+	endpoint, err := findPrivateServiceConnectEndpoint(ctx, r.client, r.deployment, key.subId, key.regionId, key.serviceId, key.endpointId)
+	if err != nil {
+		diagnostics.AddError("Failed to find Private Service Connect endpoint", err.Error())
+		return
+	}
+	if endpoint == nil {
+		diagnostics.AddError("Failed to find Private Service Connect endpoint", fmt.Sprintf("endpoint with id %d not found", key.endpointId))
+		return
+	}
+	if endpoint.Status == nil {
+		diagnostics.AddError("Failed to find Private Service Connect endpoint", fmt.Sprintf("endpoint with id %d has no status", key.endpointId))
+		return
+	}
+
+	status := redis.StringValue(endpoint.Status)
+	if (status == psc.EndpointStatusActive && action == psc.EndpointActionAccept) ||
+		(status == psc.EndpointStatusRejected && action == psc.EndpointActionReject) {
+		plan.ID = types.StringValue(key.id(r.deployment, true))
+		return
+	}
+
+	if status == psc.EndpointStatusInitialized || status == psc.EndpointStatusProcessing {
+		err := waitForPrivateServiceConnectEndpointStatus(ctx, r.client, r.deployment, key.subId, key.regionId, key.serviceId, key.endpointId,
+			psc.EndpointStatusPending, psc.EndpointStatusInitialized, psc.EndpointStatusProcessing)
+		if err != nil {
+			diagnostics.AddError("Private Service Connect endpoint failed to become pending", err.Error())
+			return
+		}
+	}
+
+	plan.ID = types.StringValue(key.id(r.deployment, true))
+
+	if err := updatePrivateServiceConnectEndpoint(ctx, r.client, r.deployment, key.subId, key.regionId, key.serviceId, key.endpointId, action); err != nil {
+		diagnostics.AddError("Failed to update Private Service Connect endpoint", err.Error())
+		return
+	}
+
+	if action == psc.EndpointActionAccept {
+		err = waitForPrivateServiceConnectEndpointStatus(ctx, r.client, r.deployment, key.subId, key.regionId, key.serviceId, key.endpointId,
+			psc.EndpointStatusActive, psc.EndpointStatusPending, psc.EndpointStatusAcceptPending)
+	} else {
+		err = waitForPrivateServiceConnectEndpointStatus(ctx, r.client, r.deployment, key.subId, key.regionId, key.serviceId, key.endpointId,
+			psc.EndpointStatusRejected, psc.EndpointStatusPending, psc.EndpointStatusRejectPending)
+	}
+	if err != nil {
+		diagnostics.AddError("Private Service Connect endpoint failed to be "+action+"ed", err.Error())
+		return
+	}
+
+	r.readAccepter(ctx, plan, diagnostics)
+}
+
+// readAccepter implements the Read operation for the Private Service Connect endpoint accepter resources.
+// Returns true if the resource was removed (not found).
+func (r *privateServiceConnectEndpointAccepterResource) readAccepter(ctx context.Context, state *PrivateServiceConnectEndpointAccepterModel, diagnostics *diag.Diagnostics) bool {
+	key := r.privateServiceConnectKeyFromID(state.ID.ValueString(), true, diagnostics)
+	if diagnostics.HasError() {
+		return false
+	}
+
+	endpoint, err := findPrivateServiceConnectEndpoint(ctx, r.client, r.deployment, key.subId, key.regionId, key.serviceId, key.endpointId)
+	if err != nil {
+		if isPrivateServiceConnectNotFound(err) {
+			log.Printf("[DEBUG] Private Service Connect %s not found, removing endpoint accepter from state", state.ID.ValueString())
+			return true
+		}
+		diagnostics.AddError("Failed to read Private Service Connect endpoint", err.Error())
+		return false
+	}
+	if endpoint == nil {
+		log.Printf("[DEBUG] Private Service Connect endpoint %s not found, removing accepter from state", state.ID.ValueString())
+		return true
+	}
+
+	state.SubscriptionID = types.StringValue(strconv.Itoa(key.subId))
+	state.RegionID = key.regionIdValue(r.deployment)
+	state.PrivateServiceConnectServiceID = types.Int64Value(int64(key.serviceId))
+	state.PrivateServiceConnectEndpointID = types.Int64Value(int64(redis.IntValue(endpoint.ID)))
+
+	return false
+}
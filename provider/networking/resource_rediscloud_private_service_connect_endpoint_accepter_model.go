@@ -0,0 +1,18 @@
+package networking
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// PrivateServiceConnectEndpointAccepterModel describes the resource data model of the acceptance or rejection of a
+// Private Service Connect endpoint. Only Active-Active endpoints have a region ID.
+type PrivateServiceConnectEndpointAccepterModel struct {
+	ID                              types.String   `tfsdk:"id"`
+	SubscriptionID                  types.String   `tfsdk:"subscription_id"`
+	RegionID                        types.Int64    `tfsdk:"region_id"`
+	PrivateServiceConnectServiceID  types.Int64    `tfsdk:"private_service_connect_service_id"`
+	PrivateServiceConnectEndpointID types.Int64    `tfsdk:"private_service_connect_endpoint_id"`
+	Action                          types.String   `tfsdk:"action"`
+	Timeouts                        timeouts.Value `tfsdk:"timeouts"`
+}
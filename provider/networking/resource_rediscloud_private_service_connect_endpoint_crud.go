@@ -0,0 +1,143 @@
+package networking
+
+import (
+	"context"
+	"log"
+	"strconv"
+
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/RedisLabs/rediscloud-go-api/service/psc"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/utils"
+)
+
+// createEndpoint implements the Create operation for the Private Service Connect endpoint resources.
+func (r *privateServiceConnectEndpointResource) createEndpoint(ctx context.Context, plan *PrivateServiceConnectEndpointModel, diagnostics *diag.Diagnostics) {
+	key := r.privateServiceConnectKeyFromPlan(plan.SubscriptionID, plan.RegionID, diagnostics)
+	if diagnostics.HasError() {
+		return
+	}
+	key.serviceId = int(plan.PrivateServiceConnectServiceID.ValueInt64())
+
+	unlock, err := r.deployment.lock(ctx, r.client, key.subId, key.regionId)
+	if err != nil {
+		diagnostics.AddError("Failed to lock subscription", err.Error())
+		return
+	}
+	defer unlock()
+
+	key.endpointId, err = createPrivateServiceConnectEndpoint(ctx, r.client, r.deployment, key.subId, key.regionId, key.serviceId, psc.CreatePrivateServiceConnectEndpoint{
+		GCPProjectID:           redis.String(plan.GcpProjectID.ValueString()),
+		GCPVPCName:             redis.String(plan.GcpVpcName.ValueString()),
+		GCPVPCSubnetName:       redis.String(plan.GcpVpcSubnetName.ValueString()),
+		EndpointConnectionName: redis.String(plan.EndpointConnectionName.ValueString()),
+	})
+	if err != nil {
+		diagnostics.AddError("Failed to create Private Service Connect endpoint", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(key.id(r.deployment, true))
+
+	if err := utils.WaitForSubscriptionToBeActive(ctx, key.subId, r.client); err != nil {
+		diagnostics.AddError("Subscription failed to become active", err.Error())
+		return
+	}
+
+	if r.readEndpoint(ctx, plan, diagnostics) {
+		diagnostics.AddError("Failed to create Private Service Connect endpoint", "the endpoint was not found after its creation")
+	}
+}
+
+// readEndpoint implements the Read operation for the Private Service Connect endpoint resources.
+// Returns true if the resource was removed (not found).
+func (r *privateServiceConnectEndpointResource) readEndpoint(ctx context.Context, state *PrivateServiceConnectEndpointModel, diagnostics *diag.Diagnostics) bool {
+	key := r.privateServiceConnectKeyFromID(state.ID.ValueString(), true, diagnostics)
+	if diagnostics.HasError() {
+		return false
+	}
+
+	endpoint, err := findPrivateServiceConnectEndpoint(ctx, r.client, r.deployment, key.subId, key.regionId, key.serviceId, key.endpointId)
+	if err != nil {
+		if isPrivateServiceConnectNotFound(err) {
+			log.Printf("[DEBUG] Private Service Connect %s not found, removing endpoint from state", state.ID.ValueString())
+			return true
+		}
+		diagnostics.AddError("Failed to read Private Service Connect endpoint", err.Error())
+		return false
+	}
+	if endpoint == nil {
+		log.Printf("[DEBUG] Private Service Connect endpoint %s not found, removing from state", state.ID.ValueString())
+		return true
+	}
+
+	// Rejected and deleted endpoints no longer have creation scripts.
+	var serviceAttachments []psc.TerraformGCPServiceAttachment
+	status := redis.StringValue(endpoint.Status)
+	if status != psc.EndpointStatusRejected && status != psc.EndpointStatusDeleted {
+		serviceAttachments, err = getPrivateServiceConnectEndpointServiceAttachments(ctx, r.client, r.deployment, key.subId, key.regionId, key.serviceId, key.endpointId)
+		if err != nil {
+			if isPrivateServiceConnectNotFound(err) {
+				log.Printf("[DEBUG] Private Service Connect %s not found, removing endpoint from state", state.ID.ValueString())
+				return true
+			}
+			diagnostics.AddError("Failed to read Private Service Connect endpoint", err.Error())
+			return false
+		}
+	}
+
+	state.SubscriptionID = types.StringValue(strconv.Itoa(key.subId))
+	state.RegionID = key.regionIdValue(r.deployment)
+	state.PrivateServiceConnectServiceID = types.Int64Value(int64(key.serviceId))
+	state.PrivateServiceConnectEndpointID = types.Int64Value(int64(redis.IntValue(endpoint.ID)))
+	state.GcpProjectID = types.StringValue(redis.StringValue(endpoint.GCPProjectID))
+	state.GcpVpcName = types.StringValue(redis.StringValue(endpoint.GCPVPCName))
+	state.GcpVpcSubnetName = types.StringValue(redis.StringValue(endpoint.GCPVPCSubnetName))
+	state.EndpointConnectionName = types.StringValue(redis.StringValue(endpoint.EndpointConnectionName))
+	state.ServiceAttachments = flattenServiceAttachments(ctx, serviceAttachments, diagnostics)
+
+	return false
+}
+
+// deleteEndpoint implements the Delete operation for the Private Service Connect endpoint resources.
+func (r *privateServiceConnectEndpointResource) deleteEndpoint(ctx context.Context, state *PrivateServiceConnectEndpointModel, diagnostics *diag.Diagnostics) {
+	key := r.privateServiceConnectKeyFromID(state.ID.ValueString(), true, diagnostics)
+	if diagnostics.HasError() {
+		return
+	}
+
+	unlock, err := r.deployment.lock(ctx, r.client, key.subId, key.regionId)
+	if err != nil {
+		diagnostics.AddError("Failed to lock subscription", err.Error())
+		return
+	}
+	defer unlock()
+
+	endpoint, err := findPrivateServiceConnectEndpoint(ctx, r.client, r.deployment, key.subId, key.regionId, key.serviceId, key.endpointId)
+	if err != nil {
+		if isPrivateServiceConnectNotFound(err) {
+			return
+		}
+		diagnostics.AddError("Failed to delete Private Service Connect endpoint", err.Error())
+		return
+	}
+	if endpoint == nil {
+		return
+	}
+
+	// It's only possible to delete an endpoint in initialized status.
+	if redis.StringValue(endpoint.Status) == psc.EndpointStatusInitialized {
+		if err := deletePrivateServiceConnectEndpoint(ctx, r.client, r.deployment, key.subId, key.regionId, key.serviceId, key.endpointId); err != nil {
+			diagnostics.AddError("Failed to delete Private Service Connect endpoint", err.Error())
+		}
+		return
+	}
+
+	// Endpoints will be automatically removed once related GCP resources are removed. So we will wait for this to
+	// happen, but we can't check the GCP resources from this provider.
+	if err := waitForPrivateServiceConnectEndpointToDisappear(ctx, r.client, r.deployment, key.subId, key.regionId, key.serviceId, key.endpointId); err != nil {
+		diagnostics.AddError("Private Service Connect endpoint failed to be removed", err.Error())
+	}
+}
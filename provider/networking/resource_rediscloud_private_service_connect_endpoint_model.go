@@ -0,0 +1,22 @@
+package networking
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// PrivateServiceConnectEndpointModel describes the resource data model of a Private Service Connect endpoint. Only
+// Active-Active endpoints have a region ID.
+type PrivateServiceConnectEndpointModel struct {
+	ID                              types.String   `tfsdk:"id"`
+	SubscriptionID                  types.String   `tfsdk:"subscription_id"`
+	RegionID                        types.Int64    `tfsdk:"region_id"`
+	PrivateServiceConnectServiceID  types.Int64    `tfsdk:"private_service_connect_service_id"`
+	PrivateServiceConnectEndpointID types.Int64    `tfsdk:"private_service_connect_endpoint_id"`
+	GcpProjectID                    types.String   `tfsdk:"gcp_project_id"`
+	GcpVpcName                      types.String   `tfsdk:"gcp_vpc_name"`
+	GcpVpcSubnetName                types.String   `tfsdk:"gcp_vpc_subnet_name"`
+	EndpointConnectionName          types.String   `tfsdk:"endpoint_connection_name"`
+	ServiceAttachments              types.List     `tfsdk:"service_attachments"`
+	Timeouts                        timeouts.Value `tfsdk:"timeouts"`
+}
@@ -0,0 +1,16 @@
+package networking
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// PrivateServiceConnectModel describes the resource data model of a Private Service Connect service. Only
+// Active-Active services have a region ID.
+type PrivateServiceConnectModel struct {
+	ID                             types.String   `tfsdk:"id"`
+	SubscriptionID                 types.String   `tfsdk:"subscription_id"`
+	RegionID                       types.Int64    `tfsdk:"region_id"`
+	PrivateServiceConnectServiceID types.Int64    `tfsdk:"private_service_connect_service_id"`
+	Timeouts                       timeouts.Value `tfsdk:"timeouts"`
+}
@@ -0,0 +1,300 @@
+package networking
+
+import (
+	"context"
+	"regexp"
+	"time"
+
+	"github.com/RedisLabs/rediscloud-go-api/service/cloud_accounts"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/utils"
+)
+
+var (
+	_ resource.Resource                = &subscriptionPeeringResource{}
+	_ resource.ResourceWithConfigure   = &subscriptionPeeringResource{}
+	_ resource.ResourceWithImportState = &subscriptionPeeringResource{}
+	_ resource.ResourceWithIdentity    = &subscriptionPeeringResource{}
+	_ resource.ResourceWithModifyPlan  = &subscriptionPeeringResource{}
+)
+
+// subscriptionPeeringResource manages a VPC peering of a Pro or Active-Active subscription.
+type subscriptionPeeringResource struct {
+	networkingResource
+}
+
+// NewSubscriptionPeeringResource returns a new resource instance for the VPC peerings of Pro subscriptions.
+func NewSubscriptionPeeringResource() resource.Resource {
+	return &subscriptionPeeringResource{networkingResource{deployment: pro}}
+}
+
+// NewActiveActiveSubscriptionPeeringResource returns a new resource instance for the VPC peerings of Active-Active
+// subscriptions.
+func NewActiveActiveSubscriptionPeeringResource() resource.Resource {
+	return &subscriptionPeeringResource{networkingResource{deployment: activeActive}}
+}
+
+// Metadata returns the resource type name.
+func (r *subscriptionPeeringResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + r.deployment.typeName("subscription_peering")
+}
+
+// optionalString is an attribute of the peering which may be configured, and is otherwise read from the peering.
+func optionalString(description string, validators ...validator.String) schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: description,
+		Optional:    true,
+		Computed:    true,
+		Validators:  validators,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+}
+
+// computedString is an attribute of the peering which is read from the peering.
+func computedString(description string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: description,
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+// Schema defines the schema for the resource.
+func (r *subscriptionPeeringResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"id": idAttribute(),
+		"subscription_id": schema.StringAttribute{
+			Description: "A valid subscription predefined in the current account",
+			Required:    true,
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(regexp.MustCompile(`^\d+$`), "must be a number"),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"provider_name": schema.StringAttribute{
+			Description: "The cloud provider to use with the vpc peering, (either `AWS` or `GCP`)",
+			Optional:    true,
+			Computed:    true,
+			Default:     stringdefault.StaticString("AWS"),
+			Validators: []validator.String{
+				stringvalidator.OneOf(cloud_accounts.ProviderValues()...),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"aws_account_id": optionalString("AWS account id that the VPC to be peered lives in"),
+		"vpc_id":         optionalString("Identifier of the VPC to be peered"),
+		"vpc_cidr": optionalString("CIDR range of the VPC to be peered",
+			utils.CIDRValidator(),
+			stringvalidator.ConflictsWith(path.MatchRoot("vpc_cidrs")),
+		),
+		"vpc_cidrs": schema.SetAttribute{
+			Description: "CIDR ranges of the VPC to be peered",
+			ElementType: types.StringType,
+			Optional:    true,
+			Computed:    true,
+			Validators: []validator.Set{
+				setvalidator.ValueStringsAre(utils.CIDRValidator()),
+				setvalidator.ConflictsWith(path.MatchRoot("vpc_cidr")),
+			},
+			PlanModifiers: []planmodifier.Set{
+				setplanmodifier.UseStateForUnknown(),
+				setplanmodifier.RequiresReplace(),
+			},
+		},
+		"gcp_project_id":         optionalString("GCP project ID that the VPC to be peered lives in"),
+		"gcp_network_name":       optionalString("The name of the network to be peered"),
+		"status":                 computedString("Current status of the account - `initiating-request`, `pending-acceptance`, `active`, `inactive` or `failed`"),
+		"aws_peering_id":         computedString("Identifier of the AWS cloud peering"),
+		"gcp_redis_project_id":   computedString("Identifier of the Redis Enterprise Cloud GCP project to be peered"),
+		"gcp_redis_network_name": computedString("The name of the Redis Enterprise Cloud network to be peered"),
+		"gcp_peering_id":         computedString("Identifier of the cloud peering"),
+	}
+
+	if r.deployment.isActiveActive() {
+		attributes["source_region"] = optionalString("AWS or GCP Region that the VPC to be peered lives in")
+		attributes["destination_region"] = optionalString("AWS Region that the VPC to be peered lives in")
+	} else {
+		attributes["region"] = optionalString("AWS Region that the VPC to be peered lives in")
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Manages a VPC peering for " + r.deployment.subscriptionName() + " in your Redis Enterprise Cloud Account.",
+		Attributes:  attributes,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// absent lists the attributes of the model which the schema of the deployment type doesn't have.
+func (r *subscriptionPeeringResource) absent() map[string]attr.Type {
+	if r.deployment.isActiveActive() {
+		return map[string]attr.Type{"region": types.StringType}
+	}
+	return map[string]attr.Type{
+		"source_region":      types.StringType,
+		"destination_region": types.StringType,
+	}
+}
+
+// identityAttributes are the attributes of the resource identity and ID. Active-Active peerings are identified
+// without their region.
+func (r *subscriptionPeeringResource) identityAttributes() []string {
+	return []string{utils.IdentitySubscriptionId, utils.IdentityPeeringId}
+}
+
+// IdentitySchema defines the identity schema for the resource.
+func (r *subscriptionPeeringResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identitySchema(r.identityAttributes())
+}
+
+// ImportState imports an existing resource.
+func (r *subscriptionPeeringResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importState(ctx, req, resp, r.identityAttributes())
+}
+
+// ModifyPlan checks the CIDRs of the peered VPC don't overlap the deployment CIDRs of the subscription, or of the
+// Active-Active subscription's source region.
+func (r *subscriptionPeeringResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state SubscriptionPeeringModel
+	resp.Diagnostics.Append(getModel(ctx, req.Plan, r.absent(), &plan)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(getModel(ctx, req.State, r.absent(), &state)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var inRegion func(utils.DeploymentCIDR) bool
+	if r.deployment.isActiveActive() {
+		if !utils.IsConfigured(plan.SourceRegion) {
+			return
+		}
+		inRegion = func(c utils.DeploymentCIDR) bool { return c.Region == plan.SourceRegion.ValueString() }
+	}
+
+	r.checkCIDROverlaps(ctx, plan.SubscriptionID, inRegion, "vpc_cidr",
+		addedCIDRs([]attr.Value{plan.VpcCidr}, []attr.Value{state.VpcCidr}), &resp.Diagnostics)
+	r.checkCIDROverlaps(ctx, plan.SubscriptionID, inRegion, "vpc_cidrs",
+		addedCIDRs(plan.VpcCidrs.Elements(), state.VpcCidrs.Elements()), &resp.Diagnostics)
+}
+
+// Create implements resource creation.
+func (r *subscriptionPeeringResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan SubscriptionPeeringModel
+	resp.Diagnostics.Append(getModel(ctx, req.Plan, r.absent(), &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Create(ctx, 10*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	r.createPeering(ctx, &plan, &resp.Diagnostics)
+	if plan.ID.IsUnknown() {
+		return
+	}
+
+	// Set the state, even if the create failed once the peering exists, so that it is tainted rather than lost.
+	resp.Diagnostics.Append(setModel(ctx, &resp.State, r.absent(), plan)...)
+	if err := utils.NullUnknownValues(&resp.State); err != nil {
+		resp.Diagnostics.AddError("Failed to save VPC peering", err.Error())
+		return
+	}
+	setIdentity(ctx, resp.Identity, plan.ID.ValueString(), r.identityAttributes(), &resp.Diagnostics)
+}
+
+// Read implements resource reading.
+func (r *subscriptionPeeringResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state SubscriptionPeeringModel
+	resp.Diagnostics.Append(getModel(ctx, req.State, r.absent(), &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Read(ctx, 10*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	removed := r.readPeering(ctx, &state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if removed {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(setModel(ctx, &resp.State, r.absent(), state)...)
+	setIdentity(ctx, resp.Identity, state.ID.ValueString(), r.identityAttributes(), &resp.Diagnostics)
+}
+
+// Update only records changes to the timeouts, as every other change replaces the peering.
+func (r *subscriptionPeeringResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan SubscriptionPeeringModel
+	resp.Diagnostics.Append(getModel(ctx, req.Plan, r.absent(), &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setModel(ctx, &resp.State, r.absent(), plan)...)
+}
+
+// Delete implements resource deletion.
+func (r *subscriptionPeeringResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state SubscriptionPeeringModel
+	resp.Diagnostics.Append(getModel(ctx, req.State, r.absent(), &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Delete(ctx, 10*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	r.deletePeering(ctx, &state, &resp.Diagnostics)
+}
@@ -0,0 +1,255 @@
+package networking
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/RedisLabs/rediscloud-go-api/service/subscriptions"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/utils"
+)
+
+// peeringRequest is what's needed to request a VPC peering of either deployment type.
+type peeringRequest struct {
+	region            *string
+	sourceRegion      *string
+	destinationRegion *string
+	awsAccountID      *string
+	vpcID             *string
+	vpcCidr           *string
+	vpcCidrs          []*string
+	provider          *string
+	vpcProjectUID     *string
+	vpcNetworkName    *string
+}
+
+// buildPeeringRequest checks that the attributes the cloud provider needs are set, and returns the peering request.
+func (r *subscriptionPeeringResource) buildPeeringRequest(ctx context.Context, plan *SubscriptionPeeringModel, diagnostics *diag.Diagnostics) *peeringRequest {
+	providerName := plan.ProviderName.ValueString()
+	request := &peeringRequest{}
+
+	require := func(name string, value types.String) *string {
+		if !utils.IsConfigured(value) || value.ValueString() == "" {
+			diagnostics.AddError("Missing VPC peering attribute", fmt.Sprintf("`%s` must be set when `provider_name` is `%s`", name, providerName))
+			return nil
+		}
+		return redis.String(value.ValueString())
+	}
+
+	switch providerName {
+	case "AWS":
+		if r.deployment.isActiveActive() {
+			request.sourceRegion = require("source_region", plan.SourceRegion)
+			request.destinationRegion = require("destination_region", plan.DestinationRegion)
+		} else {
+			request.region = require("region", plan.Region)
+		}
+		request.awsAccountID = require("aws_account_id", plan.AwsAccountID)
+		request.vpcID = require("vpc_id", plan.VpcID)
+
+		if utils.IsConfigured(plan.VpcCidr) && plan.VpcCidr.ValueString() != "" {
+			request.vpcCidr = redis.String(plan.VpcCidr.ValueString())
+		} else if utils.IsConfigured(plan.VpcCidrs) && len(plan.VpcCidrs.Elements()) > 0 {
+			var cidrs []string
+			diagnostics.Append(plan.VpcCidrs.ElementsAs(ctx, &cidrs, false)...)
+			request.vpcCidrs = redis.StringSlice(cidrs...)
+		} else {
+			diagnostics.AddError("Missing VPC peering attribute", "`vpc_cidr` or `vpc_cidrs` must be set when `provider_name` is `AWS`")
+		}
+
+	case "GCP":
+		request.vpcProjectUID = require("gcp_project_id", plan.GcpProjectID)
+		request.vpcNetworkName = require("gcp_network_name", plan.GcpNetworkName)
+		if r.deployment.isActiveActive() {
+			request.sourceRegion = require("source_region", plan.SourceRegion)
+		}
+		request.provider = redis.String(strings.ToLower(providerName))
+	}
+
+	if diagnostics.HasError() {
+		return nil
+	}
+	return request
+}
+
+// createPeering implements the Create operation for the VPC peering resources.
+func (r *subscriptionPeeringResource) createPeering(ctx context.Context, plan *SubscriptionPeeringModel, diagnostics *diag.Diagnostics) {
+	subId, err := strconv.Atoi(plan.SubscriptionID.ValueString())
+	if err != nil {
+		diagnostics.AddError("Invalid subscription ID", err.Error())
+		return
+	}
+
+	request := r.buildPeeringRequest(ctx, plan, diagnostics)
+	if request == nil {
+		return
+	}
+
+	// Active-Active peerings are made from one of the subscription's regions, which is locked rather than the whole
+	// subscription.
+	var unlock func()
+	if r.deployment.isActiveActive() {
+		unlock, err = utils.LockRegion(ctx, subId, redis.StringValue(request.sourceRegion))
+	} else {
+		unlock, err = utils.LockSubscription(ctx, subId)
+	}
+	if err != nil {
+		diagnostics.AddError("Failed to lock subscription", err.Error())
+		return
+	}
+	defer unlock()
+
+	var peeringId int
+	if r.deployment.isActiveActive() {
+		peeringId, err = r.client.Client.Subscription.CreateActiveActiveVPCPeering(ctx, subId, subscriptions.CreateActiveActiveVPCPeering{
+			SourceRegion:      request.sourceRegion,
+			DestinationRegion: request.destinationRegion,
+			AWSAccountID:      request.awsAccountID,
+			VPCId:             request.vpcID,
+			VPCCidr:           request.vpcCidr,
+			VPCCidrs:          request.vpcCidrs,
+			Provider:          request.provider,
+			VPCProjectUID:     request.vpcProjectUID,
+			VPCNetworkName:    request.vpcNetworkName,
+		})
+	} else {
+		peeringId, err = r.client.Client.Subscription.CreateVPCPeering(ctx, subId, subscriptions.CreateVPCPeering{
+			Region:         request.region,
+			AWSAccountID:   request.awsAccountID,
+			VPCId:          request.vpcID,
+			VPCCidr:        request.vpcCidr,
+			VPCCidrs:       request.vpcCidrs,
+			Provider:       request.provider,
+			VPCProjectUID:  request.vpcProjectUID,
+			VPCNetworkName: request.vpcNetworkName,
+		})
+	}
+	if err != nil {
+		diagnostics.AddError("Failed to create VPC peering", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(buildID(subId, peeringId))
+
+	if err := waitForPeeringToBeInitiated(ctx, r.client, r.deployment, subId, peeringId); err != nil {
+		diagnostics.AddError("VPC peering failed to be initiated", err.Error())
+		return
+	}
+
+	r.readPeering(ctx, plan, diagnostics)
+}
+
+// readPeering implements the Read operation for the VPC peering resources.
+// Returns true if the resource was removed (not found).
+func (r *subscriptionPeeringResource) readPeering(ctx context.Context, state *SubscriptionPeeringModel, diagnostics *diag.Diagnostics) bool {
+	ids, err := ParseID(state.ID.ValueString(), r.identityAttributes()...)
+	if err != nil {
+		diagnostics.AddError("Invalid VPC peering ID", err.Error())
+		return false
+	}
+	subId, peeringId := ids[0], ids[1]
+
+	state.SubscriptionID = types.StringValue(strconv.Itoa(subId))
+
+	peering, sourceRegion, err := findPeering(ctx, r.client, r.deployment, subId, peeringId)
+	if err != nil {
+		if isSubscriptionNotFound(err) {
+			log.Printf("[DEBUG] Subscription %d of VPC peering %d not found, removing from state", subId, peeringId)
+			return true
+		}
+		diagnostics.AddError("Failed to read VPC peering", err.Error())
+		return false
+	}
+	if peering == nil {
+		log.Printf("[DEBUG] VPC peering %d of subscription %d not found, removing from state", peeringId, subId)
+		return true
+	}
+
+	state.Status = types.StringValue(redis.StringValue(peering.Status))
+
+	providerName := "AWS"
+	if redis.StringValue(peering.GCPProjectUID) != "" {
+		providerName = "GCP"
+	}
+	state.ProviderName = types.StringValue(providerName)
+
+	if r.deployment.isActiveActive() {
+		state.SourceRegion = types.StringValue(sourceRegion)
+	}
+
+	if providerName == "AWS" {
+		state.AwsAccountID = types.StringValue(redis.StringValue(peering.AWSAccountID))
+		state.AwsPeeringID = types.StringValue(redis.StringValue(peering.AWSPeeringID))
+		state.VpcID = types.StringValue(redis.StringValue(peering.VPCId))
+		if r.deployment.isActiveActive() {
+			state.DestinationRegion = types.StringValue(redis.StringValue(peering.Region))
+		} else {
+			state.Region = types.StringValue(redis.StringValue(peering.Region))
+		}
+
+		// A peering that was created with `VPCCidrs` containing a single item will be read back with the `VPCCidr` set
+		// and `VPCCidrs` unset.
+		vpcCidr := peering.VPCCidr
+		var cidrs []string
+		if len(peering.VPCCidrs) != 0 {
+			for _, cidr := range peering.VPCCidrs {
+				if vpcCidr == nil {
+					vpcCidr = cidr.VPCCidr
+				}
+				cidrs = append(cidrs, redis.StringValue(cidr.VPCCidr))
+			}
+		} else {
+			cidrs = []string{redis.StringValue(vpcCidr)}
+		}
+
+		state.VpcCidr = types.StringValue(redis.StringValue(vpcCidr))
+		vpcCidrs, d := types.SetValueFrom(ctx, types.StringType, cidrs)
+		diagnostics.Append(d...)
+		state.VpcCidrs = vpcCidrs
+	}
+
+	if providerName == "GCP" {
+		state.GcpProjectID = types.StringValue(redis.StringValue(peering.GCPProjectUID))
+		state.GcpNetworkName = types.StringValue(redis.StringValue(peering.NetworkName))
+		state.GcpRedisProjectID = types.StringValue(redis.StringValue(peering.RedisProjectUID))
+		state.GcpRedisNetworkName = types.StringValue(redis.StringValue(peering.RedisNetworkName))
+		state.GcpPeeringID = types.StringValue(redis.StringValue(peering.CloudPeeringID))
+	}
+
+	return false
+}
+
+// deletePeering implements the Delete operation for the VPC peering resources.
+func (r *subscriptionPeeringResource) deletePeering(ctx context.Context, state *SubscriptionPeeringModel, diagnostics *diag.Diagnostics) {
+	ids, err := ParseID(state.ID.ValueString(), r.identityAttributes()...)
+	if err != nil {
+		diagnostics.AddError("Invalid VPC peering ID", err.Error())
+		return
+	}
+	subId, peeringId := ids[0], ids[1]
+
+	var unlock func()
+	if r.deployment.isActiveActive() {
+		unlock, err = utils.LockRegion(ctx, subId, state.SourceRegion.ValueString())
+	} else {
+		unlock, err = utils.LockSubscription(ctx, subId)
+	}
+	if err != nil {
+		diagnostics.AddError("Failed to lock subscription", err.Error())
+		return
+	}
+	defer unlock()
+
+	if err := deletePeering(ctx, r.client, r.deployment, subId, peeringId); err != nil {
+		if isSubscriptionNotFound(err) {
+			return
+		}
+		diagnostics.AddError("Failed to delete VPC peering", err.Error())
+	}
+}
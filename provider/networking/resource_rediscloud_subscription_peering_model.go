@@ -0,0 +1,30 @@
+package networking
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// SubscriptionPeeringModel describes the resource data model of a VPC peering. Pro peerings are made from the
+// subscription's region, and Active-Active peerings from one of the subscription's regions: Pro peerings have the
+// region of the peered VPC, and Active-Active peerings the source and destination regions.
+type SubscriptionPeeringModel struct {
+	ID                  types.String   `tfsdk:"id"`
+	SubscriptionID      types.String   `tfsdk:"subscription_id"`
+	ProviderName        types.String   `tfsdk:"provider_name"`
+	Region              types.String   `tfsdk:"region"`
+	SourceRegion        types.String   `tfsdk:"source_region"`
+	DestinationRegion   types.String   `tfsdk:"destination_region"`
+	AwsAccountID        types.String   `tfsdk:"aws_account_id"`
+	VpcID               types.String   `tfsdk:"vpc_id"`
+	VpcCidr             types.String   `tfsdk:"vpc_cidr"`
+	VpcCidrs            types.Set      `tfsdk:"vpc_cidrs"`
+	GcpProjectID        types.String   `tfsdk:"gcp_project_id"`
+	GcpNetworkName      types.String   `tfsdk:"gcp_network_name"`
+	Status              types.String   `tfsdk:"status"`
+	AwsPeeringID        types.String   `tfsdk:"aws_peering_id"`
+	GcpRedisProjectID   types.String   `tfsdk:"gcp_redis_project_id"`
+	GcpRedisNetworkName types.String   `tfsdk:"gcp_redis_network_name"`
+	GcpPeeringID        types.String   `tfsdk:"gcp_peering_id"`
+	Timeouts            timeouts.Value `tfsdk:"timeouts"`
+}
@@ -0,0 +1,225 @@
+package networking
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/utils"
+)
+
+var (
+	_ resource.Resource                = &transitGatewayAttachmentResource{}
+	_ resource.ResourceWithConfigure   = &transitGatewayAttachmentResource{}
+	_ resource.ResourceWithImportState = &transitGatewayAttachmentResource{}
+	_ resource.ResourceWithIdentity    = &transitGatewayAttachmentResource{}
+	_ resource.ResourceWithModifyPlan  = &transitGatewayAttachmentResource{}
+)
+
+// transitGatewayAttachmentResource manages the attachment of a Pro subscription, or of an Active-Active subscription's
+// region, to a Transit Gateway.
+type transitGatewayAttachmentResource struct {
+	networkingResource
+}
+
+// NewTransitGatewayAttachmentResource returns a new resource instance for the Transit Gateway attachments of Pro
+// subscriptions.
+func NewTransitGatewayAttachmentResource() resource.Resource {
+	return &transitGatewayAttachmentResource{networkingResource{deployment: pro}}
+}
+
+// NewActiveActiveTransitGatewayAttachmentResource returns a new resource instance for the Transit Gateway attachments
+// of Active-Active subscriptions.
+func NewActiveActiveTransitGatewayAttachmentResource() resource.Resource {
+	return &transitGatewayAttachmentResource{networkingResource{deployment: activeActive}}
+}
+
+// Metadata returns the resource type name.
+func (r *transitGatewayAttachmentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + r.deployment.typeName("transit_gateway_attachment")
+}
+
+// Schema defines the schema for the resource.
+func (r *transitGatewayAttachmentResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	description := "Manages a Transit Gateway Attachment to a Pro subscription in your Redis Enterprise Cloud Account."
+	subscriptionDescription := "The ID of the Pro subscription to attach"
+	if r.deployment.isActiveActive() {
+		description = "Manages a Transit Gateway Attachment to an Active Active Subscription in your Redis Enterprise Cloud Account."
+		subscriptionDescription = "The ID of the Active-Active subscription to attach"
+	}
+
+	attributes := r.transitGatewayAttributes(subscriptionDescription, "The id of the AWS region", "The id of the Transit Gateway to attach to")
+	attributes["aws_tgw_uid"] = computedString("The id of the Transit Gateway as known to AWS")
+	attributes["attachment_uid"] = computedString("A unique identifier for the Subscription/Transit Gateway attachment, if established")
+	attributes["status"] = schema.StringAttribute{
+		Description: "The status of the Transit Gateway",
+		Computed:    true,
+	}
+	attributes["attachment_status"] = schema.StringAttribute{
+		Description: "The status of the Subscription/Transit Gateway attachment, if established",
+		Computed:    true,
+	}
+	attributes["aws_account_id"] = computedString("The Transit Gateway's AWS account id")
+	attributes["cidrs"] = schema.ListAttribute{
+		Description: "A list of consumer CIDR blocks. It is recommended to use the rediscloud" +
+			r.deployment.typeName("transit_gateway_route") + " resource instead for managing CIDRs.",
+		ElementType: types.StringType,
+		Optional:    true,
+		Computed:    true,
+		PlanModifiers: []planmodifier.List{
+			listplanmodifier.UseStateForUnknown(),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Description: description,
+		Attributes:  attributes,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// IdentitySchema defines the identity schema for the resource.
+func (r *transitGatewayAttachmentResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identitySchema(r.transitGatewayIdentityAttributes())
+}
+
+// ImportState imports an existing resource.
+func (r *transitGatewayAttachmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importState(ctx, req, resp, r.transitGatewayIdentityAttributes())
+}
+
+// ModifyPlan checks the CIDRs added to the attachment don't overlap the deployment CIDRs of the subscription, or of
+// the Active-Active subscription's region.
+func (r *transitGatewayAttachmentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state TransitGatewayAttachmentModel
+	resp.Diagnostics.Append(getModel(ctx, req.Plan, r.regionIdAbsent(types.StringType), &plan)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(getModel(ctx, req.State, r.regionIdAbsent(types.StringType), &state)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.checkCIDROverlaps(ctx, plan.SubscriptionID, r.transitGatewayRegion(plan.RegionID), "cidrs",
+		addedCIDRs(plan.Cidrs.Elements(), state.Cidrs.Elements()), &resp.Diagnostics)
+}
+
+// Create implements resource creation.
+func (r *transitGatewayAttachmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan TransitGatewayAttachmentModel
+	resp.Diagnostics.Append(getModel(ctx, req.Plan, r.regionIdAbsent(types.StringType), &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Create(ctx, 5*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	r.createAttachment(ctx, &plan, &resp.Diagnostics)
+	if plan.ID.IsUnknown() {
+		return
+	}
+
+	// Set the state, even if the create failed once the attachment exists, so that it is tainted rather than lost.
+	resp.Diagnostics.Append(setModel(ctx, &resp.State, r.regionIdAbsent(types.StringType), plan)...)
+	if err := utils.NullUnknownValues(&resp.State); err != nil {
+		resp.Diagnostics.AddError("Failed to save Transit Gateway attachment", err.Error())
+		return
+	}
+	setIdentity(ctx, resp.Identity, plan.ID.ValueString(), r.transitGatewayIdentityAttributes(), &resp.Diagnostics)
+}
+
+// Read implements resource reading.
+func (r *transitGatewayAttachmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state TransitGatewayAttachmentModel
+	resp.Diagnostics.Append(getModel(ctx, req.State, r.regionIdAbsent(types.StringType), &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Read(ctx, 3*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	removed := r.readAttachment(ctx, &state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if removed {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(setModel(ctx, &resp.State, r.regionIdAbsent(types.StringType), state)...)
+	setIdentity(ctx, resp.Identity, state.ID.ValueString(), r.transitGatewayIdentityAttributes(), &resp.Diagnostics)
+}
+
+// Update implements resource update.
+func (r *transitGatewayAttachmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan TransitGatewayAttachmentModel
+	resp.Diagnostics.Append(getModel(ctx, req.Plan, r.regionIdAbsent(types.StringType), &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Update(ctx, 5*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	r.updateAttachment(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setModel(ctx, &resp.State, r.regionIdAbsent(types.StringType), plan)...)
+}
+
+// Delete implements resource deletion.
+func (r *transitGatewayAttachmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state TransitGatewayAttachmentModel
+	resp.Diagnostics.Append(getModel(ctx, req.State, r.regionIdAbsent(types.StringType), &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Delete(ctx, 5*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	r.deleteAttachment(ctx, &state, &resp.Diagnostics)
+}
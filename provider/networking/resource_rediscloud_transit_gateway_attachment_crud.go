@@ -0,0 +1,108 @@
+package networking
+
+import (
+	"context"
+	"log"
+	"strconv"
+
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/utils"
+)
+
+// createAttachment implements the Create operation for the Transit Gateway attachment resources.
+func (r *transitGatewayAttachmentResource) createAttachment(ctx context.Context, plan *TransitGatewayAttachmentModel, diagnostics *diag.Diagnostics) {
+	key := r.transitGatewayKeyFromPlan(plan.SubscriptionID, plan.RegionID, plan.TgwID, diagnostics)
+	if diagnostics.HasError() {
+		return
+	}
+
+	// At this point, cidrs has to be empty. We cannot honour the user's configuration until the invitation has been
+	// accepted.
+	if len(cidrsFromPlan(ctx, plan.Cidrs, diagnostics)) > 0 {
+		diagnostics.AddError("Failed to create Transit Gateway attachment",
+			"Attachment cannot be created with Cidrs provided, it must be accepted first. This resource may then be updated with Cidrs.")
+		return
+	}
+
+	if err := createTransitGatewayAttachment(ctx, r.client, r.deployment, key.subId, key.regionId, key.tgwId); err != nil {
+		diagnostics.AddError("Failed to create Transit Gateway attachment", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(key.id(r.deployment))
+
+	r.readAttachment(ctx, plan, diagnostics)
+}
+
+// readAttachment implements the Read operation for the Transit Gateway attachment resources.
+// Returns true if the resource was removed (not found).
+func (r *transitGatewayAttachmentResource) readAttachment(ctx context.Context, state *TransitGatewayAttachmentModel, diagnostics *diag.Diagnostics) bool {
+	key := r.transitGatewayKeyFromID(state.ID.ValueString(), diagnostics)
+	if diagnostics.HasError() {
+		return false
+	}
+
+	state.SubscriptionID = types.StringValue(strconv.Itoa(key.subId))
+	state.RegionID = key.regionIdValue(r.deployment)
+	state.TgwID = types.Int64Value(int64(key.tgwId))
+
+	tgw, err := findTransitGatewayAttachment(ctx, r.client, r.deployment, key.subId, key.regionId, key.tgwId)
+	if err != nil {
+		diagnostics.AddError("Failed to read Transit Gateway attachment", err.Error())
+		return false
+	}
+	if tgw == nil {
+		log.Printf("[DEBUG] Transit Gateway attachment %s not found, removing from state", state.ID.ValueString())
+		return true
+	}
+
+	state.AwsTgwUid = types.StringValue(redis.StringValue(tgw.AwsTgwUid))
+	state.AttachmentUid = types.StringValue(redis.StringValue(tgw.AttachmentUid))
+	state.Status = types.StringValue(redis.StringValue(tgw.Status))
+	state.AttachmentStatus = types.StringValue(redis.StringValue(tgw.AttachmentStatus))
+	state.AwsAccountID = types.StringValue(redis.StringValue(tgw.AwsAccountId))
+	state.Cidrs = flattenTransitGatewayCidrs(ctx, tgw.Cidrs, diagnostics)
+
+	return false
+}
+
+// updateAttachment implements the Update operation for the Transit Gateway attachment resources.
+func (r *transitGatewayAttachmentResource) updateAttachment(ctx context.Context, plan *TransitGatewayAttachmentModel, diagnostics *diag.Diagnostics) {
+	key := r.transitGatewayKeyFromID(plan.ID.ValueString(), diagnostics)
+	cidrs := cidrsFromPlan(ctx, plan.Cidrs, diagnostics)
+	if diagnostics.HasError() {
+		return
+	}
+
+	if err := updateTransitGatewayAttachmentCidrs(ctx, r.client, r.deployment, key.subId, key.regionId, key.tgwId, cidrs); err != nil {
+		diagnostics.AddError("Failed to update Transit Gateway attachment", err.Error())
+		return
+	}
+
+	// The configured CIDRs are kept, as the API may not report them in the same order or form.
+	planned := plan.Cidrs
+	if r.readAttachment(ctx, plan, diagnostics) {
+		diagnostics.AddError("Failed to update Transit Gateway attachment", "the Transit Gateway attachment no longer exists")
+	}
+	if utils.IsConfigured(planned) {
+		plan.Cidrs = planned
+	}
+}
+
+// deleteAttachment implements the Delete operation for the Transit Gateway attachment resources.
+func (r *transitGatewayAttachmentResource) deleteAttachment(ctx context.Context, state *TransitGatewayAttachmentModel, diagnostics *diag.Diagnostics) {
+	key := r.transitGatewayKeyFromID(state.ID.ValueString(), diagnostics)
+	if diagnostics.HasError() {
+		return
+	}
+
+	if err := deleteTransitGatewayAttachment(ctx, r.client, r.deployment, key.subId, key.regionId, key.tgwId); err != nil {
+		if isTransitGatewayAttachmentNotFound(err) {
+			return
+		}
+		diagnostics.AddError("Failed to delete Transit Gateway attachment", err.Error())
+	}
+}
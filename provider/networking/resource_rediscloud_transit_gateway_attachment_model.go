@@ -0,0 +1,22 @@
+package networking
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// TransitGatewayAttachmentModel describes the resource data model of a Transit Gateway attachment. Only Active-Active
+// attachments have a region ID.
+type TransitGatewayAttachmentModel struct {
+	ID               types.String   `tfsdk:"id"`
+	SubscriptionID   types.String   `tfsdk:"subscription_id"`
+	RegionID         types.String   `tfsdk:"region_id"`
+	TgwID            types.Int64    `tfsdk:"tgw_id"`
+	AwsTgwUid        types.String   `tfsdk:"aws_tgw_uid"`
+	AttachmentUid    types.String   `tfsdk:"attachment_uid"`
+	Status           types.String   `tfsdk:"status"`
+	AttachmentStatus types.String   `tfsdk:"attachment_status"`
+	AwsAccountID     types.String   `tfsdk:"aws_account_id"`
+	Cidrs            types.List     `tfsdk:"cidrs"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
+}
@@ -0,0 +1,205 @@
+package networking
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &transitGatewayRouteResource{}
+	_ resource.ResourceWithConfigure   = &transitGatewayRouteResource{}
+	_ resource.ResourceWithImportState = &transitGatewayRouteResource{}
+	_ resource.ResourceWithIdentity    = &transitGatewayRouteResource{}
+	_ resource.ResourceWithModifyPlan  = &transitGatewayRouteResource{}
+)
+
+// transitGatewayRouteResource manages the CIDRs routed through the Transit Gateway attachment of a Pro subscription,
+// or of an Active-Active subscription's region.
+type transitGatewayRouteResource struct {
+	networkingResource
+}
+
+// NewTransitGatewayRouteResource returns a new resource instance for the Transit Gateway routes of Pro subscriptions.
+func NewTransitGatewayRouteResource() resource.Resource {
+	return &transitGatewayRouteResource{networkingResource{deployment: pro}}
+}
+
+// NewActiveActiveTransitGatewayRouteResource returns a new resource instance for the Transit Gateway routes of
+// Active-Active subscriptions.
+func NewActiveActiveTransitGatewayRouteResource() resource.Resource {
+	return &transitGatewayRouteResource{networkingResource{deployment: activeActive}}
+}
+
+// Metadata returns the resource type name.
+func (r *transitGatewayRouteResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + r.deployment.typeName("transit_gateway_route")
+}
+
+// Schema defines the schema for the resource.
+func (r *transitGatewayRouteResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	description := "Manages Transit Gateway routing (CIDRs) for a Pro subscription in your Redis Enterprise Cloud Account."
+	subscriptionDescription := "The ID of the Pro subscription"
+	if r.deployment.isActiveActive() {
+		description = "Manages Transit Gateway routing (CIDRs) for an Active-Active Subscription in your Redis Enterprise Cloud Account."
+		subscriptionDescription = "The ID of the Active-Active subscription"
+	}
+
+	attributes := r.transitGatewayAttributes(subscriptionDescription, "The ID of the AWS region", "The ID of the Transit Gateway")
+	attributes["cidrs"] = schema.ListAttribute{
+		Description: "A list of consumer CIDR blocks",
+		ElementType: types.StringType,
+		Required:    true,
+	}
+
+	resp.Schema = schema.Schema{
+		Description: description,
+		Attributes:  attributes,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// IdentitySchema defines the identity schema for the resource.
+func (r *transitGatewayRouteResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identitySchema(r.transitGatewayIdentityAttributes())
+}
+
+// ImportState imports an existing resource.
+func (r *transitGatewayRouteResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importState(ctx, req, resp, r.transitGatewayIdentityAttributes())
+}
+
+// ModifyPlan checks the CIDRs added to the routes don't overlap the deployment CIDRs of the subscription, or of
+// the Active-Active subscription's region.
+func (r *transitGatewayRouteResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state TransitGatewayRouteModel
+	resp.Diagnostics.Append(getModel(ctx, req.Plan, r.regionIdAbsent(types.StringType), &plan)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(getModel(ctx, req.State, r.regionIdAbsent(types.StringType), &state)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.checkCIDROverlaps(ctx, plan.SubscriptionID, r.transitGatewayRegion(plan.RegionID), "cidrs",
+		addedCIDRs(plan.Cidrs.Elements(), state.Cidrs.Elements()), &resp.Diagnostics)
+}
+
+// Create implements resource creation.
+func (r *transitGatewayRouteResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan TransitGatewayRouteModel
+	resp.Diagnostics.Append(getModel(ctx, req.Plan, r.regionIdAbsent(types.StringType), &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Create(ctx, 5*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	key := r.transitGatewayKeyFromPlan(plan.SubscriptionID, plan.RegionID, plan.TgwID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.ID = types.StringValue(key.id(r.deployment))
+
+	r.updateRoute(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setModel(ctx, &resp.State, r.regionIdAbsent(types.StringType), plan)...)
+	setIdentity(ctx, resp.Identity, plan.ID.ValueString(), r.transitGatewayIdentityAttributes(), &resp.Diagnostics)
+}
+
+// Read implements resource reading.
+func (r *transitGatewayRouteResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state TransitGatewayRouteModel
+	resp.Diagnostics.Append(getModel(ctx, req.State, r.regionIdAbsent(types.StringType), &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Read(ctx, 3*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	removed := r.readRoute(ctx, &state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if removed {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(setModel(ctx, &resp.State, r.regionIdAbsent(types.StringType), state)...)
+	setIdentity(ctx, resp.Identity, state.ID.ValueString(), r.transitGatewayIdentityAttributes(), &resp.Diagnostics)
+}
+
+// Update implements resource update.
+func (r *transitGatewayRouteResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan TransitGatewayRouteModel
+	resp.Diagnostics.Append(getModel(ctx, req.Plan, r.regionIdAbsent(types.StringType), &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Update(ctx, 5*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	r.updateRoute(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setModel(ctx, &resp.State, r.regionIdAbsent(types.StringType), plan)...)
+}
+
+// Delete implements resource deletion.
+func (r *transitGatewayRouteResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state TransitGatewayRouteModel
+	resp.Diagnostics.Append(getModel(ctx, req.State, r.regionIdAbsent(types.StringType), &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Delete(ctx, 5*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	r.deleteRoute(ctx, &state, &resp.Diagnostics)
+}
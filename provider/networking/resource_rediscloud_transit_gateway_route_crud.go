@@ -0,0 +1,67 @@
+package networking
+
+import (
+	"context"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// updateRoute implements the Create and Update operations for the Transit Gateway route resources, which both set the
+// CIDRs of the attachment.
+func (r *transitGatewayRouteResource) updateRoute(ctx context.Context, plan *TransitGatewayRouteModel, diagnostics *diag.Diagnostics) {
+	key := r.transitGatewayKeyFromID(plan.ID.ValueString(), diagnostics)
+	cidrs := cidrsFromPlan(ctx, plan.Cidrs, diagnostics)
+	if diagnostics.HasError() {
+		return
+	}
+
+	if err := updateTransitGatewayAttachmentCidrs(ctx, r.client, r.deployment, key.subId, key.regionId, key.tgwId, cidrs); err != nil {
+		diagnostics.AddError("Failed to update Transit Gateway routes", err.Error())
+	}
+}
+
+// readRoute implements the Read operation for the Transit Gateway route resources.
+// Returns true if the resource was removed (not found).
+func (r *transitGatewayRouteResource) readRoute(ctx context.Context, state *TransitGatewayRouteModel, diagnostics *diag.Diagnostics) bool {
+	key := r.transitGatewayKeyFromID(state.ID.ValueString(), diagnostics)
+	if diagnostics.HasError() {
+		return false
+	}
+
+	state.SubscriptionID = types.StringValue(strconv.Itoa(key.subId))
+	state.RegionID = key.regionIdValue(r.deployment)
+	state.TgwID = types.Int64Value(int64(key.tgwId))
+
+	tgw, err := findTransitGatewayAttachment(ctx, r.client, r.deployment, key.subId, key.regionId, key.tgwId)
+	if err != nil {
+		diagnostics.AddError("Failed to read Transit Gateway routes", err.Error())
+		return false
+	}
+	if tgw == nil {
+		log.Printf("[DEBUG] Transit Gateway attachment %s not found, removing routes from state", state.ID.ValueString())
+		return true
+	}
+
+	state.Cidrs = flattenTransitGatewayCidrs(ctx, tgw.Cidrs, diagnostics)
+
+	return false
+}
+
+// deleteRoute implements the Delete operation for the Transit Gateway route resources, which clears the CIDRs of the
+// attachment.
+func (r *transitGatewayRouteResource) deleteRoute(ctx context.Context, state *TransitGatewayRouteModel, diagnostics *diag.Diagnostics) {
+	key := r.transitGatewayKeyFromID(state.ID.ValueString(), diagnostics)
+	if diagnostics.HasError() {
+		return
+	}
+
+	if err := updateTransitGatewayAttachmentCidrs(ctx, r.client, r.deployment, key.subId, key.regionId, key.tgwId, make([]*string, 0)); err != nil {
+		if isTransitGatewayAttachmentNotFound(err) {
+			return
+		}
+		diagnostics.AddError("Failed to delete Transit Gateway routes", err.Error())
+	}
+}
@@ -0,0 +1,17 @@
+package networking
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// TransitGatewayRouteModel describes the resource data model of the CIDRs routed through a Transit Gateway
+// attachment. Only Active-Active routes have a region ID.
+type TransitGatewayRouteModel struct {
+	ID             types.String   `tfsdk:"id"`
+	SubscriptionID types.String   `tfsdk:"subscription_id"`
+	RegionID       types.String   `tfsdk:"region_id"`
+	TgwID          types.Int64    `tfsdk:"tgw_id"`
+	Cidrs          types.List     `tfsdk:"cidrs"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
+}
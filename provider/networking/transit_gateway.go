@@ -0,0 +1,153 @@
+package networking
+
+import (
+	"context"
+	"regexp"
+	"strconv"
+
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/RedisLabs/rediscloud-go-api/service/transit_gateway/attachments"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/utils"
+)
+
+// transitGatewayIdentityAttributes are the attributes of the resource identity and ID of the Transit Gateway
+// attachments and routes.
+func (r *networkingResource) transitGatewayIdentityAttributes() []string {
+	return r.deployment.idAttributes(utils.IdentitySubscriptionId, utils.IdentityTgwId)
+}
+
+// transitGatewayAttributes returns the schema of the attributes identifying the Transit Gateway attachment of a
+// subscription, or of an Active-Active subscription's region.
+func (r *networkingResource) transitGatewayAttributes(subscriptionDescription string, regionDescription string, tgwDescription string) map[string]schema.Attribute {
+	number := stringvalidator.RegexMatches(regexp.MustCompile(`^\d+$`), "must be a number")
+
+	attributes := map[string]schema.Attribute{
+		"id": idAttribute(),
+		"subscription_id": schema.StringAttribute{
+			Description: subscriptionDescription,
+			Required:    true,
+			Validators:  []validator.String{number},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"tgw_id": schema.Int64Attribute{
+			Description: tgwDescription,
+			Required:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+		},
+	}
+
+	if r.deployment.isActiveActive() {
+		attributes["region_id"] = schema.StringAttribute{
+			Description: regionDescription,
+			Required:    true,
+			Validators:  []validator.String{number},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		}
+	}
+
+	return attributes
+}
+
+// transitGatewayRegion returns whether a deployment CIDR is in the Active-Active region of a Transit Gateway
+// attachment, or nil for Pro attachments, which route to every region of the subscription.
+func (r *networkingResource) transitGatewayRegion(regionId types.String) func(utils.DeploymentCIDR) bool {
+	if !r.deployment.isActiveActive() {
+		return nil
+	}
+	return func(c utils.DeploymentCIDR) bool { return strconv.Itoa(c.RegionID) == regionId.ValueString() }
+}
+
+// transitGatewayKey identifies the Transit Gateway attachment of a subscription, or of an Active-Active subscription's
+// region. The region ID of Pro subscriptions is 0.
+type transitGatewayKey struct {
+	subId    int
+	regionId int
+	tgwId    int
+}
+
+// transitGatewayKeyFromPlan reads the key of the Transit Gateway attachment from the configured attributes.
+func (r *networkingResource) transitGatewayKeyFromPlan(subscriptionId types.String, regionId types.String, tgwId types.Int64, diagnostics *diag.Diagnostics) transitGatewayKey {
+	key := transitGatewayKey{tgwId: int(tgwId.ValueInt64())}
+
+	var err error
+	if key.subId, err = strconv.Atoi(subscriptionId.ValueString()); err != nil {
+		diagnostics.AddError("Invalid subscription ID", err.Error())
+	}
+	if r.deployment.isActiveActive() {
+		if key.regionId, err = strconv.Atoi(regionId.ValueString()); err != nil {
+			diagnostics.AddError("Invalid region ID", err.Error())
+		}
+	}
+
+	return key
+}
+
+// transitGatewayKeyFromID reads the key of the Transit Gateway attachment from the resource ID.
+func (r *networkingResource) transitGatewayKeyFromID(id string, diagnostics *diag.Diagnostics) transitGatewayKey {
+	ids, err := ParseID(id, r.transitGatewayIdentityAttributes()...)
+	if err != nil {
+		diagnostics.AddError("Invalid Transit Gateway ID", err.Error())
+		return transitGatewayKey{}
+	}
+
+	if r.deployment.isActiveActive() {
+		return transitGatewayKey{subId: ids[0], regionId: ids[1], tgwId: ids[2]}
+	}
+	return transitGatewayKey{subId: ids[0], tgwId: ids[1]}
+}
+
+// id returns the resource ID of the Transit Gateway attachment or route.
+func (k transitGatewayKey) id(d deploymentType) string {
+	return transitGatewayId(d, k.subId, k.regionId, k.tgwId)
+}
+
+// regionIdValue returns the region_id attribute of Active-Active resources, or null for Pro ones.
+func (k transitGatewayKey) regionIdValue(d deploymentType) types.String {
+	if d.isActiveActive() {
+		return types.StringValue(strconv.Itoa(k.regionId))
+	}
+	return types.StringNull()
+}
+
+// cidrsFromPlan returns the configured CIDRs, which are an empty list rather than nil when there are none so that the
+// API clears them.
+func cidrsFromPlan(ctx context.Context, list types.List, diagnostics *diag.Diagnostics) []*string {
+	cidrs := make([]*string, 0)
+	if !utils.IsConfigured(list) {
+		return cidrs
+	}
+
+	var values []string
+	diagnostics.Append(list.ElementsAs(ctx, &values, false)...)
+	for _, value := range values {
+		cidrs = append(cidrs, redis.String(value))
+	}
+	return cidrs
+}
+
+// flattenTransitGatewayCidrs returns the CIDRs routed through the Transit Gateway attachment.
+func flattenTransitGatewayCidrs(ctx context.Context, cidrs []*attachments.Cidr, diagnostics *diag.Diagnostics) types.List {
+	values := make([]string, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		values = append(values, redis.StringValue(cidr.CidrAddress))
+	}
+
+	list, d := types.ListValueFrom(ctx, types.StringType, values)
+	diagnostics.Append(d...)
+	return list
+}
@@ -0,0 +1,221 @@
+package networking
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	pl "github.com/RedisLabs/rediscloud-go-api/service/privatelink"
+	"github.com/RedisLabs/rediscloud-go-api/service/psc"
+	"github.com/RedisLabs/rediscloud-go-api/service/subscriptions"
+
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/client"
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/utils"
+)
+
+// placeholderStatusDisappear is the status of a Private Service Connect endpoint which no longer exists.
+const placeholderStatusDisappear = "disappeared"
+
+// waitForPeeringToBeInitiated waits for a new VPC peering to be requested from the cloud provider. A peering which
+// isn't listed yet is still being initiated.
+func waitForPeeringToBeInitiated(ctx context.Context, api *client.ApiClient, d deploymentType, subId int, id int) error {
+	wait := &utils.Waiter{
+		Description: fmt.Sprintf("VPC peering %d of subscription %d", id, subId),
+		Pending: []string{
+			subscriptions.VPCPeeringStatusInitiatingRequest,
+		},
+		Target: []string{
+			subscriptions.VPCPeeringStatusActive,
+			subscriptions.VPCPeeringStatusInactive,
+			subscriptions.VPCPeeringStatusPendingAcceptance,
+		},
+		Timeout: 10 * time.Minute,
+
+		Refresh: func() (result interface{}, state string, err error) {
+			log.Printf("[DEBUG] Waiting for vpc peering %d to be initiated", id)
+
+			peering, _, err := findPeering(ctx, api, d, subId, id)
+			if err != nil {
+				return nil, "", err
+			}
+			if peering == nil {
+				log.Printf("Peering %d/%d not present yet", subId, id)
+				return nil, subscriptions.VPCPeeringStatusInitiatingRequest, nil
+			}
+
+			return redis.StringValue(peering.Status), redis.StringValue(peering.Status), nil
+		},
+	}
+	if d.isActiveActive() {
+		wait.Delay = 30 * time.Second
+	}
+	if _, err := wait.Wait(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// waitForPrivateServiceConnectServiceToBeActive waits for a new Private Service Connect service to be provisioned.
+func waitForPrivateServiceConnectServiceToBeActive(ctx context.Context, api *client.ApiClient, d deploymentType, subId int, regionId int) error {
+	wait := &utils.Waiter{
+		Description: "Private Service Connect service",
+		Pending: []string{
+			psc.ServiceStatusCreateQueued,
+			psc.ServiceStatusInitialized,
+			psc.ServiceStatusCreatePending},
+		Target: []string{psc.ServiceStatusActive},
+
+		Refresh: func() (result interface{}, state string, err error) {
+			log.Printf("[DEBUG] Waiting for private service connect service status %d to be active", subId)
+
+			service, err := getPrivateServiceConnectService(ctx, api, d, subId, regionId)
+			if err != nil {
+				return nil, "", err
+			}
+
+			return redis.StringValue(service.Status), redis.StringValue(service.Status), nil
+		},
+	}
+	if _, err := wait.Wait(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// waitForPrivateServiceConnectEndpointStatus waits for a Private Service Connect endpoint to move from one of the
+// pending statuses to the target status.
+func waitForPrivateServiceConnectEndpointStatus(ctx context.Context, api *client.ApiClient, d deploymentType, subId int, regionId int, serviceId int, endpointId int, target string, pending ...string) error {
+	wait := &utils.Waiter{
+		Description: "Private Service Connect endpoint",
+		Pending:     pending,
+		Target:      []string{target},
+
+		Refresh: func() (result interface{}, state string, err error) {
+			log.Printf("[DEBUG] Waiting for private service connect service endpoint status %d/%d/%d to be %s",
+				subId, serviceId, endpointId, target)
+
+			endpoint, err := findPrivateServiceConnectEndpoint(ctx, api, d, subId, regionId, serviceId, endpointId)
+			if err != nil {
+				return nil, "", err
+			}
+			if endpoint == nil {
+				return nil, "", fmt.Errorf("endpoint with id %d not found", endpointId)
+			}
+
+			return redis.StringValue(endpoint.Status), redis.StringValue(endpoint.Status), nil
+		},
+	}
+	if _, err := wait.Wait(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// waitForPrivateServiceConnectEndpointToDisappear waits for a Private Service Connect endpoint to be removed, which
+// happens once the related resources of the GCP project are removed.
+func waitForPrivateServiceConnectEndpointToDisappear(ctx context.Context, api *client.ApiClient, d deploymentType, subId int, regionId int, serviceId int, endpointId int) error {
+	wait := &utils.Waiter{
+		Description: "Private Service Connect endpoint",
+		Pending: []string{
+			psc.EndpointStatusProcessing,
+			psc.EndpointStatusPending,
+			psc.EndpointStatusAcceptPending,
+			psc.EndpointStatusActive,
+			psc.EndpointStatusDeleted,
+			psc.EndpointStatusRejected,
+			psc.EndpointStatusRejectPending,
+			psc.EndpointStatusFailed,
+		},
+		Target: []string{placeholderStatusDisappear},
+
+		Refresh: func() (result interface{}, state string, err error) {
+			log.Printf("[DEBUG] Waiting for private service connect service endpoint %d/%d/%d to be deleted",
+				subId, serviceId, endpointId)
+
+			endpoint, err := findPrivateServiceConnectEndpoint(ctx, api, d, subId, regionId, serviceId, endpointId)
+			if err != nil {
+				return nil, "", err
+			}
+			if endpoint == nil {
+				return placeholderStatusDisappear, placeholderStatusDisappear, nil
+			}
+
+			return redis.StringValue(endpoint.Status), redis.StringValue(endpoint.Status), nil
+		},
+	}
+	if _, err := wait.Wait(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// waitForPrivateLinkToBeActive waits for a new PrivateLink to be provisioned.
+func waitForPrivateLinkToBeActive(ctx context.Context, api *client.ApiClient, d deploymentType, subId int, regionId int) error {
+	description := fmt.Sprintf("private link for subscription %d", subId)
+	if d.isActiveActive() {
+		description = fmt.Sprintf("private link for subscription %d, region %d", subId, regionId)
+	}
+
+	wait := &utils.Waiter{
+		Description: description,
+		Pending: []string{
+			pl.PrivateLinkStatusInitializing},
+		Target:       []string{pl.PrivateLinkStatusActive},
+		PollInterval: 10 * time.Second,
+		// NotFound during wait means the privatelink is still being provisioned
+		// (API returns empty response while initialising).
+		NotFound: isPrivateLinkNotFound,
+		Refresh: func() (result interface{}, state string, err error) {
+			log.Printf("[DEBUG] Waiting for private link %d to be active", subId)
+
+			privateLink, err := getPrivateLink(ctx, api, d, subId, regionId)
+			if err != nil {
+				return nil, "", err
+			}
+
+			return redis.StringValue(privateLink.ShareName), redis.StringValue(privateLink.Status), nil
+		}}
+	if _, err := wait.Wait(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// waitForPrincipalToBeAssociated waits for a principal of a PrivateLink to be associated with its share.
+func waitForPrincipalToBeAssociated(ctx context.Context, api *client.ApiClient, d deploymentType, subId int, regionId int, principal string) error {
+	wait := &utils.Waiter{
+		Description: fmt.Sprintf("private link principal %s of subscription %d", principal, subId),
+		Pending: []string{
+			pl.PrivateLinkPrincipalStatusInitializing, pl.PrivateLinkPrincipalStatusAssociating},
+		Target:       []string{pl.PrivateLinkPrincipalStatusAssociated},
+		PollInterval: 10 * time.Second,
+		Refresh: func() (result interface{}, state string, err error) {
+			log.Printf("[DEBUG] Waiting for private link principal %s to be associated", principal)
+
+			privateLink, err := getPrivateLink(ctx, api, d, subId, regionId)
+			if err != nil {
+				return "", "", err
+			}
+
+			for _, p := range privateLink.Principals {
+				if redis.StringValue(p.Principal) == principal {
+					return principal, redis.StringValue(p.Status), nil
+				}
+			}
+
+			return nil, "", fmt.Errorf("principal %s not found", principal)
+		}}
+
+	if _, err := wait.Wait(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package networking
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	rediscloudApi "github.com/RedisLabs/rediscloud-go-api"
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	pl "github.com/RedisLabs/rediscloud-go-api/service/privatelink"
+	"github.com/RedisLabs/rediscloud-go-api/service/subscriptions"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/client"
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/fakeapi"
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/utils"
+)
+
+// newWaiterTestClient returns a client for a fake API holding a Pro subscription, through a transport injecting the
+// given faults. Waiters poll every few milliseconds for the duration of the test.
+func newWaiterTestClient(t *testing.T, faults client.FaultConfig) (*client.ApiClient, int) {
+	t.Helper()
+
+	scale := utils.WaitIntervalScale
+	utils.WaitIntervalScale = 0.0001
+	t.Cleanup(func() { utils.WaitIntervalScale = scale })
+
+	fake := fakeapi.New(fakeapi.Options{ProvisioningPolls: 2})
+	t.Cleanup(fake.Close)
+
+	transport := client.NewFaultTransport(client.FaultConfig{}, http.DefaultTransport)
+	api, err := fake.Client(rediscloudApi.Transporter(transport))
+	require.NoError(t, err)
+
+	subId, err := api.Subscription.Create(context.Background(), subscriptions.CreateSubscription{
+		Name:            redis.String("private-link"),
+		PaymentMethodID: redis.Int(fakeapi.PaymentMethodId),
+		CloudProviders: []*subscriptions.CreateCloudProvider{{
+			Provider: redis.String("AWS"),
+			Regions:  []*subscriptions.CreateRegion{{Region: redis.String("us-east-1")}},
+		}},
+	})
+	require.NoError(t, err)
+
+	// Faults are only injected into the waits, not the setup.
+	transport.SetFaults(faults)
+	return &client.ApiClient{Client: api}, subId
+}
+
+func TestUnitWaitForPrivateLinkToBeActive(t *testing.T) {
+	api, subId := newWaiterTestClient(t, client.FaultConfig{TaskNotFoundRate: 1, Limit: 2})
+	require.NoError(t, api.Client.PrivateLink.CreatePrivateLink(context.Background(), subId, pl.CreatePrivateLink{
+		ShareName:     redis.String("share"),
+		Principal:     redis.String("123456789012"),
+		PrincipalType: redis.String("aws_account"),
+	}))
+
+	require.NoError(t, waitForPrivateLinkToBeActive(context.Background(), api, pro, subId, 0))
+	require.NoError(t, waitForPrincipalToBeAssociated(context.Background(), api, pro, subId, 0, "123456789012"))
+
+	err := waitForPrincipalToBeAssociated(context.Background(), api, pro, subId, 0, "210987654321")
+	assert.ErrorContains(t, err, "principal 210987654321 not found")
+}
+
+func TestUnitWaitForPrivateLinkToBeActiveWithoutPrivateLink(t *testing.T) {
+	api, subId := newWaiterTestClient(t, client.FaultConfig{})
+
+	// A private link which is never created looks like one still being provisioned, until the context gives up.
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	assert.Error(t, waitForPrivateLinkToBeActive(ctx, api, pro, subId, 0))
+}
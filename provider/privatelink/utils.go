@@ -1,138 +1,13 @@
 package privatelink
 
 import (
-	"context"
-	"errors"
-	"fmt"
-	"log"
 	"sort"
 	"strconv"
-	"time"
 
 	"github.com/RedisLabs/rediscloud-go-api/redis"
 	pl "github.com/RedisLabs/rediscloud-go-api/service/privatelink"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-
-	"github.com/RedisLabs/terraform-provider-rediscloud/provider/client"
-	"github.com/RedisLabs/terraform-provider-rediscloud/provider/utils"
 )
 
-func waitForPrivateLinkToBeActive(ctx context.Context, client *client.ApiClient, subscriptionId int) error {
-	wait := &retry.StateChangeConf{
-		Pending: []string{
-			pl.PrivateLinkStatusInitializing},
-		Target:       []string{pl.PrivateLinkStatusActive},
-		Timeout:      utils.SafetyTimeout,
-		Delay:        10 * time.Second,
-		PollInterval: 10 * time.Second,
-		Refresh: func() (result interface{}, state string, err error) {
-			log.Printf("[DEBUG] Waiting for private link %d to be active", subscriptionId)
-
-			privateLink, err := client.Client.PrivateLink.GetPrivateLink(ctx, subscriptionId)
-			if err != nil {
-				// NotFound during wait means the privatelink is still being provisioned
-				// (API returns empty response while initialising). Treat as retryable.
-				var notFound *pl.NotFound
-				if errors.As(err, &notFound) {
-					return nil, pl.PrivateLinkStatusInitializing, nil
-				}
-				return nil, "", err
-			}
-
-			return *privateLink.ShareName, *privateLink.Status, nil
-		}}
-	if _, err := wait.WaitForStateContext(ctx); err != nil {
-		return err
-	}
-
-	return nil
-}
-
-func waitForActiveActivePrivateLinkToBeActive(ctx context.Context, client *client.ApiClient, subscriptionId int, regionId int) error {
-	wait := &retry.StateChangeConf{
-		Pending: []string{
-			pl.PrivateLinkStatusInitializing},
-		Target:       []string{pl.PrivateLinkStatusActive},
-		Timeout:      utils.SafetyTimeout,
-		Delay:        10 * time.Second,
-		PollInterval: 10 * time.Second,
-		Refresh: func() (result interface{}, state string, err error) {
-			log.Printf("[DEBUG] Waiting for private link %d to be active", subscriptionId)
-
-			privateLink, err := client.Client.PrivateLink.GetActiveActivePrivateLink(ctx, subscriptionId, regionId)
-			if err != nil {
-				// NotFound during wait means the privatelink is still being provisioned
-				// (API returns empty response while initialising). Treat as retryable.
-				var notFound *pl.NotFoundActiveActive
-				if errors.As(err, &notFound) {
-					return nil, pl.PrivateLinkStatusInitializing, nil
-				}
-				return nil, "", err
-			}
-
-			return *privateLink.ShareName, *privateLink.Status, nil
-		}}
-	if _, err := wait.WaitForStateContext(ctx); err != nil {
-		return err
-	}
-
-	return nil
-}
-
-func waitForPrincipalToBeAssociated(ctx context.Context, client *client.ApiClient, id int, principal *string) error {
-	wait := &retry.StateChangeConf{
-		Pending: []string{
-			pl.PrivateLinkPrincipalStatusInitializing, pl.PrivateLinkPrincipalStatusAssociating},
-		Target:       []string{pl.PrivateLinkPrincipalStatusAssociated},
-		Timeout:      utils.SafetyTimeout,
-		Delay:        10 * time.Second,
-		PollInterval: 10 * time.Second,
-		Refresh: func() (result interface{}, state string, err error) {
-			log.Printf("[DEBUG] Waiting for private link principal %d to be associated", id)
-
-			privateLink, err := client.Client.PrivateLink.GetPrivateLink(ctx, id)
-			if err != nil {
-				return "", "", err
-			}
-
-			for _, p := range privateLink.Principals {
-				if *p.Principal == *principal {
-					return *p.Principal, *p.Status, nil
-				}
-			}
-
-			return nil, "", fmt.Errorf("principal %s not found", *principal)
-		}}
-
-	if _, err := wait.WaitForStateContext(ctx); err != nil {
-		return err
-	}
-
-	return nil
-}
-
-func principalsFromSet(principals *schema.Set) []pl.PrivateLinkPrincipal {
-	var createPrincipals []pl.PrivateLinkPrincipal
-	for _, principal := range principals.List() {
-		principalMap := principal.(map[string]interface{})
-
-		createPrincipal := pl.PrivateLinkPrincipal{
-			Principal: redis.String(principalMap["principal"].(string)),
-			Alias:     redis.String(principalMap["principal_alias"].(string)),
-			Type:      redis.String(principalMap["principal_type"].(string)),
-		}
-
-		createPrincipals = append(createPrincipals, createPrincipal)
-	}
-
-	sort.Slice(createPrincipals, func(i, j int) bool {
-		return *createPrincipals[i].Principal < *createPrincipals[j].Principal
-	})
-
-	return createPrincipals
-}
-
 func flattenPrincipals(principals []*pl.PrivateLinkPrincipal) []map[string]interface{} {
 	var tfs = make([]map[string]interface{}, 0)
 
@@ -183,16 +58,6 @@ func flattenConnections(connections []*pl.PrivateLinkConnection) []map[string]in
 	return tfs
 }
 
-func waitForAllPrincipalsToBeAssociated(ctx context.Context, api *client.ApiClient, subId int, principals []pl.PrivateLinkPrincipal) error {
-	for _, principal := range principals {
-		err := waitForPrincipalToBeAssociated(ctx, api, subId, principal.Principal)
-		if err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
 func makeActiveActivePrivateLinkId(subId int, regionId int) string {
 	return strconv.Itoa(subId) + "/" + strconv.Itoa(regionId)
 }
@@ -301,7 +301,7 @@ func dataSourceRedisCloudProSubscriptionRead(ctx context.Context, d *schema.Reso
 	if err := d.Set("number_of_databases", redis.IntValue(sub.NumberOfDatabases)); err != nil {
 		return diag.FromErr(err)
 	}
-	if err := d.Set("cloud_provider", FlattenCloudDetails(sub.CloudDetails, false)); err != nil {
+	if err := d.Set("cloud_provider", FlattenCloudDetails(sub.CloudDetails)); err != nil {
 		return diag.FromErr(err)
 	}
 	if err := d.Set("status", redis.StringValue(sub.Status)); err != nil {
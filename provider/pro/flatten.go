@@ -1,53 +1,16 @@
 package pro
 
 import (
-	"context"
-	"fmt"
 	"strconv"
 
-	"github.com/RedisLabs/terraform-provider-rediscloud/provider/client"
-
 	"github.com/RedisLabs/rediscloud-go-api/redis"
 	"github.com/RedisLabs/rediscloud-go-api/service/databases"
 	"github.com/RedisLabs/rediscloud-go-api/service/maintenance"
 	"github.com/RedisLabs/rediscloud-go-api/service/pricing"
 	"github.com/RedisLabs/rediscloud-go-api/service/subscriptions"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-)
-
-func flattenSubscriptionAllowlist(ctx context.Context, subId int, api *client.ApiClient) ([]map[string]interface{}, error) {
-	allowlist, err := api.Client.Subscription.GetCIDRAllowlist(ctx, subId)
-	if err != nil {
-		return nil, err
-	}
-
-	if !isNil(allowlist.Errors) {
-		return nil, fmt.Errorf("unable to read allowlist for subscription %d: %v", subId, allowlist.Errors)
-	}
-
-	var cidrs []string
-	for _, cidr := range allowlist.CIDRIPs {
-		cidrs = append(cidrs, redis.StringValue(cidr))
-	}
-	var sgs []string
-	for _, sg := range allowlist.SecurityGroupIDs {
-		sgs = append(sgs, redis.StringValue(sg))
-	}
-
-	tfs := map[string]interface{}{}
 
-	if len(cidrs) != 0 {
-		tfs["cidrs"] = cidrs
-	}
-	if len(sgs) != 0 {
-		tfs["security_group_ids"] = sgs
-	}
-	if len(tfs) == 0 {
-		return nil, nil
-	}
-
-	return []map[string]interface{}{tfs}, nil
-}
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/utils"
+)
 
 func isNil(i interface{}) bool {
 	if i == nil {
@@ -69,7 +32,7 @@ func isNil(i interface{}) bool {
 	return false
 }
 
-func FlattenCloudDetails(cloudDetails []*subscriptions.CloudDetail, isResource bool) []map[string]interface{} {
+func FlattenCloudDetails(cloudDetails []*subscriptions.CloudDetail) []map[string]interface{} {
 	var cdl []map[string]interface{}
 
 	for _, currentCloudDetail := range cloudDetails {
@@ -84,16 +47,6 @@ func FlattenCloudDetails(cloudDetails []*subscriptions.CloudDetail, isResource b
 				"networks":                     flattenNetworks(currentRegion.Networking),
 			}
 
-			if isResource {
-				if len(currentRegion.Networking) > 0 && !redis.BoolValue(currentRegion.MultipleAvailabilityZones) {
-					regionMapString["networking_deployment_cidr"] = redis.StringValue(currentRegion.Networking[0].DeploymentCIDR)
-					regionMapString["networking_vpc_id"] = redis.StringValue(currentRegion.Networking[0].VPCId)
-				} else {
-					regionMapString["networking_deployment_cidr"] = ""
-					regionMapString["networking_vpc_id"] = ""
-				}
-			}
-
 			regions = append(regions, regionMapString)
 		}
 
@@ -172,7 +125,7 @@ func FlattenRegexRules(rules []*databases.RegexRule) []string {
 	return ret
 }
 
-func ReadPaymentMethodID(d *schema.ResourceData) (*int, error) {
+func ReadPaymentMethodID(d utils.ResourceGetter) (*int, error) {
 	pmID := d.Get("payment_method_id").(string)
 	if pmID != "" {
 		pmID, err := strconv.Atoi(pmID)
@@ -205,6 +158,26 @@ func FlattenPricing(pricing []*pricing.Pricing) []map[string]interface{} {
 	return tfs
 }
 
+// ExpandPricing converts a pricing attribute, as set by FlattenPricing, back into the API's pricing entries.
+func ExpandPricing(entries []interface{}) []*pricing.Pricing {
+	list := make([]*pricing.Pricing, 0, len(entries))
+	for _, entry := range entries {
+		entryMap := entry.(map[string]interface{})
+		list = append(list, &pricing.Pricing{
+			DatabaseName:        redis.String(entryMap["database_name"].(string)),
+			Type:                redis.String(entryMap["type"].(string)),
+			TypeDetails:         redis.String(entryMap["type_details"].(string)),
+			Quantity:            redis.Int(entryMap["quantity"].(int)),
+			QuantityMeasurement: redis.String(entryMap["quantity_measurement"].(string)),
+			PricePerUnit:        redis.Float64(entryMap["price_per_unit"].(float64)),
+			PriceCurrency:       redis.String(entryMap["price_currency"].(string)),
+			PricePeriod:         redis.String(entryMap["price_period"].(string)),
+			Region:              redis.String(entryMap["region"].(string)),
+		})
+	}
+	return list
+}
+
 func FlattenMaintenance(m *maintenance.Maintenance) []map[string]interface{} {
 	var windows []map[string]interface{}
 	for _, w := range m.Windows {
@@ -0,0 +1,34 @@
+package pro
+
+import (
+	"testing"
+
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/RedisLabs/rediscloud-go-api/service/pricing"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitExpandPricing(t *testing.T) {
+	list := []*pricing.Pricing{{
+		DatabaseName:        redis.String("cache"),
+		Type:                redis.String("Shards"),
+		TypeDetails:         redis.String("high-throughput"),
+		Quantity:            redis.Int(2),
+		QuantityMeasurement: redis.String("shards"),
+		PricePerUnit:        redis.Float64(0.124),
+		PriceCurrency:       redis.String("USD"),
+		PricePeriod:         redis.String("hour"),
+		Region:              redis.String("us-east-1"),
+	}}
+
+	// The pricing goes through state as SDK resources store it.
+	resource := &schema.Resource{Schema: map[string]*schema.Schema{
+		"pricing": DataSourceRedisCloudProSubscription().Schema["pricing"],
+	}}
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{})
+	require.NoError(t, d.Set("pricing", FlattenPricing(list)))
+
+	assert.Equal(t, list, ExpandPricing(d.Get("pricing").([]interface{})))
+}
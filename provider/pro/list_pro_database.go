@@ -0,0 +1,126 @@
+package pro
+
+import (
+	"context"
+
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/RedisLabs/rediscloud-go-api/service/subscriptions"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/utils"
+)
+
+var (
+	_ list.ListResource              = &proDatabaseListResource{}
+	_ list.ListResourceWithConfigure = &proDatabaseListResource{}
+)
+
+// proDatabaseListResource lists the databases of the account's Pro subscriptions. It shares the type name, client
+// and read logic of the managed resource.
+type proDatabaseListResource struct {
+	proDatabaseResource
+}
+
+// proDatabaseListModel describes the list resource configuration.
+type proDatabaseListModel struct {
+	SubscriptionID types.Int64 `tfsdk:"subscription_id"`
+}
+
+// NewProDatabaseListResource returns a new list resource instance.
+func NewProDatabaseListResource() list.ListResource {
+	return &proDatabaseListResource{}
+}
+
+// ListResourceConfigSchema defines the filters accepted by the list resource.
+func (r *proDatabaseListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		Description: "Lists the databases of the account's Pro subscriptions",
+		Attributes: map[string]listschema.Attribute{
+			"subscription_id": listschema.Int64Attribute{
+				Description: "Only list the databases of this subscription. When omitted, every Pro subscription is listed",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// List streams one result per Pro database.
+func (r *proDatabaseListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config proDatabaseListModel
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		subs, err := utils.ListSubscriptions(ctx, r.client, subscriptions.SubscriptionDeploymentTypeSingleRegion, int(config.SubscriptionID.ValueInt64()))
+		if err != nil {
+			result := list.ListResult{}
+			result.Diagnostics.AddError("Failed to list subscriptions", err.Error())
+			push(result)
+			return
+		}
+
+		var count int64
+		for _, sub := range subs {
+			subId := redis.IntValue(sub.ID)
+			dbs := r.client.Client.Database.List(ctx, subId)
+			for dbs.Next() {
+				if req.Limit > 0 && count >= req.Limit {
+					return
+				}
+				count++
+
+				db := dbs.Value()
+				model := listedProDatabaseModel(subId, redis.IntValue(db.ID))
+
+				result := req.NewListResult(ctx)
+				result.DisplayName = redis.StringValue(db.Name)
+				setDatabaseIdentity(ctx, result.Identity, &model, &result.Diagnostics)
+
+				if req.IncludeResource && !result.Diagnostics.HasError() {
+					if removed := r.readDatabase(ctx, &model, &result.Diagnostics); !removed && !result.Diagnostics.HasError() {
+						result.Diagnostics.Append(result.Resource.Set(ctx, model)...)
+					}
+				}
+
+				if !push(result) {
+					return
+				}
+			}
+			if err := dbs.Err(); err != nil {
+				result := list.ListResult{}
+				result.Diagnostics.AddError("Failed to list databases", err.Error())
+				push(result)
+				return
+			}
+		}
+	}
+}
+
+// listedProDatabaseModel returns the model of a listed database, before it is read. The attributes which aren't read
+// from the API are null.
+func listedProDatabaseModel(subId int, dbId int) ProDatabaseModel {
+	return ProDatabaseModel{
+		ID:                               types.StringValue(utils.BuildResourceId(subId, dbId)),
+		SubscriptionID:                   types.Int64Value(int64(subId)),
+		DbID:                             types.Int64Value(int64(dbId)),
+		AverageItemSizeInBytes:           types.Int64Null(),
+		ExternalEndpointForOSSClusterAPI: types.BoolNull(),
+		PeriodicBackupPath:               types.StringNull(),
+		ClientSSLCertificate:             types.StringNull(),
+		ClientTLSCertificates:            types.ListNull(types.StringType),
+		ReplicaOf:                        types.SetNull(types.StringType),
+		Modules:                          types.SetNull(types.ObjectType{AttrTypes: moduleAttrTypes()}),
+		SourceIPs:                        types.SetNull(types.StringType),
+		HashingPolicy:                    types.ListNull(types.StringType),
+		RemoteBackup:                     types.ListNull(types.ObjectType{AttrTypes: utils.RemoteBackupAttrTypes()}),
+		Tags:                             types.MapNull(types.StringType),
+		Port:                             types.Int64Null(),
+		CreationPending:                  types.BoolNull(),
+		Timeouts:                         nullTimeouts(),
+	}
+}
@@ -0,0 +1,75 @@
+package pro
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/RedisLabs/rediscloud-go-api/service/subscriptions"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/utils"
+)
+
+var (
+	_ list.ListResource              = &proSubscriptionListResource{}
+	_ list.ListResourceWithConfigure = &proSubscriptionListResource{}
+)
+
+// proSubscriptionListResource lists the Pro subscriptions of the account. It shares the type name, client and read
+// logic of the managed resource.
+type proSubscriptionListResource struct {
+	proSubscriptionResource
+}
+
+// NewProSubscriptionListResource returns a new list resource instance.
+func NewProSubscriptionListResource() list.ListResource {
+	return &proSubscriptionListResource{}
+}
+
+// ListResourceConfigSchema defines the filters accepted by the list resource.
+func (r *proSubscriptionListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		Description: "Lists the account's Pro subscriptions",
+	}
+}
+
+// List streams one result per Pro subscription.
+func (r *proSubscriptionListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	stream.Results = func(push func(list.ListResult) bool) {
+		subs, err := utils.ListSubscriptions(ctx, r.client, subscriptions.SubscriptionDeploymentTypeSingleRegion, 0)
+		if err != nil {
+			result := list.ListResult{}
+			result.Diagnostics.AddError("Failed to list subscriptions", err.Error())
+			push(result)
+			return
+		}
+
+		for i, sub := range subs {
+			if req.Limit > 0 && int64(i) >= req.Limit {
+				return
+			}
+
+			model := ProSubscriptionModel{
+				ID:       types.StringValue(strconv.Itoa(redis.IntValue(sub.ID))),
+				Timeouts: nullTimeouts(),
+			}
+
+			result := req.NewListResult(ctx)
+			result.DisplayName = redis.StringValue(sub.Name)
+			setIdentity(ctx, result.Identity, &model, &result.Diagnostics)
+
+			if req.IncludeResource && !result.Diagnostics.HasError() {
+				if removed := r.readSubscription(ctx, &model, &result.Diagnostics); !removed && !result.Diagnostics.HasError() {
+					result.Diagnostics.Append(result.Resource.Set(ctx, model)...)
+				}
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,737 @@
+package pro
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/RedisLabs/rediscloud-go-api/service/databases"
+	redisTags "github.com/RedisLabs/rediscloud-go-api/service/tags"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/utils"
+)
+
+// createDatabase implements the Create operation for the Pro database resource.
+func (r *proDatabaseResource) createDatabase(ctx context.Context, plan *ProDatabaseModel, diagnostics *diag.Diagnostics) {
+	subId := int(plan.SubscriptionID.ValueInt64())
+
+	// Take this change's turn on the subscription
+	change, err := utils.DatabaseChanges.Begin(ctx, r.client, subId)
+	if err != nil {
+		diagnostics.AddError("Failed to lock subscription", err.Error())
+		return
+	}
+	defer change.End()
+
+	createModules := make([]*databases.Module, 0)
+	if utils.IsConfigured(plan.Modules) {
+		var modules []ModuleModel
+		diagnostics.Append(plan.Modules.ElementsAs(ctx, &modules, false)...)
+		if diagnostics.HasError() {
+			return
+		}
+		for _, module := range modules {
+			createModules = append(createModules, &databases.Module{Name: redis.String(module.Name.ValueString())})
+		}
+	}
+
+	alerts, diags := utils.BuildAlerts(ctx, plan.Alert)
+	diagnostics.Append(diags...)
+	if diagnostics.HasError() {
+		return
+	}
+
+	remoteBackup, diags := buildBackupPlan(ctx, plan.RemoteBackup, plan.PeriodicBackupPath)
+	diagnostics.Append(diags...)
+	if diagnostics.HasError() {
+		return
+	}
+
+	createDatabase := databases.CreateDatabase{
+		Name:                 redis.String(plan.Name.ValueString()),
+		Protocol:             redis.String(plan.Protocol.ValueString()),
+		SupportOSSClusterAPI: redis.Bool(plan.SupportOSSClusterAPI.ValueBool()),
+		DataPersistence:      redis.String(plan.DataPersistence.ValueString()),
+		DataEvictionPolicy:   redis.String(plan.DataEviction.ValueString()),
+		Replication:          redis.Bool(plan.Replication.ValueBool()),
+		ThroughputMeasurement: &databases.CreateThroughputMeasurement{
+			By:    redis.String(plan.ThroughputMeasurementBy.ValueString()),
+			Value: redis.Int(int(plan.ThroughputMeasurementValue.ValueInt64())),
+		},
+		Modules:      createModules,
+		Alerts:       alerts,
+		RemoteBackup: remoteBackup,
+	}
+
+	// Set optional fields
+	if utils.IsConfigured(plan.QueryPerformanceFactor) && plan.QueryPerformanceFactor.ValueString() != "" {
+		createDatabase.QueryPerformanceFactor = redis.String(plan.QueryPerformanceFactor.ValueString())
+	}
+
+	if utils.IsConfigured(plan.RedisVersion) && plan.RedisVersion.ValueString() != "" {
+		createDatabase.RedisVersion = redis.String(plan.RedisVersion.ValueString())
+	}
+
+	if utils.IsConfigured(plan.Password) && plan.Password.ValueString() != "" {
+		createDatabase.Password = redis.String(plan.Password.ValueString())
+	}
+
+	if plan.AverageItemSizeInBytes.ValueInt64() > 0 {
+		createDatabase.AverageItemSizeInBytes = redis.Int(int(plan.AverageItemSizeInBytes.ValueInt64()))
+	}
+
+	if utils.IsConfigured(plan.RamPercentage) && plan.RamPercentage.ValueInt64() > 0 {
+		createDatabase.RamPercentage = redis.Int(int(plan.RamPercentage.ValueInt64()))
+	}
+
+	if utils.IsConfigured(plan.DatasetSizeInGB) {
+		createDatabase.DatasetSizeInGB = redis.Float64(plan.DatasetSizeInGB.ValueFloat64())
+	}
+
+	if utils.IsConfigured(plan.MemoryLimitInGB) {
+		createDatabase.MemoryLimitInGB = redis.Float64(plan.MemoryLimitInGB.ValueFloat64())
+	}
+
+	if utils.IsConfigured(plan.Port) {
+		createDatabase.PortNumber = redis.Int(int(plan.Port.ValueInt64()))
+	}
+
+	if utils.IsConfigured(plan.RespVersion) && plan.RespVersion.ValueString() != "" {
+		createDatabase.RespVersion = redis.String(plan.RespVersion.ValueString())
+	}
+
+	// Confirm sub is ready to accept a db request
+	if err := change.WaitForSubscriptionToBeActive(ctx, r.client); err != nil {
+		diagnostics.AddError("Subscription not active", err.Error())
+		return
+	}
+
+	dbId, err := r.client.Client.Database.Create(ctx, subId, createDatabase)
+	if err != nil {
+		diagnostics.AddError("Failed to create database", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(utils.BuildResourceId(subId, dbId))
+	plan.DbID = types.Int64Value(int64(dbId))
+
+	// Wait for database and subscription to be active. If the apply is interrupted meanwhile, the database is saved
+	// as pending, for the next apply to resume waiting for it.
+	if err := r.waitForDatabaseCreate(ctx, subId, dbId); err != nil {
+		if utils.CreateInterrupted(ctx, err) {
+			plan.CreationPending = types.BoolValue(true)
+			warning := utils.CreationPendingWarning(fmt.Sprintf("Database %d", dbId), err)
+			diagnostics.AddWarning(warning.Summary, warning.Detail)
+			return
+		}
+		diagnostics.AddError("Database failed to become active", err.Error())
+		return
+	}
+	plan.CreationPending = types.BoolValue(false)
+
+	// End this change before the update, which takes another turn
+	change.End()
+
+	// Some attributes on a database are not accessible by the create API, such as the password and
+	// enable_default_user. Run the update function to apply them. The database already has the planned version.
+	r.updateDatabase(ctx, plan, plan.RedisVersion, diagnostics)
+	if diagnostics.HasError() {
+		return
+	}
+
+	// Read back the state to get computed values
+	r.readDatabase(ctx, plan, diagnostics)
+}
+
+// waitForDatabaseCreate waits for a new database, and then its subscription, to be active.
+func (r *proDatabaseResource) waitForDatabaseCreate(ctx context.Context, subId int, dbId int) error {
+	if err := utils.WaitForDatabaseToBeActive(ctx, subId, dbId, r.client); err != nil {
+		return err
+	}
+	return utils.WaitForSubscriptionToBeActive(ctx, subId, r.client)
+}
+
+// readDatabase implements the Read operation for the Pro database resource.
+// Returns true if the resource was removed (not found).
+func (r *proDatabaseResource) readDatabase(ctx context.Context, state *ProDatabaseModel, diagnostics *diag.Diagnostics) bool {
+	subId, dbId, err := ToDatabaseId(state.ID.ValueString())
+	if err != nil {
+		diagnostics.AddError("Invalid resource ID", err.Error())
+		return false
+	}
+
+	// Handle case where subscription_id is 0 (not importing)
+	if subId == 0 {
+		subId = int(state.SubscriptionID.ValueInt64())
+	}
+
+	db, err := r.client.Client.Database.Get(ctx, subId, dbId)
+	if err != nil {
+		notFound := &databases.NotFound{}
+		if errors.As(err, &notFound) {
+			log.Printf("[DEBUG] Database %d not found, removing from state", dbId)
+			return true
+		}
+		diagnostics.AddError("Failed to read database", err.Error())
+		return false
+	}
+
+	// Set basic fields
+	state.SubscriptionID = types.Int64Value(int64(subId))
+	state.DbID = types.Int64Value(int64(redis.IntValue(db.ID)))
+	state.DeletionProtection = utils.DeletionProtectionValue(state.DeletionProtection)
+	state.Name = types.StringValue(redis.StringValue(db.Name))
+	state.Protocol = types.StringValue(redis.StringValue(db.Protocol))
+	state.SupportOSSClusterAPI = types.BoolValue(redis.BoolValue(db.SupportOSSClusterAPI))
+	state.RespVersion = types.StringValue(redis.StringValue(db.RespVersion))
+	state.DataPersistence = types.StringValue(redis.StringValue(db.DataPersistence))
+	state.DataEviction = types.StringValue(redis.StringValue(db.DataEvictionPolicy))
+	state.Replication = types.BoolValue(redis.BoolValue(db.Replication))
+	state.PublicEndpoint = types.StringValue(redis.StringValue(db.PublicEndpoint))
+	state.PrivateEndpoint = types.StringValue(redis.StringValue(db.PrivateEndpoint))
+	state.QueryPerformanceFactor = types.StringValue(redis.StringValue(db.QueryPerformanceFactor))
+	state.RedisVersion = types.StringValue(redis.StringValue(db.RedisVersion))
+
+	// The attributes which aren't read from the API have their defaults when the database is imported
+	if state.AverageItemSizeInBytes.IsNull() {
+		state.AverageItemSizeInBytes = types.Int64Value(0)
+	}
+	if state.ExternalEndpointForOSSClusterAPI.IsNull() {
+		state.ExternalEndpointForOSSClusterAPI = types.BoolValue(false)
+	}
+	if state.PeriodicBackupPath.IsNull() {
+		state.PeriodicBackupPath = types.StringValue("")
+	}
+	if state.ClientSSLCertificate.IsNull() {
+		state.ClientSSLCertificate = types.StringValue("")
+	}
+
+	if db.ThroughputMeasurement != nil {
+		state.ThroughputMeasurementBy = types.StringValue(redis.StringValue(db.ThroughputMeasurement.By))
+		state.ThroughputMeasurementValue = types.Int64Value(int64(redis.IntValue(db.ThroughputMeasurement.Value)))
+	}
+
+	if db.RamPercentage != nil {
+		state.RamPercentage = types.Int64Value(int64(redis.IntValue(db.RamPercentage)))
+	} else if state.RamPercentage.IsUnknown() {
+		state.RamPercentage = types.Int64Null()
+	}
+
+	// Handle memory/dataset size - only the one which is configured is planned, and refreshed. A database imported
+	// has its dataset size. Must explicitly set unused field to Null (not Unknown) after apply.
+	datasetSet := utils.IsConfigured(state.DatasetSizeInGB) && state.DatasetSizeInGB.ValueFloat64() > 0
+	memorySet := utils.IsConfigured(state.MemoryLimitInGB) && state.MemoryLimitInGB.ValueFloat64() > 0
+	if datasetSet || !memorySet {
+		state.DatasetSizeInGB = types.Float64Value(redis.Float64Value(db.DatasetSizeInGB))
+	} else {
+		state.DatasetSizeInGB = types.Float64Null()
+	}
+	if memorySet {
+		state.MemoryLimitInGB = types.Float64Value(redis.Float64Value(db.MemoryLimitInGB))
+	} else {
+		state.MemoryLimitInGB = types.Float64Null()
+	}
+
+	// Only db with the "redis" protocol returns the password.
+	if redis.StringValue(db.Protocol) == "redis" && db.Security != nil {
+		state.Password = types.StringValue(redis.StringValue(db.Security.Password))
+	} else if state.Password.IsUnknown() {
+		state.Password = types.StringNull()
+	}
+
+	modules, diags := r.flattenModules(db, state.Modules)
+	diagnostics.Append(diags...)
+	state.Modules = modules
+
+	alerts, diags := utils.FlattenAlerts(db.Alerts)
+	diagnostics.Append(diags...)
+	if alerts.IsNull() {
+		// The alert block is never null in the configuration
+		alerts = types.SetValueMust(types.ObjectType{AttrTypes: utils.AlertAttrTypes()}, []attr.Value{})
+	}
+	state.Alert = alerts
+
+	if db.Clustering != nil {
+		hashingPolicy, diags := types.ListValueFrom(ctx, types.StringType, FlattenRegexRules(db.Clustering.RegexRules))
+		diagnostics.Append(diags...)
+		state.HashingPolicy = hashingPolicy
+	} else if state.HashingPolicy.IsUnknown() {
+		state.HashingPolicy = types.ListValueMust(types.StringType, []attr.Value{})
+	}
+
+	if db.Security != nil {
+		state.EnableTLS = types.BoolValue(redis.BoolValue(db.Security.EnableTls))
+		state.EnableDefaultUser = types.BoolValue(redis.BoolValue(db.Security.EnableDefaultUser))
+
+		sourceIPs, diags := r.readSourceIPs(ctx, subId, db.Security.SourceIPs, state.SourceIPs)
+		diagnostics.Append(diags...)
+		if diagnostics.HasError() {
+			return false
+		}
+		state.SourceIPs = sourceIPs
+
+		applyCertificateState(redis.BoolValue(db.Security.TLSClientAuthentication), state)
+	}
+
+	remoteBackup, diags := flattenBackupPlan(ctx, db.Backup, state.RemoteBackup, state.PeriodicBackupPath.ValueString())
+	diagnostics.Append(diags...)
+	state.RemoteBackup = remoteBackup
+
+	// Read tags using the tags service
+	tagResponse, err := r.client.Client.Tags.Get(ctx, subId, dbId)
+	if err != nil {
+		diagnostics.AddError("Failed to read tags", err.Error())
+		return false
+	}
+	tags := make(map[string]string)
+	if tagResponse.Tags != nil {
+		for _, t := range *tagResponse.Tags {
+			tags[redis.StringValue(t.Key)] = redis.StringValue(t.Value)
+		}
+	}
+	if len(tags) == 0 && !utils.IsConfigured(state.Tags) {
+		state.Tags = types.MapNull(types.StringType)
+	} else {
+		tagsValue, diags := types.MapValueFrom(ctx, types.StringType, tags)
+		diagnostics.Append(diags...)
+		state.Tags = tagsValue
+	}
+
+	return false
+}
+
+// flattenModules returns the database's modules. For Redis 8.0+, modules are bundled by default and returned by the
+// API, so are only kept if they are configured. A database without modules has none, rather than an empty set.
+func (r *proDatabaseResource) flattenModules(db *databases.Database, prior types.Set) (types.Set, diag.Diagnostics) {
+	moduleType := types.ObjectType{AttrTypes: moduleAttrTypes()}
+
+	if shouldSuppressModuleDiffsForRedis8(redis.StringValue(db.RedisVersion)) {
+		if utils.IsConfigured(prior) {
+			return prior, nil
+		}
+		return types.SetNull(moduleType), nil
+	}
+
+	if len(db.Modules) == 0 && !utils.IsConfigured(prior) {
+		return types.SetNull(moduleType), nil
+	}
+
+	elements := make([]attr.Value, 0, len(db.Modules))
+	for _, module := range db.Modules {
+		elements = append(elements, types.ObjectValueMust(moduleAttrTypes(), map[string]attr.Value{
+			"name": types.StringValue(redis.StringValue(module.Name)),
+		}))
+	}
+	return types.SetValue(moduleType, elements)
+}
+
+// readSourceIPs returns the database's source IPs. When the API returns none, or the defaults, a custom value in the
+// state is kept. Otherwise, the defaults follow the subscription's public endpoint access.
+func (r *proDatabaseResource) readSourceIPs(ctx context.Context, subId int, apiSourceIPs []*string, prior types.Set) (types.Set, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	sourceIPs := redis.StringSliceValue(apiSourceIPs...)
+	if len(sourceIPs) > 0 && !utils.IsDefaultSourceIPs(sourceIPs) {
+		return types.SetValueFrom(ctx, types.StringType, sourceIPs)
+	}
+
+	var priorSourceIPs []string
+	if utils.IsConfigured(prior) {
+		diags.Append(prior.ElementsAs(ctx, &priorSourceIPs, false)...)
+		if diags.HasError() {
+			return prior, diags
+		}
+	}
+	if !utils.IsDefaultSourceIPs(priorSourceIPs) {
+		return prior, diags
+	}
+
+	// Wait for subscription to be fully active to ensure we have the latest state
+	if err := utils.WaitForSubscriptionToBeActive(ctx, subId, r.client); err != nil {
+		diags.AddError("Failed to wait for subscription", err.Error())
+		return prior, diags
+	}
+	subscription, err := r.client.Client.Subscription.Get(ctx, subId)
+	if err != nil {
+		diags.AddError("Failed to get subscription", err.Error())
+		return prior, diags
+	}
+
+	if subscription.PublicEndpointAccess != nil && !*subscription.PublicEndpointAccess {
+		sourceIPs = utils.DefaultPrivateIPRanges
+	} else {
+		sourceIPs = []string{utils.DefaultPublicSourceIP}
+	}
+
+	result, d := types.SetValueFrom(ctx, types.StringType, sourceIPs)
+	diags.Append(d...)
+	return result, diags
+}
+
+// applyCertificateState reconciles the certificates in the state with whether the database authenticates its clients.
+// A database which authenticates its clients without a certificate in the state has an empty list of TLS
+// certificates, which differs from an unset one, so that the next apply brings it back in line with the
+// configuration. A database which doesn't authenticate its clients has no certificates.
+func applyCertificateState(tlsAuthEnabled bool, state *ProDatabaseModel) {
+	if tlsAuthEnabled {
+		if state.ClientSSLCertificate.ValueString() == "" && len(state.ClientTLSCertificates.Elements()) == 0 {
+			state.ClientTLSCertificates = types.ListValueMust(types.StringType, []attr.Value{})
+		}
+		return
+	}
+
+	state.ClientSSLCertificate = types.StringValue("")
+	state.ClientTLSCertificates = types.ListNull(types.StringType)
+}
+
+// updateDatabase implements the Update operation for the Pro database resource. The Redis version is upgraded first
+// if it differs from the prior one.
+func (r *proDatabaseResource) updateDatabase(ctx context.Context, plan *ProDatabaseModel, priorRedisVersion types.String, diagnostics *diag.Diagnostics) {
+	subId, dbId, err := ToDatabaseId(plan.ID.ValueString())
+	if err != nil {
+		diagnostics.AddError("Invalid resource ID", err.Error())
+		return
+	}
+	if subId == 0 {
+		subId = int(plan.SubscriptionID.ValueInt64())
+	}
+
+	change, err := utils.DatabaseChanges.Begin(ctx, r.client, subId)
+	if err != nil {
+		diagnostics.AddError("Failed to lock subscription", err.Error())
+		return
+	}
+	defer change.End()
+
+	// BuildAlerts returns a non-nil (but zero length) slice when there are no alerts, so that they are removed
+	alerts, diags := utils.BuildAlerts(ctx, plan.Alert)
+	diagnostics.Append(diags...)
+	if diagnostics.HasError() {
+		return
+	}
+
+	remoteBackup, diags := buildBackupPlan(ctx, plan.RemoteBackup, plan.PeriodicBackupPath)
+	diagnostics.Append(diags...)
+	if diagnostics.HasError() {
+		return
+	}
+
+	update := databases.UpdateDatabase{
+		Name:                 redis.String(plan.Name.ValueString()),
+		SupportOSSClusterAPI: redis.Bool(plan.SupportOSSClusterAPI.ValueBool()),
+		Replication:          redis.Bool(plan.Replication.ValueBool()),
+		ThroughputMeasurement: &databases.UpdateThroughputMeasurement{
+			By:    redis.String(plan.ThroughputMeasurementBy.ValueString()),
+			Value: redis.Int(int(plan.ThroughputMeasurementValue.ValueInt64())),
+		},
+		DataPersistence:                     redis.String(plan.DataPersistence.ValueString()),
+		DataEvictionPolicy:                  redis.String(plan.DataEviction.ValueString()),
+		Alerts:                              &alerts,
+		RemoteBackup:                        remoteBackup,
+		EnableDefaultUser:                   redis.Bool(plan.EnableDefaultUser.ValueBool()),
+		UseExternalEndpointForOSSClusterAPI: redis.Bool(plan.ExternalEndpointForOSSClusterAPI.ValueBool()),
+	}
+
+	// One of the following fields must be set, validation is handled in the schema (ExactlyOneOf)
+	if utils.IsConfigured(plan.DatasetSizeInGB) {
+		update.DatasetSizeInGB = redis.Float64(plan.DatasetSizeInGB.ValueFloat64())
+	} else if utils.IsConfigured(plan.MemoryLimitInGB) {
+		update.MemoryLimitInGB = redis.Float64(plan.MemoryLimitInGB.ValueFloat64())
+	}
+
+	// Handle source_ips defaults based on subscription's public_endpoint_access setting, so that when it changes,
+	// the source_ips are updated accordingly (unless the user has explicitly set custom source_ips)
+	var sourceIPs []string
+	if utils.IsConfigured(plan.SourceIPs) {
+		diagnostics.Append(plan.SourceIPs.ElementsAs(ctx, &sourceIPs, false)...)
+		if diagnostics.HasError() {
+			return
+		}
+	}
+	if utils.IsDefaultSourceIPs(sourceIPs) {
+		subscription, err := r.client.Client.Subscription.Get(ctx, subId)
+		if err != nil {
+			diagnostics.AddError("Failed to get subscription", err.Error())
+			return
+		}
+
+		if subscription.PublicEndpointAccess != nil && !*subscription.PublicEndpointAccess {
+			sourceIPs = utils.DefaultPrivateIPRanges
+		} else {
+			sourceIPs = []string{utils.DefaultPublicSourceIP}
+		}
+	}
+	update.SourceIP = redis.StringSlice(sourceIPs...)
+
+	if utils.IsConfigured(plan.QueryPerformanceFactor) && plan.QueryPerformanceFactor.ValueString() != "" {
+		update.QueryPerformanceFactor = redis.String(plan.QueryPerformanceFactor.ValueString())
+	}
+
+	if utils.IsConfigured(plan.Password) && plan.Password.ValueString() != "" {
+		update.Password = redis.String(plan.Password.ValueString())
+	}
+
+	if utils.IsConfigured(plan.RamPercentage) && plan.RamPercentage.ValueInt64() > 0 {
+		update.RamPercentage = redis.Int(int(plan.RamPercentage.ValueInt64()))
+	}
+
+	replicaOf := make([]string, 0)
+	if utils.IsConfigured(plan.ReplicaOf) {
+		diagnostics.Append(plan.ReplicaOf.ElementsAs(ctx, &replicaOf, false)...)
+		if diagnostics.HasError() {
+			return
+		}
+	}
+	update.ReplicaOf = redis.StringSlice(replicaOf...)
+
+	// The cert validation is done by the API (HTTP 400 is returned if it's invalid).
+	clientSSLCertificate := plan.ClientSSLCertificate.ValueString()
+	var clientTLSCertificates []string
+	if utils.IsConfigured(plan.ClientTLSCertificates) {
+		diagnostics.Append(plan.ClientTLSCertificates.ElementsAs(ctx, &clientTLSCertificates, false)...)
+		if diagnostics.HasError() {
+			return
+		}
+	}
+	if plan.EnableTLS.ValueBool() {
+		update.EnableTls = redis.Bool(true)
+		if clientSSLCertificate != "" {
+			update.ClientSSLCertificate = redis.String(clientSSLCertificate)
+		}
+		// Any TLS certificates are replaced, and scrubbed when an SSL certificate is provided instead
+		tlsCerts := redis.StringSlice(clientTLSCertificates...)
+		update.ClientTLSCertificates = &tlsCerts
+	} else {
+		if clientSSLCertificate != "" {
+			// mTLS (backward compatibility): enable_tls=false, non-empty client_ssl_certificate
+			update.ClientSSLCertificate = redis.String(clientSSLCertificate)
+		} else if len(clientTLSCertificates) > 0 {
+			diagnostics.AddError("Invalid TLS configuration", "TLS certificates may not be provided while enable_tls is false")
+			return
+		} else {
+			update.EnableTls = redis.Bool(false)
+		}
+	}
+
+	if utils.IsConfigured(plan.HashingPolicy) && len(plan.HashingPolicy.Elements()) > 0 {
+		var regexRules []string
+		diagnostics.Append(plan.HashingPolicy.ElementsAs(ctx, &regexRules, false)...)
+		if diagnostics.HasError() {
+			return
+		}
+		update.RegexRules = redis.StringSlice(regexRules...)
+	}
+
+	if plan.PeriodicBackupPath.ValueString() != "" {
+		update.PeriodicBackupPath = redis.String(plan.PeriodicBackupPath.ValueString())
+	}
+
+	if utils.IsConfigured(plan.RespVersion) && plan.RespVersion.ValueString() != "" {
+		update.RespVersion = redis.String(plan.RespVersion.ValueString())
+	}
+
+	// Confirm sub + db are ready to accept a db request
+	if err := change.WaitForSubscriptionToBeActive(ctx, r.client); err != nil {
+		diagnostics.AddError("Subscription not active", err.Error())
+		return
+	}
+	if err := change.WaitForDatabaseToBeActive(ctx, r.client, dbId); err != nil {
+		diagnostics.AddError("Database not active", err.Error())
+		return
+	}
+
+	// If redis_version has changed, then upgrade first. Only upgrade when a known version goes to another known
+	// version, as either being blank could attempt to upgrade unnecessarily.
+	newVersion := plan.RedisVersion.ValueString()
+	if priorRedisVersion.ValueString() != "" && newVersion != "" && priorRedisVersion.ValueString() != newVersion {
+		if err := r.upgradeRedisVersion(ctx, subId, dbId, newVersion); err != nil {
+			diagnostics.AddError("Failed to upgrade Redis version", err.Error())
+			return
+		}
+	}
+
+	err = utils.WaitForTask(ctx, fmt.Sprintf("update database %d", dbId), func(ctx context.Context) error {
+		return r.client.Client.Database.Update(ctx, subId, dbId, update)
+	}, utils.DatabaseFallback(subId, dbId, r.client))
+	if err != nil {
+		diagnostics.AddError("Failed to update database", err.Error())
+		return
+	}
+
+	// The Tags API is synchronous so we shouldn't have to wait for anything.
+	// When tags is null (not in config), all tags are deleted by sending an empty list.
+	tagList := make([]*redisTags.Tag, 0)
+	if utils.IsConfigured(plan.Tags) {
+		var tags map[string]string
+		diagnostics.Append(plan.Tags.ElementsAs(ctx, &tags, false)...)
+		if diagnostics.HasError() {
+			return
+		}
+		for k, v := range tags {
+			tagList = append(tagList, &redisTags.Tag{
+				Key:   redis.String(k),
+				Value: redis.String(v),
+			})
+		}
+	}
+	if err := r.client.Client.Tags.Put(ctx, subId, dbId, redisTags.AllTags{Tags: &tagList}); err != nil {
+		diagnostics.AddError("Failed to update tags", err.Error())
+		return
+	}
+}
+
+// upgradeRedisVersion changes the Redis version of the database, waiting for its task to complete.
+func (r *proDatabaseResource) upgradeRedisVersion(ctx context.Context, subId int, dbId int, newVersion string) error {
+	log.Printf("[INFO] Requesting Redis version change to %s...", newVersion)
+
+	upgrade := databases.UpgradeRedisVersion{
+		TargetRedisVersion: redis.String(newVersion),
+	}
+
+	err := utils.WaitForTask(ctx, fmt.Sprintf("change the Redis version of database %d to %s", dbId, newVersion), func(ctx context.Context) error {
+		return r.client.Client.Database.UpgradeRedisVersion(ctx, subId, dbId, upgrade)
+	}, utils.DatabaseFallback(subId, dbId, r.client))
+	if err != nil {
+		return fmt.Errorf("failed to change Redis version to %s: %w", newVersion, err)
+	}
+
+	log.Printf("[INFO] Redis version change to %s completed", newVersion)
+
+	return nil
+}
+
+// deleteDatabase implements the Delete operation for the Pro database resource.
+func (r *proDatabaseResource) deleteDatabase(ctx context.Context, state *ProDatabaseModel, diagnostics *diag.Diagnostics) {
+	subId := int(state.SubscriptionID.ValueInt64())
+	_, dbId, err := ToDatabaseId(state.ID.ValueString())
+	if err != nil {
+		diagnostics.AddError("Invalid resource ID", err.Error())
+		return
+	}
+
+	utils.CheckDeletionProtection(state.DeletionProtection, fmt.Sprintf("Database %d", dbId), diagnostics)
+	if diagnostics.HasError() {
+		return
+	}
+
+	unlock, err := utils.LockSubscription(ctx, subId)
+	if err != nil {
+		diagnostics.AddError("Failed to lock subscription", err.Error())
+		return
+	}
+	defer unlock()
+
+	// Confirm sub + db are ready to accept a db request
+	if err := utils.WaitForSubscriptionToBeActive(ctx, subId, r.client); err != nil {
+		diagnostics.AddError("Subscription not active", err.Error())
+		return
+	}
+	if err := utils.WaitForDatabaseToBeActive(ctx, subId, dbId, r.client); err != nil {
+		diagnostics.AddError("Database not active", err.Error())
+		return
+	}
+
+	err = utils.WaitForTask(ctx, fmt.Sprintf("delete database %d", dbId), func(ctx context.Context) error {
+		return r.client.Client.Database.Delete(ctx, subId, dbId)
+	}, utils.SubscriptionFallback(subId, r.client))
+	if err != nil {
+		diagnostics.AddError("Failed to delete database", err.Error())
+		return
+	}
+}
+
+// buildBackupPlan converts the remote_backup block to the API request format. Without the block, backups are
+// disabled, unless they are made to the deprecated periodic_backup_path instead.
+func buildBackupPlan(ctx context.Context, remoteBackup types.List, periodicBackupPath types.String) (*databases.DatabaseBackupConfig, diag.Diagnostics) {
+	var backups []utils.RemoteBackupModel
+	if utils.IsConfigured(remoteBackup) {
+		diags := remoteBackup.ElementsAs(ctx, &backups, false)
+		if diags.HasError() {
+			return nil, diags
+		}
+	}
+
+	if len(backups) != 1 {
+		if periodicBackupPath.ValueString() != "" {
+			return nil, nil
+		}
+		return &databases.DatabaseBackupConfig{Active: redis.Bool(false)}, nil
+	}
+
+	backup := backups[0]
+	config := &databases.DatabaseBackupConfig{
+		Active:      redis.Bool(true),
+		Interval:    redis.String(backup.Interval.ValueString()),
+		StorageType: redis.String(backup.StorageType.ValueString()),
+		StoragePath: redis.String(backup.StoragePath.ValueString()),
+	}
+
+	if backup.TimeUTC.ValueString() != "" {
+		config.TimeUTC = redis.String(backup.TimeUTC.ValueString())
+	}
+
+	return config, nil
+}
+
+// flattenBackupPlan converts the API backup response to the remote_backup block, which is empty when backups are
+// disabled or made to periodic_backup_path. The API doesn't return the storage type, so it is kept from the prior
+// value. The time of backups is kept too if it wasn't set, or if the backups are every 12 hours and the API returns
+// the other time of day they are made at: for a time in the afternoon, the API returns the morning time.
+func flattenBackupPlan(ctx context.Context, backup *databases.Backup, prior types.List, periodicBackupPath string) (types.List, diag.Diagnostics) {
+	remoteBackupType := types.ObjectType{AttrTypes: utils.RemoteBackupAttrTypes()}
+
+	if backup == nil || !redis.BoolValue(backup.Enabled) || periodicBackupPath != "" {
+		return types.ListValueMust(remoteBackupType, []attr.Value{}), nil
+	}
+
+	var priorBackups []utils.RemoteBackupModel
+	if utils.IsConfigured(prior) {
+		diags := prior.ElementsAs(ctx, &priorBackups, false)
+		if diags.HasError() {
+			return prior, diags
+		}
+	}
+
+	interval := redis.StringValue(backup.Interval)
+	timeUTC := types.StringValue(redis.StringValue(backup.TimeUTC))
+	storageType := types.StringValue("")
+	if len(priorBackups) == 1 {
+		storageType = priorBackups[0].StorageType
+		priorTime := priorBackups[0].TimeUTC
+		if priorTime.IsNull() || interval == databases.BackupIntervalEvery12Hours && isOtherTimeOfDay(priorTime.ValueString(), timeUTC.ValueString()) {
+			timeUTC = priorTime
+		}
+	}
+
+	obj, diags := types.ObjectValue(utils.RemoteBackupAttrTypes(), map[string]attr.Value{
+		"interval":     types.StringValue(interval),
+		"time_utc":     timeUTC,
+		"storage_type": storageType,
+		"storage_path": types.StringValue(redis.StringValue(backup.Destination)),
+	})
+	if diags.HasError() {
+		return prior, diags
+	}
+
+	return types.ListValue(remoteBackupType, []attr.Value{obj})
+}
+
+// isOtherTimeOfDay checks whether two times, in the HH:MM format, are 12 hours apart.
+func isOtherTimeOfDay(time1 string, time2 string) bool {
+	t1, err := time.Parse("15:04", time1)
+	if err != nil {
+		return false
+	}
+	t2, err := time.Parse("15:04", time2)
+	if err != nil {
+		return false
+	}
+
+	return t1.Minute() == t2.Minute() && t1.Add(12*time.Hour).Hour() == t2.Hour()
+}
@@ -0,0 +1,62 @@
+package pro
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ProDatabaseModel describes the resource data model for the Pro database. Its alert and remote_backup blocks, and
+// its identity, share the models of the Active-Active database in utils.
+type ProDatabaseModel struct {
+	ID                               types.String   `tfsdk:"id"`
+	SubscriptionID                   types.Int64    `tfsdk:"subscription_id"`
+	DbID                             types.Int64    `tfsdk:"db_id"`
+	Name                             types.String   `tfsdk:"name"`
+	Protocol                         types.String   `tfsdk:"protocol"`
+	MemoryLimitInGB                  types.Float64  `tfsdk:"memory_limit_in_gb"`
+	DatasetSizeInGB                  types.Float64  `tfsdk:"dataset_size_in_gb"`
+	RedisVersion                     types.String   `tfsdk:"redis_version"`
+	SupportOSSClusterAPI             types.Bool     `tfsdk:"support_oss_cluster_api"`
+	RespVersion                      types.String   `tfsdk:"resp_version"`
+	ExternalEndpointForOSSClusterAPI types.Bool     `tfsdk:"external_endpoint_for_oss_cluster_api"`
+	DataPersistence                  types.String   `tfsdk:"data_persistence"`
+	DataEviction                     types.String   `tfsdk:"data_eviction"`
+	Replication                      types.Bool     `tfsdk:"replication"`
+	ThroughputMeasurementBy          types.String   `tfsdk:"throughput_measurement_by"`
+	ThroughputMeasurementValue       types.Int64    `tfsdk:"throughput_measurement_value"`
+	AverageItemSizeInBytes           types.Int64    `tfsdk:"average_item_size_in_bytes"`
+	RamPercentage                    types.Int64    `tfsdk:"ram_percentage"`
+	Password                         types.String   `tfsdk:"password"`
+	PublicEndpoint                   types.String   `tfsdk:"public_endpoint"`
+	PrivateEndpoint                  types.String   `tfsdk:"private_endpoint"`
+	ClientSSLCertificate             types.String   `tfsdk:"client_ssl_certificate"`
+	ClientTLSCertificates            types.List     `tfsdk:"client_tls_certificates"`
+	PeriodicBackupPath               types.String   `tfsdk:"periodic_backup_path"`
+	ReplicaOf                        types.Set      `tfsdk:"replica_of"`
+	Alert                            types.Set      `tfsdk:"alert"`
+	QueryPerformanceFactor           types.String   `tfsdk:"query_performance_factor"`
+	Modules                          types.Set      `tfsdk:"modules"`
+	SourceIPs                        types.Set      `tfsdk:"source_ips"`
+	HashingPolicy                    types.List     `tfsdk:"hashing_policy"`
+	EnableTLS                        types.Bool     `tfsdk:"enable_tls"`
+	EnableDefaultUser                types.Bool     `tfsdk:"enable_default_user"`
+	Port                             types.Int64    `tfsdk:"port"`
+	RemoteBackup                     types.List     `tfsdk:"remote_backup"`
+	Tags                             types.Map      `tfsdk:"tags"`
+	DeletionProtection               types.Bool     `tfsdk:"deletion_protection"`
+	CreationPending                  types.Bool     `tfsdk:"creation_pending"`
+	Timeouts                         timeouts.Value `tfsdk:"timeouts"`
+}
+
+// ModuleModel describes a module of the Pro database.
+type ModuleModel struct {
+	Name types.String `tfsdk:"name"`
+}
+
+// moduleAttrTypes returns the attribute types for module objects.
+func moduleAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"name": types.StringType,
+	}
+}
@@ -0,0 +1,100 @@
+package pro
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	rediscloudApi "github.com/RedisLabs/rediscloud-go-api"
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/RedisLabs/rediscloud-go-api/service/databases"
+	"github.com/RedisLabs/rediscloud-go-api/service/subscriptions"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/client"
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/fakeapi"
+)
+
+func TestUnitCheckPlannedMonthlyCost(t *testing.T) {
+	ctx := context.Background()
+	fake := fakeapi.New(fakeapi.Options{})
+	t.Cleanup(fake.Close)
+	c, err := fake.Client(rediscloudApi.Transporter(client.NewTaskTransport(http.DefaultTransport)))
+	require.NoError(t, err)
+
+	subId, err := c.Subscription.Create(ctx, subscriptions.CreateSubscription{
+		Name:            redis.String("budget"),
+		PaymentMethodID: redis.Int(fakeapi.PaymentMethodId),
+		CloudProviders: []*subscriptions.CreateCloudProvider{{
+			Provider: redis.String("AWS"),
+			Regions:  []*subscriptions.CreateRegion{{Region: redis.String("us-east-1")}},
+		}},
+	})
+	require.NoError(t, err)
+	dbId, err := c.Database.Create(ctx, subId, databases.CreateDatabase{Name: redis.String("cache"), MemoryLimitInGB: redis.Float64(1)})
+	require.NoError(t, err)
+
+	database := func(name string, memoryLimitInGB float64) *ProDatabaseModel {
+		return &ProDatabaseModel{
+			SubscriptionID:             types.Int64Value(int64(subId)),
+			DbID:                       types.Int64Value(int64(dbId)),
+			Name:                       types.StringValue(name),
+			Protocol:                   types.StringValue("redis"),
+			MemoryLimitInGB:            types.Float64Value(memoryLimitInGB),
+			DatasetSizeInGB:            types.Float64Null(),
+			Replication:                types.BoolValue(true),
+			ThroughputMeasurementBy:    types.StringValue("operations-per-second"),
+			ThroughputMeasurementValue: types.Int64Value(1000),
+		}
+	}
+
+	// A shard of the fake costs about 90.52 USD a month, for each started 25 GB.
+	tests := []struct {
+		name       string
+		plan       *ProDatabaseModel
+		state      *ProDatabaseModel
+		maxMonthly float64
+		err        string
+	}{
+		{name: "resized within the ceiling", plan: database("cache", 20), state: database("cache", 1), maxMonthly: 100},
+		{
+			name:       "resized over the ceiling",
+			plan:       database("cache", 100),
+			state:      database("cache", 1),
+			maxMonthly: 100,
+			err:        fmt.Sprintf(`subscription %d with database "cache" would cost 362.08 USD a month`, subId),
+		},
+		{name: "unchanged", plan: database("cache", 100), state: database("cache", 100), maxMonthly: 100},
+		{name: "no ceiling", plan: database("cache", 100), state: database("cache", 1)},
+		{
+			name:       "created over the ceiling",
+			plan:       database("other", 30),
+			maxMonthly: 200,
+			err:        `with database "other" would cost 271.56 USD a month, over the ` + "`max_monthly_cost`" + ` of 200.00 set on the provider`,
+		},
+		{name: "unknown size", plan: &ProDatabaseModel{MemoryLimitInGB: types.Float64Unknown()}, state: database("cache", 1), maxMonthly: 100},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := &proDatabaseResource{client: &client.ApiClient{Client: c, MaxMonthlyCost: test.maxMonthly}}
+
+			var diagnostics diag.Diagnostics
+			r.checkPlannedMonthlyCost(ctx, test.plan, test.state, &diagnostics)
+			if test.err == "" {
+				assert.Empty(t, diagnostics)
+				return
+			}
+			require.Len(t, diagnostics.Errors(), 1)
+			assert.Contains(t, diagnostics.Errors()[0].Detail(), test.err)
+		})
+	}
+
+	db, err := c.Database.Get(ctx, subId, dbId)
+	require.NoError(t, err)
+	assert.Equal(t, 1.0, redis.Float64Value(db.MemoryLimitInGB), "the dry runs change nothing")
+}
@@ -0,0 +1,246 @@
+package pro
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// unknownCertificate is the placeholder the SDK implementation of the resource recorded for the TLS certificates of
+// a database authenticating its clients with certificates not in the configuration.
+const unknownCertificate = "Unknown certificate"
+
+// UpgradeState upgrades the state of databases managed by the SDK implementation of the resource, version 0.
+func (r *proDatabaseResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	priorSchema := proDatabaseSchemaV0(ctx)
+
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &priorSchema,
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var prior ProDatabaseModelV0
+				resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				state := prior.upgrade()
+				upgradeProDatabaseStateV0(ctx, &state)
+
+				resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+			},
+		},
+	}
+}
+
+// ProDatabaseModelV0 describes the state of databases managed by the SDK implementation of the resource.
+type ProDatabaseModelV0 struct {
+	ID                               types.String   `tfsdk:"id"`
+	SubscriptionID                   types.Int64    `tfsdk:"subscription_id"`
+	DbID                             types.Int64    `tfsdk:"db_id"`
+	Name                             types.String   `tfsdk:"name"`
+	Protocol                         types.String   `tfsdk:"protocol"`
+	MemoryLimitInGB                  types.Float64  `tfsdk:"memory_limit_in_gb"`
+	DatasetSizeInGB                  types.Float64  `tfsdk:"dataset_size_in_gb"`
+	RedisVersion                     types.String   `tfsdk:"redis_version"`
+	SupportOSSClusterAPI             types.Bool     `tfsdk:"support_oss_cluster_api"`
+	RespVersion                      types.String   `tfsdk:"resp_version"`
+	ExternalEndpointForOSSClusterAPI types.Bool     `tfsdk:"external_endpoint_for_oss_cluster_api"`
+	DataPersistence                  types.String   `tfsdk:"data_persistence"`
+	DataEviction                     types.String   `tfsdk:"data_eviction"`
+	Replication                      types.Bool     `tfsdk:"replication"`
+	ThroughputMeasurementBy          types.String   `tfsdk:"throughput_measurement_by"`
+	ThroughputMeasurementValue       types.Int64    `tfsdk:"throughput_measurement_value"`
+	AverageItemSizeInBytes           types.Int64    `tfsdk:"average_item_size_in_bytes"`
+	RamPercentage                    types.Int64    `tfsdk:"ram_percentage"`
+	Password                         types.String   `tfsdk:"password"`
+	PublicEndpoint                   types.String   `tfsdk:"public_endpoint"`
+	PrivateEndpoint                  types.String   `tfsdk:"private_endpoint"`
+	ClientSSLCertificate             types.String   `tfsdk:"client_ssl_certificate"`
+	ClientTLSCertificates            types.List     `tfsdk:"client_tls_certificates"`
+	PeriodicBackupPath               types.String   `tfsdk:"periodic_backup_path"`
+	ReplicaOf                        types.Set      `tfsdk:"replica_of"`
+	Alert                            types.Set      `tfsdk:"alert"`
+	QueryPerformanceFactor           types.String   `tfsdk:"query_performance_factor"`
+	Modules                          types.Set      `tfsdk:"modules"`
+	SourceIPs                        types.Set      `tfsdk:"source_ips"`
+	HashingPolicy                    types.List     `tfsdk:"hashing_policy"`
+	EnableTLS                        types.Bool     `tfsdk:"enable_tls"`
+	EnableDefaultUser                types.Bool     `tfsdk:"enable_default_user"`
+	Port                             types.Int64    `tfsdk:"port"`
+	RemoteBackup                     types.List     `tfsdk:"remote_backup"`
+	Tags                             types.Map      `tfsdk:"tags"`
+	CreationPending                  types.Bool     `tfsdk:"creation_pending"`
+	Timeouts                         timeouts.Value `tfsdk:"timeouts"`
+}
+
+// upgrade returns the version 0 state as the current model, with the attributes added since at their defaults.
+func (m ProDatabaseModelV0) upgrade() ProDatabaseModel {
+	return ProDatabaseModel{
+		ID:                               m.ID,
+		SubscriptionID:                   m.SubscriptionID,
+		DbID:                             m.DbID,
+		Name:                             m.Name,
+		Protocol:                         m.Protocol,
+		MemoryLimitInGB:                  m.MemoryLimitInGB,
+		DatasetSizeInGB:                  m.DatasetSizeInGB,
+		RedisVersion:                     m.RedisVersion,
+		SupportOSSClusterAPI:             m.SupportOSSClusterAPI,
+		RespVersion:                      m.RespVersion,
+		ExternalEndpointForOSSClusterAPI: m.ExternalEndpointForOSSClusterAPI,
+		DataPersistence:                  m.DataPersistence,
+		DataEviction:                     m.DataEviction,
+		Replication:                      m.Replication,
+		ThroughputMeasurementBy:          m.ThroughputMeasurementBy,
+		ThroughputMeasurementValue:       m.ThroughputMeasurementValue,
+		AverageItemSizeInBytes:           m.AverageItemSizeInBytes,
+		RamPercentage:                    m.RamPercentage,
+		Password:                         m.Password,
+		PublicEndpoint:                   m.PublicEndpoint,
+		PrivateEndpoint:                  m.PrivateEndpoint,
+		ClientSSLCertificate:             m.ClientSSLCertificate,
+		ClientTLSCertificates:            m.ClientTLSCertificates,
+		PeriodicBackupPath:               m.PeriodicBackupPath,
+		ReplicaOf:                        m.ReplicaOf,
+		Alert:                            m.Alert,
+		QueryPerformanceFactor:           m.QueryPerformanceFactor,
+		Modules:                          m.Modules,
+		SourceIPs:                        m.SourceIPs,
+		HashingPolicy:                    m.HashingPolicy,
+		EnableTLS:                        m.EnableTLS,
+		EnableDefaultUser:                m.EnableDefaultUser,
+		Port:                             m.Port,
+		RemoteBackup:                     m.RemoteBackup,
+		Tags:                             m.Tags,
+		DeletionProtection:               types.BoolValue(false),
+		CreationPending:                  m.CreationPending,
+		Timeouts:                         m.Timeouts,
+	}
+}
+
+// proDatabaseSchemaV0 is the shape of the state of the SDK implementation of the resource. It only describes the
+// types of the attributes, so must not change when the current schema does.
+func proDatabaseSchemaV0(ctx context.Context) schema.Schema {
+	optionalString := schema.StringAttribute{Optional: true, Computed: true}
+	computedString := schema.StringAttribute{Computed: true}
+	optionalBool := schema.BoolAttribute{Optional: true, Computed: true}
+	optionalInt64 := schema.Int64Attribute{Optional: true, Computed: true}
+	optionalFloat64 := schema.Float64Attribute{Optional: true, Computed: true}
+
+	return schema.Schema{
+		Version: 0,
+		Attributes: map[string]schema.Attribute{
+			"id":                                    computedString,
+			"subscription_id":                       schema.Int64Attribute{Required: true},
+			"db_id":                                 schema.Int64Attribute{Computed: true},
+			"name":                                  schema.StringAttribute{Required: true},
+			"protocol":                              optionalString,
+			"memory_limit_in_gb":                    optionalFloat64,
+			"dataset_size_in_gb":                    optionalFloat64,
+			"redis_version":                         optionalString,
+			"support_oss_cluster_api":               optionalBool,
+			"resp_version":                          optionalString,
+			"external_endpoint_for_oss_cluster_api": optionalBool,
+			"data_persistence":                      optionalString,
+			"data_eviction":                         optionalString,
+			"replication":                           optionalBool,
+			"throughput_measurement_by":             schema.StringAttribute{Required: true},
+			"throughput_measurement_value":          schema.Int64Attribute{Required: true},
+			"average_item_size_in_bytes":            optionalInt64,
+			"ram_percentage":                        optionalInt64,
+			"password":                              schema.StringAttribute{Optional: true, Computed: true, Sensitive: true},
+			"public_endpoint":                       computedString,
+			"private_endpoint":                      computedString,
+			"client_ssl_certificate":                optionalString,
+			"client_tls_certificates":               schema.ListAttribute{Optional: true, ElementType: types.StringType},
+			"periodic_backup_path":                  optionalString,
+			"replica_of":                            schema.SetAttribute{Optional: true, ElementType: types.StringType},
+			"query_performance_factor":              optionalString,
+			"modules": schema.SetAttribute{
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.ObjectType{AttrTypes: map[string]attr.Type{"name": types.StringType}},
+			},
+			"source_ips":          schema.SetAttribute{Optional: true, Computed: true, ElementType: types.StringType},
+			"hashing_policy":      schema.ListAttribute{Optional: true, Computed: true, ElementType: types.StringType},
+			"enable_tls":          optionalBool,
+			"enable_default_user": optionalBool,
+			"port":                schema.Int64Attribute{Optional: true},
+			"tags":                schema.MapAttribute{Optional: true, ElementType: types.StringType},
+			"creation_pending":    schema.BoolAttribute{Computed: true},
+		},
+		Blocks: map[string]schema.Block{
+			"alert": schema.SetNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name":  schema.StringAttribute{Required: true},
+						"value": schema.Int64Attribute{Required: true},
+					},
+				},
+			},
+			"remote_backup": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"interval":     schema.StringAttribute{Required: true},
+						"time_utc":     schema.StringAttribute{Optional: true},
+						"storage_type": schema.StringAttribute{Required: true},
+						"storage_path": schema.StringAttribute{Required: true},
+					},
+				},
+			},
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// upgradeProDatabaseStateV0 replaces the values the SDK recorded for attributes missing from the configuration
+// with the values planned for them now, so that existing databases refresh without changes:
+//   - the SDK recorded unset lists, sets and maps as empty, which are now null;
+//   - the SDK recorded the unused one of memory_limit_in_gb and dataset_size_in_gb as 0, which is now null;
+//   - the SDK recorded an unset time_utc of remote_backup as "", which is now null;
+//   - the SDK recorded a placeholder certificate for a database authenticating its clients with certificates not
+//     in the configuration, which is now an empty list.
+func upgradeProDatabaseStateV0(ctx context.Context, state *ProDatabaseModel) {
+	if len(state.ClientTLSCertificates.Elements()) == 0 {
+		state.ClientTLSCertificates = types.ListNull(types.StringType)
+	} else if elements := state.ClientTLSCertificates.Elements(); len(elements) == 1 && elements[0].Equal(types.StringValue(unknownCertificate)) {
+		state.ClientTLSCertificates = types.ListValueMust(types.StringType, []attr.Value{})
+	}
+
+	if len(state.ReplicaOf.Elements()) == 0 {
+		state.ReplicaOf = types.SetNull(types.StringType)
+	}
+	if len(state.Modules.Elements()) == 0 {
+		state.Modules = types.SetNull(types.ObjectType{AttrTypes: moduleAttrTypes()})
+	}
+	if len(state.Tags.Elements()) == 0 {
+		state.Tags = types.MapNull(types.StringType)
+	}
+
+	if state.MemoryLimitInGB.ValueFloat64() == 0 {
+		state.MemoryLimitInGB = types.Float64Null()
+	}
+	if state.DatasetSizeInGB.ValueFloat64() == 0 {
+		state.DatasetSizeInGB = types.Float64Null()
+	}
+
+	if elements := state.RemoteBackup.Elements(); len(elements) == 1 {
+		backup := elements[0].(types.Object)
+		attributes := backup.Attributes()
+		if attributes["time_utc"].Equal(types.StringValue("")) {
+			attributes["time_utc"] = types.StringNull()
+			state.RemoteBackup = types.ListValueMust(state.RemoteBackup.ElementType(ctx), []attr.Value{
+				types.ObjectValueMust(backup.AttributeTypes(ctx), attributes),
+			})
+		}
+	}
+}
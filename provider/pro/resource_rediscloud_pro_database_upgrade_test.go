@@ -0,0 +1,204 @@
+package pro
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/utils"
+)
+
+func TestUnitUpgradeProDatabaseStateV0(t *testing.T) {
+	ctx := context.Background()
+
+	moduleType := types.ObjectType{AttrTypes: moduleAttrTypes()}
+	remoteBackupType := types.ObjectType{AttrTypes: utils.RemoteBackupAttrTypes()}
+
+	strings := func(values ...string) types.List {
+		elements := make([]attr.Value, 0, len(values))
+		for _, v := range values {
+			elements = append(elements, types.StringValue(v))
+		}
+		return types.ListValueMust(types.StringType, elements)
+	}
+	remoteBackup := func(timeUTC types.String) types.List {
+		return types.ListValueMust(remoteBackupType, []attr.Value{
+			types.ObjectValueMust(utils.RemoteBackupAttrTypes(), map[string]attr.Value{
+				"interval":     types.StringValue("every-12-hours"),
+				"time_utc":     timeUTC,
+				"storage_type": types.StringValue("aws-s3"),
+				"storage_path": types.StringValue("s3://bucket"),
+			}),
+		})
+	}
+	sdkState := func() ProDatabaseModel {
+		return ProDatabaseModel{
+			MemoryLimitInGB:       types.Float64Value(0),
+			DatasetSizeInGB:       types.Float64Value(1),
+			ClientTLSCertificates: strings(),
+			ReplicaOf:             types.SetValueMust(types.StringType, []attr.Value{}),
+			Modules:               types.SetValueMust(moduleType, []attr.Value{}),
+			Tags:                  types.MapValueMust(types.StringType, map[string]attr.Value{}),
+			RemoteBackup:          types.ListValueMust(remoteBackupType, []attr.Value{}),
+		}
+	}
+	upgradedState := func() ProDatabaseModel {
+		return ProDatabaseModel{
+			MemoryLimitInGB:       types.Float64Null(),
+			DatasetSizeInGB:       types.Float64Value(1),
+			ClientTLSCertificates: types.ListNull(types.StringType),
+			ReplicaOf:             types.SetNull(types.StringType),
+			Modules:               types.SetNull(moduleType),
+			Tags:                  types.MapNull(types.StringType),
+			RemoteBackup:          types.ListValueMust(remoteBackupType, []attr.Value{}),
+		}
+	}
+
+	tests := []struct {
+		name     string
+		modify   func(state *ProDatabaseModel)
+		expected func(state *ProDatabaseModel)
+	}{
+		{
+			name:     "empty collections and unused size become null",
+			modify:   func(state *ProDatabaseModel) {},
+			expected: func(state *ProDatabaseModel) {},
+		},
+		{
+			name: "placeholder certificate becomes an empty list",
+			modify: func(state *ProDatabaseModel) {
+				state.ClientTLSCertificates = strings(unknownCertificate)
+			},
+			expected: func(state *ProDatabaseModel) {
+				state.ClientTLSCertificates = strings()
+			},
+		},
+		{
+			name: "certificates are kept",
+			modify: func(state *ProDatabaseModel) {
+				state.ClientTLSCertificates = strings("cert")
+			},
+			expected: func(state *ProDatabaseModel) {
+				state.ClientTLSCertificates = strings("cert")
+			},
+		},
+		{
+			name: "unset backup time becomes null",
+			modify: func(state *ProDatabaseModel) {
+				state.RemoteBackup = remoteBackup(types.StringValue(""))
+			},
+			expected: func(state *ProDatabaseModel) {
+				state.RemoteBackup = remoteBackup(types.StringNull())
+			},
+		},
+		{
+			name: "backup time is kept",
+			modify: func(state *ProDatabaseModel) {
+				state.RemoteBackup = remoteBackup(types.StringValue("14:00"))
+			},
+			expected: func(state *ProDatabaseModel) {
+				state.RemoteBackup = remoteBackup(types.StringValue("14:00"))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := sdkState()
+			tt.modify(&state)
+			expected := upgradedState()
+			tt.expected(&expected)
+
+			upgradeProDatabaseStateV0(ctx, &state)
+
+			assert.True(t, expected.MemoryLimitInGB.Equal(state.MemoryLimitInGB), "memory_limit_in_gb: %s", state.MemoryLimitInGB)
+			assert.True(t, expected.DatasetSizeInGB.Equal(state.DatasetSizeInGB), "dataset_size_in_gb: %s", state.DatasetSizeInGB)
+			assert.True(t, expected.ClientTLSCertificates.Equal(state.ClientTLSCertificates), "client_tls_certificates: %s", state.ClientTLSCertificates)
+			assert.True(t, expected.ReplicaOf.Equal(state.ReplicaOf), "replica_of: %s", state.ReplicaOf)
+			assert.True(t, expected.Modules.Equal(state.Modules), "modules: %s", state.Modules)
+			assert.True(t, expected.Tags.Equal(state.Tags), "tags: %s", state.Tags)
+			assert.True(t, expected.RemoteBackup.Equal(state.RemoteBackup), "remote_backup: %s", state.RemoteBackup)
+		})
+	}
+}
+
+func TestUnitProDatabaseUpgradeStateV0(t *testing.T) {
+	ctx := context.Background()
+	r := &proDatabaseResource{}
+	upgrader := r.UpgradeState(ctx)[0]
+
+	// The state of a database as the SDK recorded it, without the attributes added since.
+	rawState := tfprotov6.RawState{JSON: []byte(`{
+		"id": "123/456",
+		"subscription_id": 123,
+		"db_id": 456,
+		"name": "cache",
+		"protocol": "redis",
+		"memory_limit_in_gb": 0,
+		"dataset_size_in_gb": 1,
+		"redis_version": "7.2",
+		"support_oss_cluster_api": false,
+		"resp_version": "resp3",
+		"external_endpoint_for_oss_cluster_api": false,
+		"data_persistence": "none",
+		"data_eviction": "volatile-lru",
+		"replication": true,
+		"throughput_measurement_by": "operations-per-second",
+		"throughput_measurement_value": 1000,
+		"average_item_size_in_bytes": 0,
+		"ram_percentage": 0,
+		"password": "secret",
+		"public_endpoint": "redis-456.example.com:12000",
+		"private_endpoint": "redis-456.internal.example.com:12000",
+		"client_ssl_certificate": "",
+		"client_tls_certificates": [],
+		"periodic_backup_path": "",
+		"replica_of": [],
+		"alert": [{"name": "dataset-size", "value": 80}],
+		"query_performance_factor": "",
+		"modules": [],
+		"source_ips": ["0.0.0.0/0"],
+		"hashing_policy": [],
+		"enable_tls": false,
+		"enable_default_user": true,
+		"port": null,
+		"remote_backup": [{"interval": "every-12-hours", "time_utc": "", "storage_type": "aws-s3", "storage_path": "s3://bucket"}],
+		"tags": {},
+		"creation_pending": null,
+		"timeouts": null
+	}`)}
+	raw, err := rawState.UnmarshalWithOpts(upgrader.PriorSchema.Type().TerraformType(ctx), tfprotov6.UnmarshalOpts{})
+	require.NoError(t, err)
+
+	var current resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &current)
+	req := resource.UpgradeStateRequest{State: &tfsdk.State{Schema: *upgrader.PriorSchema, Raw: raw}}
+	resp := resource.UpgradeStateResponse{State: tfsdk.State{Schema: current.Schema, Raw: tftypes.NewValue(current.Schema.Type().TerraformType(ctx), nil)}}
+
+	upgrader.StateUpgrader(ctx, req, &resp)
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+	var state ProDatabaseModel
+	require.False(t, resp.State.Get(ctx, &state).HasError())
+	assert.Equal(t, "123/456", state.ID.ValueString())
+	assert.Equal(t, int64(456), state.DbID.ValueInt64())
+	assert.Len(t, state.Alert.Elements(), 1)
+	assert.Equal(t, types.BoolValue(false), state.DeletionProtection)
+
+	// The values the SDK recorded for unset attributes are upgraded too.
+	assert.True(t, state.MemoryLimitInGB.IsNull())
+	assert.True(t, state.ClientTLSCertificates.IsNull())
+	assert.True(t, state.ReplicaOf.IsNull())
+	assert.True(t, state.Modules.IsNull())
+	assert.True(t, state.Tags.IsNull())
+	require.Len(t, state.RemoteBackup.Elements(), 1)
+	assert.True(t, state.RemoteBackup.Elements()[0].(types.Object).Attributes()["time_utc"].IsNull())
+}
@@ -1,24 +1,39 @@
 package pro
 
 import (
-	"bytes"
 	"context"
-	"errors"
 	"fmt"
 	"log"
 	"regexp"
+	"slices"
+	"sort"
 	"strconv"
 	"time"
 
 	"github.com/RedisLabs/rediscloud-go-api/redis"
 	"github.com/RedisLabs/rediscloud-go-api/service/cloud_accounts"
 	"github.com/RedisLabs/rediscloud-go-api/service/databases"
-	"github.com/RedisLabs/rediscloud-go-api/service/maintenance"
+	"github.com/RedisLabs/rediscloud-go-api/service/pricing"
 	"github.com/RedisLabs/rediscloud-go-api/service/subscriptions"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/RedisLabs/terraform-provider-rediscloud/provider/client"
 	"github.com/RedisLabs/terraform-provider-rediscloud/provider/utils"
@@ -26,208 +41,476 @@ import (
 
 const CMK_ENABLED_STRING = "customer-managed-key"
 
-func ResourceRedisCloudProSubscription() *schema.Resource {
-	return &schema.Resource{
+// CreationPlanDatabasePrefix starts the names of the databases created for a subscription's creation plan, which are
+// deleted once the subscription is active.
+const CreationPlanDatabasePrefix = "creation-plan-db-"
+
+var (
+	_ resource.Resource                   = &proSubscriptionResource{}
+	_ resource.ResourceWithConfigure      = &proSubscriptionResource{}
+	_ resource.ResourceWithImportState    = &proSubscriptionResource{}
+	_ resource.ResourceWithModifyPlan     = &proSubscriptionResource{}
+	_ resource.ResourceWithValidateConfig = &proSubscriptionResource{}
+	_ resource.ResourceWithIdentity       = &proSubscriptionResource{}
+	_ resource.ResourceWithUpgradeState   = &proSubscriptionResource{}
+)
 
-		CustomizeDiff: func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+// proSubscriptionResource is the resource implementation.
+type proSubscriptionResource struct {
+	client *client.ApiClient
+}
 
-			// Ensure the "creation_plan" block exists
-			_, creationPlanExists := diff.GetOk("creation_plan")
-			if !creationPlanExists {
-				if diff.Id() == "" {
-					return fmt.Errorf(`the "creation_plan" block is required`)
-				}
-				return nil
-			}
+// NewProSubscriptionResource returns a new resource instance.
+func NewProSubscriptionResource() resource.Resource {
+	return &proSubscriptionResource{}
+}
 
-			err := cloudRegionsForceNewDiff(ctx, diff, meta)
-			if err != nil {
-				return err
-			}
+// Metadata returns the resource type name.
+func (r *proSubscriptionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subscription"
+}
 
-			return nil
-		},
+// Configure adds the provider configured client to the resource.
+func (r *proSubscriptionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
 
-		Description:   "Creates a Pro Subscription within your Redis Enterprise Cloud Account.",
-		CreateContext: resourceRedisCloudProSubscriptionCreate,
-		ReadContext:   resourceRedisCloudProSubscriptionRead,
-		UpdateContext: resourceRedisCloudProSubscriptionUpdate,
-		DeleteContext: resourceRedisCloudProSubscriptionDelete,
+	client, ok := req.ProviderData.(*client.ApiClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.ApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
 
-		Importer: &schema.ResourceImporter{
-			// Let the READ operation do the heavy lifting for importing values from the API.
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+	r.client = client
+}
 
-		Timeouts: &schema.ResourceTimeout{
-			Create: schema.DefaultTimeout(30 * time.Minute),
-			Read:   schema.DefaultTimeout(10 * time.Minute),
-			Update: schema.DefaultTimeout(30 * time.Minute),
-			Delete: schema.DefaultTimeout(10 * time.Minute),
-		},
+// useStateAfterCreateStringModifier is a plan modifier for attributes which are only used when creating the
+// subscription. Changes to them are ignored after this.
+type useStateAfterCreateStringModifier struct{}
+
+var _ planmodifier.String = useStateAfterCreateStringModifier{}
+
+func (m useStateAfterCreateStringModifier) Description(_ context.Context) string {
+	return "Uses the prior state value for existing resources. Changes to this attribute are ignored after creation."
+}
+
+func (m useStateAfterCreateStringModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m useStateAfterCreateStringModifier) PlanModifyString(_ context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.State.Raw.IsNull() {
+		return
+	}
+	resp.PlanValue = req.StateValue
+}
+
+// useStateAfterCreateBlockModifier is a plan modifier for the creation_plan block, which is only used when creating
+// the subscription. Terraform requires a planned block to match the number of blocks in the configuration, so the
+// prior state is only kept when it has as many blocks, as it does unless the subscription was imported.
+type useStateAfterCreateBlockModifier struct{}
+
+var _ planmodifier.List = useStateAfterCreateBlockModifier{}
+
+func (m useStateAfterCreateBlockModifier) Description(_ context.Context) string {
+	return "Uses the prior state value for existing resources. Changes to this block are ignored after creation."
+}
+
+func (m useStateAfterCreateBlockModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m useStateAfterCreateBlockModifier) PlanModifyList(_ context.Context, req planmodifier.ListRequest, resp *planmodifier.ListResponse) {
+	if req.State.Raw.IsNull() || req.StateValue.IsNull() || req.PlanValue.IsUnknown() {
+		return
+	}
+	if len(req.StateValue.Elements()) == len(req.PlanValue.Elements()) {
+		resp.PlanValue = req.StateValue
+	}
+}
+
+// redisVersionModifier replaces the subscription when its deprecated redis_version is changed. Removing the
+// attribute, which is now set on databases instead, keeps the version the subscription was created with.
+type redisVersionModifier struct{}
+
+var _ planmodifier.String = redisVersionModifier{}
+
+func (m redisVersionModifier) Description(_ context.Context) string {
+	return "Requires replacement when the version is changed, but not when it is removed."
+}
+
+func (m redisVersionModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m redisVersionModifier) PlanModifyString(_ context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.State.Raw.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if req.ConfigValue.IsNull() || req.ConfigValue.Equal(req.StateValue) {
+		resp.PlanValue = req.StateValue
+		return
+	}
+	resp.RequiresReplace = true
+}
+
+// deletionGracePeriodModifier keeps an empty customer_managed_key_deletion_grace_period, recorded by provider
+// versions without the attribute, rather than planning the default, unless customer managed keys are being enabled.
+// TODO: remove this when customer_managed_key_deletion_grace_period is supported on api side
+type deletionGracePeriodModifier struct{}
+
+var _ planmodifier.String = deletionGracePeriodModifier{}
+
+func (m deletionGracePeriodModifier) Description(_ context.Context) string {
+	return "Keeps an empty grace period from older provider versions unless customer managed keys are enabled."
+}
+
+func (m deletionGracePeriodModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m deletionGracePeriodModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.State.Raw.IsNull() || req.StateValue.ValueString() != "" || req.PlanValue.ValueString() != "immediate" {
+		return
+	}
+
+	var cmkEnabled types.Bool
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("customer_managed_key_enabled"), &cmkEnabled)...)
+	if !cmkEnabled.ValueBool() {
+		resp.PlanValue = req.StateValue
+	}
+}
 
-		Schema: map[string]*schema.Schema{
-			"name": {
+// regionsModifier replaces the subscription when its regions change, except while it waits for its customer
+// managed keys. Regions are identified by their name and whether they span availability zones, as they were when
+// the resource was implemented with the SDK; otherwise the regions' prior state is kept, along with their networks.
+type regionsModifier struct {
+	resource *proSubscriptionResource
+}
+
+var _ planmodifier.Set = regionsModifier{}
+
+func (m regionsModifier) Description(_ context.Context) string {
+	return "Requires replacement when the regions change, unless the subscription is waiting for its customer managed keys."
+}
+
+func (m regionsModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m regionsModifier) PlanModifySet(ctx context.Context, req planmodifier.SetRequest, resp *planmodifier.SetResponse) {
+	if req.State.Raw.IsNull() || req.StateValue.IsNull() || req.PlanValue.IsUnknown() {
+		return
+	}
+
+	planned, ok := regionKeys(ctx, req.PlanValue, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	prior, _ := regionKeys(ctx, req.StateValue, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if ok && equalStrings(planned, prior) {
+		resp.PlanValue = req.StateValue
+		return
+	}
+
+	var id types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	subId, err := strconv.Atoi(id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid subscription ID", err.Error())
+		return
+	}
+	subscription, err := m.resource.client.Client.Subscription.Get(ctx, subId)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get subscription", err.Error())
+		return
+	}
+
+	// Only check for force new if not in an encryption key pending state
+	if redis.StringValue(subscription.Status) != subscriptions.SubscriptionStatusEncryptionKeyPending {
+		resp.RequiresReplace = true
+	}
+}
+
+// regionKeys returns the sorted region names and availability zone choices of a region set, and whether they are
+// all known.
+func regionKeys(ctx context.Context, set types.Set, diagnostics *diag.Diagnostics) ([]string, bool) {
+	var regions []RegionModel
+	diagnostics.Append(set.ElementsAs(ctx, &regions, true)...)
+
+	keys := make([]string, 0, len(regions))
+	for _, region := range regions {
+		if region.Region.IsUnknown() || region.MultipleAvailabilityZones.IsUnknown() {
+			return nil, false
+		}
+		keys = append(keys, fmt.Sprintf("%s-%t", region.Region.ValueString(), region.MultipleAvailabilityZones.ValueBool()))
+	}
+	sort.Strings(keys)
+	return keys, true
+}
+
+func equalStrings(a []string, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Schema defines the schema for the resource.
+func (r *proSubscriptionResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = r.schema(ctx)
+}
+
+// schema returns the schema of the resource. Version 0 was implemented with the SDK, and is read with
+// proSubscriptionSchemaV0 before being upgraded.
+func (r *proSubscriptionResource) schema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Description: "Creates a Pro Subscription within your Redis Enterprise Cloud Account.",
+		Version:     1,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the subscription",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
 				Description: "A meaningful name to identify the subscription",
-				Type:        schema.TypeString,
 				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"payment_method": schema.StringAttribute{
+				Description: "Payment method for the requested subscription. If credit card is specified, the payment method id must be defined. This information is only used when creating a new subscription and any changes will be ignored after this.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("credit-card"),
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile("^(credit-card|marketplace)$"), "must be 'credit-card' or 'marketplace'"),
+				},
+				PlanModifiers: []planmodifier.String{
+					useStateAfterCreateStringModifier{},
+				},
+			},
+			"payment_method_id": schema.StringAttribute{
+				Description: "A valid payment method pre-defined in the current account",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^\d+$`), "must be a number"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"memory_storage": schema.StringAttribute{
+				Description: "Memory storage preference: either ‘ram’ or a combination of 'ram-and-flash’",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("ram"),
+				Validators: []validator.String{
+					stringvalidator.OneOf(databases.MemoryStorageValues()...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
-			"payment_method": {
-				Description:      "Payment method for the requested subscription. If credit card is specified, the payment method id must be defined. This information is only used when creating a new subscription and any changes will be ignored after this.",
-				Type:             schema.TypeString,
-				ValidateDiagFunc: validation.ToDiagFunc(validation.StringMatch(regexp.MustCompile("^(credit-card|marketplace)$"), "must be 'credit-card' or 'marketplace'")),
-				Optional:         true,
-				Default:          "credit-card",
-				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-					if d.Id() == "" {
-						// We don't want to ignore the block if the resource is about to be created.
-						return false
-					}
-					return true
+			"redis_version": schema.StringAttribute{
+				Description:        "Version of Redis to create",
+				Optional:           true,
+				Computed:           true,
+				DeprecationMessage: "This attribute is deprecated on pro subscriptions. Please specify `redis_version` on databases directly instead.",
+				PlanModifiers: []planmodifier.String{
+					redisVersionModifier{},
 				},
 			},
-			"payment_method_id": {
-				Computed:         true,
-				Description:      "A valid payment method pre-defined in the current account",
-				Type:             schema.TypeString,
-				ValidateDiagFunc: validation.ToDiagFunc(validation.StringMatch(regexp.MustCompile("^\\d+$"), "must be a number")),
-				Optional:         true,
+			"pricing": schema.ListAttribute{
+				Description: "Pricing details totalled over this Subscription",
+				Computed:    true,
+				ElementType: types.ObjectType{AttrTypes: pricingAttrTypes()},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
 			},
-			"memory_storage": {
-				Description:      "Memory storage preference: either ‘ram’ or a combination of 'ram-and-flash’",
-				Type:             schema.TypeString,
-				Optional:         true,
-				ForceNew:         true,
-				Default:          "ram",
-				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(databases.MemoryStorageValues(), false)),
+			utils.MaxMonthlyCostKey: schema.Float64Attribute{
+				Description: utils.MaxMonthlyCostDescription,
+				Optional:    true,
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+				},
 			},
-			"allowlist": {
-				Description: "An allowlist object",
-				Type:        schema.TypeList,
-				MaxItems:    1,
+			utils.EstimatedPricingKey: schema.ListAttribute{
+				Description: utils.EstimatedPricingDescription,
+				Computed:    true,
+				ElementType: types.ObjectType{AttrTypes: pricingAttrTypes()},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"customer_managed_key_enabled": schema.BoolAttribute{
+				Description: "Whether to enable CMK (customer managed key) for the subscription. If this is true, then the subscription will be put in a pending state until you supply the CMEK. See documentation for further details on this process. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"customer_managed_key_deletion_grace_period": schema.StringAttribute{
+				Description: "The grace period for deleting the subscription. If not set, will default to immediate deletion grace period.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("immediate"),
+				PlanModifiers: []planmodifier.String{
+					deletionGracePeriodModifier{},
+				},
+			},
+			"customer_managed_key_redis_service_account": schema.StringAttribute{
+				Description: "The principal of the Redis service account that the subscription is created in. This is used by the user to give access to their customer managed key",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"public_endpoint_access": schema.BoolAttribute{
+				Description: "Whether databases in the subscription should have public endpoints. When set to false, databases will only have private endpoints. Defaults to true.",
 				Optional:    true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"cidrs": {
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			utils.DeletionProtectionKey: utils.DeletionProtectionAttribute(),
+			utils.ForceDestroyKey:       utils.ForceDestroyAttribute(),
+			utils.CreationPendingKey: schema.BoolAttribute{
+				Description: utils.CreationPendingDescription,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					utils.ResumeCreateModifier(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"allowlist": schema.ListNestedBlock{
+				Description: "An allowlist object",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"cidrs": schema.SetAttribute{
 							Description: "Set of CIDR ranges that are allowed to access the databases associated with this subscription",
-							Type:        schema.TypeSet,
 							Optional:    true,
-							Elem: &schema.Schema{
-								Type:             schema.TypeString,
-								ValidateDiagFunc: validation.ToDiagFunc(validation.IsCIDR),
+							ElementType: types.StringType,
+							Validators: []validator.Set{
+								setvalidator.ValueStringsAre(utils.CIDRValidator()),
 							},
 						},
-						"security_group_ids": {
+						"security_group_ids": schema.SetAttribute{
 							Description: "Set of security groups that are allowed to access the databases associated with this subscription",
-							Type:        schema.TypeSet,
 							Required:    true,
-							Elem: &schema.Schema{
-								Type: schema.TypeString,
-							},
+							ElementType: types.StringType,
 						},
 					},
 				},
 			},
-			"cloud_provider": {
+			"cloud_provider": schema.ListNestedBlock{
 				Description: "A cloud provider object",
-				Type:        schema.TypeList,
-				Required:    true,
-				ForceNew:    true,
-				MaxItems:    1,
-				MinItems:    1,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"provider": {
-							Description:      "The cloud provider to use with the subscription, (either `AWS` or `GCP`)",
-							Type:             schema.TypeString,
-							Optional:         true,
-							ForceNew:         true,
-							Default:          "AWS",
-							ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(cloud_accounts.ProviderValues(), false)),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeBetween(1, 1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"provider": schema.StringAttribute{
+							Description: "The cloud provider to use with the subscription, (either `AWS` or `GCP`)",
+							Optional:    true,
+							Computed:    true,
+							Default:     stringdefault.StaticString("AWS"),
+							Validators: []validator.String{
+								stringvalidator.OneOf(cloud_accounts.ProviderValues()...),
+							},
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplace(),
+							},
 						},
-						"cloud_account_id": {
-							Description:      "Cloud account identifier. Default: Redis Labs internal cloud account (using Cloud Account Id = 1 implies using Redis Labs internal cloud account). Note that a GCP subscription can be created only with Redis Labs internal cloud account",
-							Type:             schema.TypeString,
-							Optional:         true,
-							ForceNew:         true,
-							ValidateDiagFunc: validation.ToDiagFunc(validation.StringMatch(regexp.MustCompile("^\\d+$"), "must be a number")),
-							Default:          "1",
+						"cloud_account_id": schema.StringAttribute{
+							Description: "Cloud account identifier. Default: Redis Labs internal cloud account (using Cloud Account Id = 1 implies using Redis Labs internal cloud account). Note that a GCP subscription can be created only with Redis Labs internal cloud account",
+							Optional:    true,
+							Computed:    true,
+							Default:     stringdefault.StaticString("1"),
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(regexp.MustCompile(`^\d+$`), "must be a number"),
+							},
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplace(),
+							},
 						},
-						"aws_account_id": {
+						"aws_account_id": schema.StringAttribute{
 							Description: "AWS account ID associated with the subscription (only applicable for AWS subscriptions)",
-							Type:        schema.TypeString,
 							Computed:    true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
 						},
-						"region": {
+					},
+					Blocks: map[string]schema.Block{
+						"region": schema.SetNestedBlock{
 							Description: "Cloud networking details, per region (single region or multiple regions for Active-Active cluster only)",
-							Type:        schema.TypeSet,
-							Required:    true,
-							ForceNew:    false, // custom force new logic enforced elsewhere
-							MinItems:    1,
-							Set: func(v interface{}) int {
-								var buf bytes.Buffer
-								m := v.(map[string]interface{})
-								buf.WriteString(fmt.Sprintf("%s-", m["region"].(string)))
-								buf.WriteString(fmt.Sprintf("%t-", m["multiple_availability_zones"].(bool)))
-								return schema.HashString(buf.String())
+							Validators: []validator.Set{
+								setvalidator.IsRequired(),
+								setvalidator.SizeAtLeast(1),
+							},
+							PlanModifiers: []planmodifier.Set{
+								regionsModifier{resource: r},
 							},
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"region": {
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"region": schema.StringAttribute{
 										Description: "Deployment region as defined by cloud provider",
-										Type:        schema.TypeString,
 										Required:    true,
 									},
-									"multiple_availability_zones": {
+									"multiple_availability_zones": schema.BoolAttribute{
 										Description: "Support deployment on multiple availability zones within the selected region",
-										Type:        schema.TypeBool,
 										Optional:    true,
-										Default:     false,
+										Computed:    true,
+										Default:     booldefault.StaticBool(false),
 									},
-									"preferred_availability_zones": {
+									"preferred_availability_zones": schema.ListAttribute{
 										Description: "List of availability zones used",
-										Type:        schema.TypeList,
 										Optional:    true,
 										Computed:    true,
-										Elem: &schema.Schema{
-											Type: schema.TypeString,
-										},
+										ElementType: types.StringType,
 									},
-									"networking_deployment_cidr": {
-										Description:      "Deployment CIDR mask",
-										Type:             schema.TypeString,
-										Required:         true,
-										ValidateDiagFunc: validation.ToDiagFunc(validation.IsCIDR),
+									"networking_deployment_cidr": schema.StringAttribute{
+										Description: "Deployment CIDR mask",
+										Required:    true,
+										Validators: []validator.String{
+											utils.CIDRValidator(),
+										},
 									},
-									"networking_vpc_id": {
+									"networking_vpc_id": schema.StringAttribute{
 										Description: "Either an existing VPC Id (already exists in the specific region) or create a new VPC (if no VPC is specified)",
-										Type:        schema.TypeString,
 										Optional:    true,
-										Default:     "",
+										Computed:    true,
 									},
-									"networks": {
+									"networks": schema.ListAttribute{
 										Description: "List of networks used",
-										Type:        schema.TypeList,
 										Computed:    true,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"networking_subnet_id": {
-													Description: "The subnet that the subscription deploys into",
-													Type:        schema.TypeString,
-													Computed:    true,
-												},
-												"networking_deployment_cidr": {
-													Description: "Deployment CIDR mask",
-													Type:        schema.TypeString,
-													Computed:    true,
-												},
-												"networking_vpc_id": {
-													Description: "Either an existing VPC Id (already exists in the specific region) or create a new VPC (if no VPC is specified)",
-													Type:        schema.TypeString,
-													Computed:    true,
-												},
-											},
-										},
+										ElementType: types.ObjectType{AttrTypes: networkAttrTypes()},
 									},
 								},
 							},
@@ -235,178 +518,138 @@ func ResourceRedisCloudProSubscription() *schema.Resource {
 					},
 				},
 			},
-			"creation_plan": {
+			"creation_plan": schema.ListNestedBlock{
 				Description: "Information about the planned databases used to optimise the database infrastructure. This information is only used when creating a new subscription and any changes will be ignored after this.",
-				Type:        schema.TypeList,
-				MaxItems:    1,
 				// The block is required when the user provisions a new subscription.
 				// The block is ignored in the UPDATE operation or after IMPORTing the resource.
-				// Custom validation is handled in CustomizeDiff.
-				Optional: true,
-				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-					if d.Id() == "" {
-						// We don't want to ignore the block if the resource is about to be created.
-						return false
-					}
-					return true
+				// Custom validation is handled in ModifyPlan and ValidateConfig.
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					useStateAfterCreateBlockModifier{},
 				},
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"memory_limit_in_gb": {
-							Description:   "(Deprecated) Maximum memory usage for each database",
-							Type:          schema.TypeFloat,
-							Optional:      true,
-							ConflictsWith: []string{"creation_plan.0.dataset_size_in_gb"},
+				// Every attribute is computed, so that the prior state can be kept when the configuration changes.
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"memory_limit_in_gb": schema.Float64Attribute{
+							Description: "(Deprecated) Maximum memory usage for each database",
+							Optional:    true,
+							Computed:    true,
+							Validators: []validator.Float64{
+								float64validator.ConflictsWith(path.MatchRelative().AtParent().AtName("dataset_size_in_gb")),
+							},
 						},
-						"dataset_size_in_gb": {
-							Description:   "Maximum amount of data in the dataset for this specific database in GB",
-							Type:          schema.TypeFloat,
-							Optional:      true,
-							ConflictsWith: []string{"creation_plan.0.memory_limit_in_gb"},
+						"dataset_size_in_gb": schema.Float64Attribute{
+							Description: "Maximum amount of data in the dataset for this specific database in GB",
+							Optional:    true,
+							Computed:    true,
+							Validators: []validator.Float64{
+								float64validator.ConflictsWith(path.MatchRelative().AtParent().AtName("memory_limit_in_gb")),
+							},
 						},
-						"query_performance_factor": {
+						"query_performance_factor": schema.StringAttribute{
 							Description: "Query performance factor for this specific database",
-							Type:        schema.TypeString,
 							Optional:    true,
 							Computed:    true,
-							ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
-								v := val.(string)
-								matched, err := regexp.MatchString(`^([2468])x$`, v)
-								if err != nil {
-									errs = append(errs, fmt.Errorf("regex match failed: %w", err))
-									return
-								}
-								if !matched {
-									errs = append(errs, fmt.Errorf("%q must be an even value between 2x and 8x (inclusive), got: %s", key, v))
-								}
-								return
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(regexp.MustCompile(`^([2468])x$`), "must be an even value between 2x and 8x (inclusive)"),
 							},
 						},
-						"throughput_measurement_by": {
-							Description:      "Throughput measurement method, (either ‘number-of-shards’ or ‘operations-per-second’)",
-							Type:             schema.TypeString,
-							Required:         true,
-							ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"number-of-shards", "operations-per-second"}, false)),
+						"throughput_measurement_by": schema.StringAttribute{
+							Description: "Throughput measurement method, (either ‘number-of-shards’ or ‘operations-per-second’)",
+							Optional:    true,
+							Computed:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("number-of-shards", "operations-per-second"),
+							},
 						},
-						"throughput_measurement_value": {
+						"throughput_measurement_value": schema.Int64Attribute{
 							Description: "Throughput value (as applies to selected measurement method)",
-							Type:        schema.TypeInt,
-							Required:    true,
+							Optional:    true,
+							Computed:    true,
 						},
-						"average_item_size_in_bytes": {
-							Description:   "(Deprecated) Relevant only to ram-and-flash clusters. Estimated average size (measured in bytes) of the items stored in the database",
-							Type:          schema.TypeInt,
-							Optional:      true,
-							Computed:      true,
-							Default:       nil,
-							ValidateFunc:  validation.IntAtLeast(1),
-							ConflictsWith: []string{"creation_plan.0.ram_percentage"},
-							Deprecated:    "Configure `ram_percentage` instead. This attribute will be removed in the next major version of the provider.",
+						"average_item_size_in_bytes": schema.Int64Attribute{
+							Description:        "(Deprecated) Relevant only to ram-and-flash clusters. Estimated average size (measured in bytes) of the items stored in the database",
+							Optional:           true,
+							Computed:           true,
+							DeprecationMessage: "Configure `ram_percentage` instead. This attribute will be removed in the next major version of the provider.",
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+								int64validator.ConflictsWith(path.MatchRelative().AtParent().AtName("ram_percentage")),
+							},
 						},
-						"ram_percentage": {
-							Description:   "Relevant only to ram-and-flash subscriptions. The percentage of data to be stored in RAM",
-							Type:          schema.TypeInt,
-							Optional:      true,
-							Computed:      true,
-							Default:       nil,
-							ValidateFunc:  validation.IntBetween(0, 100),
-							ConflictsWith: []string{"creation_plan.0.average_item_size_in_bytes"},
+						"ram_percentage": schema.Int64Attribute{
+							Description: "Relevant only to ram-and-flash subscriptions. The percentage of data to be stored in RAM",
+							Optional:    true,
+							Computed:    true,
+							Validators: []validator.Int64{
+								int64validator.Between(0, 100),
+								int64validator.ConflictsWith(path.MatchRelative().AtParent().AtName("average_item_size_in_bytes")),
+							},
 						},
-						"quantity": {
-							Description:  "The planned number of databases",
-							Type:         schema.TypeInt,
-							Required:     true,
-							ValidateFunc: validation.IntAtLeast(1),
+						"quantity": schema.Int64Attribute{
+							Description: "The planned number of databases",
+							Optional:    true,
+							Computed:    true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
 						},
-						"support_oss_cluster_api": {
+						"support_oss_cluster_api": schema.BoolAttribute{
 							Description: "Support Redis open-source (OSS) Cluster API",
-							Type:        schema.TypeBool,
 							Optional:    true,
-							Default:     false,
+							Computed:    true,
+							Default:     booldefault.StaticBool(false),
 						},
-						"replication": {
+						"replication": schema.BoolAttribute{
 							Description: "Databases replication",
-							Type:        schema.TypeBool,
-							Required:    true,
+							Optional:    true,
+							Computed:    true,
 						},
-						"modules": {
+						"modules": schema.ListAttribute{
 							Description: "Modules that will be used by the databases in this subscription.",
-							Type:        schema.TypeList,
 							Optional:    true,
 							Computed:    true,
-							Elem: &schema.Schema{
-								Type: schema.TypeString,
-							},
+							ElementType: types.StringType,
 						},
 					},
 				},
 			},
-			"redis_version": {
-				Description: "Version of Redis to create",
-				Type:        schema.TypeString,
-				Optional:    true,
-				ForceNew:    true,
-				Deprecated:  "This attribute is deprecated on pro subscriptions. Please specify `redis_version` on databases directly instead.",
-				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-					if d.Id() == "" {
-						// Consider the property if the resource is about to be created.
-						return false
-					}
-
-					// Suppress diff if user removes the deprecated attribute
-					if new == "" {
-						return true
-					}
-
-					// Suppress diff if no actual change
-					if old == new {
-						return true
-					}
-
-					// User is requesting a version change - don't suppress
-					return false
-				},
-			},
-			"maintenance_windows": {
+			"maintenance_windows": schema.ListNestedBlock{
 				Description: "Specify the subscription's maintenance windows",
-				Type:        schema.TypeList,
-				Optional:    true,
-				Computed:    true,
-				MinItems:    1,
-				MaxItems:    1,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"mode": {
-							Description:      "Either automatic (Redis specified) or manual (User specified)",
-							Type:             schema.TypeString,
-							Required:         true,
-							ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"automatic", "manual"}, false)),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"mode": schema.StringAttribute{
+							Description: "Either automatic (Redis specified) or manual (User specified)",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("automatic", "manual"),
+							},
 						},
-						"window": {
+					},
+					Blocks: map[string]schema.Block{
+						"window": schema.ListNestedBlock{
 							Description: "A list of maintenance windows for manual-mode",
-							Type:        schema.TypeList,
-							Optional:    true, // if mode==automatic, no windows
-							MinItems:    1,    // if mode==manual, need at least 1 window
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"start_hour": {
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"start_hour": schema.Int64Attribute{
 										Description: "What hour in the day (0-23) may maintenance start",
-										Type:        schema.TypeInt,
 										Required:    true,
 									},
-									"duration_in_hours": {
+									"duration_in_hours": schema.Int64Attribute{
 										Description: "How long maintenance may take",
-										Type:        schema.TypeInt,
 										Required:    true,
 									},
-									"days": {
+									"days": schema.ListAttribute{
 										Description: "A list of days on which the window is open ('Monday', 'Tuesday' etc)",
-										Type:        schema.TypeList,
 										Required:    true,
-										MinItems:    1,
-										MaxItems:    7,
-										Elem: &schema.Schema{
-											Type: schema.TypeString,
+										ElementType: types.StringType,
+										Validators: []validator.List{
+											listvalidator.SizeBetween(1, 7),
 										},
 									},
 								},
@@ -415,804 +658,515 @@ func ResourceRedisCloudProSubscription() *schema.Resource {
 					},
 				},
 			},
-			"pricing": {
-				Description: "Pricing details totalled over this Subscription",
-				Type:        schema.TypeList,
-				Computed:    true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"database_name": {
-							Description: "The database this pricing entry applies to",
-							Type:        schema.TypeString,
-							Computed:    true,
-						},
-						"type": {
-							Description: "The type of cost e.g. 'Shards'",
-							Type:        schema.TypeString,
-							Computed:    true,
-						},
-						"type_details": {
-							Description: "Further detail e.g. 'micro'",
-							Type:        schema.TypeString,
-							Computed:    true,
-						},
-						"quantity": {
-							Description: "Self-explanatory",
-							Type:        schema.TypeInt,
-							Computed:    true,
-						},
-						"quantity_measurement": {
-							Description: "Self-explanatory",
-							Type:        schema.TypeString,
-							Computed:    true,
-						},
-						"price_per_unit": {
-							Description: "Self-explanatory",
-							Type:        schema.TypeFloat,
-							Computed:    true,
-						},
-						"price_currency": {
-							Description: "Self-explanatory e.g. 'USD'",
-							Type:        schema.TypeString,
-							Computed:    true,
-						},
-						"price_period": {
-							Description: "Self-explanatory e.g. 'hour'",
-							Type:        schema.TypeString,
-							Computed:    true,
-						},
-						"region": {
-							Description: "Self-explanatory, if the cost is associated with a particular region",
-							Type:        schema.TypeString,
-							Computed:    true,
-						},
-					},
-				},
-			},
-			"customer_managed_key_enabled": {
-				Description: "Whether to enable CMK (customer managed key) for the subscription. If this is true, then the subscription will be put in a pending state until you supply the CMEK. See documentation for further details on this process. Defaults to false.",
-				Type:        schema.TypeBool,
-				Optional:    true,
-				Default:     false,
-			},
-			"customer_managed_key_deletion_grace_period": {
-				Description: "The grace period for deleting the subscription. If not set, will default to immediate deletion grace period.",
-				Type:        schema.TypeString,
-				Optional:    true,
-				Default:     "immediate",
-				// TODO: remove this when customer_managed_key_deletion_grace_period is supported on api side
-				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-					// Only suppress diff when:
-					// 1. Old is empty (upgrading from provider version without this field)
-					// 2. New is the default value "immediate"
-					// 3. CMK is NOT being enabled (customer_managed_key_enabled is false)
-					if old == "" && new == "immediate" {
-						cmkEnabled := d.Get("customer_managed_key_enabled").(bool)
-						return !cmkEnabled
-					}
-					return false
-				},
-			},
-			"customer_managed_key": {
+			"customer_managed_key": schema.ListNestedBlock{
 				Description: "CMK resources used to encrypt the databases in this subscription. Ignored if `customer_managed_key_enabled` set to false. Supply after the database has been put into database pending state. See documentation for CMK flow.",
-				Type:        schema.TypeList,
-				Optional:    true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"resource_name": {
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"resource_name": schema.StringAttribute{
 							Description: "Resource name of the customer managed key as defined by the cloud provider.",
-							Type:        schema.TypeString,
 							Required:    true,
 						},
 					},
 				},
 			},
-			"customer_managed_key_redis_service_account": {
-				Description: "The principal of the Redis service account that the subscription is created in. This is used by the user to give access to their customer managed key",
-				Type:        schema.TypeString,
-				Computed:    true,
-			},
-			"public_endpoint_access": {
-				Description: "Whether databases in the subscription should have public endpoints. When set to false, databases will only have private endpoints. Defaults to true.",
-				Type:        schema.TypeBool,
-				Optional:    true,
-				Default:     true,
-			},
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 	}
 }
 
-// cloudRegionsForceNewDiff determines if changes to a cloud region should force
-// creation of a new resource based on whether it is a CMK pending state.
-func cloudRegionsForceNewDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
-	if diff.Id() == "" {
-		return nil
+// IdentitySchema defines the identity schema for the resource.
+func (r *proSubscriptionResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			utils.IdentitySubscriptionId: identityschema.Int64Attribute{
+				Description:       "Identifier of the subscription",
+				RequiredForImport: true,
+			},
+		},
 	}
-	return handleExistingResourceRegionChange(ctx, diff, meta)
 }
 
-func handleExistingResourceRegionChange(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
-	subscription, err := getSubscription(ctx, diff, meta)
-	if err != nil {
-		return fmt.Errorf("failed to get subscription: %w", err)
+// ValidateConfig checks the creation_plan block sets the attributes it requires, which can't be marked as required
+// in the schema as they are computed.
+func (r *proSubscriptionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var creationPlan types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("creation_plan"), &creationPlan)...)
+	if resp.Diagnostics.HasError() || creationPlan.IsNull() || creationPlan.IsUnknown() {
+		return
 	}
 
-	// Only check for force new if not in an encryption key pending state
-	if redis.StringValue(subscription.Status) != subscriptions.SubscriptionStatusEncryptionKeyPending {
-		oldRegion, newRegion := diff.GetChange("cloud_provider.0.region")
-		oldSet := oldRegion.(*schema.Set)
-		newSet := newRegion.(*schema.Set)
-
-		// Check if any differences between old and new region sets
-		if !oldSet.Equal(newSet) {
-			// Force new for the entire region configuration
-			_ = diff.ForceNew("cloud_provider.0.region") //nolint:errcheck // return value not needed in CustomizeDiff
-		}
+	var plans []CreationPlanModel
+	resp.Diagnostics.Append(creationPlan.ElementsAs(ctx, &plans, false)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	return nil
-}
 
-func getSubscription(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) (*subscriptions.Subscription, error) {
-	api := meta.(*client.ApiClient)
-	subscriptionID, err := strconv.Atoi(diff.Id())
-	if err != nil {
-		return nil, fmt.Errorf("invalid subscription ID: %w", err)
+	for i, plan := range plans {
+		required := map[string]bool{
+			"throughput_measurement_by":    plan.ThroughputMeasurementBy.IsNull(),
+			"throughput_measurement_value": plan.ThroughputMeasurementValue.IsNull(),
+			"quantity":                     plan.Quantity.IsNull(),
+			"replication":                  plan.Replication.IsNull(),
+		}
+		for _, name := range []string{"throughput_measurement_by", "throughput_measurement_value", "quantity", "replication"} {
+			if required[name] {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("creation_plan").AtListIndex(i).AtName(name),
+					"Missing required argument",
+					fmt.Sprintf("The argument %q is required, but no definition was found.", name),
+				)
+			}
+		}
 	}
-	return api.Client.Subscription.Get(ctx, subscriptionID)
 }
 
-func resourceRedisCloudProSubscriptionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	api := meta.(*client.ApiClient)
-
-	// Create CloudProviders
-	providers, err := buildCreateCloudProviders(d.Get("cloud_provider"))
-	if err != nil {
-		return diag.FromErr(err)
+// ModifyPlan implements custom plan modification logic.
+func (r *proSubscriptionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when the resource is being destroyed
+	if req.Plan.Raw.IsNull() {
+		return
 	}
 
-	// Create Subscription
-	name := d.Get("name").(string)
-
-	paymentMethod := d.Get("payment_method").(string)
-	paymentMethodID, err := ReadPaymentMethodID(d)
-	if err != nil {
-		return diag.FromErr(err)
+	var plan ProSubscriptionModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	memoryStorage := d.Get("memory_storage").(string)
-
-	// Create databases
-	plan := d.Get("creation_plan").([]interface{})
-
-	// Create creation-plan databases
-	planMap := plan[0].(map[string]interface{})
-	dbs, diags := BuildSubscriptionCreatePlanDatabases(memoryStorage, planMap)
-	if diags.HasError() {
-		return diags
+	var state *ProSubscriptionModel
+	if !req.State.Raw.IsNull() {
+		state = &ProSubscriptionModel{}
+		resp.Diagnostics.Append(req.State.Get(ctx, state)...)
 	}
-
-	createSubscriptionRequest := subscriptions.CreateSubscription{
-		Name:            redis.String(name),
-		DryRun:          redis.Bool(false),
-		PaymentMethodID: paymentMethodID,
-		PaymentMethod:   redis.String(paymentMethod),
-		MemoryStorage:   redis.String(memoryStorage),
-		CloudProviders:  providers,
-		Databases:       dbs,
+	regions, provider := newRegions(ctx, &plan, state, &resp.Diagnostics)
+	if provider != "" {
+		r.checkRegions(ctx, provider, regions, &resp.Diagnostics)
+		r.checkDeploymentCIDRs(ctx, state, regions, &resp.Diagnostics)
 	}
-
-	redisVersion := d.Get("redis_version").(string)
-	if d.Get("redis_version").(string) != "" {
-		createSubscriptionRequest.RedisVersion = redis.String(redisVersion)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	cmkEnabled := d.Get("customer_managed_key_enabled").(bool)
-
-	if cmkEnabled {
-		createSubscriptionRequest.PersistentStorageEncryptionType = redis.String(CMK_ENABLED_STRING)
+	if state != nil {
+		r.checkCurrentMonthlyCost(ctx, &plan, !req.Plan.Raw.Equal(req.State.Raw), &resp.Diagnostics)
+		return
 	}
 
-	publicEndpointAccess := d.Get("public_endpoint_access").(bool)
-	createSubscriptionRequest.PublicEndpointAccess = redis.Bool(publicEndpointAccess)
-
-	subId, err := api.Client.Subscription.Create(ctx, createSubscriptionRequest)
-	if err != nil {
-		return append(diags, diag.FromErr(err)...)
+	// Ensure the "creation_plan" block exists
+	if !plan.CreationPlan.IsUnknown() && len(plan.CreationPlan.Elements()) == 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("creation_plan"), "Missing creation plan", `the "creation_plan" block is required`)
+		return
 	}
 
-	d.SetId(strconv.Itoa(subId))
+	var config ProSubscriptionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	list := r.estimatePricing(ctx, &config, &plan, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(utils.EstimatedPricingKey), plan.EstimatedPricing)...)
 
-	// If in a CMK flow, verify the pending state
-	if cmkEnabled {
-		err = utils.WaitForSubscriptionToBeEncryptionKeyPending(ctx, subId, api)
+	if list != nil {
+		err := utils.CheckMaxMonthlyCost(fmt.Sprintf("subscription %q", plan.Name.ValueString()), list,
+			r.client.MaxMonthlyCost, plan.MaxMonthlyCost.ValueFloat64())
 		if err != nil {
-			return append(diags, diag.FromErr(err)...)
+			resp.Diagnostics.AddAttributeError(path.Root(utils.MaxMonthlyCostKey), "Estimated cost over max_monthly_cost", err.Error())
 		}
-		return resourceRedisCloudProSubscriptionRead(ctx, d, meta)
-	}
-
-	// Confirm Subscription Active status
-	err = utils.WaitForSubscriptionToBeActive(ctx, subId, api)
-
-	if err != nil {
-		return append(diags, diag.FromErr(err)...)
-	}
-
-	// There is a timing issue where the subscription is marked as active before the creation-plan databases are listed.
-	// This additional wait ensures that the databases will be listed before calling api.client.Database.List()
-	time.Sleep(30 * time.Second) //lintignore:R018
-	if err := utils.WaitForSubscriptionToBeActive(ctx, subId, api); err != nil {
-		return append(diags, diag.FromErr(err)...)
 	}
+}
 
-	// Locate Databases to confirm Active status
-	dbList := api.Client.Database.List(ctx, subId)
-
-	for dbList.Next() {
-		dbId := *dbList.Value().ID
-
-		if err := utils.WaitForDatabaseToBeActive(ctx, subId, dbId, api); err != nil {
-			return append(diags, diag.FromErr(err)...)
-		}
-		// Delete each creation-plan database
-		dbErr := api.Client.Database.Delete(ctx, subId, dbId)
-		if dbErr != nil {
-			diag.FromErr(dbErr)
-		}
+// newRegions returns the regions of the subscription's cloud_provider block it isn't already in, and the provider,
+// which is empty while it is unknown. Only these regions are checked, as they are the only ones it would be created
+// in. Regions whose names aren't known yet are left out.
+func newRegions(ctx context.Context, plan *ProSubscriptionModel, state *ProSubscriptionModel, diagnostics *diag.Diagnostics) ([]RegionModel, string) {
+	planned, provider := cloudProviderRegions(ctx, plan, diagnostics)
+	if provider == "" {
+		return nil, ""
 	}
-	if dbList.Err() != nil {
-		return append(diags, diag.FromErr(dbList.Err())...)
+	var prior []RegionModel
+	if state != nil {
+		prior, _ = cloudProviderRegions(ctx, state, diagnostics)
 	}
 
-	if redisVersion != "" {
-		if err := d.Set("redis_version", redisVersion); err != nil {
-			return append(diags, diag.FromErr(err)...)
+	var regions []RegionModel
+	for _, region := range planned {
+		existing := slices.ContainsFunc(prior, func(p RegionModel) bool {
+			return p.Region.Equal(region.Region) && p.NetworkingDeploymentCIDR.Equal(region.NetworkingDeploymentCIDR)
+		})
+		if !existing {
+			regions = append(regions, region)
 		}
 	}
-
-	// Some attributes on a database are not accessible by the subscription creation API.
-	// Run the subscription update function to apply any additional changes to the databases, such as password and so on.
-	return append(diags, resourceRedisCloudProSubscriptionUpdate(ctx, d, meta)...)
+	return regions, provider
 }
 
-func resourceRedisCloudProSubscriptionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	api := meta.(*client.ApiClient)
-
-	var diags diag.Diagnostics
-
-	subId, err := strconv.Atoi(d.Id())
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	subscription, err := api.Client.Subscription.Get(ctx, subId)
-	if err != nil {
-		notFound := &subscriptions.NotFound{}
-		if errors.As(err, &notFound) {
-			d.SetId("")
-			return diags
-		}
-		return diag.FromErr(err)
+// cloudProviderRegions returns the regions of the subscription's cloud_provider block whose names are known, and its
+// provider, which is empty while it is unknown.
+func cloudProviderRegions(ctx context.Context, model *ProSubscriptionModel, diagnostics *diag.Diagnostics) ([]RegionModel, string) {
+	if !utils.IsConfigured(model.CloudProvider) || len(model.CloudProvider.Elements()) == 0 {
+		return nil, ""
 	}
 
-	if err := d.Set("name", redis.StringValue(subscription.Name)); err != nil {
-		return diag.FromErr(err)
+	var providers []CloudProviderModel
+	diagnostics.Append(model.CloudProvider.ElementsAs(ctx, &providers, false)...)
+	if diagnostics.HasError() || !utils.IsConfigured(providers[0].Provider) || !utils.IsConfigured(providers[0].Region) {
+		return nil, ""
 	}
+	var regions []RegionModel
+	diagnostics.Append(providers[0].Region.ElementsAs(ctx, &regions, false)...)
 
-	if subscription.PaymentMethodID != nil && redis.IntValue(subscription.PaymentMethodID) != 0 {
-		paymentMethodID := strconv.Itoa(redis.IntValue(subscription.PaymentMethodID))
-		if err := d.Set("payment_method_id", paymentMethodID); err != nil {
-			return diag.FromErr(err)
+	known := make([]RegionModel, 0, len(regions))
+	for _, region := range regions {
+		if utils.IsConfigured(region.Region) {
+			known = append(known, region)
 		}
 	}
-	if err := d.Set("payment_method", redis.StringValue(subscription.PaymentMethod)); err != nil {
-		return diag.FromErr(err)
-	}
-	if err := d.Set("memory_storage", redis.StringValue(subscription.MemoryStorage)); err != nil {
-		return diag.FromErr(err)
-	}
+	return known, providers[0].Provider.ValueString()
+}
 
-	if err := d.Set("cloud_provider", FlattenCloudDetails(subscription.CloudDetails, true)); err != nil {
-		return diag.FromErr(err)
+// checkRegions checks the new regions of the subscription are regions of its cloud provider, so that a mistyped
+// region fails the plan rather than the create.
+func (r *proSubscriptionResource) checkRegions(ctx context.Context, provider string, regions []RegionModel, diagnostics *diag.Diagnostics) {
+	names := make([]string, 0, len(regions))
+	for _, region := range regions {
+		names = append(names, region.Region.ValueString())
 	}
-
-	providers, err := buildCreateCloudProviders(d.Get("cloud_provider"))
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	// CIDR allowlist is not allowed for Redis Labs internal resources subscription.
-	if len(providers) > 0 && redis.IntValue(providers[0].CloudAccountID) != 1 {
-		allowlist, err := flattenSubscriptionAllowlist(ctx, subId, api)
-		if err != nil {
-			return diag.FromErr(err)
-		}
-		if err := d.Set("allowlist", allowlist); err != nil {
-			return diag.FromErr(err)
-		}
+	for _, err := range utils.CheckRegions(ctx, r.client, provider, names) {
+		diagnostics.AddAttributeError(path.Root("cloud_provider").AtListIndex(0).AtName("region"), "Invalid region", err.Error())
 	}
+}
 
-	cmkEnabled := d.Get("customer_managed_key_enabled").(bool)
-	if !cmkEnabled {
-		m, err := api.Client.Maintenance.Get(ctx, subId)
-		if err != nil {
-			return diag.FromErr(err)
-		}
-		if err := d.Set("maintenance_windows", FlattenMaintenance(m)); err != nil {
-			return diag.FromErr(err)
-		}
+// checkDeploymentCIDRs checks the deployment CIDRs of the new regions of the subscription are /24s which don't overlap
+// each other or, when the provider's check_account_cidr_overlaps is set, those of the account's other subscriptions.
+func (r *proSubscriptionResource) checkDeploymentCIDRs(ctx context.Context, state *ProSubscriptionModel, regions []RegionModel, diagnostics *diag.Diagnostics) {
+	regionsPath := path.Root("cloud_provider").AtListIndex(0).AtName("region")
 
-		pricingList, err := api.Client.Pricing.List(ctx, subId)
-		if err != nil {
-			return diag.FromErr(err)
+	var cidrs []utils.DeploymentCIDR
+	for _, region := range regions {
+		if !utils.IsConfigured(region.NetworkingDeploymentCIDR) {
+			continue
 		}
-		if err := d.Set("pricing", FlattenPricing(pricingList)); err != nil {
-			return diag.FromErr(err)
+		cidr := region.NetworkingDeploymentCIDR.ValueString()
+		if err := utils.CheckDeploymentCIDRPrefix(cidr); err != nil {
+			diagnostics.AddAttributeError(regionsPath, "Invalid deployment CIDR", err.Error())
+			continue
 		}
-	}
-
-	if subscription.CustomerManagedKeyAccessDetails != nil && subscription.CustomerManagedKeyAccessDetails.RedisServiceAccount != nil {
-		if err := d.Set("customer_managed_key_redis_service_account", subscription.CustomerManagedKeyAccessDetails.RedisServiceAccount); err != nil {
-			return diag.FromErr(err)
+		cidrs = append(cidrs, utils.DeploymentCIDR{Region: region.Region.ValueString(), CIDR: cidr})
+	}
+	for i, cidr := range cidrs {
+		for _, other := range cidrs[i+1:] {
+			if utils.CIDRsOverlap(cidr.CIDR, other.CIDR) {
+				diagnostics.AddAttributeError(regionsPath, "Overlapping deployment CIDR",
+					fmt.Sprintf("the deployment CIDR %s of region %s overlaps the deployment CIDR %s of region %s",
+						cidr.CIDR, cidr.Region, other.CIDR, other.Region))
+			}
 		}
 	}
-
-	// Set public_endpoint_access, default to true if not set by API
-	publicEndpointAccess := true
-	if subscription.PublicEndpointAccess != nil {
-		publicEndpointAccess = redis.BoolValue(subscription.PublicEndpointAccess)
+	if diagnostics.HasError() {
+		return
 	}
-	if err := d.Set("public_endpoint_access", publicEndpointAccess); err != nil {
-		return diag.FromErr(err)
+	if r.client == nil || !r.client.CheckAccountCIDROverlaps || len(cidrs) == 0 {
+		return
 	}
 
-	cmkEnabledFromAPI := subscription.PersistentStorageEncryptionType != nil &&
-		redis.StringValue(subscription.PersistentStorageEncryptionType) == CMK_ENABLED_STRING
-	if err := d.Set("customer_managed_key_enabled", cmkEnabledFromAPI); err != nil {
-		return diag.FromErr(err)
+	// A subscription being replaced doesn't overlap itself.
+	var subId int
+	if state != nil {
+		subId, _ = strconv.Atoi(state.ID.ValueString())
 	}
-
-	if subscription.DeletionGracePeriod != nil {
-		if err := d.Set("customer_managed_key_deletion_grace_period", redis.StringValue(subscription.DeletionGracePeriod)); err != nil {
-			return diag.FromErr(err)
+	account, err := utils.AccountDeploymentCIDRs(ctx, r.client, subId)
+	if err != nil {
+		diagnostics.AddWarning("Couldn't check the deployment CIDRs of the account", err.Error())
+		return
+	}
+	for _, cidr := range cidrs {
+		description := fmt.Sprintf("the deployment CIDR %s of region %s", cidr.CIDR, cidr.Region)
+		if err := utils.CheckCIDROverlaps(description, cidr.CIDR, account); err != nil {
+			diagnostics.AddAttributeError(regionsPath, "Overlapping deployment CIDR", err.Error())
 		}
 	}
-
-	return diags
 }
 
-func resourceRedisCloudProSubscriptionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	api := meta.(*client.ApiClient)
-
-	subId, err := strconv.Atoi(d.Id())
+// checkCurrentMonthlyCost checks the current pricing of an existing subscription against its max_monthly_cost. It is
+// an error when the subscription is being changed, and only a warning otherwise, so that plans lowering its cost, such
+// as by removing databases, aren't blocked. The cost of changing its databases is checked by the database resource.
+// The pricing is only listed when a ceiling applies, and subscriptions with customer-managed keys, whose pricing
+// can't be read, are skipped.
+func (r *proSubscriptionResource) checkCurrentMonthlyCost(ctx context.Context, plan *ProSubscriptionModel, changed bool, diagnostics *diag.Diagnostics) {
+	if r.client == nil || !utils.IsConfigured(plan.Pricing) || !utils.IsFullyKnown(ctx, plan.MaxMonthlyCost) {
+		return
+	}
+	if ceiling, _ := utils.MaxMonthlyCost(r.client.MaxMonthlyCost, plan.MaxMonthlyCost.ValueFloat64()); ceiling <= 0 {
+		return
+	}
+	subId, err := strconv.Atoi(plan.ID.ValueString())
 	if err != nil {
-		return diag.FromErr(err)
+		return
 	}
 
-	utils.SubscriptionMutex.Lock(subId)
-	defer utils.SubscriptionMutex.Unlock(subId)
-
-	subscription, err := api.Client.Subscription.Get(ctx, subId)
+	list, err := r.client.Client.Pricing.List(ctx, subId)
 	if err != nil {
-		return diag.FromErr(err)
+		diagnostics.AddWarning("Couldn't check the current cost of the subscription", err.Error())
+		return
 	}
+	err = utils.CheckMaxMonthlyCost(fmt.Sprintf("subscription %s", plan.ID.ValueString()), list,
+		r.client.MaxMonthlyCost, plan.MaxMonthlyCost.ValueFloat64())
+	if err == nil {
+		return
+	}
+	if changed {
+		diagnostics.AddAttributeError(path.Root(utils.MaxMonthlyCostKey), "Current cost over max_monthly_cost", err.Error())
+		return
+	}
+	diagnostics.AddAttributeWarning(path.Root(utils.MaxMonthlyCostKey), "Current cost over max_monthly_cost", err.Error())
+}
 
-	cmkEnabled := d.Get("customer_managed_key_enabled").(bool)
-
-	// CMK flow
-	if *subscription.Status == subscriptions.SubscriptionStatusEncryptionKeyPending && cmkEnabled {
-		diags := resourceRedisCloudProSubscriptionUpdateCmk(ctx, d, api, subId)
-
-		if diags != nil {
-			return diags
-		}
+// estimatePricing previews the pricing of the subscription being created, into estimated_pricing, and warns of its
+// monthly total. The estimate is skipped while the configured values the create request is built from are unknown,
+// and a failed preview is only a warning, as the create may still succeed. It returns the previewed pricing, or nil
+// when there isn't an estimate.
+func (r *proSubscriptionResource) estimatePricing(ctx context.Context, config *ProSubscriptionModel, plan *ProSubscriptionModel, diagnostics *diag.Diagnostics) []*pricing.Pricing {
+	if r.client == nil || !utils.IsFullyKnown(ctx, config.Name, config.PaymentMethod, config.PaymentMethodID,
+		config.MemoryStorage, config.CloudProvider, config.CreationPlan, config.RedisVersion,
+		config.CustomerManagedKeyEnabled, config.PublicEndpointAccess) {
+		return nil
 	}
 
-	if d.HasChange("allowlist") {
-		cidrs := utils.SetToStringSlice(d.Get("allowlist.0.cidrs").(*schema.Set))
-		sgs := utils.SetToStringSlice(d.Get("allowlist.0.security_group_ids").(*schema.Set))
+	var d diag.Diagnostics
+	request := buildCreateSubscription(ctx, plan, &d)
+	if d.HasError() {
+		// The create reports the same errors.
+		return nil
+	}
 
-		err := api.Client.Subscription.UpdateCIDRAllowlist(ctx, subId, subscriptions.UpdateCIDRAllowlist{
-			CIDRIPs:          cidrs,
-			SecurityGroupIDs: sgs,
-		})
-		if err != nil {
-			return diag.FromErr(err)
-		}
+	description := fmt.Sprintf("subscription %q", plan.Name.ValueString())
+	list, err := utils.PreviewPricing(ctx, r.client, request)
+	if err != nil {
+		warning := utils.EstimatedPricingUnavailableWarning(description, err)
+		diagnostics.AddWarning(warning.Summary, warning.Detail)
+		plan.EstimatedPricing = types.ListNull(types.ObjectType{AttrTypes: pricingAttrTypes()})
+		return nil
 	}
 
-	if d.HasChanges("name", "payment_method_id", "public_endpoint_access") {
-		updateSubscriptionRequest := subscriptions.UpdateSubscription{}
+	plan.EstimatedPricing = flattenPricing(list)
+	warning := utils.EstimatedPricingWarning(description, list)
+	diagnostics.AddWarning(warning.Summary, warning.Detail)
+	return list
+}
 
-		if d.HasChange("name") {
-			name := d.Get("name").(string)
-			updateSubscriptionRequest.Name = &name
+// ImportState imports an existing resource.
+func (r *proSubscriptionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var id string
+	if req.ID == "" && req.Identity != nil {
+		// Importing with an identity block rather than an import ID
+		var identity ProSubscriptionIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
-
-		if d.HasChange("payment_method_id") {
-			paymentMethodID, err := ReadPaymentMethodID(d)
+		id = strconv.FormatInt(identity.SubscriptionID.ValueInt64(), 10)
+	} else {
+		id = req.ID
+		if name, ok := utils.IsImportByName(id); ok {
+			// Importing with name=<subscription name>
+			resolved, err := utils.SubscriptionNameResolver(subscriptions.SubscriptionDeploymentTypeSingleRegion)(ctx, r.client, name)
 			if err != nil {
-				return diag.FromErr(err)
+				resp.Diagnostics.AddError("Failed to import by name", err.Error())
+				return
 			}
-
-			updateSubscriptionRequest.PaymentMethodID = paymentMethodID
+			id = resolved
 		}
-
-		if d.HasChange("public_endpoint_access") {
-			publicEndpointAccess := d.Get("public_endpoint_access").(bool)
-			updateSubscriptionRequest.PublicEndpointAccess = redis.Bool(publicEndpointAccess)
-		}
-
-		err = api.Client.Subscription.Update(ctx, subId, updateSubscriptionRequest)
-		if err != nil {
-			return diag.FromErr(err)
-		}
-	}
-
-	if err := utils.WaitForSubscriptionToBeActive(ctx, subId, api); err != nil {
-		return diag.FromErr(err)
 	}
 
-	// Verify public_endpoint_access has propagated if it was changed
-	if d.HasChange("public_endpoint_access") {
-		expected := d.Get("public_endpoint_access").(bool)
-		if err := utils.WaitForSubscriptionPublicEndpointAccess(ctx, subId, api, expected); err != nil {
-			return diag.FromErr(err)
-		}
+	subId, err := strconv.Atoi(id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("Expected a subscription ID or 'name=<subscription name>', got: %s. Error: %s", req.ID, err.Error()),
+		)
+		return
 	}
 
-	if d.HasChange("maintenance_windows") {
-		var updateMaintenanceRequest maintenance.Maintenance
-		if m, ok := d.GetOk("maintenance_windows"); ok {
-			mMap := m.([]interface{})[0].(map[string]interface{})
-
-			windows := make([]*maintenance.Window, 0)
-			for _, w := range mMap["window"].([]interface{}) {
-				wMap := w.(map[string]interface{})
-				windows = append(windows, &maintenance.Window{
-					StartHour:       redis.Int(wMap["start_hour"].(int)),
-					DurationInHours: redis.Int(wMap["duration_in_hours"].(int)),
-					Days:            utils.InterfaceToStringSlice(wMap["days"].([]interface{})),
-				})
-			}
-
-			updateMaintenanceRequest = maintenance.Maintenance{
-				Mode:    redis.String(mMap["mode"].(string)),
-				Windows: windows,
-			}
-		} else {
-			updateMaintenanceRequest = maintenance.Maintenance{
-				Mode: redis.String("automatic"),
-			}
-		}
-		err = api.Client.Maintenance.Update(ctx, subId, updateMaintenanceRequest)
-		if err != nil {
-			return diag.FromErr(err)
-		}
+	// Let the READ operation do the heavy lifting for importing values from the API.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), strconv.Itoa(subId))...)
+	if resp.Identity != nil {
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, ProSubscriptionIdentityModel{
+			SubscriptionID: types.Int64Value(int64(subId)),
+		})...)
 	}
-
-	return resourceRedisCloudProSubscriptionRead(ctx, d, meta)
 }
 
-func resourceRedisCloudProSubscriptionUpdateCmk(ctx context.Context, d *schema.ResourceData, api *client.ApiClient, subId int) diag.Diagnostics {
-
-	cmkResourcesRaw, exists := d.GetOk("customer_managed_key")
-	if !exists {
-		return diag.Errorf("customer_managed_key must be set when subscription is in encryption key pending state")
+// Create implements resource creation.
+func (r *proSubscriptionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ProSubscriptionModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	cmkList := cmkResourcesRaw.([]interface{})
-	if len(cmkList) == 0 || cmkList[0] == nil {
-		return diag.Errorf("customer_managed_key cannot be empty or null")
+	timeout, diags := plan.Timeouts.Create(ctx, 30*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
 
-	customerManagedKeys := buildProCmks(cmkList)
-	deletionGracePeriod := d.Get("customer_managed_key_deletion_grace_period").(string)
-
-	updateCmkRequest := subscriptions.UpdateSubscriptionCMKs{
-		DeletionGracePeriod: redis.String(deletionGracePeriod),
-		CustomerManagedKeys: &customerManagedKeys,
-	}
-
-	if err := api.Client.Subscription.UpdateCMKs(ctx, subId, updateCmkRequest); err != nil {
-		return diag.FromErr(err)
+	// Call the CRUD implementation
+	r.createSubscription(ctx, &plan, &resp.Diagnostics)
+	if plan.ID.IsUnknown() {
+		return
 	}
 
-	if err := utils.WaitForSubscriptionToBeActive(ctx, subId, api); err != nil {
-		return diag.FromErr(err)
+	// Set the state, even if the create failed once the subscription exists, so that it is tainted rather than lost.
+	// The create-only values the API doesn't report back, and the computed values of a subscription whose create
+	// didn't finish, are left null.
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if err := utils.NullUnknownValues(&resp.State); err != nil {
+		resp.Diagnostics.AddError("Failed to save subscription", err.Error())
+		return
 	}
-
-	return nil
+	setIdentity(ctx, resp.Identity, &plan, &resp.Diagnostics)
 }
 
-func buildProCmks(cmkResources []interface{}) []subscriptions.CustomerManagedKey {
-	cmks := make([]subscriptions.CustomerManagedKey, 0, len(cmkResources))
-	for _, resource := range cmkResources {
-		cmkMap := resource.(map[string]interface{})
-
-		cmk := subscriptions.CustomerManagedKey{
-			ResourceName: redis.String(cmkMap["resource_name"].(string)),
-		}
-
-		cmks = append(cmks, cmk)
+// Read implements resource reading.
+func (r *proSubscriptionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ProSubscriptionModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	return cmks
-}
-
-func resourceRedisCloudProSubscriptionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	// use the meta value to retrieve your client from the provider configure method
-	api := meta.(*client.ApiClient)
+	timeout, diags := state.Timeouts.Read(ctx, 10*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
 
-	var diags diag.Diagnostics
+	// Call the CRUD implementation
+	removed := r.readSubscription(ctx, &state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	subId, err := strconv.Atoi(d.Id())
-	if err != nil {
-		return diag.FromErr(err)
+	if removed {
+		resp.State.RemoveResource(ctx)
+		return
 	}
 
-	utils.SubscriptionMutex.Lock(subId)
-	defer utils.SubscriptionMutex.Unlock(subId)
+	// Set the state
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	setIdentity(ctx, resp.Identity, &state, &resp.Diagnostics)
+}
 
-	subscription, err := api.Client.Subscription.Get(ctx, subId)
-	if err != nil {
-		return diag.FromErr(err)
+// Update implements resource updating.
+func (r *proSubscriptionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ProSubscriptionModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if *subscription.Status != subscriptions.SubscriptionStatusEncryptionKeyPending {
-		// Wait for the subscription to be active before deleting it.
-		if err := utils.WaitForSubscriptionToBeActive(ctx, subId, api); err != nil {
-			return diag.FromErr(err)
-		}
-
-		// There is a timing issue where the subscription is marked as active before the creation-plan databases are deleted.
-		// This additional wait ensures that the databases are deleted before the subscription is deleted.
-		time.Sleep(30 * time.Second) //lintignore:R018
-		if err := utils.WaitForSubscriptionToBeActive(ctx, subId, api); err != nil {
-			return diag.FromErr(err)
-		}
+	var state ProSubscriptionModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	// Delete subscription once all databases are deleted
-	err = api.Client.Subscription.Delete(ctx, subId)
-	if err != nil {
-		return diag.FromErr(err)
+	timeout, diags := plan.Timeouts.Update(ctx, 30*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
 
-	d.SetId("")
+	// Preserve the ID from state
+	plan.ID = state.ID
 
-	err = WaitForSubscriptionToBeDeleted(ctx, subId, api)
+	subId, err := strconv.Atoi(state.ID.ValueString())
 	if err != nil {
-		return diag.FromErr(err)
+		resp.Diagnostics.AddError("Invalid subscription ID", err.Error())
+		return
 	}
 
-	return diags
-}
-
-func buildCreateCloudProviders(providers interface{}) ([]*subscriptions.CreateCloudProvider, error) {
-	createCloudProviders := make([]*subscriptions.CreateCloudProvider, 0)
-
-	for _, provider := range providers.([]interface{}) {
-		providerMap := provider.(map[string]interface{})
-
-		providerStr := providerMap["provider"].(string)
-		cloudAccountID, err := strconv.Atoi(providerMap["cloud_account_id"].(string))
-		if err != nil {
-			return nil, err
-		}
-
-		createRegions := make([]*subscriptions.CreateRegion, 0)
-		if regions := providerMap["region"].(*schema.Set).List(); len(regions) != 0 {
-
-			for _, region := range regions {
-				regionMap := region.(map[string]interface{})
-
-				regionStr := regionMap["region"].(string)
-				multipleAvailabilityZones := regionMap["multiple_availability_zones"].(bool)
-				preferredAZs := regionMap["preferred_availability_zones"].([]interface{})
-
-				createRegion := subscriptions.CreateRegion{
-					Region:                     redis.String(regionStr),
-					MultipleAvailabilityZones:  redis.Bool(multipleAvailabilityZones),
-					PreferredAvailabilityZones: utils.InterfaceToStringSlice(preferredAZs),
-				}
-
-				if v, ok := regionMap["networking_deployment_cidr"]; ok && v != "" {
-					createRegion.Networking = &subscriptions.CreateNetworking{
-						DeploymentCIDR: redis.String(v.(string)),
-					}
-				}
-
-				if v, ok := regionMap["networking_vpc_id"]; ok && v != "" {
-					if createRegion.Networking == nil {
-						createRegion.Networking = &subscriptions.CreateNetworking{}
-					}
-					createRegion.Networking.VPCId = redis.String(v.(string))
-				}
-
-				createRegions = append(createRegions, &createRegion)
+	unlock, err := utils.LockSubscription(ctx, subId)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to lock subscription", err.Error())
+		return
+	}
+	defer unlock()
+
+	// Resume a create which was interrupted, keeping the subscription pending until it is ready
+	if state.CreationPending.ValueBool() {
+		log.Printf("[INFO] Resuming the interrupted create of subscription %d", subId)
+		if err := r.waitForSubscriptionCreate(ctx, subId, state.CustomerManagedKeyEnabled.ValueBool()); err != nil {
+			if utils.CreateInterrupted(ctx, err) {
+				warning := utils.CreationPendingWarning(fmt.Sprintf("Subscription %d", subId), err)
+				resp.Diagnostics.AddWarning(warning.Summary, warning.Detail)
+			} else {
+				resp.Diagnostics.AddError("Subscription failed to become ready", err.Error())
 			}
+			resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+			return
 		}
-
-		createCloudProvider := &subscriptions.CreateCloudProvider{
-			Provider:       redis.String(providerStr),
-			CloudAccountID: redis.Int(cloudAccountID),
-			Regions:        createRegions,
-		}
-
-		createCloudProviders = append(createCloudProviders, createCloudProvider)
-	}
-
-	return createCloudProviders, nil
-}
-
-func BuildSubscriptionCreatePlanDatabases(memoryStorage string, planMap map[string]interface{}) ([]*subscriptions.CreateDatabase, diag.Diagnostics) {
-
-	createDatabases := make([]*subscriptions.CreateDatabase, 0)
-
-	dbName := "creation-plan-db-"
-	idx := 1
-	throughputMeasurementBy := planMap["throughput_measurement_by"].(string)
-	throughputMeasurementValue := planMap["throughput_measurement_value"].(int)
-	averageItemSizeInBytes := planMap["average_item_size_in_bytes"].(int)
-	ramPercentage := planMap["ram_percentage"].(int)
-
-	numDatabases := planMap["quantity"].(int)
-	supportOSSClusterAPI := planMap["support_oss_cluster_api"].(bool)
-	replication := planMap["replication"].(bool)
-	planModules := utils.InterfaceToStringSlice(planMap["modules"].([]interface{}))
-
-	memoryLimitInGB := 0.0
-	if v, ok := planMap["memory_limit_in_gb"]; ok && v != nil {
-		memoryLimitInGB = v.(float64)
 	}
 
-	datasetSizeInGB := 0.0
-	if v, ok := planMap["dataset_size_in_gb"]; ok && v != nil {
-		datasetSizeInGB = v.(float64)
+	// Call the CRUD implementation
+	r.updateSubscription(ctx, subId, &plan, &state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	queryPerformanceFactor := ""
-	if v, ok := planMap["query_performance_factor"]; ok && v != nil {
-		queryPerformanceFactor = v.(string)
-	}
-
-	var diags diag.Diagnostics
-	if memoryStorage == databases.MemoryStorageRam && averageItemSizeInBytes != 0 {
-		// TODO This should be changed to an error when releasing 2.0 of the provider
-		diags = append(diags, diag.Diagnostic{
-			Severity: diag.Warning,
-			Summary:  "`average_item_size_in_bytes` not applicable for `ram` memory storage ",
-			Detail:   "`average_item_size_in_bytes` is only applicable when `memory_storage` is `ram-and-flash`. This will be an error in a future release of the provider",
-		})
-	}
-
-	if memoryStorage == databases.MemoryStorageRam && ramPercentage != 0 {
-		diags = append(diags, diag.Diagnostic{
-			Severity: diag.Warning,
-			Summary:  "`ram_percentage` not applicable for `ram` memory storage ",
-			Detail:   "`ram_percentage` is only applicable when `memory_storage` is `ram-and-flash`",
-		})
-	}
-
-	// Check if one of the modules is RedisGraph
-	containsGraph := false
-	for _, module := range planModules {
-		if *module == "RedisGraph" {
-			containsGraph = true
-			break
-		}
+	// Read back the state to get computed values
+	r.readSubscription(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if !containsGraph || len(planModules) <= 1 {
-		var modules []*subscriptions.CreateModules
-		for _, v := range planModules {
-			modules = append(modules, &subscriptions.CreateModules{Name: v})
-		}
-		createDatabases = append(createDatabases, createDatabase(dbName, &idx, modules, throughputMeasurementBy, throughputMeasurementValue, memoryLimitInGB, datasetSizeInGB, averageItemSizeInBytes, ramPercentage, supportOSSClusterAPI, replication, numDatabases, queryPerformanceFactor)...)
-	} else {
-		// make RedisGraph module the first module, then append the rest of the modules
-		var modules []*subscriptions.CreateModules
-		modules = append(modules, &subscriptions.CreateModules{Name: redis.String("RedisGraph")})
-		for _, v := range planModules {
-			if *v != "RedisGraph" {
-				modules = append(modules, &subscriptions.CreateModules{Name: v})
-			}
-		}
-		// create a DB with the RedisGraph module
-		createDatabases = append(createDatabases, createDatabase(dbName, &idx, modules[:1], throughputMeasurementBy, throughputMeasurementValue, memoryLimitInGB, datasetSizeInGB, averageItemSizeInBytes, ramPercentage, supportOSSClusterAPI, replication, 1, queryPerformanceFactor)...)
-		if numDatabases == 1 {
-			// create one extra DB with all other modules
-			createDatabases = append(createDatabases, createDatabase(dbName, &idx, modules[1:], throughputMeasurementBy, throughputMeasurementValue, memoryLimitInGB, datasetSizeInGB, averageItemSizeInBytes, ramPercentage, supportOSSClusterAPI, replication, 1, queryPerformanceFactor)...)
-		} else if numDatabases > 1 {
-			// create the remaining DBs with all other modules
-			createDatabases = append(createDatabases, createDatabase(dbName, &idx, modules[1:], throughputMeasurementBy, throughputMeasurementValue, memoryLimitInGB, datasetSizeInGB, averageItemSizeInBytes, ramPercentage, supportOSSClusterAPI, replication, numDatabases-1, queryPerformanceFactor)...)
-		}
-	}
-	return createDatabases, diags
+	// Set the state
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	setIdentity(ctx, resp.Identity, &plan, &resp.Diagnostics)
 }
 
-//nolint:unparam
-func createDatabase(dbName string, idx *int, modules []*subscriptions.CreateModules, throughputMeasurementBy string, throughputMeasurementValue int, memoryLimitInGB float64, datasetSizeInGB float64, averageItemSizeInBytes int, ramPercentage int, supportOSSClusterAPI bool, replication bool, numDatabases int, queryPerformanceFactor string) []*subscriptions.CreateDatabase {
-	createThroughput := &subscriptions.CreateThroughput{
-		By:    redis.String(throughputMeasurementBy),
-		Value: redis.Int(throughputMeasurementValue),
-	}
-	if len(modules) > 0 {
-		// if RedisGraph is in the modules, set throughput to operations-per-second and convert the value
-		if *modules[0].Name == "RedisGraph" {
-			if *createThroughput.By == "number-of-shards" {
-				createThroughput.By = redis.String("operations-per-second")
-				if replication {
-					createThroughput.Value = redis.Int(*createThroughput.Value * 500)
-				} else {
-					createThroughput.Value = redis.Int(*createThroughput.Value * 250)
-				}
-			}
-		}
+// Delete implements resource deletion.
+func (r *proSubscriptionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ProSubscriptionModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	var dbs []*subscriptions.CreateDatabase
-	for i := 0; i < numDatabases; i++ {
-		createDatabase := subscriptions.CreateDatabase{
-			Name:                  redis.String(dbName + strconv.Itoa(*idx)),
-			Protocol:              redis.String("redis"),
-			SupportOSSClusterAPI:  redis.Bool(supportOSSClusterAPI),
-			Replication:           redis.Bool(replication),
-			ThroughputMeasurement: createThroughput,
-			Quantity:              redis.Int(1),
-			Modules:               modules,
-		}
-		if averageItemSizeInBytes > 0 {
-			createDatabase.AverageItemSizeInBytes = redis.Int(averageItemSizeInBytes)
-		}
-
-		if ramPercentage > 0 {
-			createDatabase.RamPercentage = redis.Int(ramPercentage)
-		}
-
-		if datasetSizeInGB > 0 {
-			createDatabase.DatasetSizeInGB = redis.Float64(datasetSizeInGB)
-		}
-		if memoryLimitInGB > 0 {
-			createDatabase.MemoryLimitInGB = redis.Float64(memoryLimitInGB)
-		}
-
-		if queryPerformanceFactor != "" {
-			createDatabase.QueryPerformanceFactor = redis.String(queryPerformanceFactor)
-		}
 
-		*idx++
-		dbs = append(dbs, &createDatabase)
+	timeout, diags := state.Timeouts.Delete(ctx, 10*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	return dbs
-}
-
-func WaitForSubscriptionToBeDeleted(ctx context.Context, id int, api *client.ApiClient) error {
-	wait := &retry.StateChangeConf{
-		Pending:      []string{subscriptions.SubscriptionStatusDeleting},
-		Target:       []string{"deleted"}, // TODO: update this with deleted field in SDK
-		Timeout:      utils.SafetyTimeout,
-		Delay:        10 * time.Second,
-		PollInterval: 30 * time.Second,
-
-		Refresh: func() (result interface{}, state string, err error) {
-			log.Printf("[DEBUG] Waiting for subscription %d to be deleted", id)
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
 
-			subscription, err := api.Client.Subscription.Get(ctx, id)
-			if err != nil {
-				notFound := &subscriptions.NotFound{}
-				if errors.As(err, &notFound) {
-					return "deleted", "deleted", nil
-				} // TODO: update this with deleted field in SDK
-				return nil, "", err
-			}
+	// Call the CRUD implementation
+	r.deleteSubscription(ctx, &state, &resp.Diagnostics)
+}
 
-			return redis.StringValue(subscription.Status), redis.StringValue(subscription.Status), nil
-		},
+// setIdentity records the resource identity from the model, when the identity is supported.
+func setIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, model *ProSubscriptionModel, diagnostics *diag.Diagnostics) {
+	if identity == nil || diagnostics.HasError() {
+		return
 	}
-	if _, err := wait.WaitForStateContext(ctx); err != nil {
-		return err
+	subId, err := strconv.Atoi(model.ID.ValueString())
+	if err != nil {
+		diagnostics.AddError("Invalid subscription ID", err.Error())
+		return
 	}
-
-	return nil
+	diagnostics.Append(identity.Set(ctx, ProSubscriptionIdentityModel{
+		SubscriptionID: types.Int64Value(int64(subId)),
+	})...)
 }
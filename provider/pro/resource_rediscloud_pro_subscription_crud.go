@@ -0,0 +1,930 @@
+package pro
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/RedisLabs/rediscloud-go-api/service/databases"
+	"github.com/RedisLabs/rediscloud-go-api/service/maintenance"
+	"github.com/RedisLabs/rediscloud-go-api/service/pricing"
+	"github.com/RedisLabs/rediscloud-go-api/service/subscriptions"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/client"
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/utils"
+)
+
+// createSubscription creates the subscription, waits for it to be ready and applies the attributes which can't be
+// set on creation. The plan's ID is set once the subscription exists, even if a later step fails.
+func (r *proSubscriptionResource) createSubscription(ctx context.Context, plan *ProSubscriptionModel, diagnostics *diag.Diagnostics) {
+	createSubscriptionRequest := buildCreateSubscription(ctx, plan, diagnostics)
+	if diagnostics.HasError() {
+		return
+	}
+	cmkEnabled := plan.CustomerManagedKeyEnabled.ValueBool()
+
+	subId, err := r.client.Client.Subscription.Create(ctx, createSubscriptionRequest)
+	if err != nil {
+		diagnostics.AddError("Failed to create subscription", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(strconv.Itoa(subId))
+
+	// Wait for the subscription to be ready. If the apply is interrupted first, the subscription is saved to state
+	// as pending, for the next apply to resume waiting for it.
+	plan.CreationPending = types.BoolValue(true)
+	if err := r.waitForSubscriptionCreate(ctx, subId, cmkEnabled); err != nil {
+		if utils.CreateInterrupted(ctx, err) {
+			warning := utils.CreationPendingWarning(fmt.Sprintf("Subscription %d", subId), err)
+			diagnostics.AddWarning(warning.Summary, warning.Detail)
+			return
+		}
+		diagnostics.AddError("Subscription failed to become ready", err.Error())
+		return
+	}
+	plan.CreationPending = types.BoolValue(false)
+
+	// If in a CMK flow, the subscription waits for its customer managed keys
+	if !cmkEnabled {
+		unlock, err := utils.LockSubscription(ctx, subId)
+		if err != nil {
+			diagnostics.AddError("Failed to lock subscription", err.Error())
+			return
+		}
+		defer unlock()
+
+		// Some attributes of a subscription are not accessible by the subscription creation API.
+		if len(plan.Allowlist.Elements()) > 0 {
+			r.updateAllowlist(ctx, subId, plan, diagnostics)
+		}
+		if len(plan.MaintenanceWindows.Elements()) > 0 && !diagnostics.HasError() {
+			r.updateMaintenanceWindows(ctx, subId, plan, diagnostics)
+		}
+		if diagnostics.HasError() {
+			return
+		}
+	}
+
+	r.readSubscription(ctx, plan, diagnostics)
+}
+
+// buildCreateSubscription converts the plan into the API's create request.
+func buildCreateSubscription(ctx context.Context, plan *ProSubscriptionModel, diagnostics *diag.Diagnostics) subscriptions.CreateSubscription {
+	// Create CloudProviders
+	providers := buildCreateCloudProviders(ctx, plan.CloudProvider, diagnostics)
+	if diagnostics.HasError() {
+		return subscriptions.CreateSubscription{}
+	}
+
+	paymentMethodID, err := readPaymentMethodID(plan.PaymentMethodID)
+	if err != nil {
+		diagnostics.AddError("Invalid payment method ID", err.Error())
+		return subscriptions.CreateSubscription{}
+	}
+
+	memoryStorage := plan.MemoryStorage.ValueString()
+
+	// Create creation-plan databases
+	var creationPlans []CreationPlanModel
+	diagnostics.Append(plan.CreationPlan.ElementsAs(ctx, &creationPlans, false)...)
+	if diagnostics.HasError() {
+		return subscriptions.CreateSubscription{}
+	}
+	if len(creationPlans) == 0 {
+		diagnostics.AddError("Missing creation plan", `the "creation_plan" block is required`)
+		return subscriptions.CreateSubscription{}
+	}
+	dbs := BuildSubscriptionCreatePlanDatabases(ctx, memoryStorage, creationPlans[0], diagnostics)
+	if diagnostics.HasError() {
+		return subscriptions.CreateSubscription{}
+	}
+
+	createSubscriptionRequest := subscriptions.CreateSubscription{
+		Name:            redis.String(plan.Name.ValueString()),
+		DryRun:          redis.Bool(false),
+		PaymentMethodID: paymentMethodID,
+		PaymentMethod:   redis.String(plan.PaymentMethod.ValueString()),
+		MemoryStorage:   redis.String(memoryStorage),
+		CloudProviders:  providers,
+		Databases:       dbs,
+	}
+
+	if redisVersion := plan.RedisVersion.ValueString(); redisVersion != "" {
+		createSubscriptionRequest.RedisVersion = redis.String(redisVersion)
+	}
+
+	if plan.CustomerManagedKeyEnabled.ValueBool() {
+		createSubscriptionRequest.PersistentStorageEncryptionType = redis.String(CMK_ENABLED_STRING)
+	}
+
+	createSubscriptionRequest.PublicEndpointAccess = redis.Bool(plan.PublicEndpointAccess.ValueBool())
+
+	return createSubscriptionRequest
+}
+
+// waitForSubscriptionCreate waits for a new subscription to finish provisioning, and deletes the databases of its
+// creation plan. It also resumes a create which was interrupted.
+func (r *proSubscriptionResource) waitForSubscriptionCreate(ctx context.Context, subId int, cmkEnabled bool) error {
+	api := r.client
+
+	// If in a CMK flow, verify the pending state
+	if cmkEnabled {
+		return utils.WaitForSubscriptionToBeEncryptionKeyPending(ctx, subId, api)
+	}
+
+	// Confirm Subscription Active status
+	if err := utils.WaitForSubscriptionToBeActive(ctx, subId, api); err != nil {
+		return err
+	}
+
+	// Locate Databases to confirm Active status
+	dbList := api.Client.Database.List(ctx, subId)
+
+	for dbList.Next() {
+		// A resumed create may find databases created since, which aren't part of the creation plan.
+		if !strings.HasPrefix(redis.StringValue(dbList.Value().Name), CreationPlanDatabasePrefix) {
+			continue
+		}
+		dbId := *dbList.Value().ID
+
+		if err := utils.WaitForDatabaseToBeActive(ctx, subId, dbId, api); err != nil {
+			return err
+		}
+		// Delete each creation-plan database
+		err := utils.WaitForTask(ctx, fmt.Sprintf("delete creation-plan database %d", dbId), func(ctx context.Context) error {
+			return api.Client.Database.Delete(ctx, subId, dbId)
+		}, utils.SubscriptionFallback(subId, api))
+		if err != nil {
+			log.Printf("[WARN] Failed to delete creation-plan database %d of subscription %d: %s", dbId, subId, err)
+		}
+	}
+	return dbList.Err()
+}
+
+// readSubscription refreshes the model from the API. It returns true if the subscription no longer exists.
+func (r *proSubscriptionResource) readSubscription(ctx context.Context, model *ProSubscriptionModel, diagnostics *diag.Diagnostics) bool {
+	api := r.client
+
+	subId, err := strconv.Atoi(model.ID.ValueString())
+	if err != nil {
+		diagnostics.AddError("Invalid subscription ID", err.Error())
+		return false
+	}
+
+	subscription, err := api.Client.Subscription.Get(ctx, subId)
+	if err != nil {
+		notFound := &subscriptions.NotFound{}
+		if errors.As(err, &notFound) {
+			return true
+		}
+		diagnostics.AddError("Failed to read subscription", err.Error())
+		return false
+	}
+
+	model.Name = types.StringValue(redis.StringValue(subscription.Name))
+	model.DeletionProtection = utils.DeletionProtectionValue(model.DeletionProtection)
+	model.ForceDestroy = utils.ForceDestroyValue(model.ForceDestroy)
+	if model.EstimatedPricing.IsUnknown() {
+		// Kept as planned, or null when the subscription wasn't created by this resource
+		model.EstimatedPricing = types.ListNull(types.ObjectType{AttrTypes: pricingAttrTypes()})
+	}
+
+	if subscription.PaymentMethodID != nil && redis.IntValue(subscription.PaymentMethodID) != 0 {
+		model.PaymentMethodID = types.StringValue(strconv.Itoa(redis.IntValue(subscription.PaymentMethodID)))
+	} else if model.PaymentMethodID.IsUnknown() {
+		model.PaymentMethodID = types.StringNull()
+	}
+	model.PaymentMethod = types.StringValue(redis.StringValue(subscription.PaymentMethod))
+	model.MemoryStorage = types.StringValue(redis.StringValue(subscription.MemoryStorage))
+
+	model.CloudProvider = flattenCloudProviders(ctx, subscription.CloudDetails, model.CloudProvider, diagnostics)
+	if diagnostics.HasError() {
+		return false
+	}
+
+	// CIDR allowlist is not allowed for Redis Labs internal resources subscription.
+	if len(subscription.CloudDetails) > 0 && redis.IntValue(subscription.CloudDetails[0].CloudAccountID) != 1 {
+		model.Allowlist = flattenAllowlist(ctx, subId, api, model.Allowlist, diagnostics)
+		if diagnostics.HasError() {
+			return false
+		}
+	} else if model.Allowlist.IsNull() {
+		model.Allowlist = types.ListValueMust(types.ObjectType{AttrTypes: allowlistAttrTypes()}, []attr.Value{})
+	}
+
+	if !model.CustomerManagedKeyEnabled.ValueBool() {
+		m, err := api.Client.Maintenance.Get(ctx, subId)
+		if err != nil {
+			diagnostics.AddError("Failed to read maintenance windows", err.Error())
+			return false
+		}
+		model.MaintenanceWindows = flattenMaintenanceWindows(m, model.MaintenanceWindows)
+
+		pricingList, err := api.Client.Pricing.List(ctx, subId)
+		if err != nil {
+			diagnostics.AddError("Failed to read pricing", err.Error())
+			return false
+		}
+		model.Pricing = flattenPricing(pricingList)
+	} else {
+		if model.MaintenanceWindows.IsNull() {
+			model.MaintenanceWindows = types.ListValueMust(types.ObjectType{AttrTypes: maintenanceWindowsAttrTypes()}, []attr.Value{})
+		}
+		if model.Pricing.IsUnknown() {
+			model.Pricing = types.ListNull(types.ObjectType{AttrTypes: pricingAttrTypes()})
+		}
+	}
+
+	if subscription.CustomerManagedKeyAccessDetails != nil && subscription.CustomerManagedKeyAccessDetails.RedisServiceAccount != nil {
+		model.CustomerManagedKeyRedisServiceAccount = types.StringValue(redis.StringValue(subscription.CustomerManagedKeyAccessDetails.RedisServiceAccount))
+	} else if model.CustomerManagedKeyRedisServiceAccount.IsUnknown() {
+		model.CustomerManagedKeyRedisServiceAccount = types.StringNull()
+	}
+
+	// Set public_endpoint_access, default to true if not set by API
+	utils.SetBoolFromAPI(&model.PublicEndpointAccess, subscription.PublicEndpointAccess, true)
+
+	model.CustomerManagedKeyEnabled = types.BoolValue(subscription.PersistentStorageEncryptionType != nil &&
+		redis.StringValue(subscription.PersistentStorageEncryptionType) == CMK_ENABLED_STRING)
+
+	if subscription.DeletionGracePeriod != nil {
+		model.CustomerManagedKeyDeletionGracePeriod = types.StringValue(redis.StringValue(subscription.DeletionGracePeriod))
+	}
+
+	// The create-only blocks aren't reported by the API, so are only known from the configuration.
+	if model.CreationPlan.IsNull() {
+		model.CreationPlan = types.ListValueMust(types.ObjectType{AttrTypes: creationPlanAttrTypes()}, []attr.Value{})
+	}
+	if model.CustomerManagedKey.IsNull() {
+		model.CustomerManagedKey = types.ListValueMust(types.ObjectType{AttrTypes: customerManagedKeyAttrTypes()}, []attr.Value{})
+	}
+
+	return false
+}
+
+// updateSubscription applies the changes between the state and the plan. The caller must hold the subscription's
+// lock.
+func (r *proSubscriptionResource) updateSubscription(ctx context.Context, subId int, plan *ProSubscriptionModel, state *ProSubscriptionModel, diagnostics *diag.Diagnostics) {
+	api := r.client
+
+	subscription, err := api.Client.Subscription.Get(ctx, subId)
+	if err != nil {
+		diagnostics.AddError("Failed to read subscription", err.Error())
+		return
+	}
+
+	// CMK flow
+	if redis.StringValue(subscription.Status) == subscriptions.SubscriptionStatusEncryptionKeyPending && plan.CustomerManagedKeyEnabled.ValueBool() {
+		r.updateCustomerManagedKeys(ctx, subId, plan, diagnostics)
+		if diagnostics.HasError() {
+			return
+		}
+	}
+
+	if !plan.Allowlist.Equal(state.Allowlist) {
+		r.updateAllowlist(ctx, subId, plan, diagnostics)
+		if diagnostics.HasError() {
+			return
+		}
+	}
+
+	nameChanged := !plan.Name.Equal(state.Name)
+	paymentMethodIDChanged := !plan.PaymentMethodID.Equal(state.PaymentMethodID)
+	publicEndpointAccessChanged := !plan.PublicEndpointAccess.Equal(state.PublicEndpointAccess)
+
+	if nameChanged || paymentMethodIDChanged || publicEndpointAccessChanged {
+		updateSubscriptionRequest := subscriptions.UpdateSubscription{}
+
+		if nameChanged {
+			updateSubscriptionRequest.Name = redis.String(plan.Name.ValueString())
+		}
+
+		if paymentMethodIDChanged {
+			paymentMethodID, err := readPaymentMethodID(plan.PaymentMethodID)
+			if err != nil {
+				diagnostics.AddError("Invalid payment method ID", err.Error())
+				return
+			}
+			updateSubscriptionRequest.PaymentMethodID = paymentMethodID
+		}
+
+		if publicEndpointAccessChanged {
+			updateSubscriptionRequest.PublicEndpointAccess = redis.Bool(plan.PublicEndpointAccess.ValueBool())
+		}
+
+		err = utils.WaitForTask(ctx, fmt.Sprintf("update subscription %d", subId), func(ctx context.Context) error {
+			return api.Client.Subscription.Update(ctx, subId, updateSubscriptionRequest)
+		}, utils.SubscriptionFallback(subId, api))
+		if err != nil {
+			diagnostics.AddError("Failed to update subscription", err.Error())
+			return
+		}
+	}
+
+	// Verify public_endpoint_access has propagated if it was changed
+	if publicEndpointAccessChanged {
+		if err := utils.WaitForSubscriptionPublicEndpointAccess(ctx, subId, api, plan.PublicEndpointAccess.ValueBool()); err != nil {
+			diagnostics.AddError("Failed to update public endpoint access", err.Error())
+			return
+		}
+	}
+
+	if !plan.MaintenanceWindows.Equal(state.MaintenanceWindows) {
+		r.updateMaintenanceWindows(ctx, subId, plan, diagnostics)
+	}
+}
+
+// updateCustomerManagedKeys supplies the customer managed keys of a subscription waiting for them.
+func (r *proSubscriptionResource) updateCustomerManagedKeys(ctx context.Context, subId int, plan *ProSubscriptionModel, diagnostics *diag.Diagnostics) {
+	var keys []CustomerManagedKeyModel
+	diagnostics.Append(plan.CustomerManagedKey.ElementsAs(ctx, &keys, false)...)
+	if diagnostics.HasError() {
+		return
+	}
+	if len(keys) == 0 {
+		diagnostics.AddError("Missing customer managed keys", "customer_managed_key must be set when subscription is in encryption key pending state")
+		return
+	}
+
+	customerManagedKeys := make([]subscriptions.CustomerManagedKey, 0, len(keys))
+	for _, key := range keys {
+		customerManagedKeys = append(customerManagedKeys, subscriptions.CustomerManagedKey{
+			ResourceName: redis.String(key.ResourceName.ValueString()),
+		})
+	}
+
+	updateCmkRequest := subscriptions.UpdateSubscriptionCMKs{
+		DeletionGracePeriod: redis.String(plan.CustomerManagedKeyDeletionGracePeriod.ValueString()),
+		CustomerManagedKeys: &customerManagedKeys,
+	}
+
+	err := utils.WaitForTask(ctx, fmt.Sprintf("update the customer managed keys of subscription %d", subId), func(ctx context.Context) error {
+		return r.client.Client.Subscription.UpdateCMKs(ctx, subId, updateCmkRequest)
+	}, utils.SubscriptionFallback(subId, r.client))
+	if err != nil {
+		diagnostics.AddError("Failed to update customer managed keys", err.Error())
+	}
+}
+
+// updateAllowlist replaces the subscription's allowlist with the planned one.
+func (r *proSubscriptionResource) updateAllowlist(ctx context.Context, subId int, plan *ProSubscriptionModel, diagnostics *diag.Diagnostics) {
+	cidrs := make([]*string, 0)
+	sgs := make([]*string, 0)
+
+	var allowlists []AllowlistModel
+	diagnostics.Append(plan.Allowlist.ElementsAs(ctx, &allowlists, false)...)
+	if diagnostics.HasError() {
+		return
+	}
+	if len(allowlists) > 0 {
+		cidrs = setToStringSlice(ctx, allowlists[0].CIDRs, diagnostics)
+		sgs = setToStringSlice(ctx, allowlists[0].SecurityGroupIDs, diagnostics)
+		if diagnostics.HasError() {
+			return
+		}
+	}
+
+	err := utils.WaitForTask(ctx, fmt.Sprintf("update the allowlist of subscription %d", subId), func(ctx context.Context) error {
+		return r.client.Client.Subscription.UpdateCIDRAllowlist(ctx, subId, subscriptions.UpdateCIDRAllowlist{
+			CIDRIPs:          cidrs,
+			SecurityGroupIDs: sgs,
+		})
+	}, utils.SubscriptionFallback(subId, r.client))
+	if err != nil {
+		diagnostics.AddError("Failed to update allowlist", err.Error())
+	}
+}
+
+// updateMaintenanceWindows sets the subscription's maintenance windows to the planned ones, or to automatic
+// maintenance if none are planned.
+func (r *proSubscriptionResource) updateMaintenanceWindows(ctx context.Context, subId int, plan *ProSubscriptionModel, diagnostics *diag.Diagnostics) {
+	updateMaintenanceRequest := maintenance.Maintenance{
+		Mode: redis.String("automatic"),
+	}
+
+	var maintenanceWindows []MaintenanceWindowsModel
+	diagnostics.Append(plan.MaintenanceWindows.ElementsAs(ctx, &maintenanceWindows, false)...)
+	if diagnostics.HasError() {
+		return
+	}
+	if len(maintenanceWindows) > 0 {
+		var windowModels []MaintenanceWindowModel
+		diagnostics.Append(maintenanceWindows[0].Window.ElementsAs(ctx, &windowModels, false)...)
+		if diagnostics.HasError() {
+			return
+		}
+
+		windows := make([]*maintenance.Window, 0)
+		for _, w := range windowModels {
+			var days []string
+			diagnostics.Append(w.Days.ElementsAs(ctx, &days, false)...)
+			if diagnostics.HasError() {
+				return
+			}
+			windows = append(windows, &maintenance.Window{
+				StartHour:       redis.Int(int(w.StartHour.ValueInt64())),
+				DurationInHours: redis.Int(int(w.DurationInHours.ValueInt64())),
+				Days:            redis.StringSlice(days...),
+			})
+		}
+
+		updateMaintenanceRequest = maintenance.Maintenance{
+			Mode:    redis.String(maintenanceWindows[0].Mode.ValueString()),
+			Windows: windows,
+		}
+	}
+
+	if err := r.client.Client.Maintenance.Update(ctx, subId, updateMaintenanceRequest); err != nil {
+		diagnostics.AddError("Failed to update maintenance windows", err.Error())
+	}
+}
+
+// deleteSubscription deletes the subscription once it is ready, and waits for it to be gone.
+func (r *proSubscriptionResource) deleteSubscription(ctx context.Context, state *ProSubscriptionModel, diagnostics *diag.Diagnostics) {
+	api := r.client
+
+	subId, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		diagnostics.AddError("Invalid subscription ID", err.Error())
+		return
+	}
+
+	utils.CheckDeletionProtection(state.DeletionProtection, fmt.Sprintf("Subscription %d", subId), diagnostics)
+	if diagnostics.HasError() {
+		return
+	}
+
+	unlock, err := utils.LockSubscription(ctx, subId)
+	if err != nil {
+		diagnostics.AddError("Failed to lock subscription", err.Error())
+		return
+	}
+	defer unlock()
+
+	subscription, err := api.Client.Subscription.Get(ctx, subId)
+	if err != nil {
+		diagnostics.AddError("Failed to read subscription", err.Error())
+		return
+	}
+
+	if redis.StringValue(subscription.Status) != subscriptions.SubscriptionStatusEncryptionKeyPending {
+		// Wait for the subscription to be active before deleting it.
+		if err := utils.WaitForSubscriptionToBeActive(ctx, subId, api); err != nil {
+			diagnostics.AddError("Subscription failed to become active", err.Error())
+			return
+		}
+
+		// The databases Terraform doesn't manage would otherwise prevent the subscription from being deleted.
+		if state.ForceDestroy.ValueBool() {
+			utils.ForceDestroyDatabases(ctx, subId, api, diagnostics)
+			if diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	// Delete subscription once all databases are deleted
+	err = utils.WaitForTask(ctx, fmt.Sprintf("delete subscription %d", subId), func(ctx context.Context) error {
+		return api.Client.Subscription.Delete(ctx, subId)
+	}, func(ctx context.Context) error {
+		return WaitForSubscriptionToBeDeleted(ctx, subId, api)
+	})
+	if err != nil {
+		diagnostics.AddError("Failed to delete subscription", err.Error())
+	}
+}
+
+// readPaymentMethodID converts a payment_method_id into the API's form.
+func readPaymentMethodID(value types.String) (*int, error) {
+	if value.ValueString() == "" {
+		return nil, nil
+	}
+	pmID, err := strconv.Atoi(value.ValueString())
+	if err != nil {
+		return nil, err
+	}
+	return redis.Int(pmID), nil
+}
+
+// setToStringSlice converts a set of strings into the API's form.
+func setToStringSlice(ctx context.Context, set types.Set, diagnostics *diag.Diagnostics) []*string {
+	var values []string
+	diagnostics.Append(set.ElementsAs(ctx, &values, false)...)
+	return redis.StringSlice(values...)
+}
+
+func buildCreateCloudProviders(ctx context.Context, cloudProviders types.List, diagnostics *diag.Diagnostics) []*subscriptions.CreateCloudProvider {
+	createCloudProviders := make([]*subscriptions.CreateCloudProvider, 0)
+
+	var providers []CloudProviderModel
+	diagnostics.Append(cloudProviders.ElementsAs(ctx, &providers, false)...)
+	if diagnostics.HasError() {
+		return nil
+	}
+
+	for _, provider := range providers {
+		cloudAccountID, err := strconv.Atoi(provider.CloudAccountID.ValueString())
+		if err != nil {
+			diagnostics.AddError("Invalid cloud account ID", err.Error())
+			return nil
+		}
+
+		var regions []RegionModel
+		diagnostics.Append(provider.Region.ElementsAs(ctx, &regions, false)...)
+		if diagnostics.HasError() {
+			return nil
+		}
+
+		createRegions := make([]*subscriptions.CreateRegion, 0)
+		for _, region := range regions {
+			var preferredAZs []string
+			if utils.IsConfigured(region.PreferredAvailabilityZones) {
+				diagnostics.Append(region.PreferredAvailabilityZones.ElementsAs(ctx, &preferredAZs, false)...)
+				if diagnostics.HasError() {
+					return nil
+				}
+			}
+
+			createRegion := subscriptions.CreateRegion{
+				Region:                     redis.String(region.Region.ValueString()),
+				MultipleAvailabilityZones:  redis.Bool(region.MultipleAvailabilityZones.ValueBool()),
+				PreferredAvailabilityZones: redis.StringSlice(preferredAZs...),
+			}
+
+			if v := region.NetworkingDeploymentCIDR.ValueString(); v != "" {
+				createRegion.Networking = &subscriptions.CreateNetworking{
+					DeploymentCIDR: redis.String(v),
+				}
+			}
+
+			if v := region.NetworkingVPCID.ValueString(); v != "" {
+				if createRegion.Networking == nil {
+					createRegion.Networking = &subscriptions.CreateNetworking{}
+				}
+				createRegion.Networking.VPCId = redis.String(v)
+			}
+
+			createRegions = append(createRegions, &createRegion)
+		}
+
+		createCloudProviders = append(createCloudProviders, &subscriptions.CreateCloudProvider{
+			Provider:       redis.String(provider.Provider.ValueString()),
+			CloudAccountID: redis.Int(cloudAccountID),
+			Regions:        createRegions,
+		})
+	}
+
+	return createCloudProviders
+}
+
+// BuildSubscriptionCreatePlanDatabases returns the databases to create a subscription with, for its creation plan.
+func BuildSubscriptionCreatePlanDatabases(ctx context.Context, memoryStorage string, plan CreationPlanModel, diagnostics *diag.Diagnostics) []*subscriptions.CreateDatabase {
+	createDatabases := make([]*subscriptions.CreateDatabase, 0)
+
+	dbName := CreationPlanDatabasePrefix
+	idx := 1
+	throughputMeasurementBy := plan.ThroughputMeasurementBy.ValueString()
+	throughputMeasurementValue := int(plan.ThroughputMeasurementValue.ValueInt64())
+	averageItemSizeInBytes := int(plan.AverageItemSizeInBytes.ValueInt64())
+	ramPercentage := int(plan.RamPercentage.ValueInt64())
+
+	numDatabases := int(plan.Quantity.ValueInt64())
+	supportOSSClusterAPI := plan.SupportOSSClusterAPI.ValueBool()
+	replication := plan.Replication.ValueBool()
+	memoryLimitInGB := plan.MemoryLimitInGB.ValueFloat64()
+	datasetSizeInGB := plan.DatasetSizeInGB.ValueFloat64()
+	queryPerformanceFactor := plan.QueryPerformanceFactor.ValueString()
+
+	var planModules []string
+	if utils.IsConfigured(plan.Modules) {
+		diagnostics.Append(plan.Modules.ElementsAs(ctx, &planModules, false)...)
+		if diagnostics.HasError() {
+			return nil
+		}
+	}
+
+	if memoryStorage == databases.MemoryStorageRam && averageItemSizeInBytes != 0 {
+		// TODO This should be changed to an error when releasing 2.0 of the provider
+		diagnostics.AddWarning(
+			"`average_item_size_in_bytes` not applicable for `ram` memory storage ",
+			"`average_item_size_in_bytes` is only applicable when `memory_storage` is `ram-and-flash`. This will be an error in a future release of the provider",
+		)
+	}
+
+	if memoryStorage == databases.MemoryStorageRam && ramPercentage != 0 {
+		diagnostics.AddWarning(
+			"`ram_percentage` not applicable for `ram` memory storage ",
+			"`ram_percentage` is only applicable when `memory_storage` is `ram-and-flash`",
+		)
+	}
+
+	// Check if one of the modules is RedisGraph
+	containsGraph := false
+	for _, module := range planModules {
+		if module == "RedisGraph" {
+			containsGraph = true
+			break
+		}
+	}
+
+	if !containsGraph || len(planModules) <= 1 {
+		var modules []*subscriptions.CreateModules
+		for _, v := range planModules {
+			modules = append(modules, &subscriptions.CreateModules{Name: redis.String(v)})
+		}
+		createDatabases = append(createDatabases, createDatabase(dbName, &idx, modules, throughputMeasurementBy, throughputMeasurementValue, memoryLimitInGB, datasetSizeInGB, averageItemSizeInBytes, ramPercentage, supportOSSClusterAPI, replication, numDatabases, queryPerformanceFactor)...)
+	} else {
+		// make RedisGraph module the first module, then append the rest of the modules
+		var modules []*subscriptions.CreateModules
+		modules = append(modules, &subscriptions.CreateModules{Name: redis.String("RedisGraph")})
+		for _, v := range planModules {
+			if v != "RedisGraph" {
+				modules = append(modules, &subscriptions.CreateModules{Name: redis.String(v)})
+			}
+		}
+		// create a DB with the RedisGraph module
+		createDatabases = append(createDatabases, createDatabase(dbName, &idx, modules[:1], throughputMeasurementBy, throughputMeasurementValue, memoryLimitInGB, datasetSizeInGB, averageItemSizeInBytes, ramPercentage, supportOSSClusterAPI, replication, 1, queryPerformanceFactor)...)
+		if numDatabases == 1 {
+			// create one extra DB with all other modules
+			createDatabases = append(createDatabases, createDatabase(dbName, &idx, modules[1:], throughputMeasurementBy, throughputMeasurementValue, memoryLimitInGB, datasetSizeInGB, averageItemSizeInBytes, ramPercentage, supportOSSClusterAPI, replication, 1, queryPerformanceFactor)...)
+		} else if numDatabases > 1 {
+			// create the remaining DBs with all other modules
+			createDatabases = append(createDatabases, createDatabase(dbName, &idx, modules[1:], throughputMeasurementBy, throughputMeasurementValue, memoryLimitInGB, datasetSizeInGB, averageItemSizeInBytes, ramPercentage, supportOSSClusterAPI, replication, numDatabases-1, queryPerformanceFactor)...)
+		}
+	}
+	return createDatabases
+}
+
+//nolint:unparam
+func createDatabase(dbName string, idx *int, modules []*subscriptions.CreateModules, throughputMeasurementBy string, throughputMeasurementValue int, memoryLimitInGB float64, datasetSizeInGB float64, averageItemSizeInBytes int, ramPercentage int, supportOSSClusterAPI bool, replication bool, numDatabases int, queryPerformanceFactor string) []*subscriptions.CreateDatabase {
+	createThroughput := &subscriptions.CreateThroughput{
+		By:    redis.String(throughputMeasurementBy),
+		Value: redis.Int(throughputMeasurementValue),
+	}
+	if len(modules) > 0 {
+		// if RedisGraph is in the modules, set throughput to operations-per-second and convert the value
+		if *modules[0].Name == "RedisGraph" {
+			if *createThroughput.By == "number-of-shards" {
+				createThroughput.By = redis.String("operations-per-second")
+				if replication {
+					createThroughput.Value = redis.Int(*createThroughput.Value * 500)
+				} else {
+					createThroughput.Value = redis.Int(*createThroughput.Value * 250)
+				}
+			}
+		}
+	}
+	var dbs []*subscriptions.CreateDatabase
+	for i := 0; i < numDatabases; i++ {
+		createDatabase := subscriptions.CreateDatabase{
+			Name:                  redis.String(dbName + strconv.Itoa(*idx)),
+			Protocol:              redis.String("redis"),
+			SupportOSSClusterAPI:  redis.Bool(supportOSSClusterAPI),
+			Replication:           redis.Bool(replication),
+			ThroughputMeasurement: createThroughput,
+			Quantity:              redis.Int(1),
+			Modules:               modules,
+		}
+		if averageItemSizeInBytes > 0 {
+			createDatabase.AverageItemSizeInBytes = redis.Int(averageItemSizeInBytes)
+		}
+
+		if ramPercentage > 0 {
+			createDatabase.RamPercentage = redis.Int(ramPercentage)
+		}
+
+		if datasetSizeInGB > 0 {
+			createDatabase.DatasetSizeInGB = redis.Float64(datasetSizeInGB)
+		}
+		if memoryLimitInGB > 0 {
+			createDatabase.MemoryLimitInGB = redis.Float64(memoryLimitInGB)
+		}
+
+		if queryPerformanceFactor != "" {
+			createDatabase.QueryPerformanceFactor = redis.String(queryPerformanceFactor)
+		}
+
+		*idx++
+		dbs = append(dbs, &createDatabase)
+	}
+	return dbs
+}
+
+// flattenCloudProviders converts the subscription's cloud details into the cloud_provider block. Where the API
+// doesn't report what was configured, such as the deployment CIDR of a region spanning availability zones, the prior
+// value is kept.
+func flattenCloudProviders(ctx context.Context, cloudDetails []*subscriptions.CloudDetail, prior types.List, diagnostics *diag.Diagnostics) types.List {
+	var priorProviders []CloudProviderModel
+	if utils.IsConfigured(prior) {
+		diagnostics.Append(prior.ElementsAs(ctx, &priorProviders, true)...)
+	}
+	priorRegions := map[string]RegionModel{}
+	var priorAWSAccountID types.String
+	if len(priorProviders) > 0 {
+		priorAWSAccountID = priorProviders[0].AWSAccountID
+		if utils.IsConfigured(priorProviders[0].Region) {
+			var regions []RegionModel
+			diagnostics.Append(priorProviders[0].Region.ElementsAs(ctx, &regions, true)...)
+			for _, region := range regions {
+				priorRegions[region.Region.ValueString()] = region
+			}
+		}
+	}
+	if diagnostics.HasError() {
+		return prior
+	}
+
+	providers := make([]attr.Value, 0, len(cloudDetails))
+	for _, cloudDetail := range cloudDetails {
+		regions := make([]attr.Value, 0, len(cloudDetail.Regions))
+		for _, region := range cloudDetail.Regions {
+			priorRegion, hasPrior := priorRegions[redis.StringValue(region.Region)]
+			multipleAZs := redis.BoolValue(region.MultipleAvailabilityZones)
+
+			preferredAZs, diags := types.ListValueFrom(ctx, types.StringType, redis.StringSliceValue(region.PreferredAvailabilityZones...))
+			diagnostics.Append(diags...)
+			if hasPrior && utils.IsConfigured(priorRegion.PreferredAvailabilityZones) && len(priorRegion.PreferredAvailabilityZones.Elements()) > 0 {
+				preferredAZs = priorRegion.PreferredAvailabilityZones
+			}
+
+			deploymentCIDR := types.StringValue("")
+			vpcID := types.StringValue("")
+			if len(region.Networking) > 0 && !multipleAZs {
+				deploymentCIDR = types.StringValue(redis.StringValue(region.Networking[0].DeploymentCIDR))
+				vpcID = types.StringValue(redis.StringValue(region.Networking[0].VPCId))
+			} else if hasPrior {
+				if utils.IsConfigured(priorRegion.NetworkingDeploymentCIDR) {
+					deploymentCIDR = priorRegion.NetworkingDeploymentCIDR
+				}
+				if utils.IsConfigured(priorRegion.NetworkingVPCID) {
+					vpcID = priorRegion.NetworkingVPCID
+				}
+			}
+
+			networks := make([]attr.Value, 0, len(region.Networking))
+			for _, network := range region.Networking {
+				networks = append(networks, types.ObjectValueMust(networkAttrTypes(), map[string]attr.Value{
+					"networking_subnet_id":       types.StringValue(redis.StringValue(network.SubnetID)),
+					"networking_deployment_cidr": types.StringValue(redis.StringValue(network.DeploymentCIDR)),
+					"networking_vpc_id":          types.StringValue(redis.StringValue(network.VPCId)),
+				}))
+			}
+
+			regions = append(regions, types.ObjectValueMust(regionAttrTypes(), map[string]attr.Value{
+				"region":                       types.StringValue(redis.StringValue(region.Region)),
+				"multiple_availability_zones":  types.BoolValue(multipleAZs),
+				"preferred_availability_zones": preferredAZs,
+				"networking_deployment_cidr":   deploymentCIDR,
+				"networking_vpc_id":            vpcID,
+				"networks":                     types.ListValueMust(types.ObjectType{AttrTypes: networkAttrTypes()}, networks),
+			}))
+		}
+
+		awsAccountID := priorAWSAccountID
+		if cloudDetail.AWSAccountID != nil {
+			awsAccountID = types.StringValue(redis.StringValue(cloudDetail.AWSAccountID))
+		} else if awsAccountID.IsUnknown() {
+			awsAccountID = types.StringNull()
+		}
+
+		providers = append(providers, types.ObjectValueMust(cloudProviderAttrTypes(), map[string]attr.Value{
+			"provider":         types.StringValue(redis.StringValue(cloudDetail.Provider)),
+			"cloud_account_id": types.StringValue(strconv.Itoa(redis.IntValue(cloudDetail.CloudAccountID))),
+			"aws_account_id":   awsAccountID,
+			"region":           types.SetValueMust(types.ObjectType{AttrTypes: regionAttrTypes()}, regions),
+		}))
+	}
+
+	return types.ListValueMust(types.ObjectType{AttrTypes: cloudProviderAttrTypes()}, providers)
+}
+
+// flattenAllowlist reads the subscription's allowlist into the allowlist block. Empty sets of CIDRs are null unless
+// they were configured as empty.
+func flattenAllowlist(ctx context.Context, subId int, api *client.ApiClient, prior types.List, diagnostics *diag.Diagnostics) types.List {
+	allowlistType := types.ObjectType{AttrTypes: allowlistAttrTypes()}
+
+	allowlist, err := api.Client.Subscription.GetCIDRAllowlist(ctx, subId)
+	if err != nil {
+		diagnostics.AddError("Failed to read allowlist", err.Error())
+		return prior
+	}
+
+	if !isNil(allowlist.Errors) {
+		diagnostics.AddError("Failed to read allowlist", fmt.Sprintf("unable to read allowlist for subscription %d: %v", subId, allowlist.Errors))
+		return prior
+	}
+
+	if len(allowlist.CIDRIPs) == 0 && len(allowlist.SecurityGroupIDs) == 0 {
+		return types.ListValueMust(allowlistType, []attr.Value{})
+	}
+
+	var priorAllowlists []AllowlistModel
+	if utils.IsConfigured(prior) {
+		diagnostics.Append(prior.ElementsAs(ctx, &priorAllowlists, false)...)
+	}
+
+	cidrs := types.SetNull(types.StringType)
+	if len(allowlist.CIDRIPs) > 0 || (len(priorAllowlists) > 0 && !priorAllowlists[0].CIDRs.IsNull()) {
+		cidrs = types.SetValueMust(types.StringType, stringValues(allowlist.CIDRIPs))
+	}
+	sgs := types.SetValueMust(types.StringType, stringValues(allowlist.SecurityGroupIDs))
+
+	return types.ListValueMust(allowlistType, []attr.Value{
+		types.ObjectValueMust(allowlistAttrTypes(), map[string]attr.Value{
+			"cidrs":              cidrs,
+			"security_group_ids": sgs,
+		}),
+	})
+}
+
+// flattenMaintenanceWindows converts the subscription's maintenance into the maintenance_windows block. Automatic
+// maintenance is only recorded if it was configured, as it is the API's default.
+func flattenMaintenanceWindows(m *maintenance.Maintenance, prior types.List) types.List {
+	maintenanceWindowsType := types.ObjectType{AttrTypes: maintenanceWindowsAttrTypes()}
+
+	if redis.StringValue(m.Mode) != "manual" && (prior.IsNull() || prior.IsUnknown() || len(prior.Elements()) == 0) {
+		return types.ListValueMust(maintenanceWindowsType, []attr.Value{})
+	}
+
+	windows := make([]attr.Value, 0, len(m.Windows))
+	for _, w := range m.Windows {
+		windows = append(windows, types.ObjectValueMust(maintenanceWindowAttrTypes(), map[string]attr.Value{
+			"start_hour":        types.Int64Value(int64(redis.IntValue(w.StartHour))),
+			"duration_in_hours": types.Int64Value(int64(redis.IntValue(w.DurationInHours))),
+			"days":              types.ListValueMust(types.StringType, stringValues(w.Days)),
+		}))
+	}
+
+	return types.ListValueMust(maintenanceWindowsType, []attr.Value{
+		types.ObjectValueMust(maintenanceWindowsAttrTypes(), map[string]attr.Value{
+			"mode":   types.StringValue(redis.StringValue(m.Mode)),
+			"window": types.ListValueMust(types.ObjectType{AttrTypes: maintenanceWindowAttrTypes()}, windows),
+		}),
+	})
+}
+
+// flattenPricing converts the subscription's pricing into the pricing attribute.
+func flattenPricing(pricingList []*pricing.Pricing) types.List {
+	entries := make([]attr.Value, 0, len(pricingList))
+	for _, p := range pricingList {
+		entries = append(entries, types.ObjectValueMust(pricingAttrTypes(), map[string]attr.Value{
+			"database_name":        types.StringValue(redis.StringValue(p.DatabaseName)),
+			"type":                 types.StringValue(redis.StringValue(p.Type)),
+			"type_details":         types.StringValue(redis.StringValue(p.TypeDetails)),
+			"quantity":             types.Int64Value(int64(redis.IntValue(p.Quantity))),
+			"quantity_measurement": types.StringValue(redis.StringValue(p.QuantityMeasurement)),
+			"price_per_unit":       types.Float64Value(redis.Float64Value(p.PricePerUnit)),
+			"price_currency":       types.StringValue(redis.StringValue(p.PriceCurrency)),
+			"price_period":         types.StringValue(redis.StringValue(p.PricePeriod)),
+			"region":               types.StringValue(redis.StringValue(p.Region)),
+		}))
+	}
+	return types.ListValueMust(types.ObjectType{AttrTypes: pricingAttrTypes()}, entries)
+}
+
+func stringValues(values []*string) []attr.Value {
+	result := make([]attr.Value, 0, len(values))
+	for _, v := range values {
+		result = append(result, types.StringValue(redis.StringValue(v)))
+	}
+	return result
+}
+
+func WaitForSubscriptionToBeDeleted(ctx context.Context, id int, api *client.ApiClient) error {
+	wait := &utils.Waiter{
+		Description:  fmt.Sprintf("subscription %d", id),
+		Pending:      []string{subscriptions.SubscriptionStatusDeleting},
+		Target:       []string{"deleted"}, // TODO: update this with deleted field in SDK
+		Delay:        10 * time.Second,
+		PollInterval: 30 * time.Second,
+
+		Refresh: func() (result interface{}, state string, err error) {
+			log.Printf("[DEBUG] Waiting for subscription %d to be deleted", id)
+
+			subscription, err := api.Client.Subscription.Get(ctx, id)
+			if err != nil {
+				notFound := &subscriptions.NotFound{}
+				if errors.As(err, &notFound) {
+					return "deleted", "deleted", nil
+				} // TODO: update this with deleted field in SDK
+				return nil, "", err
+			}
+
+			return redis.StringValue(subscription.Status), redis.StringValue(subscription.Status), nil
+		},
+	}
+	if _, err := wait.Wait(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,222 @@
+package pro
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ProSubscriptionModel describes the resource data model for the Pro subscription.
+type ProSubscriptionModel struct {
+	ID                                    types.String   `tfsdk:"id"`
+	Name                                  types.String   `tfsdk:"name"`
+	PaymentMethod                         types.String   `tfsdk:"payment_method"`
+	PaymentMethodID                       types.String   `tfsdk:"payment_method_id"`
+	MemoryStorage                         types.String   `tfsdk:"memory_storage"`
+	Allowlist                             types.List     `tfsdk:"allowlist"`
+	CloudProvider                         types.List     `tfsdk:"cloud_provider"`
+	CreationPlan                          types.List     `tfsdk:"creation_plan"`
+	RedisVersion                          types.String   `tfsdk:"redis_version"`
+	MaintenanceWindows                    types.List     `tfsdk:"maintenance_windows"`
+	Pricing                               types.List     `tfsdk:"pricing"`
+	EstimatedPricing                      types.List     `tfsdk:"estimated_pricing"`
+	MaxMonthlyCost                        types.Float64  `tfsdk:"max_monthly_cost"`
+	CustomerManagedKeyEnabled             types.Bool     `tfsdk:"customer_managed_key_enabled"`
+	CustomerManagedKeyDeletionGracePeriod types.String   `tfsdk:"customer_managed_key_deletion_grace_period"`
+	CustomerManagedKey                    types.List     `tfsdk:"customer_managed_key"`
+	CustomerManagedKeyRedisServiceAccount types.String   `tfsdk:"customer_managed_key_redis_service_account"`
+	PublicEndpointAccess                  types.Bool     `tfsdk:"public_endpoint_access"`
+	DeletionProtection                    types.Bool     `tfsdk:"deletion_protection"`
+	ForceDestroy                          types.Bool     `tfsdk:"force_destroy"`
+	CreationPending                       types.Bool     `tfsdk:"creation_pending"`
+	Timeouts                              timeouts.Value `tfsdk:"timeouts"`
+}
+
+// AllowlistModel describes the allowlist nested block.
+type AllowlistModel struct {
+	CIDRs            types.Set `tfsdk:"cidrs"`
+	SecurityGroupIDs types.Set `tfsdk:"security_group_ids"`
+}
+
+// CloudProviderModel describes the cloud_provider nested block.
+type CloudProviderModel struct {
+	Provider       types.String `tfsdk:"provider"`
+	CloudAccountID types.String `tfsdk:"cloud_account_id"`
+	AWSAccountID   types.String `tfsdk:"aws_account_id"`
+	Region         types.Set    `tfsdk:"region"`
+}
+
+// RegionModel describes the region nested block within cloud_provider.
+type RegionModel struct {
+	Region                     types.String `tfsdk:"region"`
+	MultipleAvailabilityZones  types.Bool   `tfsdk:"multiple_availability_zones"`
+	PreferredAvailabilityZones types.List   `tfsdk:"preferred_availability_zones"`
+	NetworkingDeploymentCIDR   types.String `tfsdk:"networking_deployment_cidr"`
+	NetworkingVPCID            types.String `tfsdk:"networking_vpc_id"`
+	Networks                   types.List   `tfsdk:"networks"`
+}
+
+// NetworkModel describes the networks computed within a region.
+type NetworkModel struct {
+	NetworkingSubnetID       types.String `tfsdk:"networking_subnet_id"`
+	NetworkingDeploymentCIDR types.String `tfsdk:"networking_deployment_cidr"`
+	NetworkingVPCID          types.String `tfsdk:"networking_vpc_id"`
+}
+
+// CreationPlanModel describes the creation_plan nested block.
+type CreationPlanModel struct {
+	MemoryLimitInGB            types.Float64 `tfsdk:"memory_limit_in_gb"`
+	DatasetSizeInGB            types.Float64 `tfsdk:"dataset_size_in_gb"`
+	QueryPerformanceFactor     types.String  `tfsdk:"query_performance_factor"`
+	ThroughputMeasurementBy    types.String  `tfsdk:"throughput_measurement_by"`
+	ThroughputMeasurementValue types.Int64   `tfsdk:"throughput_measurement_value"`
+	AverageItemSizeInBytes     types.Int64   `tfsdk:"average_item_size_in_bytes"`
+	RamPercentage              types.Int64   `tfsdk:"ram_percentage"`
+	Quantity                   types.Int64   `tfsdk:"quantity"`
+	SupportOSSClusterAPI       types.Bool    `tfsdk:"support_oss_cluster_api"`
+	Replication                types.Bool    `tfsdk:"replication"`
+	Modules                    types.List    `tfsdk:"modules"`
+}
+
+// MaintenanceWindowsModel describes the maintenance_windows nested block.
+type MaintenanceWindowsModel struct {
+	Mode   types.String `tfsdk:"mode"`
+	Window types.List   `tfsdk:"window"`
+}
+
+// MaintenanceWindowModel describes the window nested block within maintenance_windows.
+type MaintenanceWindowModel struct {
+	StartHour       types.Int64 `tfsdk:"start_hour"`
+	DurationInHours types.Int64 `tfsdk:"duration_in_hours"`
+	Days            types.List  `tfsdk:"days"`
+}
+
+// PricingModel describes a pricing entry of the subscription.
+type PricingModel struct {
+	DatabaseName        types.String  `tfsdk:"database_name"`
+	Type                types.String  `tfsdk:"type"`
+	TypeDetails         types.String  `tfsdk:"type_details"`
+	Quantity            types.Int64   `tfsdk:"quantity"`
+	QuantityMeasurement types.String  `tfsdk:"quantity_measurement"`
+	PricePerUnit        types.Float64 `tfsdk:"price_per_unit"`
+	PriceCurrency       types.String  `tfsdk:"price_currency"`
+	PricePeriod         types.String  `tfsdk:"price_period"`
+	Region              types.String  `tfsdk:"region"`
+}
+
+// CustomerManagedKeyModel describes the customer_managed_key nested block.
+type CustomerManagedKeyModel struct {
+	ResourceName types.String `tfsdk:"resource_name"`
+}
+
+// ProSubscriptionIdentityModel describes the resource identity of the Pro subscription.
+type ProSubscriptionIdentityModel struct {
+	SubscriptionID types.Int64 `tfsdk:"subscription_id"`
+}
+
+// allowlistAttrTypes returns the attribute types for allowlist objects.
+func allowlistAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"cidrs":              types.SetType{ElemType: types.StringType},
+		"security_group_ids": types.SetType{ElemType: types.StringType},
+	}
+}
+
+// cloudProviderAttrTypes returns the attribute types for cloud_provider objects.
+func cloudProviderAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"provider":         types.StringType,
+		"cloud_account_id": types.StringType,
+		"aws_account_id":   types.StringType,
+		"region":           types.SetType{ElemType: types.ObjectType{AttrTypes: regionAttrTypes()}},
+	}
+}
+
+// regionAttrTypes returns the attribute types for region objects.
+func regionAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"region":                       types.StringType,
+		"multiple_availability_zones":  types.BoolType,
+		"preferred_availability_zones": types.ListType{ElemType: types.StringType},
+		"networking_deployment_cidr":   types.StringType,
+		"networking_vpc_id":            types.StringType,
+		"networks":                     types.ListType{ElemType: types.ObjectType{AttrTypes: networkAttrTypes()}},
+	}
+}
+
+// networkAttrTypes returns the attribute types for network objects.
+func networkAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"networking_subnet_id":       types.StringType,
+		"networking_deployment_cidr": types.StringType,
+		"networking_vpc_id":          types.StringType,
+	}
+}
+
+// maintenanceWindowsAttrTypes returns the attribute types for maintenance_windows objects.
+func maintenanceWindowsAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"mode":   types.StringType,
+		"window": types.ListType{ElemType: types.ObjectType{AttrTypes: maintenanceWindowAttrTypes()}},
+	}
+}
+
+// maintenanceWindowAttrTypes returns the attribute types for window objects.
+func maintenanceWindowAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"start_hour":        types.Int64Type,
+		"duration_in_hours": types.Int64Type,
+		"days":              types.ListType{ElemType: types.StringType},
+	}
+}
+
+// pricingAttrTypes returns the attribute types for pricing objects.
+func pricingAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"database_name":        types.StringType,
+		"type":                 types.StringType,
+		"type_details":         types.StringType,
+		"quantity":             types.Int64Type,
+		"quantity_measurement": types.StringType,
+		"price_per_unit":       types.Float64Type,
+		"price_currency":       types.StringType,
+		"price_period":         types.StringType,
+		"region":               types.StringType,
+	}
+}
+
+// creationPlanAttrTypes returns the attribute types for creation_plan objects.
+func creationPlanAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"memory_limit_in_gb":           types.Float64Type,
+		"dataset_size_in_gb":           types.Float64Type,
+		"query_performance_factor":     types.StringType,
+		"throughput_measurement_by":    types.StringType,
+		"throughput_measurement_value": types.Int64Type,
+		"average_item_size_in_bytes":   types.Int64Type,
+		"ram_percentage":               types.Int64Type,
+		"quantity":                     types.Int64Type,
+		"support_oss_cluster_api":      types.BoolType,
+		"replication":                  types.BoolType,
+		"modules":                      types.ListType{ElemType: types.StringType},
+	}
+}
+
+// customerManagedKeyAttrTypes returns the attribute types for customer_managed_key objects.
+func customerManagedKeyAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"resource_name": types.StringType,
+	}
+}
+
+// nullTimeouts returns an unset timeouts block, for models which aren't read from a plan or state.
+func nullTimeouts() timeouts.Value {
+	return timeouts.Value{
+		Object: types.ObjectNull(map[string]attr.Type{
+			"create": types.StringType,
+			"read":   types.StringType,
+			"update": types.StringType,
+			"delete": types.StringType,
+		}),
+	}
+}
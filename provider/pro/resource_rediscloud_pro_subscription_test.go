@@ -0,0 +1,135 @@
+package pro
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/RedisLabs/rediscloud-go-api/service/databases"
+	"github.com/RedisLabs/rediscloud-go-api/service/subscriptions"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/client"
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/fakeapi"
+)
+
+func TestUnitCheckDeploymentCIDRs(t *testing.T) {
+	region := func(name string, cidr types.String) RegionModel {
+		return RegionModel{Region: types.StringValue(name), NetworkingDeploymentCIDR: cidr}
+	}
+
+	tests := []struct {
+		name    string
+		regions []RegionModel
+		errors  []string
+	}{
+		{
+			name: "separate",
+			regions: []RegionModel{
+				region("us-east-1", types.StringValue("10.0.0.0/24")),
+				region("eu-west-1", types.StringValue("10.0.1.0/24")),
+			},
+		},
+		{
+			name: "overlapping",
+			regions: []RegionModel{
+				region("us-east-1", types.StringValue("10.0.0.0/24")),
+				region("eu-west-1", types.StringValue("10.0.1.0/24")),
+				region("eu-west-2", types.StringValue("10.0.0.0/24")),
+			},
+			errors: []string{
+				"the deployment CIDR 10.0.0.0/24 of region us-east-1 overlaps the deployment CIDR 10.0.0.0/24 of region eu-west-2",
+			},
+		},
+		{
+			name: "unknown",
+			regions: []RegionModel{
+				region("us-east-1", types.StringValue("10.0.0.0/24")),
+				region("eu-west-1", types.StringUnknown()),
+			},
+		},
+		{
+			name: "wrong prefix length",
+			regions: []RegionModel{
+				region("us-east-1", types.StringValue("10.0.0.0/16")),
+				region("eu-west-1", types.StringValue("10.0.1.0/24")),
+			},
+			errors: []string{
+				"the deployment CIDR 10.0.0.0/16 must have a prefix length of /24, not /16",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var diagnostics diag.Diagnostics
+			(&proSubscriptionResource{}).checkDeploymentCIDRs(context.Background(), nil, test.regions, &diagnostics)
+
+			var errors []string
+			for _, d := range diagnostics.Errors() {
+				errors = append(errors, d.Detail())
+			}
+			assert.Equal(t, test.errors, errors)
+		})
+	}
+}
+
+func TestUnitCheckCurrentMonthlyCost(t *testing.T) {
+	ctx := context.Background()
+	fake := fakeapi.New(fakeapi.Options{})
+	t.Cleanup(fake.Close)
+	c, err := fake.Client()
+	require.NoError(t, err)
+
+	subId, err := c.Subscription.Create(ctx, subscriptions.CreateSubscription{
+		Name:            redis.String("budget"),
+		PaymentMethodID: redis.Int(fakeapi.PaymentMethodId),
+		CloudProviders: []*subscriptions.CreateCloudProvider{{
+			Provider: redis.String("AWS"),
+			Regions:  []*subscriptions.CreateRegion{{Region: redis.String("us-east-1")}},
+		}},
+	})
+	require.NoError(t, err)
+	_, err = c.Database.Create(ctx, subId, databases.CreateDatabase{Name: redis.String("cache"), MemoryLimitInGB: redis.Float64(30)})
+	require.NoError(t, err)
+
+	// The two shards of the database cost about 181.04 USD a month.
+	pricingList := flattenPricing(nil)
+	tests := []struct {
+		name       string
+		pricing    types.List
+		maxMonthly float64
+		changed    bool
+		errors     int
+		warnings   int
+	}{
+		{name: "changed over the ceiling", pricing: pricingList, maxMonthly: 100, changed: true, errors: 1},
+		{name: "unchanged over the ceiling", pricing: pricingList, maxMonthly: 100, warnings: 1},
+		{name: "within the ceiling", pricing: pricingList, maxMonthly: 200, changed: true},
+		{name: "no ceiling", pricing: pricingList, changed: true},
+		{name: "pricing unavailable", pricing: types.ListNull(types.ObjectType{AttrTypes: pricingAttrTypes()}), maxMonthly: 100, changed: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			plan := &ProSubscriptionModel{
+				ID:             types.StringValue(strconv.Itoa(subId)),
+				Pricing:        test.pricing,
+				MaxMonthlyCost: types.Float64Value(test.maxMonthly),
+			}
+			r := &proSubscriptionResource{client: &client.ApiClient{Client: c}}
+
+			var diagnostics diag.Diagnostics
+			r.checkCurrentMonthlyCost(ctx, plan, test.changed, &diagnostics)
+			assert.Len(t, diagnostics.Errors(), test.errors)
+			assert.Len(t, diagnostics.Warnings(), test.warnings)
+			for _, d := range diagnostics {
+				assert.Contains(t, d.Detail(), "would cost 181.04 USD a month")
+			}
+		})
+	}
+}
@@ -0,0 +1,246 @@
+package pro
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// UpgradeState upgrades the state of subscriptions managed by the SDK implementation of the resource, version 0.
+func (r *proSubscriptionResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	priorSchema := proSubscriptionSchemaV0(ctx)
+
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &priorSchema,
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var prior ProSubscriptionModelV0
+				resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				state := prior.upgrade()
+				upgradeProSubscriptionStateV0(ctx, &state, &resp.Diagnostics)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+			},
+		},
+	}
+}
+
+// ProSubscriptionModelV0 describes the state of subscriptions managed by the SDK implementation of the resource.
+type ProSubscriptionModelV0 struct {
+	ID                                    types.String   `tfsdk:"id"`
+	Name                                  types.String   `tfsdk:"name"`
+	PaymentMethod                         types.String   `tfsdk:"payment_method"`
+	PaymentMethodID                       types.String   `tfsdk:"payment_method_id"`
+	MemoryStorage                         types.String   `tfsdk:"memory_storage"`
+	Allowlist                             types.List     `tfsdk:"allowlist"`
+	CloudProvider                         types.List     `tfsdk:"cloud_provider"`
+	CreationPlan                          types.List     `tfsdk:"creation_plan"`
+	RedisVersion                          types.String   `tfsdk:"redis_version"`
+	MaintenanceWindows                    types.List     `tfsdk:"maintenance_windows"`
+	Pricing                               types.List     `tfsdk:"pricing"`
+	CustomerManagedKeyEnabled             types.Bool     `tfsdk:"customer_managed_key_enabled"`
+	CustomerManagedKeyDeletionGracePeriod types.String   `tfsdk:"customer_managed_key_deletion_grace_period"`
+	CustomerManagedKey                    types.List     `tfsdk:"customer_managed_key"`
+	CustomerManagedKeyRedisServiceAccount types.String   `tfsdk:"customer_managed_key_redis_service_account"`
+	PublicEndpointAccess                  types.Bool     `tfsdk:"public_endpoint_access"`
+	CreationPending                       types.Bool     `tfsdk:"creation_pending"`
+	Timeouts                              timeouts.Value `tfsdk:"timeouts"`
+}
+
+// upgrade returns the version 0 state as the current model, with the attributes added since at their defaults.
+func (m ProSubscriptionModelV0) upgrade() ProSubscriptionModel {
+	return ProSubscriptionModel{
+		ID:                                    m.ID,
+		Name:                                  m.Name,
+		PaymentMethod:                         m.PaymentMethod,
+		PaymentMethodID:                       m.PaymentMethodID,
+		MemoryStorage:                         m.MemoryStorage,
+		Allowlist:                             m.Allowlist,
+		CloudProvider:                         m.CloudProvider,
+		CreationPlan:                          m.CreationPlan,
+		RedisVersion:                          m.RedisVersion,
+		MaintenanceWindows:                    m.MaintenanceWindows,
+		Pricing:                               m.Pricing,
+		EstimatedPricing:                      types.ListNull(types.ObjectType{AttrTypes: pricingAttrTypes()}),
+		MaxMonthlyCost:                        types.Float64Null(),
+		CustomerManagedKeyEnabled:             m.CustomerManagedKeyEnabled,
+		CustomerManagedKeyDeletionGracePeriod: m.CustomerManagedKeyDeletionGracePeriod,
+		CustomerManagedKey:                    m.CustomerManagedKey,
+		CustomerManagedKeyRedisServiceAccount: m.CustomerManagedKeyRedisServiceAccount,
+		PublicEndpointAccess:                  m.PublicEndpointAccess,
+		DeletionProtection:                    types.BoolValue(false),
+		ForceDestroy:                          types.BoolValue(false),
+		CreationPending:                       m.CreationPending,
+		Timeouts:                              m.Timeouts,
+	}
+}
+
+// proSubscriptionSchemaV0 is the shape of the state of the SDK implementation of the resource. It only describes the
+// types of the attributes, so must not change when the current schema does.
+func proSubscriptionSchemaV0(ctx context.Context) schema.Schema {
+	optionalString := schema.StringAttribute{Optional: true, Computed: true}
+	computedString := schema.StringAttribute{Computed: true}
+	optionalBool := schema.BoolAttribute{Optional: true, Computed: true}
+	optionalInt64 := schema.Int64Attribute{Optional: true, Computed: true}
+	optionalFloat64 := schema.Float64Attribute{Optional: true, Computed: true}
+	optionalStrings := schema.ListAttribute{Optional: true, Computed: true, ElementType: types.StringType}
+
+	return schema.Schema{
+		Version: 0,
+		Attributes: map[string]schema.Attribute{
+			"id":                computedString,
+			"name":              optionalString,
+			"payment_method":    optionalString,
+			"payment_method_id": optionalString,
+			"memory_storage":    optionalString,
+			"redis_version":     optionalString,
+			"pricing": schema.ListAttribute{
+				Computed: true,
+				ElementType: types.ObjectType{AttrTypes: map[string]attr.Type{
+					"database_name":        types.StringType,
+					"type":                 types.StringType,
+					"type_details":         types.StringType,
+					"quantity":             types.Int64Type,
+					"quantity_measurement": types.StringType,
+					"price_per_unit":       types.Float64Type,
+					"price_currency":       types.StringType,
+					"price_period":         types.StringType,
+					"region":               types.StringType,
+				}},
+			},
+			"customer_managed_key_enabled":               optionalBool,
+			"customer_managed_key_deletion_grace_period": optionalString,
+			"customer_managed_key_redis_service_account": computedString,
+			"public_endpoint_access":                     optionalBool,
+			"creation_pending":                           schema.BoolAttribute{Computed: true},
+		},
+		Blocks: map[string]schema.Block{
+			"allowlist": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"cidrs":              schema.SetAttribute{Optional: true, ElementType: types.StringType},
+						"security_group_ids": schema.SetAttribute{Required: true, ElementType: types.StringType},
+					},
+				},
+			},
+			"cloud_provider": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"provider":         optionalString,
+						"cloud_account_id": optionalString,
+						"aws_account_id":   computedString,
+					},
+					Blocks: map[string]schema.Block{
+						"region": schema.SetNestedBlock{
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"region":                       schema.StringAttribute{Required: true},
+									"multiple_availability_zones":  optionalBool,
+									"preferred_availability_zones": optionalStrings,
+									"networking_deployment_cidr":   schema.StringAttribute{Required: true},
+									"networking_vpc_id":            optionalString,
+									"networks": schema.ListAttribute{
+										Computed: true,
+										ElementType: types.ObjectType{AttrTypes: map[string]attr.Type{
+											"networking_subnet_id":       types.StringType,
+											"networking_deployment_cidr": types.StringType,
+											"networking_vpc_id":          types.StringType,
+										}},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"creation_plan": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"memory_limit_in_gb":           optionalFloat64,
+						"dataset_size_in_gb":           optionalFloat64,
+						"query_performance_factor":     optionalString,
+						"throughput_measurement_by":    optionalString,
+						"throughput_measurement_value": optionalInt64,
+						"average_item_size_in_bytes":   optionalInt64,
+						"ram_percentage":               optionalInt64,
+						"quantity":                     optionalInt64,
+						"support_oss_cluster_api":      optionalBool,
+						"replication":                  optionalBool,
+						"modules":                      optionalStrings,
+					},
+				},
+			},
+			"maintenance_windows": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"mode": schema.StringAttribute{Required: true},
+					},
+					Blocks: map[string]schema.Block{
+						"window": schema.ListNestedBlock{
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"start_hour":        schema.Int64Attribute{Required: true},
+									"duration_in_hours": schema.Int64Attribute{Required: true},
+									"days":              schema.ListAttribute{Required: true, ElementType: types.StringType},
+								},
+							},
+						},
+					},
+				},
+			},
+			"customer_managed_key": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"resource_name": schema.StringAttribute{Required: true},
+					},
+				},
+			},
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// upgradeProSubscriptionStateV0 replaces the values the SDK recorded for attributes missing from the configuration
+// with the values planned for them now, so that existing subscriptions refresh without changes:
+//   - the SDK recorded an unset set of allowlist CIDRs as an empty set, which is now null;
+//   - the SDK recorded the default automatic maintenance of a subscription without maintenance_windows, which is now
+//     only recorded if manual, or configured.
+func upgradeProSubscriptionStateV0(ctx context.Context, state *ProSubscriptionModel, diagnostics *diag.Diagnostics) {
+	var allowlists []AllowlistModel
+	diagnostics.Append(state.Allowlist.ElementsAs(ctx, &allowlists, false)...)
+	if diagnostics.HasError() {
+		return
+	}
+	if len(allowlists) > 0 && !allowlists[0].CIDRs.IsNull() && len(allowlists[0].CIDRs.Elements()) == 0 {
+		allowlists[0].CIDRs = types.SetNull(types.StringType)
+		allowlist, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: allowlistAttrTypes()}, allowlists)
+		diagnostics.Append(diags...)
+		state.Allowlist = allowlist
+	}
+
+	var maintenanceWindows []MaintenanceWindowsModel
+	diagnostics.Append(state.MaintenanceWindows.ElementsAs(ctx, &maintenanceWindows, false)...)
+	if diagnostics.HasError() {
+		return
+	}
+	if len(maintenanceWindows) == 1 && maintenanceWindows[0].Mode.ValueString() == "automatic" && len(maintenanceWindows[0].Window.Elements()) == 0 {
+		state.MaintenanceWindows = types.ListValueMust(types.ObjectType{AttrTypes: maintenanceWindowsAttrTypes()}, []attr.Value{})
+	}
+}
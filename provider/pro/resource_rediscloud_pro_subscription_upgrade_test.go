@@ -0,0 +1,159 @@
+package pro
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitUpgradeProSubscriptionStateV0(t *testing.T) {
+	ctx := context.Background()
+
+	allowlistType := types.ObjectType{AttrTypes: allowlistAttrTypes()}
+	maintenanceType := types.ObjectType{AttrTypes: maintenanceWindowsAttrTypes()}
+	windowType := types.ObjectType{AttrTypes: maintenanceWindowAttrTypes()}
+
+	allowlist := func(cidrs types.Set) types.List {
+		return types.ListValueMust(allowlistType, []attr.Value{
+			types.ObjectValueMust(allowlistAttrTypes(), map[string]attr.Value{
+				"cidrs":              cidrs,
+				"security_group_ids": types.SetValueMust(types.StringType, []attr.Value{types.StringValue("sg-1")}),
+			}),
+		})
+	}
+	maintenance := func(mode string, windows ...attr.Value) types.List {
+		return types.ListValueMust(maintenanceType, []attr.Value{
+			types.ObjectValueMust(maintenanceWindowsAttrTypes(), map[string]attr.Value{
+				"mode":   types.StringValue(mode),
+				"window": types.ListValueMust(windowType, windows),
+			}),
+		})
+	}
+	window := types.ObjectValueMust(maintenanceWindowAttrTypes(), map[string]attr.Value{
+		"start_hour":        types.Int64Value(22),
+		"duration_in_hours": types.Int64Value(8),
+		"days":              types.ListValueMust(types.StringType, []attr.Value{types.StringValue("Tuesday")}),
+	})
+	cidrs := types.SetValueMust(types.StringType, []attr.Value{types.StringValue("10.0.0.0/24")})
+	noMaintenance := types.ListValueMust(maintenanceType, []attr.Value{})
+
+	tests := []struct {
+		name                string
+		allowlist           types.List
+		maintenance         types.List
+		expectedAllowlist   types.List
+		expectedMaintenance types.List
+	}{
+		{
+			name:                "empty CIDRs become null",
+			allowlist:           allowlist(types.SetValueMust(types.StringType, []attr.Value{})),
+			maintenance:         noMaintenance,
+			expectedAllowlist:   allowlist(types.SetNull(types.StringType)),
+			expectedMaintenance: noMaintenance,
+		},
+		{
+			name:                "CIDRs are kept",
+			allowlist:           allowlist(cidrs),
+			maintenance:         noMaintenance,
+			expectedAllowlist:   allowlist(cidrs),
+			expectedMaintenance: noMaintenance,
+		},
+		{
+			name:                "default automatic maintenance is dropped",
+			allowlist:           types.ListValueMust(allowlistType, []attr.Value{}),
+			maintenance:         maintenance("automatic"),
+			expectedAllowlist:   types.ListValueMust(allowlistType, []attr.Value{}),
+			expectedMaintenance: noMaintenance,
+		},
+		{
+			name:                "manual maintenance is kept",
+			allowlist:           types.ListValueMust(allowlistType, []attr.Value{}),
+			maintenance:         maintenance("manual", window),
+			expectedAllowlist:   types.ListValueMust(allowlistType, []attr.Value{}),
+			expectedMaintenance: maintenance("manual", window),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := ProSubscriptionModel{
+				Allowlist:          tt.allowlist,
+				MaintenanceWindows: tt.maintenance,
+			}
+			var diags diag.Diagnostics
+
+			upgradeProSubscriptionStateV0(ctx, &state, &diags)
+
+			require.False(t, diags.HasError(), "%v", diags)
+			assert.True(t, tt.expectedAllowlist.Equal(state.Allowlist), "allowlist: %s", state.Allowlist)
+			assert.True(t, tt.expectedMaintenance.Equal(state.MaintenanceWindows), "maintenance_windows: %s", state.MaintenanceWindows)
+		})
+	}
+}
+
+func TestUnitProSubscriptionUpgradeStateV0(t *testing.T) {
+	ctx := context.Background()
+	r := &proSubscriptionResource{}
+	upgrader := r.UpgradeState(ctx)[0]
+
+	// The state of a subscription as the SDK recorded it, without the attributes added since.
+	rawState := tfprotov6.RawState{JSON: []byte(`{
+		"id": "123",
+		"name": "cache",
+		"payment_method": "credit-card",
+		"payment_method_id": "456",
+		"memory_storage": "ram",
+		"redis_version": "7.2",
+		"pricing": [{"database_name": "db", "type": "Shards", "type_details": "micro", "quantity": 2, "quantity_measurement": "shards",
+			"price_per_unit": 0.1, "price_currency": "USD", "price_period": "hour", "region": "us-east-1"}],
+		"customer_managed_key_enabled": false,
+		"customer_managed_key_deletion_grace_period": "immediate",
+		"customer_managed_key_redis_service_account": "",
+		"public_endpoint_access": true,
+		"allowlist": [{"cidrs": [], "security_group_ids": ["sg-1"]}],
+		"cloud_provider": [{"provider": "AWS", "cloud_account_id": "1", "aws_account_id": "", "region": [{
+			"region": "us-east-1", "multiple_availability_zones": false, "preferred_availability_zones": ["use1-az1"],
+			"networking_deployment_cidr": "10.0.0.0/24", "networking_vpc_id": "",
+			"networks": [{"networking_subnet_id": "subnet-1", "networking_deployment_cidr": "10.0.0.0/24", "networking_vpc_id": "vpc-1"}]}]}],
+		"creation_plan": [],
+		"maintenance_windows": [{"mode": "automatic", "window": []}],
+		"customer_managed_key": [],
+		"timeouts": null
+	}`)}
+	raw, err := rawState.UnmarshalWithOpts(upgrader.PriorSchema.Type().TerraformType(ctx), tfprotov6.UnmarshalOpts{})
+	require.NoError(t, err)
+
+	var current resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &current)
+	req := resource.UpgradeStateRequest{State: &tfsdk.State{Schema: *upgrader.PriorSchema, Raw: raw}}
+	resp := resource.UpgradeStateResponse{State: tfsdk.State{Schema: current.Schema, Raw: tftypes.NewValue(current.Schema.Type().TerraformType(ctx), nil)}}
+
+	upgrader.StateUpgrader(ctx, req, &resp)
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+	var state ProSubscriptionModel
+	require.False(t, resp.State.Get(ctx, &state).HasError())
+	assert.Equal(t, "123", state.ID.ValueString())
+	assert.Len(t, state.Pricing.Elements(), 1)
+	assert.Len(t, state.CloudProvider.Elements(), 1)
+	assert.True(t, state.CreationPending.IsNull())
+	assert.Equal(t, types.BoolValue(false), state.DeletionProtection)
+	assert.Equal(t, types.BoolValue(false), state.ForceDestroy)
+	assert.True(t, state.EstimatedPricing.IsNull())
+	assert.True(t, state.MaxMonthlyCost.IsNull())
+
+	// The values the SDK recorded for unset attributes are upgraded too.
+	var allowlists []AllowlistModel
+	require.False(t, state.Allowlist.ElementsAs(ctx, &allowlists, false).HasError())
+	assert.True(t, allowlists[0].CIDRs.IsNull())
+	assert.Empty(t, state.MaintenanceWindows.Elements())
+}
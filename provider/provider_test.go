@@ -1,7 +1,10 @@
 package provider
 
 import (
+	"errors"
 	"os"
+	"path/filepath"
+	"strings"
 	"sync"
 	"testing"
 
@@ -63,8 +66,56 @@ func TestProvider(t *testing.T) {
 	}
 }
 
+func TestUnitProvider_ResourceIdentity(t *testing.T) {
+	for name, res := range NewSdkProvider("dev")().ResourcesMap {
+		if res.Importer == nil {
+			continue
+		}
+		if res.Identity == nil {
+			t.Errorf("importable resource %s has no identity schema", name)
+		}
+	}
+}
+
 func testAccPreCheck(t *testing.T) {
 	requireEnvironmentVariables(t, RedisCloudUrlEnvVar, rediscloudApi.AccessKeyEnvVar, rediscloudApi.SecretKeyEnvVar)
+	insertTestCassette(t)
+}
+
+// vcrMutex runs the tests recording or replaying cassettes one at a time, as every client shares the cassette
+// inserted.
+var vcrMutex sync.Mutex
+
+// insertTestCassette records the test's interactions with the API to testdata/cassettes, or replays them from there,
+// when REDISCLOUD_VCR is set. Tests without a recorded cassette are skipped while replaying.
+func insertTestCassette(t *testing.T) {
+	mode, err := client.VCRMode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mode == "" {
+		return
+	}
+
+	path := filepath.Join("testdata", "cassettes", strings.ReplaceAll(t.Name(), "/", "_")+".json")
+	if mode == client.VCRModeReplay {
+		if _, err := os.Stat(path); errors.Is(err, os.ErrNotExist) {
+			t.Skipf("No cassette recorded at %s", path)
+		}
+	}
+
+	vcrMutex.Lock()
+	eject, err := client.SharedVCRTransport(mode).Insert(path)
+	if err != nil {
+		vcrMutex.Unlock()
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		defer vcrMutex.Unlock()
+		if err := eject(!t.Failed()); err != nil {
+			t.Errorf("Failed to save cassette %s: %s", path, err)
+		}
+	})
 }
 
 func testAccAwsPreExistingCloudAccountPreCheck(t *testing.T) {
@@ -6,6 +6,7 @@ import (
 	"os"
 	"testing"
 
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/networking"
 	"github.com/RedisLabs/terraform-provider-rediscloud/provider/utils"
 
 	"github.com/RedisLabs/rediscloud-go-api/redis"
@@ -42,21 +43,22 @@ func TestAccResourceRedisCloudActiveActivePrivateServiceConnectEndpointAccepter_
 					func(s *terraform.State) error {
 						r := s.RootModule().Resources[resourceName]
 
-						accepterId, err := toPscEndpointActiveActiveAccepterId(r.Primary.ID)
+						ids, err := networking.ParseID(r.Primary.ID, utils.IdentitySubscriptionId, utils.IdentityRegionId,
+							utils.IdentityPscServiceId, utils.IdentityPscEndpointId)
 						if err != nil {
 							return fmt.Errorf("couldn't parse the accepter ID: %s", r.Primary.ID)
 						}
 
 						client := sharedTestClient(t)
 						endpoints, err := client.Client.PrivateServiceConnect.GetActiveActiveEndpoints(context.TODO(),
-							accepterId.subscriptionId, accepterId.regionId, accepterId.pscServiceId)
+							ids[0], ids[1], ids[2])
 						if err != nil {
 							return err
 						}
 
-						endpoint := findPrivateServiceConnectEndpoints(accepterId.endpointId, endpoints.Endpoints)
+						endpoint := networking.FindPrivateServiceConnectEndpoint(ids[3], endpoints.Endpoints)
 						if endpoint == nil {
-							return fmt.Errorf("couldn't find endpoint with ID: %d", accepterId.endpointId)
+							return fmt.Errorf("couldn't find endpoint with ID: %d", ids[3])
 						}
 
 						if redis.StringValue(endpoint.Status) != psc.EndpointStatusActive {
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/networking"
 	"github.com/RedisLabs/terraform-provider-rediscloud/provider/utils"
 )
 
@@ -42,20 +43,21 @@ func TestAccResourceRedisCloudPrivateServiceConnectEndpointAccepter_Create(t *te
 					func(s *terraform.State) error {
 						r := s.RootModule().Resources[resourceName]
 
-						accepterId, err := toPscEndpointAccepterId(r.Primary.ID)
+						ids, err := networking.ParseID(r.Primary.ID, utils.IdentitySubscriptionId, utils.IdentityPscServiceId,
+							utils.IdentityPscEndpointId)
 						if err != nil {
 							return fmt.Errorf("couldn't parse the accepter ID: %s", r.Primary.ID)
 						}
 
 						client := sharedTestClient(t)
-						endpoints, err := client.Client.PrivateServiceConnect.GetEndpoints(context.TODO(), accepterId.subscriptionId, accepterId.pscServiceId)
+						endpoints, err := client.Client.PrivateServiceConnect.GetEndpoints(context.TODO(), ids[0], ids[1])
 						if err != nil {
 							return err
 						}
 
-						endpoint := findPrivateServiceConnectEndpoints(accepterId.endpointId, endpoints.Endpoints)
+						endpoint := networking.FindPrivateServiceConnectEndpoint(ids[2], endpoints.Endpoints)
 						if endpoint == nil {
-							return fmt.Errorf("couldn't find endpoint with ID: %d", accepterId.endpointId)
+							return fmt.Errorf("couldn't find endpoint with ID: %d", ids[2])
 						}
 
 						if redis.StringValue(endpoint.Status) != psc.EndpointStatusActive {
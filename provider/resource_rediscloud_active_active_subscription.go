@@ -5,8 +5,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log"
 	"regexp"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/RedisLabs/terraform-provider-rediscloud/provider/client"
@@ -15,6 +17,7 @@ import (
 
 	"github.com/RedisLabs/rediscloud-go-api/redis"
 	"github.com/RedisLabs/rediscloud-go-api/service/maintenance"
+	"github.com/RedisLabs/rediscloud-go-api/service/pricing"
 	"github.com/RedisLabs/rediscloud-go-api/service/subscriptions"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -29,23 +32,40 @@ func resourceRedisCloudActiveActiveSubscription() *schema.Resource {
 		UpdateContext: resourceRedisCloudActiveActiveSubscriptionUpdate,
 		DeleteContext: resourceRedisCloudActiveActiveSubscriptionDelete,
 		CustomizeDiff: func(ctx context.Context, diff *schema.ResourceDiff, i interface{}) error {
-			_, cPlanExists := diff.GetOk("creation_plan")
-			if cPlanExists {
-				return nil
+			if err := utils.ResumeCreateDiff(diff); err != nil {
+				return err
+			}
+
+			api, _ := i.(*client.ApiClient)
+			if diff.Id() != "" {
+				return checkActiveActiveMonthlyCost(diff, api)
 			}
 
 			// The resource hasn't been created yet, but the creation plan is missing.
-			if diff.Id() == "" {
+			if _, cPlanExists := diff.GetOk("creation_plan"); !cPlanExists {
 				return fmt.Errorf(`the "creation_plan" block is required`)
 			}
-			return nil
+			if err := checkActiveActiveDeploymentCIDRs(ctx, diff, api); err != nil {
+				return err
+			}
+			if api == nil {
+				return nil
+			}
+			if err := checkActiveActiveRegions(ctx, diff, api); err != nil {
+				return err
+			}
+			return estimateActiveActivePricing(ctx, diff, api)
 		},
 
 		Importer: &schema.ResourceImporter{
 			// Let the READ operation do the heavy lifting for importing values from the API.
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: utils.ImportStateWithIdentity(
+				utils.ImportStateByName(utils.SubscriptionNameResolver(subscriptions.SubscriptionDeploymentTypeActiveActive), nil),
+				utils.IdentitySubscriptionId),
 		},
 
+		Identity: utils.SubscriptionIdentity(),
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
 			Read:   schema.DefaultTimeout(10 * time.Minute),
@@ -254,55 +274,19 @@ func resourceRedisCloudActiveActiveSubscription() *schema.Resource {
 				Description: "Pricing details totalled over this Subscription",
 				Type:        schema.TypeList,
 				Computed:    true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"database_name": {
-							Description: "The database this pricing entry applies to",
-							Type:        schema.TypeString,
-							Computed:    true,
-						},
-						"type": {
-							Description: "The type of cost e.g. 'Shards'",
-							Type:        schema.TypeString,
-							Computed:    true,
-						},
-						"type_details": {
-							Description: "Further detail e.g. 'micro'",
-							Type:        schema.TypeString,
-							Computed:    true,
-						},
-						"quantity": {
-							Description: "Self-explanatory",
-							Type:        schema.TypeInt,
-							Computed:    true,
-						},
-						"quantity_measurement": {
-							Description: "Self-explanatory",
-							Type:        schema.TypeString,
-							Computed:    true,
-						},
-						"price_per_unit": {
-							Description: "Self-explanatory",
-							Type:        schema.TypeFloat,
-							Computed:    true,
-						},
-						"price_currency": {
-							Description: "Self-explanatory e.g. 'USD'",
-							Type:        schema.TypeString,
-							Computed:    true,
-						},
-						"price_period": {
-							Description: "Self-explanatory e.g. 'hour'",
-							Type:        schema.TypeString,
-							Computed:    true,
-						},
-						"region": {
-							Description: "Self-explanatory, if the cost is associated with a particular region",
-							Type:        schema.TypeString,
-							Computed:    true,
-						},
-					},
-				},
+				Elem:        pricingResource(),
+			},
+			utils.MaxMonthlyCostKey: {
+				Description:  utils.MaxMonthlyCostDescription,
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				ValidateFunc: validation.FloatAtLeast(0),
+			},
+			utils.EstimatedPricingKey: {
+				Description: utils.EstimatedPricingDescription,
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        pricingResource(),
 			},
 			"customer_managed_key_enabled": {
 				Description: "Whether to enable CMK (customer managed key) for the subscription. If this is true, then the subscription will be put in a pending state until you supply the CMEK. See documentation for further details on this process. Defaults to false.",
@@ -357,6 +341,9 @@ func resourceRedisCloudActiveActiveSubscription() *schema.Resource {
 				Optional:    true,
 				Default:     true,
 			},
+			utils.DeletionProtectionKey: utils.DeletionProtectionSchema(),
+			utils.ForceDestroyKey:       utils.ForceDestroySchema(),
+			utils.CreationPendingKey:    utils.CreationPendingSchema(),
 		},
 	}
 }
@@ -364,6 +351,64 @@ func resourceRedisCloudActiveActiveSubscription() *schema.Resource {
 func resourceRedisCloudActiveActiveSubscriptionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api := meta.(*client.ApiClient)
 
+	createSubscriptionRequest, err := buildActiveActiveCreateSubscription(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	cmkEnabled := d.Get("customer_managed_key_enabled").(bool)
+	redisVersion := d.Get("redis_version").(string)
+
+	subId, err := api.Client.Subscription.Create(ctx, createSubscriptionRequest)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(subId))
+
+	if diags := waitForActiveActiveSubscriptionCreate(ctx, d, api, subId); diags != nil {
+		return diags
+	}
+
+	// If in a CMK flow, the subscription waits for its customer managed keys
+	if cmkEnabled {
+		return resourceRedisCloudActiveActiveSubscriptionRead(ctx, d, meta)
+	}
+
+	if m, ok := d.GetOk("maintenance_windows"); ok {
+		mMap := m.([]interface{})[0].(map[string]interface{})
+
+		windows := make([]*maintenance.Window, 0)
+		for _, w := range mMap["window"].([]interface{}) {
+			wMap := w.(map[string]interface{})
+			windows = append(windows, &maintenance.Window{
+				StartHour:       redis.Int(wMap["start_hour"].(int)),
+				DurationInHours: redis.Int(wMap["duration_in_hours"].(int)),
+				Days:            utils.InterfaceToStringSlice(wMap["days"].([]interface{})),
+			})
+		}
+
+		updateMaintenanceRequest := maintenance.Maintenance{
+			Mode:    redis.String(mMap["mode"].(string)),
+			Windows: windows,
+		}
+		err = api.Client.Maintenance.Update(ctx, subId, updateMaintenanceRequest)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if redisVersion != "" {
+		if err := d.Set("redis_version", redisVersion); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return append(estimatedPricingDiagnostics(d), resourceRedisCloudActiveActiveSubscriptionRead(ctx, d, meta)...)
+}
+
+// buildActiveActiveCreateSubscription converts the resource's values into the API's create request. It is called
+// when creating the subscription, and when planning it to estimate its pricing.
+func buildActiveActiveCreateSubscription(d utils.ResourceGetter) (subscriptions.CreateSubscription, error) {
 	plan := d.Get("creation_plan").([]interface{})
 
 	// Create creation-plan databases
@@ -372,7 +417,7 @@ func resourceRedisCloudActiveActiveSubscriptionCreate(ctx context.Context, d *sc
 	// Create CloudProviders
 	providers, err := buildCreateActiveActiveCloudProviders(d.Get("cloud_provider").(string), planMap)
 	if err != nil {
-		return diag.FromErr(err)
+		return subscriptions.CreateSubscription{}, err
 	}
 
 	// Create Subscription
@@ -381,7 +426,7 @@ func resourceRedisCloudActiveActiveSubscriptionCreate(ctx context.Context, d *sc
 	paymentMethod := d.Get("payment_method").(string)
 	paymentMethodID, err := pro.ReadPaymentMethodID(d)
 	if err != nil {
-		return diag.FromErr(err)
+		return subscriptions.CreateSubscription{}, err
 	}
 
 	// Create databases
@@ -402,89 +447,190 @@ func resourceRedisCloudActiveActiveSubscriptionCreate(ctx context.Context, d *sc
 		createSubscriptionRequest.RedisVersion = redis.String(redisVersion)
 	}
 
-	subId, err := api.Client.Subscription.Create(ctx, createSubscriptionRequest)
-	if err != nil {
-		return diag.FromErr(err)
+	return createSubscriptionRequest, nil
+}
+
+// activeActiveCreateKeys are the attributes the create request is built from.
+var activeActiveCreateKeys = []string{"name", "payment_method", "payment_method_id", "cloud_provider", "creation_plan",
+	"customer_managed_key_enabled", "public_endpoint_access", "redis_version"}
+
+// checkActiveActiveRegions checks the regions of the creation plan are regions of the subscription's cloud provider,
+// so that a mistyped region fails the plan rather than the create. Regions whose names aren't known yet are skipped.
+func checkActiveActiveRegions(ctx context.Context, diff *schema.ResourceDiff, api *client.ApiClient) error {
+	if !diff.NewValueKnown("cloud_provider") {
+		return nil
 	}
 
-	d.SetId(strconv.Itoa(subId))
+	var regions []string
+	for _, r := range diff.Get("creation_plan.0.region").(*schema.Set).List() {
+		if region := r.(map[string]interface{})["region"].(string); region != "" {
+			regions = append(regions, region)
+		}
+	}
+	return errors.Join(utils.CheckRegions(ctx, api, diff.Get("cloud_provider").(string), regions)...)
+}
 
-	// If in a CMK flow, verify the pending state
-	if cmkEnabled {
-		err = utils.WaitForSubscriptionToBeEncryptionKeyPending(ctx, subId, api)
-		if err != nil {
-			return diag.FromErr(err)
+// checkActiveActiveDeploymentCIDRs checks the deployment CIDRs of the creation plan's regions have a prefix length
+// the cloud provider allows, and don't overlap each other. When the provider's check_account_cidr_overlaps is set,
+// they are also checked against those of the account's other subscriptions.
+func checkActiveActiveDeploymentCIDRs(ctx context.Context, diff *schema.ResourceDiff, api *client.ApiClient) error {
+	var planned []utils.DeploymentCIDR
+	for _, r := range diff.Get("creation_plan.0.region").(*schema.Set).List() {
+		region := r.(map[string]interface{})
+		if cidr := region["networking_deployment_cidr"].(string); cidr != "" {
+			planned = append(planned, utils.DeploymentCIDR{Region: region["region"].(string), CIDR: cidr})
 		}
-		return resourceRedisCloudActiveActiveSubscriptionRead(ctx, d, meta)
 	}
 
-	// Confirm Subscription Active status
-	err = utils.WaitForSubscriptionToBeActive(ctx, subId, api)
+	var errs []error
+	for i, region := range planned {
+		if err := utils.CheckDeploymentCIDRPrefix(region.CIDR); err != nil {
+			errs = append(errs, err)
+		}
+		for _, other := range planned[i+1:] {
+			if utils.CIDRsOverlap(region.CIDR, other.CIDR) {
+				errs = append(errs, fmt.Errorf("the deployment CIDR %s of region %s overlaps the deployment CIDR %s of region %s",
+					region.CIDR, region.Region, other.CIDR, other.Region))
+			}
+		}
+	}
+	if len(errs) > 0 || api == nil || !api.CheckAccountCIDROverlaps || len(planned) == 0 {
+		return errors.Join(errs...)
+	}
+
+	account, err := utils.AccountDeploymentCIDRs(ctx, api, 0)
 	if err != nil {
-		return diag.FromErr(err)
+		log.Printf("[WARN] Couldn't check the deployment CIDRs of the account: %s", err)
+		return nil
+	}
+	for _, region := range planned {
+		description := fmt.Sprintf("the deployment CIDR %s of region %s", region.CIDR, region.Region)
+		if err := utils.CheckCIDROverlaps(description, region.CIDR, account); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// estimateActiveActivePricing previews the pricing of the subscription being created into estimated_pricing, and
+// checks it against max_monthly_cost. It is skipped while the configured values the create request is built from are
+// unknown, and a preview which fails is only logged, as the create may still succeed. Plans of SDK resources can't
+// carry warnings, so the monthly total is reported by the create.
+func estimateActiveActivePricing(ctx context.Context, diff *schema.ResourceDiff, api *client.ApiClient) error {
+	config := diff.GetRawConfig()
+	for _, key := range activeActiveCreateKeys {
+		if !config.GetAttr(key).IsWhollyKnown() {
+			return nil
+		}
+	}
+
+	request, err := buildActiveActiveCreateSubscription(diff)
+	if err != nil {
+		// The create reports the same error.
+		return nil
+	}
+
+	list, err := utils.PreviewPricing(ctx, api, request)
+	if err != nil {
+		warning := utils.EstimatedPricingUnavailableWarning(fmt.Sprintf("subscription %q", diff.Get("name").(string)), err)
+		log.Printf("[WARN] %s: %s", warning.Summary, warning.Detail)
+		return diff.SetNew(utils.EstimatedPricingKey, []interface{}{})
+	}
+
+	description := fmt.Sprintf("subscription %q", diff.Get("name").(string))
+	log.Printf("[INFO] %s", utils.EstimatedPricingWarning(description, list).Summary)
+	if err := diff.SetNew(utils.EstimatedPricingKey, pro.FlattenPricing(list)); err != nil {
+		return err
+	}
+	return utils.CheckMaxMonthlyCost(description, list, api.MaxMonthlyCost, diff.Get(utils.MaxMonthlyCostKey).(float64))
+}
+
+// checkActiveActiveMonthlyCost checks the current pricing of an existing subscription against its max_monthly_cost,
+// failing the plan when the subscription is being changed. Otherwise, so that plans lowering its cost, such as by
+// removing databases, aren't blocked, it is only a warning, which the refresh reports as plans of SDK resources can't.
+func checkActiveActiveMonthlyCost(diff *schema.ResourceDiff, api *client.ApiClient) error {
+	if api == nil || len(diff.GetChangedKeysPrefix("")) == 0 {
+		return nil
+	}
+
+	list := pro.ExpandPricing(diff.Get("pricing").([]interface{}))
+	return utils.CheckMaxMonthlyCost(fmt.Sprintf("subscription %s", diff.Id()), list, api.MaxMonthlyCost, diff.Get(utils.MaxMonthlyCostKey).(float64))
+}
+
+// currentMonthlyCostDiagnostics returns the warning for a subscription whose current pricing is over its
+// max_monthly_cost.
+func currentMonthlyCostDiagnostics(d *schema.ResourceData, api *client.ApiClient, list []*pricing.Pricing) diag.Diagnostics {
+	err := utils.CheckMaxMonthlyCost(fmt.Sprintf("subscription %s", d.Id()), list, api.MaxMonthlyCost, d.Get(utils.MaxMonthlyCostKey).(float64))
+	if err == nil {
+		return nil
 	}
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  "Current cost over max_monthly_cost",
+		Detail:   err.Error(),
+	}}
+}
+
+// estimatedPricingDiagnostics returns the warning reporting the monthly total of the subscription's estimated_pricing,
+// if it was estimated.
+func estimatedPricingDiagnostics(d *schema.ResourceData) diag.Diagnostics {
+	list := pro.ExpandPricing(d.Get(utils.EstimatedPricingKey).([]interface{}))
+	if len(list) == 0 {
+		return nil
+	}
+	return diag.Diagnostics{utils.EstimatedPricingWarning(fmt.Sprintf("subscription %q", d.Get("name").(string)), list)}
+}
 
-	// There is a timing issue where the subscription is marked as active before the creation-plan databases are listed.
-	// This additional wait ensures that the databases will be listed before calling api.client.Database.List()
-	time.Sleep(30 * time.Second) //lintignore:R018
+// waitForActiveActiveSubscriptionCreate waits for a new subscription to finish provisioning, and deletes the
+// databases of its creation plan. It also resumes a create which was interrupted. If this create is interrupted in
+// turn, the subscription is marked as pending and a warning returned.
+func waitForActiveActiveSubscriptionCreate(ctx context.Context, d *schema.ResourceData, api *client.ApiClient, subId int) diag.Diagnostics {
+	description := fmt.Sprintf("Active-Active subscription %d", subId)
+
+	// If in a CMK flow, verify the pending state
+	if d.Get("customer_managed_key_enabled").(bool) {
+		if err := utils.WaitForSubscriptionToBeEncryptionKeyPending(ctx, subId, api); err != nil {
+			return utils.CreationPendingDiagnostics(ctx, d, description, err)
+		}
+		return nil
+	}
+
+	// Confirm Subscription Active status
 	if err := utils.WaitForSubscriptionToBeActive(ctx, subId, api); err != nil {
-		return diag.FromErr(err)
+		return utils.CreationPendingDiagnostics(ctx, d, description, err)
 	}
 
 	// Locate Databases to confirm Active status
 	dbList := api.Client.Database.List(ctx, subId)
 
 	for dbList.Next() {
+		// A resumed create may find databases created since, which aren't part of the creation plan.
+		if !strings.HasPrefix(redis.StringValue(dbList.Value().Name), pro.CreationPlanDatabasePrefix) {
+			continue
+		}
 		dbId := *dbList.Value().ID
 
 		if err := utils.WaitForDatabaseToBeActive(ctx, subId, dbId, api); err != nil {
-			return diag.FromErr(err)
+			return utils.CreationPendingDiagnostics(ctx, d, description, err)
 		}
 		// Delete each creation-plan database
-		dbErr := api.Client.Database.Delete(ctx, subId, dbId)
-		if dbErr != nil {
-			diag.FromErr(dbErr)
+		err := utils.WaitForTask(ctx, fmt.Sprintf("delete creation-plan database %d", dbId), func(ctx context.Context) error {
+			return api.Client.Database.Delete(ctx, subId, dbId)
+		}, utils.SubscriptionFallback(subId, api))
+		if err != nil {
+			log.Printf("[WARN] Failed to delete creation-plan database %d of subscription %d: %s", dbId, subId, err)
 		}
 	}
 	if dbList.Err() != nil {
-		return diag.FromErr(dbList.Err())
+		return utils.CreationPendingDiagnostics(ctx, d, description, dbList.Err())
 	}
 
 	// Check that the subscription is in an active state before calling the read function
 	if err := utils.WaitForSubscriptionToBeActive(ctx, subId, api); err != nil {
-		return diag.FromErr(err)
-	}
-
-	if m, ok := d.GetOk("maintenance_windows"); ok {
-		mMap := m.([]interface{})[0].(map[string]interface{})
-
-		windows := make([]*maintenance.Window, 0)
-		for _, w := range mMap["window"].([]interface{}) {
-			wMap := w.(map[string]interface{})
-			windows = append(windows, &maintenance.Window{
-				StartHour:       redis.Int(wMap["start_hour"].(int)),
-				DurationInHours: redis.Int(wMap["duration_in_hours"].(int)),
-				Days:            utils.InterfaceToStringSlice(wMap["days"].([]interface{})),
-			})
-		}
-
-		updateMaintenanceRequest := maintenance.Maintenance{
-			Mode:    redis.String(mMap["mode"].(string)),
-			Windows: windows,
-		}
-		err = api.Client.Maintenance.Update(ctx, subId, updateMaintenanceRequest)
-		if err != nil {
-			return diag.FromErr(err)
-		}
+		return utils.CreationPendingDiagnostics(ctx, d, description, err)
 	}
 
-	if redisVersion != "" {
-		if err := d.Set("redis_version", redisVersion); err != nil {
-			return diag.FromErr(err)
-		}
-	}
-
-	return resourceRedisCloudActiveActiveSubscriptionRead(ctx, d, meta)
+	return nil
 }
 
 func resourceRedisCloudActiveActiveSubscriptionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -525,6 +671,10 @@ func resourceRedisCloudActiveActiveSubscriptionRead(ctx context.Context, d *sche
 		}
 	}
 
+	if err := utils.SetResourceIdentity(d, map[string]interface{}{utils.IdentitySubscriptionId: subId}); err != nil {
+		return diag.FromErr(err)
+	}
+
 	if err := d.Set("name", redis.StringValue(subscription.Name)); err != nil {
 		return diag.FromErr(err)
 	}
@@ -572,6 +722,7 @@ func resourceRedisCloudActiveActiveSubscriptionRead(ctx context.Context, d *sche
 		if err := d.Set("pricing", pro.FlattenPricing(pricingList)); err != nil {
 			return diag.FromErr(err)
 		}
+		diags = append(diags, currentMonthlyCostDiagnostics(d, api, pricingList)...)
 	}
 
 	if subscription.CustomerManagedKeyAccessDetails != nil && subscription.CustomerManagedKeyAccessDetails.RedisServiceAccount != nil {
@@ -589,6 +740,14 @@ func resourceRedisCloudActiveActiveSubscriptionRead(ctx context.Context, d *sche
 		return diag.FromErr(err)
 	}
 
+	if err := utils.SetDeletionProtection(d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := utils.SetForceDestroy(d); err != nil {
+		return diag.FromErr(err)
+	}
+
 	cmkEnabledFromAPI := subscription.PersistentStorageEncryptionType != nil &&
 		redis.StringValue(subscription.PersistentStorageEncryptionType) == pro.CMK_ENABLED_STRING
 	if err := d.Set("customer_managed_key_enabled", cmkEnabledFromAPI); err != nil {
@@ -612,8 +771,18 @@ func resourceRedisCloudActiveActiveSubscriptionUpdate(ctx context.Context, d *sc
 		return diag.FromErr(err)
 	}
 
-	utils.SubscriptionMutex.Lock(subId)
-	defer utils.SubscriptionMutex.Unlock(subId)
+	unlock, err := utils.LockSubscription(ctx, subId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer unlock()
+
+	if diags := utils.ResumeCreate(d, func() diag.Diagnostics {
+		log.Printf("[INFO] Resuming the interrupted create of subscription %d", subId)
+		return waitForActiveActiveSubscriptionCreate(ctx, d, api, subId)
+	}); diags != nil {
+		return diags
+	}
 
 	subscription, err := api.Client.Subscription.Get(ctx, subId)
 	if err != nil {
@@ -653,16 +822,14 @@ func resourceRedisCloudActiveActiveSubscriptionUpdate(ctx context.Context, d *sc
 			updateSubscriptionRequest.PublicEndpointAccess = redis.Bool(publicEndpointAccess)
 		}
 
-		err = api.Client.Subscription.Update(ctx, subId, updateSubscriptionRequest)
+		err = utils.WaitForTask(ctx, fmt.Sprintf("update subscription %d", subId), func(ctx context.Context) error {
+			return api.Client.Subscription.Update(ctx, subId, updateSubscriptionRequest)
+		}, utils.SubscriptionFallback(subId, api))
 		if err != nil {
-			return diag.FromErr(err)
+			return utils.TaskDiagnostics(err)
 		}
 	}
 
-	if err := utils.WaitForSubscriptionToBeActive(ctx, subId, api); err != nil {
-		return diag.FromErr(err)
-	}
-
 	// Verify public_endpoint_access has propagated if it was changed
 	if d.HasChange("public_endpoint_access") {
 		expected := d.Get("public_endpoint_access").(bool)
@@ -724,12 +891,11 @@ func resourceRedisCloudActiveActiveSubscriptionUpdateCmk(ctx context.Context, d
 		CustomerManagedKeys: &customerManagedKeys,
 	}
 
-	if err := api.Client.Subscription.UpdateCMKs(ctx, subId, updateCmkRequest); err != nil {
-		return diag.FromErr(err)
-	}
-
-	if err := utils.WaitForSubscriptionToBeActive(ctx, subId, api); err != nil {
-		return diag.FromErr(err)
+	err := utils.WaitForTask(ctx, fmt.Sprintf("update the customer managed keys of subscription %d", subId), func(ctx context.Context) error {
+		return api.Client.Subscription.UpdateCMKs(ctx, subId, updateCmkRequest)
+	}, utils.SubscriptionFallback(subId, api))
+	if err != nil {
+		return utils.TaskDiagnostics(err)
 	}
 
 	return nil
@@ -746,8 +912,15 @@ func resourceRedisCloudActiveActiveSubscriptionDelete(ctx context.Context, d *sc
 		return diag.FromErr(err)
 	}
 
-	utils.SubscriptionMutex.Lock(subId)
-	defer utils.SubscriptionMutex.Unlock(subId)
+	if diags := utils.DeletionProtectionDiagnostics(d, fmt.Sprintf("Subscription %d", subId)); diags.HasError() {
+		return diags
+	}
+
+	unlock, err := utils.LockSubscription(ctx, subId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer unlock()
 
 	subscription, err := api.Client.Subscription.Get(ctx, subId)
 	if err != nil {
@@ -760,26 +933,26 @@ func resourceRedisCloudActiveActiveSubscriptionDelete(ctx context.Context, d *sc
 			return diag.FromErr(err)
 		}
 
-		// There is a timing issue where the subscription is marked as active before the creation-plan databases are deleted.
-		// This additional wait ensures that the databases are deleted before the subscription is deleted.
-		time.Sleep(30 * time.Second) //lintignore:R018
-		if err := utils.WaitForSubscriptionToBeActive(ctx, subId, api); err != nil {
-			return diag.FromErr(err)
+		// The databases Terraform doesn't manage would otherwise prevent the subscription from being deleted.
+		if d.Get(utils.ForceDestroyKey).(bool) {
+			diags = append(diags, utils.ForceDestroyDatabasesDiagnostics(ctx, subId, api)...)
+			if diags.HasError() {
+				return diags
+			}
 		}
 		// Delete subscription once all databases are deleted
 	}
-	err = api.Client.Subscription.Delete(ctx, subId)
+	err = utils.WaitForTask(ctx, fmt.Sprintf("delete subscription %d", subId), func(ctx context.Context) error {
+		return api.Client.Subscription.Delete(ctx, subId)
+	}, func(ctx context.Context) error {
+		return pro.WaitForSubscriptionToBeDeleted(ctx, subId, api)
+	})
 	if err != nil {
-		return diag.FromErr(err)
+		return append(diags, utils.TaskDiagnostics(err)...)
 	}
 
 	d.SetId("")
 
-	err = pro.WaitForSubscriptionToBeDeleted(ctx, subId, api)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
 	return diags
 }
 
@@ -848,7 +1021,7 @@ func buildCreateActiveActiveCloudProviders(provider string, creationPlan map[str
 func buildSubscriptionCreatePlanAADatabases(planMap map[string]interface{}) []*subscriptions.CreateDatabase {
 	createDatabases := make([]*subscriptions.CreateDatabase, 0)
 
-	dbName := "creation-plan-db-"
+	dbName := pro.CreationPlanDatabasePrefix
 	idx := 1
 	numDatabases := planMap["quantity"].(int)
 	memoryLimitInGB := planMap["memory_limit_in_gb"].(float64)
@@ -917,3 +1090,56 @@ func buildAACmks(cmkResources []interface{}) []subscriptions.CustomerManagedKey
 
 	return cmks
 }
+
+// pricingResource is the schema of the entries of pricing and estimated_pricing.
+func pricingResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"database_name": {
+				Description: "The database this pricing entry applies to",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"type": {
+				Description: "The type of cost e.g. 'Shards'",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"type_details": {
+				Description: "Further detail e.g. 'micro'",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"quantity": {
+				Description: "Self-explanatory",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"quantity_measurement": {
+				Description: "Self-explanatory",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"price_per_unit": {
+				Description: "Self-explanatory",
+				Type:        schema.TypeFloat,
+				Computed:    true,
+			},
+			"price_currency": {
+				Description: "Self-explanatory e.g. 'USD'",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"price_period": {
+				Description: "Self-explanatory e.g. 'hour'",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"region": {
+				Description: "Self-explanatory, if the cost is associated with a particular region",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
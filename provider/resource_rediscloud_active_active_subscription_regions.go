@@ -3,9 +3,12 @@ package provider
 import (
 	"context"
 	"errors"
+	"fmt"
+	"log"
 	"reflect"
 	"regexp"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/RedisLabs/terraform-provider-rediscloud/provider/client"
@@ -15,6 +18,7 @@ import (
 	"github.com/RedisLabs/rediscloud-go-api/service/databases"
 	"github.com/RedisLabs/rediscloud-go-api/service/regions"
 	"github.com/RedisLabs/rediscloud-go-api/service/subscriptions"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -27,11 +31,17 @@ func resourceRedisCloudActiveActiveSubscriptionRegions() *schema.Resource {
 		ReadContext:   resourceRedisCloudActiveActiveRegionRead,
 		UpdateContext: resourceRedisCloudActiveActiveRegionUpdate,
 		DeleteContext: resourceRedisCloudActiveActiveRegionDelete,
+		CustomizeDiff: func(ctx context.Context, diff *schema.ResourceDiff, i interface{}) error {
+			api, _ := i.(*client.ApiClient)
+			return checkActiveActiveRegionChanges(ctx, diff, api)
+		},
 		Importer: &schema.ResourceImporter{
 			// Let the READ operation do the heavy lifting for importing values from the API.
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: utils.ImportStateWithIdentity(nil, utils.IdentitySubscriptionId),
 		},
 
+		Identity: utils.SubscriptionIdentity(),
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
 			Read:   schema.DefaultTimeout(10 * time.Minute),
@@ -52,6 +62,12 @@ func resourceRedisCloudActiveActiveSubscriptionRegions() *schema.Resource {
 				Type:        schema.TypeBool,
 				Optional:    true,
 			},
+			"region_changes": {
+				Description: "The regions the apply deletes or recreates, and the databases whose local data that deletes. Kept until the next refresh",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 			"region": {
 				Description: "Cloud networking details, per region (multiple regions for Active-Active cluster)",
 				Type:        schema.TypeSet,
@@ -164,6 +180,12 @@ func resourceRedisCloudActiveActiveRegionUpdate(ctx context.Context, d *schema.R
 
 	desiredRegions := buildRegionsFromResourceData(d.Get("region").(*schema.Set))
 
+	// The plan checks the flags too, unless the subscription ID was unknown.
+	changes := activeActiveRegionChanges(existingRegions.Regions, desiredRegions)
+	if err := checkRegionChanges(changes, deleteRegionsFlag); err != nil {
+		return diag.FromErr(err)
+	}
+
 	// Determine which regions currently exist but aren't in the config
 	// These will need to be deleted
 	regionsToDelete := make([]*regions.Region, 0)
@@ -185,9 +207,6 @@ func resourceRedisCloudActiveActiveRegionUpdate(ctx context.Context, d *schema.R
 			regionsToCreate = append(regionsToCreate, r)
 		} else {
 			if shouldRecreateRegion(r, existingRegion) {
-				if !*r.RecreateRegion || !deleteRegionsFlag {
-					return diag.Errorf("Region %s needs to be recreated but recreate_region flag was not set!", *r.Region)
-				}
 				regionsToRecreate = append(regionsToRecreate, r)
 			} else if shouldUpdateRegionDatabases(r, existingRegion) {
 				regionsToUpdateDatabases = append(regionsToUpdateDatabases, r)
@@ -198,7 +217,7 @@ func resourceRedisCloudActiveActiveRegionUpdate(ctx context.Context, d *schema.R
 	if len(regionsToCreate) > 0 {
 		err := regionsCreate(ctx, subId, regionsToCreate, api)
 		if err != nil {
-			return diag.FromErr(err)
+			return utils.TaskDiagnostics(err)
 		}
 	}
 
@@ -211,37 +230,50 @@ func resourceRedisCloudActiveActiveRegionUpdate(ctx context.Context, d *schema.R
 
 		err := regionsDelete(ctx, subId, regionIds, api)
 		if err != nil {
-			return diag.FromErr(err)
+			return utils.TaskDiagnostics(err)
 		}
 		err = regionsCreate(ctx, subId, regionsToRecreate, api)
 		if err != nil {
-			return diag.FromErr(err)
+			return utils.TaskDiagnostics(err)
 		}
 	}
 
 	if len(regionsToUpdateDatabases) > 0 {
 		err = regionsUpdateDatabases(ctx, subId, api, regionsToUpdateDatabases, existingRegionMap)
 		if err != nil {
-			return diag.FromErr(err)
+			return utils.TaskDiagnostics(err)
 		}
 	}
 
 	if len(regionsToDelete) > 0 {
-		if !deleteRegionsFlag {
-			return diag.Errorf("Region has been removed, but delete_regions flag was not set!")
-		}
-
 		regionIds := make([]*string, 0)
 		for _, r := range regionsToDelete {
 			regionIds = append(regionIds, r.Region)
 		}
 		err := regionsDelete(ctx, subId, regionIds, api)
 		if err != nil {
-			return diag.FromErr(err)
+			return utils.TaskDiagnostics(err)
 		}
 	}
 
-	return resourceRedisCloudActiveActiveRegionRead(ctx, d, meta)
+	var diags diag.Diagnostics
+	for _, change := range changes {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Region local data deleted",
+			Detail:   change.String(),
+		})
+	}
+	diags = append(diags, resourceRedisCloudActiveActiveRegionRead(ctx, d, meta)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// Read clears the changes, which are only kept in state until the next refresh.
+	if err := d.Set("region_changes", describeRegionChanges(changes)); err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	return diags
 }
 
 func resourceRedisCloudActiveActiveRegionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -262,6 +294,12 @@ func resourceRedisCloudActiveActiveRegionRead(ctx context.Context, d *schema.Res
 		return diag.FromErr(err)
 	}
 
+	if err := utils.SetResourceIdentity(d, map[string]interface{}{
+		utils.IdentitySubscriptionId: subId,
+	}); err != nil {
+		return diag.FromErr(err)
+	}
+
 	if err := d.Set("subscription_id", strconv.Itoa(*existingRegions.SubscriptionId)); err != nil {
 		return diag.FromErr(err)
 	}
@@ -310,6 +348,9 @@ func resourceRedisCloudActiveActiveRegionRead(ctx context.Context, d *schema.Res
 	if err := d.Set("region", newRegions); err != nil {
 		return diag.FromErr(err)
 	}
+	if err := d.Set("region_changes", []string{}); err != nil {
+		return diag.FromErr(err)
+	}
 
 	return nil
 }
@@ -325,8 +366,11 @@ func regionsCreate(ctx context.Context, subId int, regionsToCreate []*RequestedR
 		return nil
 	}
 
-	utils.SubscriptionMutex.Lock(subId)
-	defer utils.SubscriptionMutex.Unlock(subId)
+	unlock, err := utils.LockSubscription(ctx, subId)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	// Call GO API createRegion for all non-existing regions
 	for _, currentRegion := range regionsToCreate {
@@ -351,21 +395,11 @@ func regionsCreate(ctx context.Context, subId int, regionsToCreate []*RequestedR
 			Databases:      createDatabases,
 		}
 
-		_, err := api.Client.Regions.Create(ctx, subId, createRegion)
-
-		if err != nil {
-			return err
-		}
-
-		// Wait for the subscription to be active before deleting it.
-		if err := utils.WaitForSubscriptionToBeActive(ctx, subId, api); err != nil {
+		err := utils.WaitForTask(ctx, fmt.Sprintf("create region %s", redis.StringValue(currentRegion.Region)), func(ctx context.Context) error {
+			_, err := api.Client.Regions.Create(ctx, subId, createRegion)
 			return err
-		}
-
-		// There is a timing issue where the subscription is marked as active before the creation-plan databases are deleted.
-		// This additional wait ensures that the databases are deleted before the subscription is deleted.
-		time.Sleep(30 * time.Second) //lintignore:R018
-		if err := utils.WaitForSubscriptionToBeActive(ctx, subId, api); err != nil {
+		}, utils.SubscriptionFallback(subId, api))
+		if err != nil {
 			return err
 		}
 	}
@@ -399,29 +433,22 @@ func regionsUpdateDatabases(ctx context.Context, subId int, api *client.ApiClien
 	}
 
 	if len(databaseUpdates) > 0 {
-		utils.SubscriptionMutex.Lock(subId)
-		defer utils.SubscriptionMutex.Unlock(subId)
+		unlock, err := utils.LockSubscription(ctx, subId)
+		if err != nil {
+			return err
+		}
+		defer unlock()
 
 		for dbId, localRegionProperties := range databaseUpdates {
 			dbUpdate := databases.UpdateActiveActiveDatabase{
 				Regions: localRegionProperties,
 			}
-			err := api.Client.Database.ActiveActiveUpdate(ctx, subId, dbId, dbUpdate)
+			err := utils.WaitForTask(ctx, fmt.Sprintf("update the regions of database %d", dbId), func(ctx context.Context) error {
+				return api.Client.Database.ActiveActiveUpdate(ctx, subId, dbId, dbUpdate)
+			}, utils.SubscriptionFallback(subId, api))
 			if err != nil {
 				return err
 			}
-
-			// Wait for the subscription to be active before deleting it.
-			if err := utils.WaitForSubscriptionToBeActive(ctx, subId, api); err != nil {
-				return err
-			}
-
-			// There is a timing issue where the subscription is marked as active before the creation-plan databases are deleted.
-			// This additional wait ensures that the databases are deleted before the subscription is deleted.
-			time.Sleep(30 * time.Second) //lintignore:R018
-			if err := utils.WaitForSubscriptionToBeActive(ctx, subId, api); err != nil {
-				return err
-			}
 		}
 	}
 
@@ -429,8 +456,11 @@ func regionsUpdateDatabases(ctx context.Context, subId int, api *client.ApiClien
 }
 
 func regionsDelete(ctx context.Context, subId int, regionsToDelete []*string, api *client.ApiClient) error {
-	utils.SubscriptionMutex.Lock(subId)
-	defer utils.SubscriptionMutex.Unlock(subId)
+	unlock, err := utils.LockSubscription(ctx, subId)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	deleteRegions := regions.DeleteRegions{}
 	for _, region := range regionsToDelete {
@@ -440,24 +470,9 @@ func regionsDelete(ctx context.Context, subId int, regionsToDelete []*string, ap
 		deleteRegions.Regions = append(deleteRegions.Regions, &deleteRegion)
 	}
 
-	err := api.Client.Regions.DeleteWithQuery(ctx, subId, deleteRegions)
-	if err != nil {
-		return err
-	}
-
-	// Wait for the subscription to be active before deleting it.
-	if err := utils.WaitForSubscriptionToBeActive(ctx, subId, api); err != nil {
-		return err
-	}
-
-	// There is a timing issue where the subscription is marked as active before the creation-plan databases are deleted.
-	// This additional wait ensures that the databases are deleted before the subscription is deleted.
-	time.Sleep(30 * time.Second) //lintignore:R018
-	if err := utils.WaitForSubscriptionToBeActive(ctx, subId, api); err != nil {
-		return err
-	}
-
-	return nil
+	return utils.WaitForTask(ctx, "delete regions", func(ctx context.Context) error {
+		return api.Client.Regions.DeleteWithQuery(ctx, subId, deleteRegions)
+	}, utils.SubscriptionFallback(subId, api))
 }
 
 func buildRegionsFromResourceData(rd *schema.Set) map[string]*RequestedRegion {
@@ -495,6 +510,162 @@ func buildRegionsFromResourceData(rd *schema.Set) map[string]*RequestedRegion {
 	return result
 }
 
+// checkActiveActiveRegionChanges checks the delete_regions and recreate_region flags are set for the regions the plan
+// deletes or recreates, against the regions the subscription has, and plans region_changes with the changes so they
+// show in the plan. Regions whose name or deployment CIDR is unknown, like the subscription ID of a subscription not
+// yet created, are left to the update, and region_changes is then unknown.
+func checkActiveActiveRegionChanges(ctx context.Context, diff *schema.ResourceDiff, api *client.ApiClient) error {
+	if api == nil {
+		return nil
+	}
+	if !diff.NewValueKnown("subscription_id") {
+		return diff.SetNewComputed("region_changes")
+	}
+	subId, err := strconv.Atoi(diff.Get("subscription_id").(string))
+	if err != nil {
+		return nil
+	}
+
+	config := diff.GetRawConfig()
+	deleteRegions := config.GetAttr("delete_regions")
+	if !deleteRegions.IsKnown() {
+		return diff.SetNewComputed("region_changes")
+	}
+	desiredRegions, ok := plannedRegions(config.GetAttr("region"))
+	if !ok {
+		return diff.SetNewComputed("region_changes")
+	}
+
+	existingRegions, err := api.Client.Regions.List(ctx, subId)
+	if err != nil {
+		log.Printf("[WARN] Couldn't list the regions of subscription %d to check the regions deleted: %s", subId, err)
+		return diff.SetNewComputed("region_changes")
+	}
+
+	changes := activeActiveRegionChanges(existingRegions.Regions, desiredRegions)
+	if err := checkRegionChanges(changes, !deleteRegions.IsNull() && deleteRegions.True()); err != nil {
+		return err
+	}
+	if len(changes) == 0 {
+		// The changes of the last apply are left as they are, rather than planning an update to clear them.
+		return nil
+	}
+	return diff.SetNew("region_changes", describeRegionChanges(changes))
+}
+
+// describeRegionChanges returns the description of each change.
+func describeRegionChanges(changes []regionChange) []string {
+	descriptions := make([]string, 0, len(changes))
+	for _, change := range changes {
+		descriptions = append(descriptions, change.String())
+	}
+	return descriptions
+}
+
+// plannedRegions returns the regions configured, by name, or false if any name is unknown. The deployment CIDR and
+// recreate_region flag of a region are nil while unknown.
+func plannedRegions(config cty.Value) (map[string]*RequestedRegion, bool) {
+	if !config.IsKnown() || config.IsNull() {
+		return nil, false
+	}
+
+	result := make(map[string]*RequestedRegion)
+	for it := config.ElementIterator(); it.Next(); {
+		_, element := it.Element()
+		if !element.IsKnown() {
+			return nil, false
+		}
+		name := element.GetAttr("region")
+		if !name.IsKnown() || name.IsNull() {
+			return nil, false
+		}
+
+		region := &RequestedRegion{Region: redis.String(name.AsString())}
+		if cidr := element.GetAttr("networking_deployment_cidr"); cidr.IsKnown() && !cidr.IsNull() {
+			region.DeploymentCIDR = redis.String(cidr.AsString())
+		}
+		if recreate := element.GetAttr("recreate_region"); recreate.IsKnown() {
+			region.RecreateRegion = redis.Bool(!recreate.IsNull() && recreate.True())
+		}
+		result[*region.Region] = region
+	}
+	return result, true
+}
+
+// regionChange is an existing region which is deleted, or recreated with the deployment CIDR desired. Either way, the
+// local data of its databases is deleted.
+type regionChange struct {
+	existing *regions.Region
+	desired  *RequestedRegion
+}
+
+// activeActiveRegionChanges returns the existing regions which aren't desired, and those whose deployment CIDR is
+// changed. Regions whose deployment CIDR is unknown aren't recreated.
+func activeActiveRegionChanges(existingRegions []*regions.Region, desiredRegions map[string]*RequestedRegion) []regionChange {
+	var changes []regionChange
+	for _, existing := range existingRegions {
+		desired, ok := desiredRegions[redis.StringValue(existing.Region)]
+		switch {
+		case !ok:
+			changes = append(changes, regionChange{existing: existing})
+		case desired.DeploymentCIDR != nil && shouldRecreateRegion(desired, existing):
+			changes = append(changes, regionChange{existing: existing, desired: desired})
+		}
+	}
+	return changes
+}
+
+// missingFlags returns the flags the change needs which aren't set. The recreate_region flag of a region isn't missing
+// while it's unknown.
+func (c regionChange) missingFlags(deleteRegions bool) []string {
+	var missing []string
+	if c.desired != nil && c.desired.RecreateRegion != nil && !*c.desired.RecreateRegion {
+		missing = append(missing, "recreate_region")
+	}
+	if !deleteRegions {
+		missing = append(missing, "delete_regions")
+	}
+	return missing
+}
+
+// String describes the change, e.g. `removing region eu-west-1 deletes the local data of database "cache"`.
+func (c regionChange) String() string {
+	name := redis.StringValue(c.existing.Region)
+	description := fmt.Sprintf("removing region %s deletes the region", name)
+	if c.desired != nil {
+		description = fmt.Sprintf("changing the deployment CIDR of region %s from %s to %s recreates the region", name,
+			redis.StringValue(c.existing.DeploymentCIDR), redis.StringValue(c.desired.DeploymentCIDR))
+	}
+
+	var names []string
+	for _, database := range c.existing.Databases {
+		names = append(names, fmt.Sprintf("%q", redis.StringValue(database.DatabaseName)))
+	}
+	switch len(names) {
+	case 0:
+		return description
+	case 1:
+		return fmt.Sprintf("%s, deleting the local data of database %s", description, names[0])
+	}
+	return fmt.Sprintf("%s, deleting the local data of databases %s and %s", description,
+		strings.Join(names[:len(names)-1], ", "), names[len(names)-1])
+}
+
+// checkRegionChanges returns an error for each change whose flags aren't set.
+func checkRegionChanges(changes []regionChange, deleteRegions bool) error {
+	var errs []error
+	for _, change := range changes {
+		switch missing := change.missingFlags(deleteRegions); len(missing) {
+		case 0:
+		case 1:
+			errs = append(errs, fmt.Errorf("%s, but the %s flag was not set", change, missing[0]))
+		default:
+			errs = append(errs, fmt.Errorf("%s, but the %s flags were not set", change, strings.Join(missing, " and ")))
+		}
+	}
+	return errors.Join(errs...)
+}
+
 func shouldRecreateRegion(desiredRegion *RequestedRegion, existingRegion *regions.Region) bool {
 	return *existingRegion.DeploymentCIDR != *desiredRegion.DeploymentCIDR
 }
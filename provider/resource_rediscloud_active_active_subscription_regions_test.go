@@ -2,15 +2,25 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"testing"
 
 	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/RedisLabs/rediscloud-go-api/service/regions"
+	"github.com/RedisLabs/rediscloud-go-api/service/subscriptions"
+	"github.com/hashicorp/go-cty/cty"
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/client"
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/fakeapi"
 	"github.com/RedisLabs/terraform-provider-rediscloud/provider/utils"
 )
 
@@ -332,3 +342,229 @@ resource "rediscloud_active_active_subscription_regions" "example" {
  }
  
 `
+
+func TestUnitCheckRegionChanges(t *testing.T) {
+	existing := []*regions.Region{
+		{Region: redis.String("us-east-1"), DeploymentCIDR: redis.String("10.0.0.0/24"), Databases: []*regions.Database{
+			{DatabaseName: redis.String("cache")},
+		}},
+		{Region: redis.String("eu-west-1"), DeploymentCIDR: redis.String("10.0.1.0/24"), Databases: []*regions.Database{
+			{DatabaseName: redis.String("cache")},
+			{DatabaseName: redis.String("sessions")},
+			{DatabaseName: redis.String("queue")},
+		}},
+		{Region: redis.String("ap-southeast-1"), DeploymentCIDR: redis.String("10.0.2.0/24")},
+	}
+	desired := func(cidr *string, recreate *bool) map[string]*RequestedRegion {
+		return map[string]*RequestedRegion{
+			"us-east-1": {Region: redis.String("us-east-1"), DeploymentCIDR: redis.String("10.0.0.0/24"), RecreateRegion: redis.Bool(false)},
+			"eu-west-1": {Region: redis.String("eu-west-1"), DeploymentCIDR: cidr, RecreateRegion: recreate},
+		}
+	}
+
+	tests := []struct {
+		name          string
+		desired       map[string]*RequestedRegion
+		deleteRegions bool
+		changes       []string
+		err           string
+	}{
+		{
+			name:    "region removed",
+			desired: desired(redis.String("10.0.1.0/24"), redis.Bool(false)),
+			changes: []string{"removing region ap-southeast-1 deletes the region"},
+			err:     "removing region ap-southeast-1 deletes the region, but the delete_regions flag was not set",
+		},
+		{
+			name:          "region removed with delete_regions",
+			desired:       desired(redis.String("10.0.1.0/24"), redis.Bool(false)),
+			deleteRegions: true,
+			changes:       []string{"removing region ap-southeast-1 deletes the region"},
+		},
+		{
+			name:    "region recreated",
+			desired: desired(redis.String("10.0.3.0/24"), redis.Bool(false)),
+			changes: []string{
+				`changing the deployment CIDR of region eu-west-1 from 10.0.1.0/24 to 10.0.3.0/24 recreates the region, deleting the local data of databases "cache", "sessions" and "queue"`,
+				"removing region ap-southeast-1 deletes the region",
+			},
+			err: `changing the deployment CIDR of region eu-west-1 from 10.0.1.0/24 to 10.0.3.0/24 recreates the region, deleting the local data of databases "cache", "sessions" and "queue", but the recreate_region and delete_regions flags were not set` + "\n" +
+				"removing region ap-southeast-1 deletes the region, but the delete_regions flag was not set",
+		},
+		{
+			name:          "region recreated without recreate_region",
+			desired:       desired(redis.String("10.0.3.0/24"), redis.Bool(false)),
+			deleteRegions: true,
+			changes: []string{
+				`changing the deployment CIDR of region eu-west-1 from 10.0.1.0/24 to 10.0.3.0/24 recreates the region, deleting the local data of databases "cache", "sessions" and "queue"`,
+				"removing region ap-southeast-1 deletes the region",
+			},
+			err: `changing the deployment CIDR of region eu-west-1 from 10.0.1.0/24 to 10.0.3.0/24 recreates the region, deleting the local data of databases "cache", "sessions" and "queue", but the recreate_region flag was not set`,
+		},
+		{
+			name:          "region recreated with both flags",
+			desired:       desired(redis.String("10.0.3.0/24"), redis.Bool(true)),
+			deleteRegions: true,
+			changes: []string{
+				`changing the deployment CIDR of region eu-west-1 from 10.0.1.0/24 to 10.0.3.0/24 recreates the region, deleting the local data of databases "cache", "sessions" and "queue"`,
+				"removing region ap-southeast-1 deletes the region",
+			},
+		},
+		{
+			name:          "unknown deployment CIDR",
+			desired:       desired(nil, nil),
+			deleteRegions: true,
+			changes:       []string{"removing region ap-southeast-1 deletes the region"},
+		},
+		{
+			name:          "unknown recreate_region",
+			desired:       desired(redis.String("10.0.3.0/24"), nil),
+			deleteRegions: true,
+			changes: []string{
+				`changing the deployment CIDR of region eu-west-1 from 10.0.1.0/24 to 10.0.3.0/24 recreates the region, deleting the local data of databases "cache", "sessions" and "queue"`,
+				"removing region ap-southeast-1 deletes the region",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			changes := activeActiveRegionChanges(existing, test.desired)
+			var descriptions []string
+			for _, change := range changes {
+				descriptions = append(descriptions, change.String())
+			}
+			assert.Equal(t, test.changes, descriptions)
+
+			err := checkRegionChanges(changes, test.deleteRegions)
+			if test.err == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, test.err)
+		})
+	}
+
+	removed := regionChange{existing: existing[0]}
+	assert.Equal(t, `removing region us-east-1 deletes the region, deleting the local data of database "cache"`, removed.String())
+}
+
+func TestUnitPlannedRegions(t *testing.T) {
+	regionType := cty.Object(map[string]cty.Type{
+		"region":                     cty.String,
+		"networking_deployment_cidr": cty.String,
+		"recreate_region":            cty.Bool,
+	})
+	region := func(name cty.Value, cidr cty.Value, recreate cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{"region": name, "networking_deployment_cidr": cidr, "recreate_region": recreate})
+	}
+
+	planned, ok := plannedRegions(cty.SetVal([]cty.Value{
+		region(cty.StringVal("us-east-1"), cty.StringVal("10.0.0.0/24"), cty.True),
+		region(cty.StringVal("eu-west-1"), cty.UnknownVal(cty.String), cty.NullVal(cty.Bool)),
+		region(cty.StringVal("ap-southeast-1"), cty.StringVal("10.0.2.0/24"), cty.UnknownVal(cty.Bool)),
+	}))
+	require.True(t, ok)
+	assert.Equal(t, map[string]*RequestedRegion{
+		"us-east-1":      {Region: redis.String("us-east-1"), DeploymentCIDR: redis.String("10.0.0.0/24"), RecreateRegion: redis.Bool(true)},
+		"eu-west-1":      {Region: redis.String("eu-west-1"), RecreateRegion: redis.Bool(false)},
+		"ap-southeast-1": {Region: redis.String("ap-southeast-1"), DeploymentCIDR: redis.String("10.0.2.0/24")},
+	}, planned)
+
+	_, ok = plannedRegions(cty.SetVal([]cty.Value{
+		region(cty.UnknownVal(cty.String), cty.StringVal("10.0.0.0/24"), cty.False),
+	}))
+	assert.False(t, ok)
+
+	_, ok = plannedRegions(cty.UnknownVal(cty.Set(regionType)))
+	assert.False(t, ok)
+}
+
+func TestUnitActiveActiveRegionChangesPlan(t *testing.T) {
+	ctx := context.Background()
+	scale := utils.WaitIntervalScale
+	utils.WaitIntervalScale = 0.0001
+	t.Cleanup(func() { utils.WaitIntervalScale = scale })
+
+	fake := fakeapi.New(fakeapi.Options{ProvisioningPolls: 2})
+	t.Cleanup(fake.Close)
+	api, err := fake.Client()
+	require.NoError(t, err)
+
+	subId, err := api.Subscription.Create(ctx, subscriptions.CreateSubscription{
+		Name:            redis.String("regions"),
+		PaymentMethodID: redis.Int(fakeapi.PaymentMethodId),
+		DeploymentType:  redis.String(subscriptions.SubscriptionDeploymentTypeActiveActive),
+		CloudProviders: []*subscriptions.CreateCloudProvider{{
+			Provider: redis.String("AWS"),
+			Regions: []*subscriptions.CreateRegion{
+				{Region: redis.String("us-east-1"), Networking: &subscriptions.CreateNetworking{DeploymentCIDR: redis.String("10.0.0.0/24")}},
+				{Region: redis.String("eu-west-1"), Networking: &subscriptions.CreateNetworking{DeploymentCIDR: redis.String("10.0.1.0/24")}},
+			},
+		}},
+	})
+	require.NoError(t, err)
+
+	region := func(name string, cidr string) map[string]interface{} {
+		return map[string]interface{}{
+			"region":                     name,
+			"networking_deployment_cidr": cidr,
+			"database": []interface{}{map[string]interface{}{
+				"database_id":                       1,
+				"database_name":                     "cache",
+				"local_write_operations_per_second": 1000,
+				"local_read_operations_per_second":  1000,
+			}},
+		}
+	}
+	configuration := func(deleteRegions bool, regions ...map[string]interface{}) map[string]interface{} {
+		elements := make([]interface{}, 0, len(regions))
+		for _, r := range regions {
+			elements = append(elements, r)
+		}
+		return map[string]interface{}{
+			"subscription_id": strconv.Itoa(subId),
+			"delete_regions":  deleteRegions,
+			"region":          elements,
+		}
+	}
+
+	r := resourceRedisCloudActiveActiveSubscriptionRegions()
+	d := schema.TestResourceDataRaw(t, r.Schema, configuration(false, region("us-east-1", "10.0.0.0/24"), region("eu-west-1", "10.0.1.0/24")))
+	d.SetId(strconv.Itoa(subId))
+	require.NoError(t, d.Set("region_changes", []string{}))
+
+	plan := func(t *testing.T, raw map[string]interface{}) (*terraform.InstanceDiff, error) {
+		encoded, err := json.Marshal(raw)
+		require.NoError(t, err)
+		config, err := ctyjson.Unmarshal(encoded, r.CoreConfigSchema().ImpliedType())
+		require.NoError(t, err)
+
+		state := d.State()
+		state.RawConfig = config
+		return r.SimpleDiff(ctx, state, terraform.NewResourceConfigShimmed(config, r.CoreConfigSchema()), &client.ApiClient{Client: api})
+	}
+
+	t.Run("unchanged", func(t *testing.T) {
+		diff, err := plan(t, configuration(false, region("us-east-1", "10.0.0.0/24"), region("eu-west-1", "10.0.1.0/24")))
+		require.NoError(t, err)
+		assert.NotContains(t, diff.Attributes, "region_changes.#")
+	})
+
+	t.Run("changed", func(t *testing.T) {
+		recreated := region("us-east-1", "10.0.2.0/24")
+		recreated["recreate_region"] = true
+		diff, err := plan(t, configuration(true, recreated))
+		require.NoError(t, err)
+		require.Contains(t, diff.Attributes, "region_changes.#")
+		assert.Equal(t, "2", diff.Attributes["region_changes.#"].New)
+		assert.Equal(t, "changing the deployment CIDR of region us-east-1 from 10.0.0.0/24 to 10.0.2.0/24 recreates the region",
+			diff.Attributes["region_changes.0"].New)
+		assert.Equal(t, "removing region eu-west-1 deletes the region", diff.Attributes["region_changes.1"].New)
+	})
+
+	t.Run("without delete_regions", func(t *testing.T) {
+		_, err := plan(t, configuration(false, region("us-east-1", "10.0.0.0/24")))
+		assert.ErrorContains(t, err, "removing region eu-west-1 deletes the region, but the delete_regions flag was not set")
+	})
+}
@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
 	"strconv"
 	"time"
@@ -10,11 +11,11 @@ import (
 	"github.com/RedisLabs/rediscloud-go-api/redis"
 	"github.com/RedisLabs/rediscloud-go-api/service/cloud_accounts"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	client2 "github.com/RedisLabs/terraform-provider-rediscloud/provider/client"
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/utils"
 )
 
 func resourceRedisCloudCloudAccount() *schema.Resource {
@@ -26,15 +27,17 @@ func resourceRedisCloudCloudAccount() *schema.Resource {
 		DeleteContext: resourceRedisCloudCloudAccountDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			StateContext: utils.ImportStateWithIdentity(func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 				_, err := strconv.Atoi(d.Id())
 				if err != nil {
 					return nil, err
 				}
 				return []*schema.ResourceData{d}, nil
-			},
+			}, utils.IdentityId),
 		},
 
+		Identity: utils.AccountIdentity(),
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
 			Read:   schema.DefaultTimeout(5 * time.Minute),
@@ -126,11 +129,12 @@ func resourceRedisCloudCloudAccountCreate(ctx context.Context, d *schema.Resourc
 }
 
 func waitForCloudAccountToBeActive(ctx context.Context, id int, client *client2.ApiClient) error {
-	wait := &retry.StateChangeConf{
-		Delay:   10 * time.Second,
-		Pending: []string{cloud_accounts.StatusDraft, cloud_accounts.StatusChangeDraft},
-		Target:  []string{cloud_accounts.StatusActive},
-		Timeout: 1 * time.Minute,
+	wait := &utils.Waiter{
+		Description: fmt.Sprintf("cloud account %d", id),
+		Delay:       10 * time.Second,
+		Pending:     []string{cloud_accounts.StatusDraft, cloud_accounts.StatusChangeDraft},
+		Target:      []string{cloud_accounts.StatusActive},
+		Timeout:     1 * time.Minute,
 
 		Refresh: func() (result interface{}, state string, err error) {
 			log.Printf("[DEBUG] Waiting for cloud account %d to be active", id)
@@ -144,7 +148,7 @@ func waitForCloudAccountToBeActive(ctx context.Context, id int, client *client2.
 			return status, status, nil
 		},
 	}
-	if _, err := wait.WaitForStateContext(ctx); err != nil {
+	if _, err := wait.Wait(ctx); err != nil {
 		return err
 	}
 
@@ -171,6 +175,12 @@ func resourceRedisCloudCloudAccountRead(ctx context.Context, d *schema.ResourceD
 		return diag.FromErr(err)
 	}
 
+	if err := utils.SetResourceIdentity(d, map[string]interface{}{
+		utils.IdentityId: id,
+	}); err != nil {
+		return diag.FromErr(err)
+	}
+
 	if err := d.Set("access_key_id", redis.StringValue(account.AccessKeyID)); err != nil {
 		return diag.FromErr(err)
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/RedisLabs/terraform-provider-rediscloud/provider/client"
 	"github.com/RedisLabs/terraform-provider-rediscloud/provider/privatelink"
@@ -20,6 +21,30 @@ import (
 
 const RedisCloudUrlEnvVar = "REDISCLOUD_URL"
 
+// BatchDatabaseChangesEnvVar sets the batch_database_changes option when it isn't configured.
+const BatchDatabaseChangesEnvVar = "REDISCLOUD_BATCH_DATABASE_CHANGES"
+
+// batchDatabaseChangesDescription describes the batch_database_changes option, in both the SDK and the framework
+// provider's schema.
+var batchDatabaseChangesDescription = fmt.Sprintf("When true, database creates and updates made at the same time "+
+	"against the same subscription are grouped, and run back to back under a single lock on the subscription, each "+
+	"starting as soon as the previous one's task finishes. Defaults to false, and can also be set by the `%s` "+
+	"environment variable.", BatchDatabaseChangesEnvVar)
+
+// maxMonthlyCostDescription describes the max_monthly_cost option, in both the SDK and the framework provider's
+// schema.
+const maxMonthlyCostDescription = "The highest monthly cost allowed for each subscription, in the currency of its " +
+	"pricing. Plans creating a subscription whose `estimated_pricing` costs more fail, as do plans changing a " +
+	"subscription whose current `pricing` costs more. A subscription's own `max_monthly_cost` applies if it is lower. " +
+	"Plans creating a `rediscloud_subscription_database`, or changing its size, throughput or replication, fail if a " +
+	"dry run of the change prices its subscription over this."
+
+// checkAccountCIDROverlapsDescription describes the check_account_cidr_overlaps option, in both the SDK and the
+// framework provider's schema.
+const checkAccountCIDROverlapsDescription = "When true, the deployment CIDRs of subscriptions being created are " +
+	"checked during plan against those of every other subscription in the account, which lists the account's " +
+	"subscriptions. Defaults to false."
+
 func init() {
 	schema.DescriptionKind = schema.StringMarkdown
 }
@@ -46,11 +71,30 @@ func NewSdkProvider(version string) func() *schema.Provider {
 					Description: fmt.Sprintf("This is the Redis Cloud API secret key. It must be provided but can also be set by the `%s` environment variable.", rediscloudApi.SecretKeyEnvVar),
 					Optional:    true,
 				},
+				"batch_database_changes": {
+					Type:        schema.TypeBool,
+					Description: batchDatabaseChangesDescription,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc(BatchDatabaseChangesEnvVar, false),
+				},
+				"max_monthly_cost": {
+					Type:         schema.TypeFloat,
+					Description:  maxMonthlyCostDescription,
+					Optional:     true,
+					ValidateFunc: validation.FloatAtLeast(0),
+				},
+				"check_account_cidr_overlaps": {
+					Type:        schema.TypeBool,
+					Description: checkAccountCIDROverlapsDescription,
+					Optional:    true,
+				},
 			},
 			DataSourcesMap: map[string]*schema.Resource{
 				// Note the difference in public data-source name and the file/method name.
 				// This is to help the developer relate their changes to what they would see happening in the Redis Console.
 				// <default> == flexible == pro
+				// Note: the peering, transit gateway, Private Service Connect and PrivateLink resources are served by
+				// the Plugin Framework provider
 				"rediscloud_subscription":                               pro.DataSourceRedisCloudProSubscription(),
 				"rediscloud_database":                                   pro.DataSourceRedisCloudProDatabase(),
 				"rediscloud_database_modules":                           dataSourceRedisCloudDatabaseModules(),
@@ -82,36 +126,15 @@ func NewSdkProvider(version string) func() *schema.Provider {
 				"rediscloud_acl_user":                                        dataSourceRedisCloudAclUser(),
 			},
 			ResourcesMap: map[string]*schema.Resource{
-				"rediscloud_cloud_account":           resourceRedisCloudCloudAccount(),
-				"rediscloud_essentials_subscription": resourceRedisCloudEssentialsSubscription(),
-				"rediscloud_essentials_database":     resourceRedisCloudEssentialsDatabase(),
+				"rediscloud_cloud_account": resourceRedisCloudCloudAccount(),
 				// Note the difference in public resource name and the file/method name.
 				// <default> == flexible == pro
-				"rediscloud_subscription":                              pro.ResourceRedisCloudProSubscription(),
-				"rediscloud_subscription_database":                     pro.ResourceRedisCloudProDatabase(),
-				"rediscloud_subscription_peering":                      resourceRedisCloudSubscriptionPeering(),
-				"rediscloud_private_service_connect":                   resourceRedisCloudPrivateServiceConnect(),
-				"rediscloud_private_service_connect_endpoint":          resourceRedisCloudPrivateServiceConnectEndpoint(),
-				"rediscloud_private_service_connect_endpoint_accepter": resourceRedisCloudPrivateServiceConnectEndpointAccepter(),
-				"rediscloud_private_link":                              privatelink.ResourceRedisCloudPrivateLink(),
-				"rediscloud_active_active_private_link":                privatelink.ResourceRedisCloudActiveActivePrivateLink(),
 
 				"rediscloud_active_active_subscription": resourceRedisCloudActiveActiveSubscription(),
 				// Note: rediscloud_active_active_subscription_database is served by the Plugin Framework provider
-				"rediscloud_active_active_subscription_regions":                      resourceRedisCloudActiveActiveSubscriptionRegions(),
-				"rediscloud_active_active_subscription_peering":                      resourceRedisCloudActiveActiveSubscriptionPeering(),
-				"rediscloud_active_active_private_service_connect":                   resourceRedisCloudActiveActivePrivateServiceConnect(),
-				"rediscloud_active_active_private_service_connect_endpoint":          resourceRedisCloudActiveActivePrivateServiceConnectEndpoint(),
-				"rediscloud_active_active_private_service_connect_endpoint_accepter": resourceRedisCloudActiveActivePrivateServiceConnectEndpointAccepter(),
-				"rediscloud_transit_gateway_attachment":                              resourceRedisCloudTransitGatewayAttachment(),
-				"rediscloud_active_active_transit_gateway_attachment":                resourceRedisCloudActiveActiveTransitGatewayAttachment(),
-				"rediscloud_transit_gateway_route":                                   resourceRedisCloudTransitGatewayRoute(),
-				"rediscloud_active_active_transit_gateway_route":                     resourceRedisCloudActiveActiveTransitGatewayRoute(),
-				"rediscloud_transit_gateway_invitation_acceptor":                     transitgateway.ResourceRedisCloudTransitGatewayInvitationAcceptor(),
-				"rediscloud_active_active_transit_gateway_invitation_acceptor":       transitgateway.ResourceRedisCloudActiveActiveTransitGatewayInvitationAcceptor(),
-				"rediscloud_acl_rule":                                                resourceRedisCloudAclRule(),
-				"rediscloud_acl_role":                                                resourceRedisCloudAclRole(),
-				"rediscloud_acl_user":                                                resourceRedisCloudAclUser(),
+				"rediscloud_active_active_subscription_regions":                resourceRedisCloudActiveActiveSubscriptionRegions(),
+				"rediscloud_transit_gateway_invitation_acceptor":               transitgateway.ResourceRedisCloudTransitGatewayInvitationAcceptor(),
+				"rediscloud_active_active_transit_gateway_invitation_acceptor": transitgateway.ResourceRedisCloudActiveActiveTransitGatewayInvitationAcceptor(),
 			},
 		}
 
@@ -144,13 +167,22 @@ func configure(version string, p *schema.Provider) func(context.Context, *schema
 
 		config = append(config, rediscloudApi.Logger(&debugLogger{}))
 
+		transport, err := client.NewTransport()
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+		config = append(config, rediscloudApi.Transporter(transport))
+
 		apiClient, err := rediscloudApi.NewClient(config...)
 		if err != nil {
 			return nil, diag.FromErr(err)
 		}
 
 		return &client.ApiClient{
-			Client: apiClient,
+			Client:                   apiClient,
+			BatchDatabaseChanges:     d.Get("batch_database_changes").(bool),
+			MaxMonthlyCost:           d.Get("max_monthly_cost").(float64),
+			CheckAccountCIDROverlaps: d.Get("check_account_cidr_overlaps").(bool),
 		}, nil
 	}
 }
@@ -18,6 +18,10 @@ import (
 	fixedSubscriptions "github.com/RedisLabs/rediscloud-go-api/service/fixed/subscriptions"
 	"github.com/RedisLabs/rediscloud-go-api/service/subscriptions"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/client"
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/fakeapi"
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/utils"
 )
 
 const testResourcePrefix = "tf-test"
@@ -48,11 +52,38 @@ func (c *sweepErrorCollector) result() error {
 	return errors.Join(c.errors...)
 }
 
+// fakeApiEnvVar points the acceptance tests at an in-process fake of the Redis Cloud API instead of a real account,
+// so CRUD, import and drift paths can be exercised offline. Essentials resources aren't supported by the fake.
+const fakeApiEnvVar = "REDISCLOUD_FAKE_API"
+
 func TestMain(m *testing.M) {
 	sweeperClients = make(map[string]*rediscloudApi.Client)
+
+	if os.Getenv(fakeApiEnvVar) != "" {
+		// resource.TestMain exits the process, which also shuts the fake down.
+		fake := fakeapi.New(fakeapi.DefaultOptions)
+		_ = os.Setenv(RedisCloudUrlEnvVar, fake.URL)
+		_ = os.Setenv(rediscloudApi.AccessKeyEnvVar, "fake-access-key")
+		_ = os.Setenv(rediscloudApi.SecretKeyEnvVar, "fake-secret-key")
+	}
+
+	if mode, _ := client.VCRMode(); mode == client.VCRModeReplay {
+		// Nothing is sent to the API, so any credentials will do, and there is no need to wait between polls.
+		setDefaultEnv(RedisCloudUrlEnvVar, "https://api.redislabs.com/v1")
+		setDefaultEnv(rediscloudApi.AccessKeyEnvVar, "replay-access-key")
+		setDefaultEnv(rediscloudApi.SecretKeyEnvVar, "replay-secret-key")
+		utils.WaitIntervalScale = 0.001
+	}
+
 	resource.TestMain(m)
 }
 
+func setDefaultEnv(name string, value string) {
+	if _, ok := os.LookupEnv(name); !ok {
+		_ = os.Setenv(name, value)
+	}
+}
+
 func sharedClientForRegion(region string) (*rediscloudApi.Client, error) {
 	if client, ok := sweeperClients[region]; ok {
 		return client, nil
@@ -9,10 +9,10 @@ import (
 
 	"github.com/RedisLabs/rediscloud-go-api/service/transit_gateway/attachments"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/RedisLabs/terraform-provider-rediscloud/provider/client"
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/utils"
 )
 
 func DataSourceRedisCloudActiveActiveTransitGatewayInvitations() *schema.Resource {
@@ -97,7 +97,8 @@ func dataSourceRedisCloudActiveActiveTransitGatewayInvitationsRead(ctx context.C
 
 	if waitTimeoutSeconds > 0 {
 		// Wait for invitations to appear
-		wait := &retry.StateChangeConf{
+		wait := &utils.Waiter{
+			Description:  fmt.Sprintf("Transit Gateway invitations for subscription %d, region %d", subId, regionId),
 			Pending:      []string{"waiting"},
 			Target:       []string{"found"},
 			Timeout:      time.Duration(waitTimeoutSeconds) * time.Second,
@@ -120,7 +121,7 @@ func dataSourceRedisCloudActiveActiveTransitGatewayInvitationsRead(ctx context.C
 			},
 		}
 
-		result, err := wait.WaitForStateContext(ctx)
+		result, err := wait.Wait(ctx)
 		if err != nil {
 			return diag.Errorf("Timeout waiting for Active-Active Transit Gateway invitations to appear for subscription %d, region %d: %s", subId, regionId, err)
 		}
@@ -2,6 +2,7 @@ package transitgateway
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"strconv"
 	"time"
@@ -9,10 +10,10 @@ import (
 	"github.com/RedisLabs/rediscloud-go-api/redis"
 	"github.com/RedisLabs/rediscloud-go-api/service/transit_gateway/attachments"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/RedisLabs/terraform-provider-rediscloud/provider/client"
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/utils"
 )
 
 func DataSourceRedisCloudTransitGatewayInvitations() *schema.Resource {
@@ -91,7 +92,8 @@ func dataSourceRedisCloudTransitGatewayInvitationsRead(ctx context.Context, d *s
 
 	if waitTimeoutSeconds > 0 {
 		// Wait for invitations to appear
-		wait := &retry.StateChangeConf{
+		wait := &utils.Waiter{
+			Description:  fmt.Sprintf("Transit Gateway invitations for subscription %d", subId),
 			Pending:      []string{"waiting"},
 			Target:       []string{"found"},
 			Timeout:      time.Duration(waitTimeoutSeconds) * time.Second,
@@ -114,7 +116,7 @@ func dataSourceRedisCloudTransitGatewayInvitationsRead(ctx context.Context, d *s
 			},
 		}
 
-		result, err := wait.WaitForStateContext(ctx)
+		result, err := wait.Wait(ctx)
 		if err != nil {
 			return diag.Errorf("Timeout waiting for Transit Gateway invitations to appear for subscription %d: %s", subId, err)
 		}
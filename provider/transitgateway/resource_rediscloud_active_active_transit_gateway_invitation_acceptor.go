@@ -26,9 +26,11 @@ func ResourceRedisCloudActiveActiveTransitGatewayInvitationAcceptor() *schema.Re
 		DeleteContext: resourceRedisCloudActiveActiveTransitGatewayInvitationAcceptorDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: utils.ImportStateWithIdentity(nil, utils.IdentitySubscriptionId, utils.IdentityRegionId, utils.IdentityTgwInvitationId),
 		},
 
+		Identity: utils.NewResourceIdentity(utils.IdentitySubscriptionId, utils.IdentityRegionId, utils.IdentityTgwInvitationId),
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Read:   schema.DefaultTimeout(10 * time.Minute),
@@ -98,10 +100,13 @@ func resourceRedisCloudActiveActiveTransitGatewayInvitationAcceptorCreate(ctx co
 		return diag.FromErr(err)
 	}
 
-	utils.SubscriptionMutex.Lock(subscriptionId)
-	defer utils.SubscriptionMutex.Unlock(subscriptionId)
-
 	regionId := d.Get("region_id").(int)
+
+	unlock, err := utils.RLockActiveActiveRegion(ctx, api, subscriptionId, regionId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer unlock()
 	tgwInvitationId := d.Get("tgw_invitation_id").(int)
 	action := d.Get("action").(string)
 
@@ -167,6 +172,14 @@ func resourceRedisCloudActiveActiveTransitGatewayInvitationAcceptorRead(ctx cont
 		return diags
 	}
 
+	if err := utils.SetResourceIdentity(d, map[string]interface{}{
+		utils.IdentitySubscriptionId:  subscriptionId,
+		utils.IdentityRegionId:        regionId,
+		utils.IdentityTgwInvitationId: tgwInvitationId,
+	}); err != nil {
+		return diag.FromErr(err)
+	}
+
 	if err := d.Set("subscription_id", strconv.Itoa(subscriptionId)); err != nil {
 		return diag.FromErr(err)
 	}
@@ -27,9 +27,11 @@ func ResourceRedisCloudTransitGatewayInvitationAcceptor() *schema.Resource {
 		DeleteContext: resourceRedisCloudTransitGatewayInvitationAcceptorDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: utils.ImportStateWithIdentity(nil, utils.IdentitySubscriptionId, utils.IdentityTgwInvitationId),
 		},
 
+		Identity: utils.NewResourceIdentity(utils.IdentitySubscriptionId, utils.IdentityTgwInvitationId),
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Read:   schema.DefaultTimeout(10 * time.Minute),
@@ -93,8 +95,11 @@ func resourceRedisCloudTransitGatewayInvitationAcceptorCreate(ctx context.Contex
 		return diag.FromErr(err)
 	}
 
-	utils.SubscriptionMutex.Lock(subscriptionId)
-	defer utils.SubscriptionMutex.Unlock(subscriptionId)
+	unlock, err := utils.RLockSubscription(ctx, subscriptionId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer unlock()
 
 	tgwInvitationId := d.Get("tgw_invitation_id").(int)
 	action := d.Get("action").(string)
@@ -161,6 +166,13 @@ func resourceRedisCloudTransitGatewayInvitationAcceptorRead(ctx context.Context,
 		return diags
 	}
 
+	if err := utils.SetResourceIdentity(d, map[string]interface{}{
+		utils.IdentitySubscriptionId:  subscriptionId,
+		utils.IdentityTgwInvitationId: tgwInvitationId,
+	}); err != nil {
+		return diag.FromErr(err)
+	}
+
 	if err := d.Set("subscription_id", strconv.Itoa(subscriptionId)); err != nil {
 		return diag.FromErr(err)
 	}
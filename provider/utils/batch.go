@@ -0,0 +1,178 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/RedisLabs/rediscloud-go-api/service/databases"
+	"github.com/RedisLabs/rediscloud-go-api/service/subscriptions"
+
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/client"
+)
+
+// DatabaseChangeWindow is how long the first database change on a subscription waits for others to join its batch.
+const DatabaseChangeWindow = 5 * time.Second
+
+// DatabaseChanges coalesces the database creates and updates made at the same time against a subscription, when
+// the provider's batch_database_changes option is set.
+//
+// The API only accepts one change per subscription at a time, and has no call changing several databases of an
+// existing subscription at once, so a batch can't be sent as a single request. Instead, the changes arriving within
+// DatabaseChangeWindow of each other share one lock on the subscription and run back to back: each change starts as
+// soon as the previous one's task has finished, checking the subscription is ready straight away instead of after a
+// full poll interval.
+var DatabaseChanges = NewChangeQueue(DatabaseChangeWindow)
+
+// ChangeQueue hands out turns to make database changes on subscriptions.
+type ChangeQueue struct {
+	window time.Duration
+
+	mu      sync.Mutex
+	batches map[int]*changeBatch
+}
+
+// NewChangeQueue returns a ChangeQueue whose batches wait window for changes to join them.
+func NewChangeQueue(window time.Duration) *ChangeQueue {
+	return &ChangeQueue{window: window, batches: map[int]*changeBatch{}}
+}
+
+// changeBatch is the changes to a subscription sharing a lock on it. Changes joining the batch while it runs are
+// appended to pending, and run in turn.
+type changeBatch struct {
+	subId   int
+	pending []*Change
+}
+
+// Change is one database create or update's turn to change a subscription, begun by ChangeQueue.Begin.
+type Change struct {
+	subId   int
+	batched bool
+	// turn is closed when the change may start.
+	turn chan struct{}
+	// done is closed when the change has ended, for the next change in the batch to start.
+	done chan struct{}
+	once sync.Once
+	// unlock releases the change's own lock on the subscription, if it isn't batched.
+	unlock func()
+}
+
+// Begin blocks until a database change can be made on the subscription, returning the Change, whose End must be
+// called once the change is finished. Unless api has batching enabled, this is the same as taking an exclusive lock
+// on the subscription. If ctx is done before the change's turn comes, its error is returned.
+func (q *ChangeQueue) Begin(ctx context.Context, api *client.ApiClient, subId int) (*Change, error) {
+	if !api.BatchDatabaseChanges {
+		unlock, err := LockSubscription(ctx, subId)
+		if err != nil {
+			return nil, err
+		}
+		return &Change{subId: subId, unlock: unlock}, nil
+	}
+
+	change := &Change{
+		subId:   subId,
+		batched: true,
+		turn:    make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+
+	q.mu.Lock()
+	batch, ok := q.batches[subId]
+	if !ok {
+		batch = &changeBatch{subId: subId}
+		q.batches[subId] = batch
+		go q.run(batch)
+	}
+	batch.pending = append(batch.pending, change)
+	q.mu.Unlock()
+
+	select {
+	case <-change.turn:
+		return change, nil
+	case <-ctx.Done():
+	}
+
+	q.mu.Lock()
+	for i, pending := range batch.pending {
+		if pending == change {
+			batch.pending = append(batch.pending[:i], batch.pending[i+1:]...)
+			q.mu.Unlock()
+			return nil, fmt.Errorf("stopped waiting to change subscription %d: %w", subId, ctx.Err())
+		}
+	}
+	q.mu.Unlock()
+
+	// The change's turn came just as ctx was done, so it must end for the next change to start.
+	<-change.turn
+	change.End()
+	return nil, fmt.Errorf("stopped waiting to change subscription %d: %w", subId, ctx.Err())
+}
+
+// run waits for changes to join a new batch, then locks the subscription and gives each change its turn, until no
+// changes are left.
+func (q *ChangeQueue) run(batch *changeBatch) {
+	time.Sleep(WaitInterval(q.window))
+
+	// Waiting for the lock can't be cancelled, as the batch outlives the change which started it. Changes which
+	// stop waiting meanwhile leave the batch.
+	unlock, _ := LockSubscription(context.Background(), batch.subId)
+	defer unlock()
+
+	count := 0
+	for {
+		q.mu.Lock()
+		if len(batch.pending) == 0 {
+			delete(q.batches, batch.subId)
+			q.mu.Unlock()
+			break
+		}
+		change := batch.pending[0]
+		batch.pending = batch.pending[1:]
+		q.mu.Unlock()
+
+		count++
+		log.Printf("[DEBUG] Starting database change %d of the batch on subscription %d", count, batch.subId)
+		close(change.turn)
+		<-change.done
+	}
+	log.Printf("[INFO] Made %d database changes on subscription %d as one batch", count, batch.subId)
+}
+
+// End finishes the change, releasing the subscription for the next change. It may be called more than once, so that
+// it can be both deferred and called early.
+func (c *Change) End() {
+	c.once.Do(func() {
+		if c.batched {
+			close(c.done)
+		} else {
+			c.unlock()
+		}
+	})
+}
+
+// WaitForSubscriptionToBeActive waits for the subscription to be ready for the change. A batched change checks the
+// subscription straight away, as the change before it in the batch has usually just left it active.
+func (c *Change) WaitForSubscriptionToBeActive(ctx context.Context, api *client.ApiClient) error {
+	if c.batched {
+		subscription, err := api.Client.Subscription.Get(ctx, c.subId)
+		if err == nil && redis.StringValue(subscription.Status) == subscriptions.SubscriptionStatusActive {
+			return nil
+		}
+	}
+	return WaitForSubscriptionToBeActive(ctx, c.subId, api)
+}
+
+// WaitForDatabaseToBeActive waits for a database to be ready for the change. A batched change checks the database
+// straight away, as it usually isn't being changed by anything else.
+func (c *Change) WaitForDatabaseToBeActive(ctx context.Context, api *client.ApiClient, dbId int) error {
+	if c.batched {
+		database, err := api.Client.Database.Get(ctx, c.subId, dbId)
+		if err == nil && redis.StringValue(database.Status) == databases.StatusActive {
+			return nil
+		}
+	}
+	return WaitForDatabaseToBeActive(ctx, c.subId, dbId, api)
+}
@@ -0,0 +1,116 @@
+package utils
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/RedisLabs/rediscloud-go-api/service/subscriptions"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/client"
+)
+
+func TestUnitChangeQueueUnbatched(t *testing.T) {
+	q := NewChangeQueue(time.Hour)
+	change, err := q.Begin(context.Background(), &client.ApiClient{}, 101)
+	require.NoError(t, err)
+
+	// Without batching, a change is an exclusive lock on the subscription, taken without waiting for a window.
+	_, ok := tryLock(t, SubscriptionLocks, SubscriptionLockKey(101), SharedLock)
+	assert.False(t, ok)
+
+	change.End()
+	change.End()
+	unlock, ok := tryLock(t, SubscriptionLocks, SubscriptionLockKey(101), ExclusiveLock)
+	require.True(t, ok)
+	unlock()
+}
+
+func TestUnitChangeQueueBatched(t *testing.T) {
+	q := NewChangeQueue(200 * time.Millisecond)
+	api := &client.ApiClient{BatchDatabaseChanges: true}
+
+	var mu sync.Mutex
+	var order []int
+	running := 0
+
+	var wg sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			change, err := q.Begin(context.Background(), api, 102)
+			if !assert.NoError(t, err) {
+				return
+			}
+			defer change.End()
+
+			mu.Lock()
+			running++
+			assert.Equal(t, 1, running, "changes in a batch run one at a time")
+			order = append(order, i)
+			mu.Unlock()
+
+			// The batch holds the subscription's lock from one change to the next.
+			_, ok := tryLock(t, SubscriptionLocks, SubscriptionLockKey(102), SharedLock)
+			assert.False(t, ok)
+
+			mu.Lock()
+			running--
+			mu.Unlock()
+		}()
+		// Changes run in the order they joined the batch.
+		require.Eventually(t, func() bool {
+			q.mu.Lock()
+			defer q.mu.Unlock()
+			return q.batches[102] != nil && len(q.batches[102].pending) == i+1
+		}, time.Second, time.Millisecond)
+	}
+	wg.Wait()
+
+	assert.Equal(t, []int{0, 1, 2}, order)
+	require.Eventually(t, func() bool {
+		q.mu.Lock()
+		defer q.mu.Unlock()
+		return len(q.batches) == 0
+	}, time.Second, time.Millisecond)
+	unlock, ok := tryLock(t, SubscriptionLocks, SubscriptionLockKey(102), ExclusiveLock)
+	require.True(t, ok)
+	unlock()
+}
+
+func TestUnitChangeQueueCancelled(t *testing.T) {
+	q := NewChangeQueue(50 * time.Millisecond)
+	api := &client.ApiClient{BatchDatabaseChanges: true}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := q.Begin(ctx, api, 103)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.ErrorContains(t, err, "stopped waiting to change subscription 103")
+
+	// The cancelled change left the batch, so the next change isn't held up by it.
+	change, err := q.Begin(context.Background(), api, 103)
+	require.NoError(t, err)
+	change.End()
+}
+
+func TestUnitChangeWaitsStraightAway(t *testing.T) {
+	_, api, _ := newWaiterTestClient(t, client.FaultConfig{})
+	subId := createWaiterTestSubscription(t, api, subscriptions.CreateSubscription{})
+	require.NoError(t, WaitForSubscriptionToBeActive(context.Background(), subId, api))
+
+	// With waits at their real length, only a check made straight away returns within the test's deadline.
+	scaleWaitIntervals(t, 1)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	api.BatchDatabaseChanges = true
+	change, err := NewChangeQueue(0).Begin(ctx, api, subId)
+	require.NoError(t, err)
+	defer change.End()
+	assert.NoError(t, change.WaitForSubscriptionToBeActive(ctx, api))
+}
@@ -0,0 +1,269 @@
+package utils
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"net"
+	"slices"
+	"strings"
+
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/RedisLabs/rediscloud-go-api/service/subscriptions"
+
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/client"
+)
+
+// deploymentCIDRPrefixLength is the prefix length the API requires of the deployment CIDR of a subscription region.
+const deploymentCIDRPrefixLength = 24
+
+// CheckDeploymentCIDRPrefix returns an error if the deployment CIDR isn't a /24, as the API requires. CIDRs which
+// aren't valid are left to other checks.
+func CheckDeploymentCIDRPrefix(cidr string) error {
+	_, network, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return nil
+	}
+
+	if ones, _ := network.Mask.Size(); ones != deploymentCIDRPrefixLength {
+		return fmt.Errorf("the deployment CIDR %s must have a prefix length of /%d, not /%d", cidr, deploymentCIDRPrefixLength, ones)
+	}
+	return nil
+}
+
+// CIDRsOverlap reports whether two CIDRs share any address. CIDRs which aren't valid don't overlap.
+func CIDRsOverlap(a string, b string) bool {
+	_, first, err := net.ParseCIDR(a)
+	if err != nil {
+		return false
+	}
+	_, second, err := net.ParseCIDR(b)
+	if err != nil {
+		return false
+	}
+	return first.Contains(second.IP) || second.Contains(first.IP)
+}
+
+// DeploymentCIDR is the deployment CIDR of one of a subscription's regions. Only the regions of Active-Active
+// subscriptions have an ID.
+type DeploymentCIDR struct {
+	SubscriptionID   int
+	SubscriptionName string
+	Region           string
+	RegionID         int
+	CIDR             string
+}
+
+// String describes the deployment CIDR, e.g. `10.0.0.0/24 of subscription 123 ("cache") in us-east-1`.
+func (c DeploymentCIDR) String() string {
+	if c.SubscriptionName == "" {
+		return fmt.Sprintf("%s of subscription %d in %s", c.CIDR, c.SubscriptionID, c.Region)
+	}
+	return fmt.Sprintf("%s of subscription %d (%q) in %s", c.CIDR, c.SubscriptionID, c.SubscriptionName, c.Region)
+}
+
+// OverlappingDeploymentCIDRs returns the deployment CIDRs the CIDR overlaps.
+func OverlappingDeploymentCIDRs(cidr string, deploymentCIDRs []DeploymentCIDR) []DeploymentCIDR {
+	var overlapping []DeploymentCIDR
+	for _, deploymentCIDR := range deploymentCIDRs {
+		if CIDRsOverlap(cidr, deploymentCIDR.CIDR) {
+			overlapping = append(overlapping, deploymentCIDR)
+		}
+	}
+	return overlapping
+}
+
+// CheckCIDROverlaps returns an error listing the deployment CIDRs the CIDR overlaps, if any. The description names
+// the CIDR, e.g. `vpc_cidr 10.0.0.0/16`.
+func CheckCIDROverlaps(description string, cidr string, deploymentCIDRs []DeploymentCIDR) error {
+	overlapping := OverlappingDeploymentCIDRs(cidr, deploymentCIDRs)
+	if len(overlapping) == 0 {
+		return nil
+	}
+
+	ranges := make([]string, len(overlapping))
+	for i, deploymentCIDR := range overlapping {
+		ranges[i] = "the deployment CIDR " + deploymentCIDR.String()
+	}
+	return fmt.Errorf("%s overlaps %s. Traffic to the addresses in both ranges can't be routed", description, strings.Join(ranges, ", and "))
+}
+
+// SubscriptionDeploymentCIDRs returns the deployment CIDRs of a subscription's regions. Those of Active-Active
+// subscriptions are listed with their region IDs.
+func SubscriptionDeploymentCIDRs(ctx context.Context, api *client.ApiClient, subscription *subscriptions.Subscription) ([]DeploymentCIDR, error) {
+	subId := redis.IntValue(subscription.ID)
+	name := redis.StringValue(subscription.Name)
+
+	var cidrs []DeploymentCIDR
+	if redis.StringValue(subscription.DeploymentType) == subscriptions.SubscriptionDeploymentTypeActiveActive {
+		regions, err := api.Client.Subscription.ListActiveActiveRegions(ctx, subId)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list the regions of subscription %d: %w", subId, err)
+		}
+		for _, region := range regions {
+			if cidr := redis.StringValue(region.DeploymentCIDR); cidr != "" {
+				cidrs = append(cidrs, DeploymentCIDR{SubscriptionID: subId, SubscriptionName: name,
+					Region: redis.StringValue(region.Region), RegionID: redis.IntValue(region.RegionId), CIDR: cidr})
+			}
+		}
+		return cidrs, nil
+	}
+
+	for _, details := range subscription.CloudDetails {
+		for _, region := range details.Regions {
+			for _, networking := range region.Networking {
+				if cidr := redis.StringValue(networking.DeploymentCIDR); cidr != "" {
+					cidrs = append(cidrs, DeploymentCIDR{SubscriptionID: subId, SubscriptionName: name,
+						Region: redis.StringValue(region.Region), CIDR: cidr})
+				}
+			}
+		}
+	}
+	return cidrs, nil
+}
+
+// GetSubscriptionDeploymentCIDRs returns the deployment CIDRs of the subscription's regions.
+func GetSubscriptionDeploymentCIDRs(ctx context.Context, api *client.ApiClient, subId int) ([]DeploymentCIDR, error) {
+	subscription, err := api.Client.Subscription.Get(ctx, subId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get subscription %d: %w", subId, err)
+	}
+	return SubscriptionDeploymentCIDRs(ctx, api, subscription)
+}
+
+// AccountDeploymentCIDRs returns the deployment CIDRs of every subscription in the account, but the one excluded,
+// whose ID may be 0.
+func AccountDeploymentCIDRs(ctx context.Context, api *client.ApiClient, excludedSubId int) ([]DeploymentCIDR, error) {
+	list, err := api.Client.Subscription.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the subscriptions: %w", err)
+	}
+
+	var cidrs []DeploymentCIDR
+	for _, subscription := range list {
+		if redis.IntValue(subscription.ID) == excludedSubId {
+			continue
+		}
+		subscriptionCIDRs, err := SubscriptionDeploymentCIDRs(ctx, api, subscription)
+		if err != nil {
+			return nil, err
+		}
+		cidrs = append(cidrs, subscriptionCIDRs...)
+	}
+	return cidrs, nil
+}
+
+// SubscriptionPeeringCIDRs returns the CIDRs of the VPCs peered with a subscription.
+func SubscriptionPeeringCIDRs(ctx context.Context, api *client.ApiClient, subscription *subscriptions.Subscription) ([]string, error) {
+	subId := redis.IntValue(subscription.ID)
+
+	// A peering's vpc_cidr is usually the first of its vpc_cidrs too.
+	var cidrs []string
+	add := func(cidr *string, more []*subscriptions.CIDR) {
+		values := []*string{cidr}
+		for _, c := range more {
+			values = append(values, c.VPCCidr)
+		}
+		for _, value := range values {
+			if v := redis.StringValue(value); v != "" && !slices.Contains(cidrs, v) {
+				cidrs = append(cidrs, v)
+			}
+		}
+	}
+
+	if redis.StringValue(subscription.DeploymentType) == subscriptions.SubscriptionDeploymentTypeActiveActive {
+		regions, err := api.Client.Subscription.ListActiveActiveVPCPeering(ctx, subId)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list the peerings of subscription %d: %w", subId, err)
+		}
+		for _, region := range regions {
+			for _, peering := range region.VPCPeerings {
+				add(peering.VPCCidr, peering.VPCCidrs)
+			}
+		}
+		return cidrs, nil
+	}
+
+	peerings, err := api.Client.Subscription.ListVPCPeering(ctx, subId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the peerings of subscription %d: %w", subId, err)
+	}
+	for _, peering := range peerings {
+		add(peering.VPCCidr, peering.VPCCidrs)
+	}
+	return cidrs, nil
+}
+
+// AccountCIDRs returns the deployment CIDRs of every subscription in the account, and the CIDRs of the VPCs peered
+// with them.
+func AccountCIDRs(ctx context.Context, api *client.ApiClient) ([]string, error) {
+	list, err := api.Client.Subscription.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the subscriptions: %w", err)
+	}
+
+	var cidrs []string
+	for _, subscription := range list {
+		deploymentCIDRs, err := SubscriptionDeploymentCIDRs(ctx, api, subscription)
+		if err != nil {
+			return nil, err
+		}
+		for _, deploymentCIDR := range deploymentCIDRs {
+			cidrs = append(cidrs, deploymentCIDR.CIDR)
+		}
+
+		peeringCIDRs, err := SubscriptionPeeringCIDRs(ctx, api, subscription)
+		if err != nil {
+			return nil, err
+		}
+		cidrs = append(cidrs, peeringCIDRs...)
+	}
+	return cidrs, nil
+}
+
+// NextFreeCIDR returns the first block of the prefix length in the IPv4 pool which doesn't overlap any of the taken
+// CIDRs. Taken CIDRs which aren't valid are ignored.
+func NextFreeCIDR(pool string, prefixLength int, taken []string) (string, error) {
+	_, network, err := net.ParseCIDR(pool)
+	if err != nil || network.IP.To4() == nil {
+		return "", fmt.Errorf("the pool %s isn't an IPv4 CIDR", pool)
+	}
+	poolLength, _ := network.Mask.Size()
+	if prefixLength < poolLength || prefixLength > 32 {
+		return "", fmt.Errorf("a /%d block doesn't fit in the pool %s", prefixLength, pool)
+	}
+
+	type addressRange struct{ first, last uint64 }
+	toRange := func(n *net.IPNet) addressRange {
+		ones, bits := n.Mask.Size()
+		first := uint64(binary.BigEndian.Uint32(n.IP.To4()))
+		return addressRange{first: first, last: first + 1<<(bits-ones) - 1}
+	}
+
+	var ranges []addressRange
+	for _, cidr := range taken {
+		if _, n, err := net.ParseCIDR(cidr); err == nil && n.IP.To4() != nil {
+			ranges = append(ranges, toRange(n))
+		}
+	}
+
+	available := toRange(network)
+	size := uint64(1) << (32 - prefixLength)
+	for first := available.first; first+size-1 <= available.last; {
+		last := first + size - 1
+		next := first
+		for _, r := range ranges {
+			if r.first <= last && first <= r.last && r.last+1 > next {
+				next = r.last + 1
+			}
+		}
+		if next == first {
+			ip := make(net.IP, 4)
+			binary.BigEndian.PutUint32(ip, uint32(first))
+			return fmt.Sprintf("%s/%d", ip, prefixLength), nil
+		}
+		// Skip past the taken range, to the next block boundary.
+		first = (next + size - 1) / size * size
+	}
+	return "", fmt.Errorf("the pool %s has no free /%d block", pool, prefixLength)
+}
@@ -0,0 +1,211 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/RedisLabs/rediscloud-go-api/service/subscriptions"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/client"
+)
+
+func TestUnitCheckDeploymentCIDRPrefix(t *testing.T) {
+	tests := []struct {
+		cidr string
+		err  string
+	}{
+		{cidr: "10.0.0.0/24"},
+		{cidr: "10.0.0.0/16", err: "the deployment CIDR 10.0.0.0/16 must have a prefix length of /24, not /16"},
+		{cidr: "10.0.0.0/28", err: "a prefix length of /24, not /28"},
+		{cidr: "not a CIDR"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.cidr, func(t *testing.T) {
+			err := CheckDeploymentCIDRPrefix(test.cidr)
+			if test.err == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, test.err)
+		})
+	}
+}
+
+func TestUnitCIDRsOverlap(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected bool
+	}{
+		{a: "10.0.0.0/24", b: "10.0.0.0/24", expected: true},
+		{a: "10.0.0.0/16", b: "10.0.5.0/24", expected: true},
+		{a: "10.0.5.128/25", b: "10.0.0.0/16", expected: true},
+		{a: "10.0.0.0/24", b: "10.0.1.0/24"},
+		{a: "192.168.0.0/16", b: "10.0.0.0/8"},
+		{a: "10.0.0.0/24", b: "invalid"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.a+" "+test.b, func(t *testing.T) {
+			assert.Equal(t, test.expected, CIDRsOverlap(test.a, test.b))
+		})
+	}
+}
+
+func TestUnitCheckCIDROverlaps(t *testing.T) {
+	deploymentCIDRs := []DeploymentCIDR{
+		{SubscriptionID: 1, SubscriptionName: "cache", Region: "us-east-1", CIDR: "10.0.0.0/24"},
+		{SubscriptionID: 2, Region: "eu-west-1", RegionID: 7, CIDR: "10.0.1.0/24"},
+		{SubscriptionID: 3, Region: "us-east-1", CIDR: "192.168.0.0/24"},
+	}
+
+	assert.NoError(t, CheckCIDROverlaps("vpc_cidr 172.16.0.0/16", "172.16.0.0/16", deploymentCIDRs))
+	assert.EqualError(t, CheckCIDROverlaps("vpc_cidr 10.0.0.0/16", "10.0.0.0/16", deploymentCIDRs),
+		`vpc_cidr 10.0.0.0/16 overlaps the deployment CIDR 10.0.0.0/24 of subscription 1 ("cache") in us-east-1, and `+
+			`the deployment CIDR 10.0.1.0/24 of subscription 2 in eu-west-1. Traffic to the addresses in both ranges can't be routed`)
+}
+
+func TestUnitAccountDeploymentCIDRs(t *testing.T) {
+	_, api, _ := newWaiterTestClient(t, client.FaultConfig{})
+	proId := createWaiterTestSubscription(t, api, subscriptions.CreateSubscription{})
+	aaId, regionId := createActiveActiveWaiterTestSubscription(t, api)
+
+	cidrs, err := AccountDeploymentCIDRs(context.Background(), api, 0)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{
+		fmt.Sprintf(`10.0.0.0/24 of subscription %d ("waiter") in us-east-1`, proId),
+		fmt.Sprintf(`10.0.0.0/24 of subscription %d ("waiter") in us-east-1`, aaId),
+		fmt.Sprintf(`10.0.1.0/24 of subscription %d ("waiter") in eu-west-1`, aaId),
+	}, deploymentCIDRStrings(cidrs))
+	for _, cidr := range cidrs {
+		if cidr.SubscriptionID == aaId && cidr.Region == "eu-west-1" {
+			assert.Equal(t, regionId, cidr.RegionID)
+		}
+	}
+
+	cidrs, err = AccountDeploymentCIDRs(context.Background(), api, aaId)
+	require.NoError(t, err)
+	require.Len(t, cidrs, 1)
+	assert.Equal(t, proId, cidrs[0].SubscriptionID)
+
+	cidrs, err = GetSubscriptionDeploymentCIDRs(context.Background(), api, aaId)
+	require.NoError(t, err)
+	assert.Len(t, cidrs, 2)
+}
+
+func deploymentCIDRStrings(cidrs []DeploymentCIDR) []string {
+	var descriptions []string
+	for _, cidr := range cidrs {
+		descriptions = append(descriptions, cidr.String())
+	}
+	return descriptions
+}
+
+func TestUnitDeploymentCIDRString(t *testing.T) {
+	cidr := DeploymentCIDR{SubscriptionID: 12, Region: "us-east-1", CIDR: "10.0.0.0/24"}
+	assert.Equal(t, "10.0.0.0/24 of subscription 12 in us-east-1", cidr.String())
+
+	cidr.SubscriptionName = "cache"
+	assert.Equal(t, `10.0.0.0/24 of subscription 12 ("cache") in us-east-1`, cidr.String())
+}
+
+func TestUnitNextFreeCIDR(t *testing.T) {
+	tests := []struct {
+		name         string
+		pool         string
+		prefixLength int
+		taken        []string
+		expected     string
+		err          string
+	}{
+		{name: "empty pool", pool: "10.0.0.0/16", prefixLength: 24, expected: "10.0.0.0/24"},
+		{name: "whole pool", pool: "10.0.0.0/24", prefixLength: 24, expected: "10.0.0.0/24"},
+		{name: "pool address not aligned", pool: "10.0.3.7/16", prefixLength: 24, expected: "10.0.0.0/24"},
+		{
+			name:         "after taken blocks",
+			pool:         "10.0.0.0/16",
+			prefixLength: 24,
+			taken:        []string{"10.0.0.0/24", "10.0.1.0/24"},
+			expected:     "10.0.2.0/24",
+		},
+		{
+			name:         "in a gap",
+			pool:         "10.0.0.0/16",
+			prefixLength: 24,
+			taken:        []string{"10.0.0.0/24", "10.0.2.0/23"},
+			expected:     "10.0.1.0/24",
+		},
+		{
+			name:         "past a smaller block",
+			pool:         "10.0.0.0/16",
+			prefixLength: 24,
+			taken:        []string{"10.0.0.128/25", "10.0.1.16/28"},
+			expected:     "10.0.2.0/24",
+		},
+		{
+			name:         "past a larger block",
+			pool:         "10.0.0.0/8",
+			prefixLength: 24,
+			taken:        []string{"10.0.0.0/16"},
+			expected:     "10.1.0.0/24",
+		},
+		{
+			name:         "taken outside the pool",
+			pool:         "10.0.0.0/16",
+			prefixLength: 24,
+			taken:        []string{"192.168.0.0/16", "not a CIDR"},
+			expected:     "10.0.0.0/24",
+		},
+		{
+			name:         "pool inside a taken block",
+			pool:         "10.0.0.0/16",
+			prefixLength: 24,
+			taken:        []string{"10.0.0.0/8"},
+			err:          "the pool 10.0.0.0/16 has no free /24 block",
+		},
+		{
+			name:         "end of the address space",
+			pool:         "255.255.255.0/24",
+			prefixLength: 25,
+			taken:        []string{"255.255.255.0/25"},
+			expected:     "255.255.255.128/25",
+		},
+		{name: "block larger than the pool", pool: "10.0.0.0/24", prefixLength: 16, err: "a /16 block doesn't fit in the pool 10.0.0.0/24"},
+		{name: "IPv6 pool", pool: "fd00::/64", prefixLength: 72, err: "the pool fd00::/64 isn't an IPv4 CIDR"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cidr, err := NextFreeCIDR(test.pool, test.prefixLength, test.taken)
+			if test.err != "" {
+				assert.ErrorContains(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, cidr)
+		})
+	}
+}
+
+func TestUnitAccountCIDRs(t *testing.T) {
+	_, api, _ := newWaiterTestClient(t, client.FaultConfig{})
+	proId := createWaiterTestSubscription(t, api, subscriptions.CreateSubscription{})
+	createActiveActiveWaiterTestSubscription(t, api)
+
+	_, err := api.Client.Subscription.CreateVPCPeering(context.Background(), proId, subscriptions.CreateVPCPeering{
+		Provider:     redis.String("AWS"),
+		Region:       redis.String("us-east-1"),
+		AWSAccountID: redis.String("123456789012"),
+		VPCId:        redis.String("vpc-1"),
+		VPCCidrs:     []*string{redis.String("172.16.0.0/16"), redis.String("172.17.0.0/16")},
+	})
+	require.NoError(t, err)
+
+	cidrs, err := AccountCIDRs(context.Background(), api)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"10.0.0.0/24", "10.0.0.0/24", "10.0.1.0/24", "172.16.0.0/16", "172.17.0.0/16"}, cidrs)
+}
@@ -0,0 +1,133 @@
+package utils
+
+import (
+	"context"
+
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/RedisLabs/rediscloud-go-api/service/databases"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// DefaultPublicSourceIP is the source IP a database allows when its subscription's public endpoint is accessible.
+const DefaultPublicSourceIP = "0.0.0.0/0"
+
+// DefaultPrivateIPRanges are the RFC1918 private IP ranges a database allows when its subscription's public endpoint
+// is blocked.
+var DefaultPrivateIPRanges = []string{"10.0.0.0/8", "172.16.0.0/12", "192.168.0.0/16", "100.64.0.0/10"}
+
+// AlertModel describes an alert nested block of the Pro and Active-Active databases.
+type AlertModel struct {
+	Name  types.String `tfsdk:"name"`
+	Value types.Int64  `tfsdk:"value"`
+}
+
+// RemoteBackupModel describes a remote_backup nested block of the Pro and Active-Active databases.
+type RemoteBackupModel struct {
+	Interval    types.String `tfsdk:"interval"`
+	TimeUTC     types.String `tfsdk:"time_utc"`
+	StorageType types.String `tfsdk:"storage_type"`
+	StoragePath types.String `tfsdk:"storage_path"`
+}
+
+// DatabaseIdentityModel describes the resource identity of the Pro and Active-Active databases.
+type DatabaseIdentityModel struct {
+	SubscriptionID types.Int64 `tfsdk:"subscription_id"`
+	DbID           types.Int64 `tfsdk:"db_id"`
+}
+
+// AlertAttrTypes returns the attribute types for alert objects.
+func AlertAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"name":  types.StringType,
+		"value": types.Int64Type,
+	}
+}
+
+// RemoteBackupAttrTypes returns the attribute types for remote_backup objects.
+func RemoteBackupAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"interval":     types.StringType,
+		"time_utc":     types.StringType,
+		"storage_type": types.StringType,
+		"storage_path": types.StringType,
+	}
+}
+
+// IsDefaultSourceIPs checks if the given source IPs match one of the default patterns:
+// - RFC1918 private ranges (when public_endpoint_access is false)
+// - Public access "0.0.0.0/0" (when public_endpoint_access is true)
+// This is used to determine if source IPs should be re-computed when public_endpoint_access changes.
+func IsDefaultSourceIPs(sourceIPs []string) bool {
+	if len(sourceIPs) == 0 {
+		return true
+	}
+
+	// Check if it's the default public access pattern
+	if len(sourceIPs) == 1 && sourceIPs[0] == DefaultPublicSourceIP {
+		return true
+	}
+
+	// Check if it matches the RFC1918 private ranges
+	if len(sourceIPs) == len(DefaultPrivateIPRanges) {
+		privateIPSet := make(map[string]bool)
+		for _, ip := range DefaultPrivateIPRanges {
+			privateIPSet[ip] = true
+		}
+		for _, ip := range sourceIPs {
+			if !privateIPSet[ip] {
+				return false
+			}
+		}
+		return true
+	}
+
+	return false
+}
+
+// BuildAlerts converts a types.Set of alerts to the API request format.
+func BuildAlerts(ctx context.Context, alertSet types.Set) ([]*databases.Alert, diag.Diagnostics) {
+	if alertSet.IsNull() || alertSet.IsUnknown() {
+		return []*databases.Alert{}, nil
+	}
+
+	var alertModels []AlertModel
+	diags := alertSet.ElementsAs(ctx, &alertModels, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	alerts := make([]*databases.Alert, 0, len(alertModels))
+	for _, alert := range alertModels {
+		alerts = append(alerts, &databases.Alert{
+			Name:  redis.String(alert.Name.ValueString()),
+			Value: redis.Int(int(alert.Value.ValueInt64())),
+		})
+	}
+
+	return alerts, nil
+}
+
+// FlattenAlerts converts API alert responses to a types.Set, which is null when there are no alerts.
+func FlattenAlerts(alerts []*databases.Alert) (types.Set, diag.Diagnostics) {
+	alertType := types.ObjectType{AttrTypes: AlertAttrTypes()}
+
+	if len(alerts) == 0 {
+		return types.SetNull(alertType), nil
+	}
+
+	elements := make([]attr.Value, 0, len(alerts))
+	for _, alert := range alerts {
+		obj, diags := types.ObjectValue(AlertAttrTypes(), map[string]attr.Value{
+			"name":  types.StringValue(redis.StringValue(alert.Name)),
+			"value": types.Int64Value(int64(redis.IntValue(alert.Value))),
+		})
+		if diags.HasError() {
+			return types.SetNull(alertType), diags
+		}
+		elements = append(elements, obj)
+	}
+
+	return types.SetValue(alertType, elements)
+}
@@ -0,0 +1,81 @@
+package utils
+
+import (
+	"fmt"
+
+	fwdiag "github.com/hashicorp/terraform-plugin-framework/diag"
+	fwschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DeletionProtectionKey is the attribute preventing a subscription or database from being deleted. As deletes are
+// checked against the prior state, disabling it only takes effect once applied, so that a single destroy can't both
+// disable the protection and delete the resource.
+const DeletionProtectionKey = "deletion_protection"
+
+// DeletionProtectionDescription describes the DeletionProtectionKey attribute.
+const DeletionProtectionDescription = "Whether Terraform is prevented from deleting the resource, including to replace it. It must be set to false, and applied, before the resource can be destroyed. Defaults to false."
+
+// deletionProtectionSummary and deletionProtectionDetail are the error refusing to delete a protected resource.
+const (
+	deletionProtectionSummary = "Deletion protection is enabled"
+	deletionProtectionDetail  = "%s can't be deleted while `deletion_protection` is true. Set `deletion_protection` to false and apply the change, before destroying or replacing it."
+)
+
+// DeletionProtectionSchema is the schema of the DeletionProtectionKey attribute of SDK resources.
+func DeletionProtectionSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: DeletionProtectionDescription,
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+	}
+}
+
+// SetDeletionProtection records the DeletionProtectionKey attribute of an SDK resource on read, so that resources
+// created or imported before it existed have it set to its default.
+func SetDeletionProtection(d *schema.ResourceData) error {
+	return d.Set(DeletionProtectionKey, d.Get(DeletionProtectionKey).(bool))
+}
+
+// DeletionProtectionDiagnostics returns an error if the SDK resource being deleted is protected.
+func DeletionProtectionDiagnostics(d *schema.ResourceData, description string) diag.Diagnostics {
+	if !d.Get(DeletionProtectionKey).(bool) {
+		return nil
+	}
+	return diag.Diagnostics{{
+		Severity: diag.Error,
+		Summary:  deletionProtectionSummary,
+		Detail:   fmt.Sprintf(deletionProtectionDetail, description),
+	}}
+}
+
+// DeletionProtectionAttribute is the schema of the DeletionProtectionKey attribute of Plugin Framework resources.
+func DeletionProtectionAttribute() fwschema.BoolAttribute {
+	return fwschema.BoolAttribute{
+		Description: DeletionProtectionDescription,
+		Optional:    true,
+		Computed:    true,
+		Default:     booldefault.StaticBool(false),
+	}
+}
+
+// DeletionProtectionValue returns the DeletionProtectionKey attribute of a Plugin Framework resource on read, which is
+// null for resources created or imported before it existed, as its default.
+func DeletionProtectionValue(value types.Bool) types.Bool {
+	if value.IsNull() || value.IsUnknown() {
+		return types.BoolValue(false)
+	}
+	return value
+}
+
+// CheckDeletionProtection adds an error if the Plugin Framework resource being deleted is protected.
+func CheckDeletionProtection(value types.Bool, description string, diagnostics *fwdiag.Diagnostics) {
+	if !value.ValueBool() {
+		return
+	}
+	diagnostics.AddError(deletionProtectionSummary, fmt.Sprintf(deletionProtectionDetail, description))
+}
@@ -0,0 +1,93 @@
+package utils
+
+import (
+	"testing"
+
+	fwdiag "github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitCheckDeletionProtection(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   types.Bool
+		refused bool
+	}{
+		{name: "enabled", value: types.BoolValue(true), refused: true},
+		{name: "disabled", value: types.BoolValue(false)},
+		{name: "unset", value: types.BoolNull()},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var diags fwdiag.Diagnostics
+			CheckDeletionProtection(test.value, "Subscription 1", &diags)
+			if !test.refused {
+				assert.Empty(t, diags)
+				return
+			}
+			require.Len(t, diags, 1)
+			assert.Equal(t, "Deletion protection is enabled", diags[0].Summary())
+			assert.Contains(t, diags[0].Detail(), "Subscription 1 can't be deleted")
+		})
+	}
+}
+
+func TestUnitDeletionProtectionValue(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    types.Bool
+		expected types.Bool
+	}{
+		{name: "enabled", value: types.BoolValue(true), expected: types.BoolValue(true)},
+		{name: "disabled", value: types.BoolValue(false), expected: types.BoolValue(false)},
+		{name: "created before the attribute", value: types.BoolNull(), expected: types.BoolValue(false)},
+		{name: "unknown", value: types.BoolUnknown(), expected: types.BoolValue(false)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, DeletionProtectionValue(test.value))
+		})
+	}
+}
+
+func TestUnitDeletionProtectionDiagnostics(t *testing.T) {
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			DeletionProtectionKey: DeletionProtectionSchema(),
+		},
+	}
+
+	tests := []struct {
+		name    string
+		state   map[string]interface{}
+		refused bool
+	}{
+		{name: "enabled", state: map[string]interface{}{DeletionProtectionKey: true}, refused: true},
+		{name: "disabled", state: map[string]interface{}{DeletionProtectionKey: false}},
+		{name: "created before the attribute", state: map[string]interface{}{}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, resource.Schema, test.state)
+			d.SetId("1")
+
+			require.NoError(t, SetDeletionProtection(d))
+			assert.Equal(t, test.refused, d.Get(DeletionProtectionKey))
+
+			diags := DeletionProtectionDiagnostics(d, "Subscription 1")
+			if !test.refused {
+				assert.Empty(t, diags)
+				return
+			}
+			require.Len(t, diags, 1)
+			assert.Equal(t, "Deletion protection is enabled", diags[0].Summary)
+			assert.Contains(t, diags[0].Detail, "Subscription 1 can't be deleted")
+		})
+	}
+}
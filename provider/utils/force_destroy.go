@@ -0,0 +1,142 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	fwdiag "github.com/hashicorp/terraform-plugin-framework/diag"
+	fwschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/client"
+)
+
+// ForceDestroyKey is the attribute deleting the databases left in a subscription, such as those created outside
+// Terraform, before the subscription itself is deleted. Like DeletionProtectionKey, it's read from the prior state.
+const ForceDestroyKey = "force_destroy"
+
+// ForceDestroyDescription describes the ForceDestroyKey attribute.
+const ForceDestroyDescription = "Whether the databases remaining in the subscription, including those not managed by Terraform, are deleted before the subscription is destroyed. Each deleted database is reported as a warning. Defaults to false."
+
+// forceDestroySummary and forceDestroyDetail are the warning reporting a database deleted by force_destroy.
+const (
+	forceDestroySummary = "Database deleted by force_destroy"
+	forceDestroyDetail  = "Database %d (%s) was deleted from subscription %d before the subscription, as `force_destroy` is true."
+)
+
+// ForceDestroySchema is the schema of the ForceDestroyKey attribute of SDK resources.
+func ForceDestroySchema() *schema.Schema {
+	return &schema.Schema{
+		Description: ForceDestroyDescription,
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+	}
+}
+
+// SetForceDestroy records the ForceDestroyKey attribute of an SDK resource on read, so that resources created or
+// imported before it existed have it set to its default.
+func SetForceDestroy(d *schema.ResourceData) error {
+	return d.Set(ForceDestroyKey, d.Get(ForceDestroyKey).(bool))
+}
+
+// ForceDestroyAttribute is the schema of the ForceDestroyKey attribute of Plugin Framework resources.
+func ForceDestroyAttribute() fwschema.BoolAttribute {
+	return fwschema.BoolAttribute{
+		Description: ForceDestroyDescription,
+		Optional:    true,
+		Computed:    true,
+		Default:     booldefault.StaticBool(false),
+	}
+}
+
+// ForceDestroyValue returns the ForceDestroyKey attribute of a Plugin Framework resource on read, which is null for
+// resources created or imported before it existed, as its default.
+func ForceDestroyValue(value types.Bool) types.Bool {
+	if value.IsNull() || value.IsUnknown() {
+		return types.BoolValue(false)
+	}
+	return value
+}
+
+// deletedDatabase is a database deleted by force_destroy.
+type deletedDatabase struct {
+	id   int
+	name string
+}
+
+// deleteSubscriptionDatabases deletes every database of an active subscription, one at a time, waiting for each to be
+// gone. The databases deleted before an error are returned along with it.
+func deleteSubscriptionDatabases(ctx context.Context, subId int, api *client.ApiClient) ([]deletedDatabase, error) {
+	// The databases are listed before any is deleted, as deleting them would shift the pages being listed.
+	var remaining []deletedDatabase
+	list := api.Client.Database.List(ctx, subId)
+	for list.Next() {
+		db := list.Value()
+		remaining = append(remaining, deletedDatabase{id: redis.IntValue(db.ID), name: redis.StringValue(db.Name)})
+	}
+	if err := list.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list the databases of subscription %d: %w", subId, err)
+	}
+
+	var deleted []deletedDatabase
+	for _, db := range remaining {
+		log.Printf("[DEBUG] Deleting database %d (%s) of subscription %d, as force_destroy is set", db.id, db.name, subId)
+
+		err := WaitForTask(ctx, fmt.Sprintf("delete database %d", db.id), func(ctx context.Context) error {
+			return api.Client.Database.Delete(ctx, subId, db.id)
+		}, func(ctx context.Context) error {
+			return WaitForDatabaseToBeDeleted(ctx, subId, db.id, api)
+		})
+		if err != nil {
+			return deleted, fmt.Errorf("failed to delete database %d (%s): %w", db.id, db.name, err)
+		}
+		deleted = append(deleted, db)
+	}
+
+	if len(deleted) > 0 {
+		if err := WaitForSubscriptionToBeActive(ctx, subId, api); err != nil {
+			return deleted, err
+		}
+	}
+	return deleted, nil
+}
+
+// ForceDestroyDatabases deletes the databases remaining in the subscription of a Plugin Framework resource, adding a
+// warning for each deleted database.
+func ForceDestroyDatabases(ctx context.Context, subId int, api *client.ApiClient, diagnostics *fwdiag.Diagnostics) {
+	deleted, err := deleteSubscriptionDatabases(ctx, subId, api)
+	for _, db := range deleted {
+		diagnostics.AddWarning(forceDestroySummary, fmt.Sprintf(forceDestroyDetail, db.id, db.name, subId))
+	}
+	if err != nil {
+		diagnostics.AddError("Failed to delete the subscription's databases", err.Error())
+	}
+}
+
+// ForceDestroyDatabasesDiagnostics deletes the databases remaining in the subscription of an SDK resource, returning a
+// warning for each deleted database.
+func ForceDestroyDatabasesDiagnostics(ctx context.Context, subId int, api *client.ApiClient) diag.Diagnostics {
+	var diags diag.Diagnostics
+	deleted, err := deleteSubscriptionDatabases(ctx, subId, api)
+	for _, db := range deleted {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  forceDestroySummary,
+			Detail:   fmt.Sprintf(forceDestroyDetail, db.id, db.name, subId),
+		})
+	}
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to delete the subscription's databases",
+			Detail:   err.Error(),
+		})
+	}
+	return diags
+}
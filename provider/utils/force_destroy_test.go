@@ -0,0 +1,141 @@
+package utils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/RedisLabs/rediscloud-go-api/service/databases"
+	"github.com/RedisLabs/rediscloud-go-api/service/subscriptions"
+	fwdiag "github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/client"
+)
+
+// createForceDestroyTestDatabases creates active databases with the given names in the subscription.
+func createForceDestroyTestDatabases(t *testing.T, api *client.ApiClient, subId int, names ...string) {
+	t.Helper()
+	require.NoError(t, WaitForSubscriptionToBeActive(context.Background(), subId, api))
+	for _, name := range names {
+		dbId, err := api.Client.Database.Create(context.Background(), subId, databases.CreateDatabase{
+			Name:            redis.String(name),
+			DatasetSizeInGB: redis.Float64(1),
+		})
+		require.NoError(t, err)
+		require.NoError(t, WaitForDatabaseToBeActive(context.Background(), subId, dbId, api))
+	}
+}
+
+func TestUnitForceDestroyDatabases(t *testing.T) {
+	tests := []struct {
+		name     string
+		dbs      []string
+		faults   client.FaultConfig
+		warnings []string
+		err      string
+	}{
+		{name: "no databases"},
+		{
+			name:     "unmanaged databases",
+			dbs:      []string{"first", "second"},
+			warnings: []string{"(first) was deleted from subscription", "(second) was deleted from subscription"},
+		},
+		{
+			name:   "listing fails",
+			dbs:    []string{"first"},
+			faults: client.FaultConfig{ServerErrorRate: 1, Limit: 1},
+			err:    "failed to list the databases",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, api, transport := newWaiterTestClient(t, client.FaultConfig{})
+			subId := createWaiterTestSubscription(t, api, subscriptions.CreateSubscription{})
+			createForceDestroyTestDatabases(t, api, subId, test.dbs...)
+
+			// Faults are only injected into the deletes, not the setup.
+			transport.SetFaults(test.faults)
+
+			var diagnostics fwdiag.Diagnostics
+			ForceDestroyDatabases(context.Background(), subId, api, &diagnostics)
+
+			warnings := diagnostics.Warnings()
+			require.Len(t, warnings, len(test.warnings))
+			for i, warning := range test.warnings {
+				assert.Equal(t, "Database deleted by force_destroy", warnings[i].Summary())
+				assert.Contains(t, warnings[i].Detail(), warning)
+			}
+
+			if test.err != "" {
+				require.True(t, diagnostics.HasError())
+				assert.Contains(t, diagnostics.Errors()[0].Detail(), test.err)
+				return
+			}
+			require.False(t, diagnostics.HasError(), "%v", diagnostics)
+
+			list := api.Client.Database.List(context.Background(), subId)
+			assert.False(t, list.Next(), "every database is deleted")
+			require.NoError(t, list.Err())
+		})
+	}
+}
+
+func TestUnitForceDestroyDatabasesDiagnostics(t *testing.T) {
+	_, api, _ := newWaiterTestClient(t, client.FaultConfig{})
+	subId, _ := createActiveActiveWaiterTestSubscription(t, api)
+	require.NoError(t, WaitForSubscriptionToBeActive(context.Background(), subId, api))
+	dbId, err := api.Client.Database.ActiveActiveCreate(context.Background(), subId, databases.CreateActiveActiveDatabase{
+		Name:                  redis.String("unmanaged"),
+		DatasetSizeInGB:       redis.Float64(1),
+		GlobalPassword:        redis.String("password"),
+		GlobalSourceIP:        []*string{redis.String("0.0.0.0/0")},
+		GlobalDataPersistence: redis.String("none"),
+	})
+	require.NoError(t, err)
+	require.NoError(t, WaitForDatabaseToBeActive(context.Background(), subId, dbId, api))
+
+	diags := ForceDestroyDatabasesDiagnostics(context.Background(), subId, api)
+	require.Len(t, diags, 1)
+	assert.Equal(t, diag.Warning, diags[0].Severity)
+	assert.Contains(t, diags[0].Detail, "(unmanaged) was deleted from subscription")
+
+	_, err = api.Client.Database.GetActiveActive(context.Background(), subId, dbId)
+	assert.Error(t, err, "the database is deleted")
+}
+
+func TestUnitForceDestroyValue(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    types.Bool
+		expected types.Bool
+	}{
+		{name: "enabled", value: types.BoolValue(true), expected: types.BoolValue(true)},
+		{name: "disabled", value: types.BoolValue(false), expected: types.BoolValue(false)},
+		{name: "created before the attribute", value: types.BoolNull(), expected: types.BoolValue(false)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, ForceDestroyValue(test.value))
+		})
+	}
+}
+
+func TestUnitSetForceDestroy(t *testing.T) {
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			ForceDestroyKey: ForceDestroySchema(),
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{})
+	d.SetId("1")
+	require.NoError(t, SetForceDestroy(d))
+	assert.Equal(t, false, d.Get(ForceDestroyKey))
+}
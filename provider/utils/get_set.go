@@ -17,6 +17,13 @@ const SafetyTimeout = 6 * time.Hour
 // subscription provisioning. This is shorter than SafetyTimeout as tests typically complete within 45 minutes.
 const TransitGatewayProvisioningTimeout = 40 * time.Minute
 
+// ResourceGetter reads the values of an SDK resource, from its schema.ResourceData, or from its schema.ResourceDiff
+// while it is planned.
+type ResourceGetter interface {
+	Get(key string) interface{}
+	GetOk(key string) (interface{}, bool)
+}
+
 // GetString safely retrieves a string value from schema.ResourceData.
 func GetString(d *schema.ResourceData, key string) *string {
 	if v, ok := d.GetOk(key); ok {
@@ -0,0 +1,128 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Resource identity attribute names. Resource IDs are made of the values of these attributes joined by slashes,
+// e.g. <subscription_id>/<region_id>/<tgw_id>.
+const (
+	IdentitySubscriptionId  = "subscription_id"
+	IdentityDatabaseId      = "db_id"
+	IdentityRegionId        = "region_id"
+	IdentityTgwId           = "tgw_id"
+	IdentityTgwInvitationId = "tgw_invitation_id"
+	IdentityPeeringId       = "peering_id"
+	IdentityPscServiceId    = "psc_service_id"
+	IdentityPscEndpointId   = "endpoint_id"
+	IdentityId              = "id"
+)
+
+var identityDescriptions = map[string]string{
+	IdentitySubscriptionId:  "Identifier of the subscription",
+	IdentityDatabaseId:      "Identifier of the database",
+	IdentityRegionId:        "Identifier of the Active-Active region",
+	IdentityTgwId:           "Identifier of the Transit Gateway",
+	IdentityTgwInvitationId: "Identifier of the Transit Gateway invitation",
+	IdentityPeeringId:       "Identifier of the VPC peering",
+	IdentityPscServiceId:    "Identifier of the Private Service Connect service",
+	IdentityPscEndpointId:   "Identifier of the Private Service Connect endpoint",
+	IdentityId:              "Identifier of the resource",
+}
+
+// IdentityDescription describes a resource identity attribute.
+func IdentityDescription(attribute string) string {
+	return identityDescriptions[attribute]
+}
+
+// NewResourceIdentity returns a resource identity made of the given numeric attributes, listed in the order they
+// appear in the resource ID.
+func NewResourceIdentity(attributes ...string) *schema.ResourceIdentity {
+	return &schema.ResourceIdentity{
+		Version: 0,
+		SchemaFunc: func() map[string]*schema.Schema {
+			s := make(map[string]*schema.Schema, len(attributes))
+			for _, attribute := range attributes {
+				s[attribute] = &schema.Schema{
+					Description:       IdentityDescription(attribute),
+					Type:              schema.TypeInt,
+					RequiredForImport: true,
+				}
+			}
+			return s
+		},
+	}
+}
+
+// SubscriptionIdentity is the resource identity of a subscription, or of a resource which exists once per subscription.
+func SubscriptionIdentity() *schema.ResourceIdentity {
+	return NewResourceIdentity(IdentitySubscriptionId)
+}
+
+// DatabaseIdentity is the resource identity of a database: its subscription and database identifiers.
+func DatabaseIdentity() *schema.ResourceIdentity {
+	return NewResourceIdentity(IdentitySubscriptionId, IdentityDatabaseId)
+}
+
+// AccountIdentity is the resource identity of an account-level object (such as an ACL rule, role or user)
+// addressed by its numeric identifier alone.
+func AccountIdentity() *schema.ResourceIdentity {
+	return NewResourceIdentity(IdentityId)
+}
+
+// SetResourceIdentity records the given identity attributes on the resource. It should be called by the read
+// operation once the resource has been found, so the identity is tracked across refreshes.
+func SetResourceIdentity(d *schema.ResourceData, values map[string]interface{}) error {
+	identity, err := d.Identity()
+	if err != nil {
+		return err
+	}
+	for k, v := range values {
+		if err := identity.Set(k, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ImportStateWithIdentity returns an import function accepting either an import ID or an `identity` block. When
+// importing by identity, the resource ID is built from the identity attributes, in the order given, before calling
+// next. A nil next behaves like schema.ImportStatePassthroughContext.
+func ImportStateWithIdentity(next schema.StateContextFunc, attributes ...string) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		if d.Id() == "" {
+			id, err := resourceIdFromIdentity(d, attributes)
+			if err != nil {
+				return nil, err
+			}
+			d.SetId(id)
+		}
+
+		if next == nil {
+			return []*schema.ResourceData{d}, nil
+		}
+		return next(ctx, d, meta)
+	}
+}
+
+func resourceIdFromIdentity(d *schema.ResourceData, attributes []string) (string, error) {
+	identity, err := d.Identity()
+	if err != nil {
+		return "", fmt.Errorf("error getting identity: %w", err)
+	}
+
+	parts := make([]string, 0, len(attributes))
+	for _, attribute := range attributes {
+		v, ok := identity.GetOk(attribute)
+		if !ok {
+			return "", fmt.Errorf("expected identity to contain a non-zero %s", attribute)
+		}
+		parts = append(parts, strconv.Itoa(v.(int)))
+	}
+	return strings.Join(parts, "/"), nil
+}
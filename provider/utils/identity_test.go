@@ -0,0 +1,88 @@
+package utils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitImportStateWithIdentity(t *testing.T) {
+	res := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {Type: schema.TypeString, Optional: true},
+		},
+		Identity: NewResourceIdentity(IdentitySubscriptionId, IdentityRegionId, IdentityTgwId),
+	}
+
+	tests := []struct {
+		name     string
+		id       string
+		identity map[string]interface{}
+		expected string
+		errors   bool
+	}{
+		{
+			name:     "import ID",
+			id:       "1/2/3",
+			expected: "1/2/3",
+		},
+		{
+			name: "identity",
+			identity: map[string]interface{}{
+				IdentitySubscriptionId: 10,
+				IdentityRegionId:       20,
+				IdentityTgwId:          30,
+			},
+			expected: "10/20/30",
+		},
+		{
+			name: "incomplete identity",
+			identity: map[string]interface{}{
+				IdentitySubscriptionId: 10,
+			},
+			errors: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			d := res.TestResourceData()
+			d.SetId(test.id)
+			if test.identity != nil {
+				require.NoError(t, SetResourceIdentity(d, test.identity))
+			}
+
+			importer := ImportStateWithIdentity(nil, IdentitySubscriptionId, IdentityRegionId, IdentityTgwId)
+			result, err := importer(context.Background(), d, nil)
+			if test.errors {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, result, 1)
+			assert.Equal(t, test.expected, result[0].Id())
+		})
+	}
+}
+
+func TestUnitImportStateWithIdentity_Next(t *testing.T) {
+	res := &schema.Resource{Identity: DatabaseIdentity()}
+	d := res.TestResourceData()
+	require.NoError(t, SetResourceIdentity(d, map[string]interface{}{
+		IdentitySubscriptionId: 1,
+		IdentityDatabaseId:     2,
+	}))
+
+	var seen string
+	importer := ImportStateWithIdentity(func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		seen = d.Id()
+		return []*schema.ResourceData{d}, nil
+	}, IdentitySubscriptionId, IdentityDatabaseId)
+
+	_, err := importer(context.Background(), d, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "1/2", seen)
+}
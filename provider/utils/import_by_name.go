@@ -0,0 +1,210 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/RedisLabs/rediscloud-go-api/service/subscriptions"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/client"
+)
+
+// ImportNamePrefix marks an import ID which refers to a resource by name rather than by numeric ID,
+// e.g. `name=my-subscription` or `name=my-subscription/my-database`.
+const ImportNamePrefix = "name="
+
+// NameResolverFunc turns the name given in a `name=` import ID into the resource ID.
+type NameResolverFunc func(ctx context.Context, api *client.ApiClient, name string) (string, error)
+
+// IsImportByName reports whether the import ID refers to a resource by name, returning the name.
+func IsImportByName(id string) (string, bool) {
+	if !strings.HasPrefix(id, ImportNamePrefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(id, ImportNamePrefix), true
+}
+
+// ImportStateByName returns an import function accepting a `name=` import ID in addition to the usual ID. The
+// name is resolved to the resource ID before calling next. A nil next behaves like
+// schema.ImportStatePassthroughContext.
+func ImportStateByName(resolve NameResolverFunc, next schema.StateContextFunc) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		if name, ok := IsImportByName(d.Id()); ok {
+			id, err := resolve(ctx, meta.(*client.ApiClient), name)
+			if err != nil {
+				return nil, err
+			}
+			d.SetId(id)
+		}
+
+		if next == nil {
+			return []*schema.ResourceData{d}, nil
+		}
+		return next(ctx, d, meta)
+	}
+}
+
+// SplitDatabaseImportName splits a `<subscription-name>/<database-name>` import name. Database names cannot contain
+// a slash, so the name is split on the last one.
+func SplitDatabaseImportName(name string) (string, string, error) {
+	i := strings.LastIndex(name, "/")
+	if i <= 0 || i == len(name)-1 {
+		return "", "", fmt.Errorf("expected name=<subscription name>/<database name>, got name=%s", name)
+	}
+	return name[:i], name[i+1:], nil
+}
+
+// SubscriptionNameResolver resolves a subscription of the given deployment type by name.
+func SubscriptionNameResolver(deploymentType string) NameResolverFunc {
+	return func(ctx context.Context, api *client.ApiClient, name string) (string, error) {
+		subId, err := findSubscriptionByName(ctx, api, deploymentType, name)
+		if err != nil {
+			return "", err
+		}
+		return strconv.Itoa(subId), nil
+	}
+}
+
+// DatabaseNameResolver resolves a database by the name of its subscription, of the given deployment type, and its
+// own name.
+func DatabaseNameResolver(deploymentType string) NameResolverFunc {
+	return func(ctx context.Context, api *client.ApiClient, name string) (string, error) {
+		subName, dbName, err := SplitDatabaseImportName(name)
+		if err != nil {
+			return "", err
+		}
+
+		subId, err := findSubscriptionByName(ctx, api, deploymentType, subName)
+		if err != nil {
+			return "", err
+		}
+
+		var candidates []NamedId
+		if deploymentType == subscriptions.SubscriptionDeploymentTypeActiveActive {
+			list := api.Client.Database.ListActiveActive(ctx, subId)
+			for list.Next() {
+				db := list.Value()
+				candidates = append(candidates, NamedId{Id: redis.IntValue(db.ID), Name: redis.StringValue(db.Name)})
+			}
+			err = list.Err()
+		} else {
+			list := api.Client.Database.List(ctx, subId)
+			for list.Next() {
+				db := list.Value()
+				candidates = append(candidates, NamedId{Id: redis.IntValue(db.ID), Name: redis.StringValue(db.Name)})
+			}
+			err = list.Err()
+		}
+		if err != nil {
+			return "", err
+		}
+
+		dbId, err := MatchByName(fmt.Sprintf("database of subscription %q", subName), dbName, candidates)
+		if err != nil {
+			return "", err
+		}
+		return BuildResourceId(subId, dbId), nil
+	}
+}
+
+// AclRuleNameResolver resolves an ACL rule by name.
+func AclRuleNameResolver(ctx context.Context, api *client.ApiClient, name string) (string, error) {
+	rules, err := api.Client.RedisRules.List(ctx)
+	if err != nil {
+		return "", err
+	}
+	var candidates []NamedId
+	for _, rule := range rules {
+		candidates = append(candidates, NamedId{Id: redis.IntValue(rule.ID), Name: redis.StringValue(rule.Name)})
+	}
+	return matchIdByName("ACL rule", name, candidates)
+}
+
+// AclRoleNameResolver resolves an ACL role by name.
+func AclRoleNameResolver(ctx context.Context, api *client.ApiClient, name string) (string, error) {
+	roles, err := api.Client.Roles.List(ctx)
+	if err != nil {
+		return "", err
+	}
+	var candidates []NamedId
+	for _, role := range roles {
+		candidates = append(candidates, NamedId{Id: redis.IntValue(role.ID), Name: redis.StringValue(role.Name)})
+	}
+	return matchIdByName("ACL role", name, candidates)
+}
+
+// AclUserNameResolver resolves an ACL user by name.
+func AclUserNameResolver(ctx context.Context, api *client.ApiClient, name string) (string, error) {
+	users, err := api.Client.Users.List(ctx)
+	if err != nil {
+		return "", err
+	}
+	var candidates []NamedId
+	for _, user := range users {
+		candidates = append(candidates, NamedId{Id: redis.IntValue(user.ID), Name: redis.StringValue(user.Name)})
+	}
+	return matchIdByName("ACL user", name, candidates)
+}
+
+// NamedId is a resource ID alongside the resource name, as returned by the API's list operations.
+type NamedId struct {
+	Id   int
+	Name string
+}
+
+// MatchByName returns the ID of the single candidate with the given name. It fails if there is no such candidate,
+// or if the name is ambiguous. kind describes the resource in error messages.
+func MatchByName(kind string, name string, candidates []NamedId) (int, error) {
+	var ids []int
+	for _, candidate := range candidates {
+		if candidate.Name == name {
+			ids = append(ids, candidate.Id)
+		}
+	}
+
+	switch len(ids) {
+	case 0:
+		return 0, fmt.Errorf("no %s named %q was found", kind, name)
+	case 1:
+		return ids[0], nil
+	default:
+		sort.Ints(ids)
+		idStrings := make([]string, len(ids))
+		for i, id := range ids {
+			idStrings[i] = strconv.Itoa(id)
+		}
+		return 0, fmt.Errorf("more than one %s is named %q (IDs %s), import using the ID instead",
+			kind, name, strings.Join(idStrings, ", "))
+	}
+}
+
+func matchIdByName(kind string, name string, candidates []NamedId) (string, error) {
+	id, err := MatchByName(kind, name, candidates)
+	if err != nil {
+		return "", err
+	}
+	return strconv.Itoa(id), nil
+}
+
+func findSubscriptionByName(ctx context.Context, api *client.ApiClient, deploymentType string, name string) (int, error) {
+	subs, err := ListSubscriptions(ctx, api, deploymentType, 0)
+	if err != nil {
+		return 0, err
+	}
+
+	var candidates []NamedId
+	for _, sub := range subs {
+		candidates = append(candidates, NamedId{Id: redis.IntValue(sub.ID), Name: redis.StringValue(sub.Name)})
+	}
+
+	kind := "Pro subscription"
+	if deploymentType == subscriptions.SubscriptionDeploymentTypeActiveActive {
+		kind = "Active-Active subscription"
+	}
+	return MatchByName(kind, name, candidates)
+}
@@ -0,0 +1,91 @@
+package utils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/client"
+)
+
+func TestUnitMatchByName(t *testing.T) {
+	candidates := []NamedId{
+		{Id: 1, Name: "production"},
+		{Id: 3, Name: "staging"},
+		{Id: 2, Name: "staging"},
+	}
+
+	tests := []struct {
+		name     string
+		expected int
+		err      string
+	}{
+		{name: "production", expected: 1},
+		{name: "development", err: `no subscription named "development" was found`},
+		{name: "staging", err: `more than one subscription is named "staging" (IDs 2, 3), import using the ID instead`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			id, err := MatchByName("subscription", test.name, candidates)
+			if test.err != "" {
+				assert.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, id)
+		})
+	}
+}
+
+func TestUnitSplitDatabaseImportName(t *testing.T) {
+	tests := []struct {
+		input  string
+		sub    string
+		db     string
+		errors bool
+	}{
+		{input: "my-sub/my-db", sub: "my-sub", db: "my-db"},
+		{input: "team/my-sub/my-db", sub: "team/my-sub", db: "my-db"},
+		{input: "my-sub", errors: true},
+		{input: "/my-db", errors: true},
+		{input: "my-sub/", errors: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			sub, db, err := SplitDatabaseImportName(test.input)
+			if test.errors {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.sub, sub)
+			assert.Equal(t, test.db, db)
+		})
+	}
+}
+
+func TestUnitImportStateByName(t *testing.T) {
+	resolve := func(ctx context.Context, api *client.ApiClient, name string) (string, error) {
+		assert.Equal(t, "my-sub/my-db", name)
+		return "12/34", nil
+	}
+	importer := ImportStateByName(resolve, nil)
+	res := &schema.Resource{}
+
+	d := res.TestResourceData()
+	d.SetId("name=my-sub/my-db")
+	result, err := importer(context.Background(), d, &client.ApiClient{})
+	require.NoError(t, err)
+	assert.Equal(t, "12/34", result[0].Id())
+
+	d = res.TestResourceData()
+	d.SetId("56/78")
+	result, err = importer(context.Background(), d, &client.ApiClient{})
+	require.NoError(t, err)
+	assert.Equal(t, "56/78", result[0].Id())
+}
@@ -0,0 +1,36 @@
+package utils
+
+import (
+	"context"
+
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/RedisLabs/rediscloud-go-api/service/subscriptions"
+
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/client"
+)
+
+// ListSubscriptions returns the account's Pro subscriptions with the given deployment type
+// (subscriptions.SubscriptionDeploymentTypeSingleRegion or subscriptions.SubscriptionDeploymentTypeActiveActive).
+// If subscriptionId is non-zero, only that subscription is returned, provided it has the given deployment type.
+func ListSubscriptions(ctx context.Context, api *client.ApiClient, deploymentType string, subscriptionId int) ([]*subscriptions.Subscription, error) {
+	list, err := api.Client.Subscription.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var matched []*subscriptions.Subscription
+	for _, sub := range list {
+		actual := redis.StringValue(sub.DeploymentType)
+		if actual == "" {
+			actual = subscriptions.SubscriptionDeploymentTypeSingleRegion
+		}
+		if actual != deploymentType {
+			continue
+		}
+		if subscriptionId != 0 && redis.IntValue(sub.ID) != subscriptionId {
+			continue
+		}
+		matched = append(matched, sub)
+	}
+	return matched, nil
+}
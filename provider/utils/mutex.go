@@ -1,38 +1,279 @@
 package utils
 
-import "sync"
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
 
-// Lock that must be acquired when modifying something related to a subscription as only one _thing_ can modify a subscription and all sub-resources at any time
-var SubscriptionMutex = newPerIdLock()
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/client"
+)
 
-func newPerIdLock() *perIdLock {
-	return &perIdLock{
-		store: map[int]*sync.Mutex{},
+// SubscriptionLocks are the locks which must be acquired when modifying something related to a subscription, as a
+// subscription only accepts one change at a time. Changes to a single region of an Active-Active subscription only
+// lock that region, and operations which rely on a subscription without changing it share their lock.
+var SubscriptionLocks = NewLockManager()
+
+// LockSubscription acquires an exclusive lock on a whole subscription, returning a function releasing it.
+func LockSubscription(ctx context.Context, subId int) (func(), error) {
+	return SubscriptionLocks.Lock(ctx, SubscriptionLockKey(subId), ExclusiveLock)
+}
+
+// RLockSubscription acquires a shared lock on a whole subscription, returning a function releasing it.
+func RLockSubscription(ctx context.Context, subId int) (func(), error) {
+	return SubscriptionLocks.Lock(ctx, SubscriptionLockKey(subId), SharedLock)
+}
+
+// LockRegion acquires an exclusive lock on one region of an Active-Active subscription, returning a function
+// releasing it. If region is empty, the whole subscription is locked.
+func LockRegion(ctx context.Context, subId int, region string) (func(), error) {
+	return SubscriptionLocks.Lock(ctx, RegionLockKey(subId, region), ExclusiveLock)
+}
+
+// LockActiveActiveRegion acquires an exclusive lock on the region of an Active-Active subscription with the given ID,
+// returning a function releasing it. If the subscription has no such region, the whole subscription is locked instead.
+func LockActiveActiveRegion(ctx context.Context, api *client.ApiClient, subId int, regionId int) (func(), error) {
+	key, err := activeActiveRegionLockKey(ctx, api, subId, regionId)
+	if err != nil {
+		return nil, err
+	}
+	return SubscriptionLocks.Lock(ctx, key, ExclusiveLock)
+}
+
+// RLockActiveActiveRegion acquires a shared lock on the region of an Active-Active subscription with the given ID,
+// returning a function releasing it. If the subscription has no such region, the whole subscription is locked instead.
+func RLockActiveActiveRegion(ctx context.Context, api *client.ApiClient, subId int, regionId int) (func(), error) {
+	key, err := activeActiveRegionLockKey(ctx, api, subId, regionId)
+	if err != nil {
+		return nil, err
+	}
+	return SubscriptionLocks.Lock(ctx, key, SharedLock)
+}
+
+// activeActiveRegionLockKey looks up the name of a region by its ID, as regions are locked by name. The names are
+// cached by the client, so the regions are only listed the first time one of them is locked.
+func activeActiveRegionLockKey(ctx context.Context, api *client.ApiClient, subId int, regionId int) (LockKey, error) {
+	name, err := api.RegionName(ctx, subId, regionId)
+	if err != nil {
+		return LockKey{}, fmt.Errorf("failed to find the name of region %d of subscription %d: %w", regionId, subId, err)
+	}
+	if name == "" {
+		log.Printf("[WARN] Locking subscription %d as it has no region %d", subId, regionId)
+		return SubscriptionLockKey(subId), nil
+	}
+	return RegionLockKey(subId, name), nil
+}
+
+// LockMode is whether a lock is shared or exclusive.
+type LockMode int
+
+const (
+	// SharedLock is held by operations which need a subscription not to change, but don't change it themselves.
+	SharedLock LockMode = iota
+	// ExclusiveLock is held by operations which change a subscription.
+	ExclusiveLock
+)
+
+func (m LockMode) String() string {
+	if m == ExclusiveLock {
+		return "exclusive"
+	}
+	return "shared"
+}
+
+// LockKey is what a lock is taken on: a whole subscription, or one region of an Active-Active subscription.
+//
+// A lock on a whole subscription conflicts with every exclusive lock on the subscription or its regions, and an
+// exclusive lock on a whole subscription conflicts with every lock. A lock on a region only conflicts with locks on
+// the same region and on the whole subscription, so that different regions can be changed at the same time.
+type LockKey struct {
+	SubscriptionId int
+	// Region is the name of the region locked, or empty for the whole subscription.
+	Region string
+}
+
+// SubscriptionLockKey is the key locking a whole subscription.
+func SubscriptionLockKey(subId int) LockKey {
+	return LockKey{SubscriptionId: subId}
+}
+
+// RegionLockKey is the key locking one region of an Active-Active subscription.
+func RegionLockKey(subId int, region string) LockKey {
+	return LockKey{SubscriptionId: subId, Region: region}
+}
+
+func (k LockKey) String() string {
+	if k.Region == "" {
+		return fmt.Sprintf("subscription %d", k.SubscriptionId)
+	}
+	return fmt.Sprintf("subscription %d region %s", k.SubscriptionId, k.Region)
+}
+
+// LockManager hands out shared and exclusive locks on subscriptions and their regions.
+type LockManager struct {
+	mu            sync.Mutex
+	subscriptions map[int]*subscriptionLocks
+}
+
+// NewLockManager returns a LockManager with no locks held.
+func NewLockManager() *LockManager {
+	return &LockManager{subscriptions: map[int]*subscriptionLocks{}}
+}
+
+// subscriptionLocks counts the locks held on a subscription and its regions.
+type subscriptionLocks struct {
+	held map[LockKey]*heldLocks
+	// exclusiveWaiters counts the callers waiting for an exclusive lock on the whole subscription, which new shared
+	// and region locks give way to, so that a steady stream of them doesn't starve the whole subscription's changes.
+	exclusiveWaiters int
+	waiters          int
+	// released is closed, and replaced, whenever a lock is released.
+	released chan struct{}
+}
+
+type heldLocks struct {
+	shared    int
+	exclusive int
+}
+
+func (s *subscriptionLocks) count(key LockKey, mode LockMode) int {
+	held, ok := s.held[key]
+	if !ok {
+		return 0
 	}
+	if mode == ExclusiveLock {
+		return held.exclusive
+	}
+	return held.shared
 }
 
-type perIdLock struct {
-	lock  sync.Mutex
-	store map[int]*sync.Mutex
+// available reports whether a lock on key can be taken without conflicting with the locks held.
+func (s *subscriptionLocks) available(key LockKey, mode LockMode) bool {
+	whole := SubscriptionLockKey(key.SubscriptionId)
+	if s.count(whole, ExclusiveLock) > 0 {
+		return false
+	}
+
+	if key.Region == "" {
+		if mode == SharedLock {
+			return s.exclusiveWaiters == 0 && !s.anyRegion(ExclusiveLock)
+		}
+		return s.count(whole, SharedLock) == 0 && !s.anyRegion(SharedLock) && !s.anyRegion(ExclusiveLock)
+	}
+
+	if s.exclusiveWaiters > 0 || s.count(key, ExclusiveLock) > 0 {
+		return false
+	}
+	if mode == ExclusiveLock {
+		return s.count(whole, SharedLock) == 0 && s.count(key, SharedLock) == 0
+	}
+	return true
 }
 
-func (m *perIdLock) Lock(id int) {
-	m.get(id).Lock()
+func (s *subscriptionLocks) anyRegion(mode LockMode) bool {
+	for key := range s.held {
+		if key.Region != "" && s.count(key, mode) > 0 {
+			return true
+		}
+	}
+	return false
 }
 
-func (m *perIdLock) Unlock(id int) {
-	m.get(id).Unlock()
+func (s *subscriptionLocks) add(key LockKey, mode LockMode, delta int) {
+	held, ok := s.held[key]
+	if !ok {
+		held = &heldLocks{}
+		s.held[key] = held
+	}
+	if mode == ExclusiveLock {
+		held.exclusive += delta
+	} else {
+		held.shared += delta
+	}
+	if held.shared == 0 && held.exclusive == 0 {
+		delete(s.held, key)
+	}
 }
 
-func (m *perIdLock) get(id int) *sync.Mutex {
-	m.lock.Lock()
-	defer m.lock.Unlock()
+func (m *LockManager) get(subId int) *subscriptionLocks {
+	s, ok := m.subscriptions[subId]
+	if !ok {
+		s = &subscriptionLocks{
+			held:     map[LockKey]*heldLocks{},
+			released: make(chan struct{}),
+		}
+		m.subscriptions[subId] = s
+	}
+	return s
+}
 
-	if v, ok := m.store[id]; ok {
-		return v
+// forget drops the record of a subscription with no locks held or waited for.
+func (m *LockManager) forget(subId int, s *subscriptionLocks) {
+	if len(s.held) == 0 && s.waiters == 0 {
+		delete(m.subscriptions, subId)
 	}
+}
+
+// Lock blocks until a lock on key can be taken, returning a function releasing it. Calling the function more than
+// once releases the lock only once, so it can be both deferred and called early. If ctx is done before the lock is
+// taken, its error is returned.
+func (m *LockManager) Lock(ctx context.Context, key LockKey, mode LockMode) (func(), error) {
+	start := time.Now()
+	waiting := false
+
+	m.mu.Lock()
+	s := m.get(key.SubscriptionId)
+	for !s.available(key, mode) {
+		if !waiting {
+			waiting = true
+			s.waiters++
+			if mode == ExclusiveLock && key.Region == "" {
+				s.exclusiveWaiters++
+			}
+			log.Printf("[DEBUG] Waiting for %s lock on %s", mode, key)
+		}
+		released := s.released
+		m.mu.Unlock()
 
-	mutex := &sync.Mutex{}
-	m.store[id] = mutex
-	return mutex
+		select {
+		case <-ctx.Done():
+			m.mu.Lock()
+			m.stopWaiting(key, mode, s)
+			m.mu.Unlock()
+			return nil, fmt.Errorf("stopped waiting for %s lock on %s after %s: %w", mode, key, time.Since(start).Round(time.Second), ctx.Err())
+		case <-released:
+		}
+
+		m.mu.Lock()
+	}
+	s.add(key, mode, 1)
+	if waiting {
+		m.stopWaiting(key, mode, s)
+		log.Printf("[INFO] Acquired %s lock on %s after waiting %s", mode, key, time.Since(start).Round(time.Millisecond))
+	}
+	m.mu.Unlock()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			m.mu.Lock()
+			defer m.mu.Unlock()
+			s.add(key, mode, -1)
+			close(s.released)
+			s.released = make(chan struct{})
+			m.forget(key.SubscriptionId, s)
+		})
+	}, nil
+}
+
+func (m *LockManager) stopWaiting(key LockKey, mode LockMode, s *subscriptionLocks) {
+	s.waiters--
+	if mode == ExclusiveLock && key.Region == "" {
+		s.exclusiveWaiters--
+		// Shared locks may have been holding back for this waiter.
+		close(s.released)
+		s.released = make(chan struct{})
+	}
+	m.forget(key.SubscriptionId, s)
 }
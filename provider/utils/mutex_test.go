@@ -0,0 +1,185 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/client"
+)
+
+// tryLock takes a lock if it is available straight away.
+func tryLock(t *testing.T, m *LockManager, key LockKey, mode LockMode) (func(), bool) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	unlock, err := m.Lock(ctx, key, mode)
+	if err != nil {
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		return nil, false
+	}
+	return unlock, true
+}
+
+func TestUnitLockManagerConflicts(t *testing.T) {
+	whole := SubscriptionLockKey(1)
+	east := RegionLockKey(1, "us-east-1")
+	west := RegionLockKey(1, "us-west-2")
+	other := SubscriptionLockKey(2)
+
+	tests := []struct {
+		name      string
+		held      LockKey
+		heldMode  LockMode
+		want      LockKey
+		wantMode  LockMode
+		available bool
+	}{
+		{name: "exclusive blocks exclusive", held: whole, heldMode: ExclusiveLock, want: whole, wantMode: ExclusiveLock},
+		{name: "exclusive blocks shared", held: whole, heldMode: ExclusiveLock, want: whole, wantMode: SharedLock},
+		{name: "shared blocks exclusive", held: whole, heldMode: SharedLock, want: whole, wantMode: ExclusiveLock},
+		{name: "shared allows shared", held: whole, heldMode: SharedLock, want: whole, wantMode: SharedLock, available: true},
+		{name: "subscription exclusive blocks region", held: whole, heldMode: ExclusiveLock, want: east, wantMode: SharedLock},
+		{name: "region exclusive blocks subscription", held: east, heldMode: ExclusiveLock, want: whole, wantMode: SharedLock},
+		{name: "subscription shared blocks region exclusive", held: whole, heldMode: SharedLock, want: east, wantMode: ExclusiveLock},
+		{name: "subscription shared allows region shared", held: whole, heldMode: SharedLock, want: east, wantMode: SharedLock, available: true},
+		{name: "region shared blocks subscription exclusive", held: east, heldMode: SharedLock, want: whole, wantMode: ExclusiveLock},
+		{name: "region shared allows subscription shared", held: east, heldMode: SharedLock, want: whole, wantMode: SharedLock, available: true},
+		{name: "region exclusive blocks same region", held: east, heldMode: ExclusiveLock, want: east, wantMode: SharedLock},
+		{name: "region exclusive allows other region", held: east, heldMode: ExclusiveLock, want: west, wantMode: ExclusiveLock, available: true},
+		{name: "exclusive allows other subscription", held: whole, heldMode: ExclusiveLock, want: other, wantMode: ExclusiveLock, available: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			m := NewLockManager()
+			unlockHeld, err := m.Lock(context.Background(), test.held, test.heldMode)
+			require.NoError(t, err)
+
+			unlock, ok := tryLock(t, m, test.want, test.wantMode)
+			assert.Equal(t, test.available, ok)
+			if ok {
+				unlock()
+			}
+
+			// Once the held lock is released, the wanted one is always available.
+			unlockHeld()
+			unlock, ok = tryLock(t, m, test.want, test.wantMode)
+			require.True(t, ok)
+			unlock()
+			assert.Empty(t, m.subscriptions, "nothing is left behind once every lock is released")
+		})
+	}
+}
+
+func TestUnitLockManagerWaitsForRelease(t *testing.T) {
+	m := NewLockManager()
+	unlock, err := m.Lock(context.Background(), SubscriptionLockKey(1), ExclusiveLock)
+	require.NoError(t, err)
+
+	acquired := make(chan func())
+	go func() {
+		unlock, err := m.Lock(context.Background(), SubscriptionLockKey(1), ExclusiveLock)
+		assert.NoError(t, err)
+		acquired <- unlock
+	}()
+
+	select {
+	case <-acquired:
+		t.Fatal("the lock was acquired while still held")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	unlock()
+	// Releasing a lock twice doesn't release the next holder's lock.
+	unlock()
+	select {
+	case unlockNext := <-acquired:
+		_, ok := tryLock(t, m, SubscriptionLockKey(1), SharedLock)
+		assert.False(t, ok)
+		unlockNext()
+	case <-time.After(time.Second):
+		t.Fatal("the lock wasn't acquired once released")
+	}
+	assert.Empty(t, m.subscriptions)
+}
+
+func TestUnitLockManagerCancelled(t *testing.T) {
+	m := NewLockManager()
+	unlock, err := m.Lock(context.Background(), RegionLockKey(1, "us-east-1"), ExclusiveLock)
+	require.NoError(t, err)
+	defer unlock()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = m.Lock(ctx, SubscriptionLockKey(1), ExclusiveLock)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.ErrorContains(t, err, "stopped waiting for exclusive lock on subscription 1")
+
+	// The cancelled waiter no longer holds back other locks.
+	unlockWest, ok := tryLock(t, m, RegionLockKey(1, "us-west-2"), ExclusiveLock)
+	require.True(t, ok)
+	unlockWest()
+}
+
+func TestUnitLockManagerExclusiveNotStarved(t *testing.T) {
+	m := NewLockManager()
+	unlockShared, err := m.Lock(context.Background(), SubscriptionLockKey(1), SharedLock)
+	require.NoError(t, err)
+
+	acquired := make(chan func())
+	go func() {
+		unlock, err := m.Lock(context.Background(), SubscriptionLockKey(1), ExclusiveLock)
+		assert.NoError(t, err)
+		acquired <- unlock
+	}()
+	require.Eventually(t, func() bool {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		return m.subscriptions[1].exclusiveWaiters == 1
+	}, time.Second, time.Millisecond)
+
+	// New shared and region locks give way to the waiting exclusive lock.
+	_, ok := tryLock(t, m, SubscriptionLockKey(1), SharedLock)
+	assert.False(t, ok)
+	_, ok = tryLock(t, m, RegionLockKey(1, "us-east-1"), SharedLock)
+	assert.False(t, ok)
+
+	unlockShared()
+	unlock := <-acquired
+	unlock()
+	assert.Empty(t, m.subscriptions)
+}
+
+func TestUnitLockKeyString(t *testing.T) {
+	assert.Equal(t, "subscription 1", SubscriptionLockKey(1).String())
+	assert.Equal(t, "subscription 1 region us-east-1", RegionLockKey(1, "us-east-1").String())
+}
+
+func TestUnitActiveActiveRegionLockKey(t *testing.T) {
+	_, api, transport := newWaiterTestClient(t, client.FaultConfig{})
+	subId, regionId := createActiveActiveWaiterTestSubscription(t, api)
+
+	key, err := activeActiveRegionLockKey(context.Background(), api, subId, regionId)
+	require.NoError(t, err)
+	assert.Equal(t, RegionLockKey(subId, "eu-west-1"), key)
+
+	// The region names are cached, so the API isn't called again for a region already seen.
+	transport.SetFaults(client.FaultConfig{ServerErrorRate: 1})
+	key, err = activeActiveRegionLockKey(context.Background(), api, subId, regionId)
+	require.NoError(t, err)
+	assert.Equal(t, RegionLockKey(subId, "eu-west-1"), key)
+
+	// A region not seen before lists the regions again, and a failure to list them is an error.
+	_, err = activeActiveRegionLockKey(context.Background(), api, subId, regionId+100)
+	assert.ErrorContains(t, err, fmt.Sprintf("failed to find the name of region %d of subscription %d", regionId+100, subId))
+
+	transport.SetFaults(client.FaultConfig{})
+	key, err = activeActiveRegionLockKey(context.Background(), api, subId, regionId+100)
+	require.NoError(t, err)
+	assert.Equal(t, SubscriptionLockKey(subId), key)
+}
@@ -1,8 +1,13 @@
 package utils
 
 import (
+	"context"
+
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 // IsConfigured returns true if the field was explicitly set by the user.
@@ -39,3 +44,63 @@ func SetStringPreserveConfig(field *types.String, apiValue *string) {
 	}
 	SetStringFromAPI(field, apiValue)
 }
+
+// IsFullyKnown reports whether the values, and every value nested in them, are known.
+func IsFullyKnown(ctx context.Context, values ...attr.Value) bool {
+	for _, value := range values {
+		raw, err := value.ToTerraformValue(ctx)
+		if err != nil || !raw.IsFullyKnown() {
+			return false
+		}
+	}
+	return true
+}
+
+// NullUnknownValues replaces the unknown values in a state with nulls. It is for saving a resource whose create
+// didn't finish, and so whose computed values couldn't be read back.
+func NullUnknownValues(state *tfsdk.State) error {
+	raw, err := tftypes.Transform(state.Raw, func(_ *tftypes.AttributePath, value tftypes.Value) (tftypes.Value, error) {
+		if !value.IsKnown() {
+			return tftypes.NewValue(value.Type(), nil), nil
+		}
+		return value, nil
+	})
+	if err != nil {
+		return err
+	}
+	state.Raw = raw
+	return nil
+}
+
+// resumeCreateBoolModifier is a plan modifier for the creation_pending marker. A new resource's marker is unknown,
+// as its create may be interrupted. An existing resource's marker is planned to be false, so that an interrupted
+// create is resumed by an update.
+type resumeCreateBoolModifier struct{}
+
+var _ planmodifier.Bool = resumeCreateBoolModifier{}
+
+// ResumeCreateModifier returns the plan modifier of the CreationPendingKey attribute of Plugin Framework resources.
+// It is the equivalent of ResumeCreateDiff.
+func ResumeCreateModifier() planmodifier.Bool {
+	return resumeCreateBoolModifier{}
+}
+
+func (m resumeCreateBoolModifier) Description(_ context.Context) string {
+	return "Plans an update resuming an interrupted create."
+}
+
+func (m resumeCreateBoolModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m resumeCreateBoolModifier) PlanModifyBool(_ context.Context, req planmodifier.BoolRequest, resp *planmodifier.BoolResponse) {
+	if req.State.Raw.IsNull() {
+		return
+	}
+	// Resources created before the marker existed don't have one, and were never interrupted
+	if req.StateValue.IsNull() {
+		resp.PlanValue = req.StateValue
+		return
+	}
+	resp.PlanValue = types.BoolValue(false)
+}
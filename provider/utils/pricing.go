@@ -0,0 +1,164 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/RedisLabs/rediscloud-go-api/service/databases"
+	"github.com/RedisLabs/rediscloud-go-api/service/pricing"
+	"github.com/RedisLabs/rediscloud-go-api/service/subscriptions"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/client"
+)
+
+// EstimatedPricingKey is the attribute holding the pricing of a subscription's creation plan, as estimated when the
+// subscription is planned. Unlike pricing, it is known before the subscription is created, and kept as planned.
+const EstimatedPricingKey = "estimated_pricing"
+
+// EstimatedPricingDescription describes the EstimatedPricingKey attribute.
+const EstimatedPricingDescription = "The pricing of the subscription's `creation_plan`, as estimated by the API when the subscription was planned. Empty when the creation plan wasn't known during the plan, or when the subscription was imported."
+
+// hoursPerMonth converts hourly prices into monthly ones, for an average month.
+const hoursPerMonth = 730
+
+// PreviewPricing returns the pricing of a subscription create request, without creating anything. The request is
+// made as a dry run, whose task reports the pricing as its resource.
+func PreviewPricing(ctx context.Context, api *client.ApiClient, request subscriptions.CreateSubscription) ([]*pricing.Pricing, error) {
+	request.DryRun = redis.Bool(true)
+	return dryRunPricing(ctx, func(ctx context.Context) error {
+		_, err := api.Client.Subscription.Create(ctx, request)
+		return err
+	})
+}
+
+// PreviewDatabaseCreatePricing returns the pricing of the subscription once the database is created in it, without
+// creating anything. The request is made as a dry run, whose task reports the pricing as its resource.
+func PreviewDatabaseCreatePricing(ctx context.Context, api *client.ApiClient, subId int, request databases.CreateDatabase) ([]*pricing.Pricing, error) {
+	request.DryRun = redis.Bool(true)
+	return dryRunPricing(ctx, func(ctx context.Context) error {
+		_, err := api.Client.Database.Create(ctx, subId, request)
+		return err
+	})
+}
+
+// PreviewDatabaseUpdatePricing returns the pricing of the subscription once the database is updated, without updating
+// anything. The request is made as a dry run, whose task reports the pricing as its resource.
+func PreviewDatabaseUpdatePricing(ctx context.Context, api *client.ApiClient, subId int, dbId int, request databases.UpdateDatabase) ([]*pricing.Pricing, error) {
+	request.DryRun = redis.Bool(true)
+	return dryRunPricing(ctx, func(ctx context.Context) error {
+		return api.Client.Database.Update(ctx, subId, dbId, request)
+	})
+}
+
+// dryRunPricing makes a dry run request, and returns the pricing its task reports.
+func dryRunPricing(ctx context.Context, request func(ctx context.Context) error) ([]*pricing.Pricing, error) {
+	taskCtx, tasks := client.TrackTasks(ctx)
+	if err := request(taskCtx); err != nil {
+		return nil, err
+	}
+
+	task := tasks.Last()
+	if task == nil || len(task.Resource) == 0 {
+		return nil, errors.New("the dry run didn't report any pricing")
+	}
+	var resource pricing.ListPricingResponse
+	if err := json.Unmarshal(task.Resource, &resource); err != nil {
+		return nil, fmt.Errorf("failed to read the pricing of the dry run: %w", err)
+	}
+	return resource.Pricing, nil
+}
+
+// MonthlyPrice returns the monthly price of a pricing entry.
+func MonthlyPrice(p *pricing.Pricing) float64 {
+	price := float64(redis.IntValue(p.Quantity)) * redis.Float64Value(p.PricePerUnit)
+	switch strings.ToLower(redis.StringValue(p.PricePeriod)) {
+	case "month", "monthly":
+		return price
+	case "year", "yearly", "annual":
+		return price / 12
+	default:
+		return price * hoursPerMonth
+	}
+}
+
+// MonthlyPricingTotal returns the monthly total of the pricing entries, and their currency.
+func MonthlyPricingTotal(list []*pricing.Pricing) (float64, string) {
+	var total float64
+	var currency string
+	for _, p := range list {
+		total += MonthlyPrice(p)
+		if currency == "" {
+			currency = redis.StringValue(p.PriceCurrency)
+		}
+	}
+	return total, currency
+}
+
+// EstimatedPricingWarning is the warning reporting the estimated monthly cost of a subscription being created.
+func EstimatedPricingWarning(description string, list []*pricing.Pricing) diag.Diagnostic {
+	total, currency := MonthlyPricingTotal(list)
+	return diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("Estimated cost of %s: %.2f %s a month", description, total, currency),
+		Detail: fmt.Sprintf("The entries of `%s` total %.2f %s a month, counting %d hours a month for hourly "+
+			"prices. The estimate only covers the creation plan at the API's current prices; the cost of the "+
+			"subscription depends on the databases it ends up with.", EstimatedPricingKey, total, currency, hoursPerMonth),
+	}
+}
+
+// EstimatedPricingUnavailableWarning is the warning for a subscription whose pricing couldn't be estimated. The
+// subscription can still be created.
+func EstimatedPricingUnavailableWarning(description string, err error) diag.Diagnostic {
+	return diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("Couldn't estimate the cost of %s", description),
+		Detail:   fmt.Sprintf("The pricing of the creation plan couldn't be previewed: %s. `%s` will be empty.", err, EstimatedPricingKey),
+	}
+}
+
+// MaxMonthlyCostKey is the attribute capping the monthly cost of a subscription, on the provider and on the
+// subscription resources.
+const MaxMonthlyCostKey = "max_monthly_cost"
+
+// MaxMonthlyCostDescription describes the MaxMonthlyCostKey attribute of the subscription resources.
+const MaxMonthlyCostDescription = "The highest monthly cost allowed for the subscription, in the currency of its pricing. Plans creating the subscription fail if its `estimated_pricing` costs more, and plans changing it fail if its current `pricing` does. The lower of this and the provider's `max_monthly_cost` applies."
+
+// MaxMonthlyCost returns the ceiling applying to a subscription, the lower of the provider's and the subscription's
+// max_monthly_cost, and where it was set. A ceiling of 0 isn't set.
+func MaxMonthlyCost(providerMax float64, subscriptionMax float64) (float64, string) {
+	switch {
+	case subscriptionMax > 0 && (providerMax <= 0 || subscriptionMax <= providerMax):
+		return subscriptionMax, "the subscription"
+	case providerMax > 0:
+		return providerMax, "the provider"
+	default:
+		return 0, ""
+	}
+}
+
+// CheckMaxMonthlyCost returns an error listing the pricing entries of a subscription if their monthly total exceeds
+// the ceiling applying to it.
+func CheckMaxMonthlyCost(description string, list []*pricing.Pricing, providerMax float64, subscriptionMax float64) error {
+	ceiling, setOn := MaxMonthlyCost(providerMax, subscriptionMax)
+	if ceiling <= 0 {
+		return nil
+	}
+	total, currency := MonthlyPricingTotal(list)
+	if total <= ceiling {
+		return nil
+	}
+
+	var items strings.Builder
+	for _, p := range list {
+		fmt.Fprintf(&items, "\n  - database_name: %q, type: %q, quantity: %d, price_per_unit: %g %s per %s (%.2f a month)",
+			redis.StringValue(p.DatabaseName), redis.StringValue(p.Type), redis.IntValue(p.Quantity),
+			redis.Float64Value(p.PricePerUnit), redis.StringValue(p.PriceCurrency), redis.StringValue(p.PricePeriod), MonthlyPrice(p))
+	}
+	return fmt.Errorf("%s would cost %.2f %s a month, over the `%s` of %.2f set on %s:%s",
+		description, total, currency, MaxMonthlyCostKey, ceiling, setOn, items.String())
+}
@@ -0,0 +1,260 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	rediscloudApi "github.com/RedisLabs/rediscloud-go-api"
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/RedisLabs/rediscloud-go-api/service/databases"
+	"github.com/RedisLabs/rediscloud-go-api/service/pricing"
+	"github.com/RedisLabs/rediscloud-go-api/service/subscriptions"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/client"
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/fakeapi"
+)
+
+func TestUnitPreviewPricing(t *testing.T) {
+	regions := []*subscriptions.CreateRegion{
+		{Region: redis.String("us-east-1"), Networking: &subscriptions.CreateNetworking{DeploymentCIDR: redis.String("10.0.0.0/24")}},
+		{Region: redis.String("eu-west-1"), Networking: &subscriptions.CreateNetworking{DeploymentCIDR: redis.String("10.0.1.0/24")}},
+	}
+
+	tests := []struct {
+		name           string
+		deploymentType *string
+		regions        []*subscriptions.CreateRegion
+		untracked      bool
+		entries        []string
+		err            string
+	}{
+		{name: "single region", regions: regions[:1], entries: []string{"us-east-1"}},
+		{
+			name:           "active-active",
+			deploymentType: redis.String(subscriptions.SubscriptionDeploymentTypeActiveActive),
+			regions:        regions,
+			entries:        []string{"us-east-1", "eu-west-1"},
+		},
+		{name: "tasks not tracked", regions: regions[:1], untracked: true, err: "didn't report any pricing"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fake := fakeapi.New(fakeapi.Options{})
+			t.Cleanup(fake.Close)
+
+			transport := http.DefaultTransport
+			if !test.untracked {
+				transport = client.NewTaskTransport(transport)
+			}
+			c, err := fake.Client(rediscloudApi.Transporter(transport))
+			require.NoError(t, err)
+			api := &client.ApiClient{Client: c}
+
+			list, err := PreviewPricing(context.Background(), api, subscriptions.CreateSubscription{
+				Name:            redis.String("estimate"),
+				DeploymentType:  test.deploymentType,
+				DryRun:          redis.Bool(false),
+				PaymentMethodID: redis.Int(fakeapi.PaymentMethodId),
+				CloudProviders:  []*subscriptions.CreateCloudProvider{{Provider: redis.String("AWS"), Regions: test.regions}},
+				Databases:       []*subscriptions.CreateDatabase{{Name: redis.String("creation-plan"), Quantity: redis.Int(2)}},
+			})
+			if test.err != "" {
+				assert.ErrorContains(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, list, len(test.entries))
+			for i, region := range test.entries {
+				assert.Equal(t, region, redis.StringValue(list[i].Region))
+				assert.Equal(t, "creation-plan", redis.StringValue(list[i].DatabaseName))
+				assert.Equal(t, 2, redis.IntValue(list[i].Quantity))
+			}
+
+			subs, err := api.Client.Subscription.List(context.Background())
+			require.NoError(t, err)
+			assert.Empty(t, subs, "a dry run creates nothing")
+		})
+	}
+}
+
+func TestUnitPreviewDatabasePricing(t *testing.T) {
+	ctx := context.Background()
+	fake := fakeapi.New(fakeapi.Options{})
+	t.Cleanup(fake.Close)
+	c, err := fake.Client(rediscloudApi.Transporter(client.NewTaskTransport(http.DefaultTransport)))
+	require.NoError(t, err)
+	api := &client.ApiClient{Client: c}
+
+	subId, err := c.Subscription.Create(ctx, subscriptions.CreateSubscription{
+		Name:            redis.String("pricing"),
+		PaymentMethodID: redis.Int(fakeapi.PaymentMethodId),
+		CloudProviders: []*subscriptions.CreateCloudProvider{{
+			Provider: redis.String("AWS"),
+			Regions:  []*subscriptions.CreateRegion{{Region: redis.String("us-east-1")}},
+		}},
+	})
+	require.NoError(t, err)
+	dbId, err := c.Database.Create(ctx, subId, databases.CreateDatabase{Name: redis.String("cache"), MemoryLimitInGB: redis.Float64(1)})
+	require.NoError(t, err)
+
+	quantities := func(list []*pricing.Pricing) map[string]int {
+		result := make(map[string]int)
+		for _, p := range list {
+			result[redis.StringValue(p.DatabaseName)] = redis.IntValue(p.Quantity)
+		}
+		return result
+	}
+
+	list, err := PreviewDatabaseUpdatePricing(ctx, api, subId, dbId, databases.UpdateDatabase{MemoryLimitInGB: redis.Float64(60)})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"cache": 3}, quantities(list))
+
+	list, err = PreviewDatabaseCreatePricing(ctx, api, subId, databases.CreateDatabase{Name: redis.String("other"), DatasetSizeInGB: redis.Float64(30)})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"cache": 1, "other": 2}, quantities(list))
+
+	db, err := c.Database.Get(ctx, subId, dbId)
+	require.NoError(t, err)
+	assert.Equal(t, 1.0, redis.Float64Value(db.MemoryLimitInGB), "a dry run changes nothing")
+	dbs := c.Database.List(ctx, subId)
+	var names []string
+	for dbs.Next() {
+		names = append(names, redis.StringValue(dbs.Value().Name))
+	}
+	require.NoError(t, dbs.Err())
+	assert.Equal(t, []string{"cache"}, names, "a dry run creates nothing")
+}
+
+func TestUnitMonthlyPricingTotal(t *testing.T) {
+	entry := func(quantity int, price float64, period string) *pricing.Pricing {
+		return &pricing.Pricing{
+			Quantity:      redis.Int(quantity),
+			PricePerUnit:  redis.Float64(price),
+			PriceCurrency: redis.String("USD"),
+			PricePeriod:   redis.String(period),
+		}
+	}
+
+	tests := []struct {
+		name     string
+		list     []*pricing.Pricing
+		expected float64
+		currency string
+	}{
+		{name: "empty"},
+		{name: "hourly", list: []*pricing.Pricing{entry(2, 0.5, "hour")}, expected: 730, currency: "USD"},
+		{name: "monthly", list: []*pricing.Pricing{entry(1, 100, "month")}, expected: 100, currency: "USD"},
+		{name: "yearly", list: []*pricing.Pricing{entry(1, 1200, "year")}, expected: 100, currency: "USD"},
+		{
+			name:     "mixed periods",
+			list:     []*pricing.Pricing{entry(1, 1, "hour"), entry(3, 10, "month")},
+			expected: 760,
+			currency: "USD",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			total, currency := MonthlyPricingTotal(test.list)
+			assert.InDelta(t, test.expected, total, 0.001)
+			assert.Equal(t, test.currency, currency)
+		})
+	}
+}
+
+func TestUnitEstimatedPricingWarning(t *testing.T) {
+	warning := EstimatedPricingWarning(`subscription "example"`, []*pricing.Pricing{{
+		Quantity:      redis.Int(1),
+		PricePerUnit:  redis.Float64(0.1),
+		PriceCurrency: redis.String("USD"),
+		PricePeriod:   redis.String("hour"),
+	}})
+	assert.Equal(t, diag.Warning, warning.Severity)
+	assert.Equal(t, `Estimated cost of subscription "example": 73.00 USD a month`, warning.Summary)
+	assert.Contains(t, warning.Detail, "The entries of `estimated_pricing` total 73.00 USD a month")
+
+	unavailable := EstimatedPricingUnavailableWarning(`subscription "example"`, errors.New("503"))
+	assert.Equal(t, diag.Warning, unavailable.Severity)
+	assert.Equal(t, `Couldn't estimate the cost of subscription "example"`, unavailable.Summary)
+	assert.Contains(t, unavailable.Detail, "503")
+}
+
+func TestUnitMaxMonthlyCost(t *testing.T) {
+	tests := []struct {
+		name            string
+		providerMax     float64
+		subscriptionMax float64
+		expected        float64
+		setOn           string
+	}{
+		{name: "unset"},
+		{name: "provider", providerMax: 1000, expected: 1000, setOn: "the provider"},
+		{name: "subscription", subscriptionMax: 500, expected: 500, setOn: "the subscription"},
+		{name: "lower subscription", providerMax: 1000, subscriptionMax: 500, expected: 500, setOn: "the subscription"},
+		{name: "lower provider", providerMax: 200, subscriptionMax: 500, expected: 200, setOn: "the provider"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ceiling, setOn := MaxMonthlyCost(test.providerMax, test.subscriptionMax)
+			assert.Equal(t, test.expected, ceiling)
+			assert.Equal(t, test.setOn, setOn)
+		})
+	}
+}
+
+func TestUnitCheckMaxMonthlyCost(t *testing.T) {
+	// 10 shards at 0.2 an hour cost 1460 a month.
+	list := []*pricing.Pricing{{
+		DatabaseName:  redis.String("cache"),
+		Type:          redis.String("Shards"),
+		Quantity:      redis.Int(10),
+		PricePerUnit:  redis.Float64(0.2),
+		PriceCurrency: redis.String("USD"),
+		PricePeriod:   redis.String("hour"),
+	}}
+
+	tests := []struct {
+		name            string
+		providerMax     float64
+		subscriptionMax float64
+		err             []string
+	}{
+		{name: "no ceiling"},
+		{name: "under the ceiling", providerMax: 2000},
+		{
+			name:        "over the provider's ceiling",
+			providerMax: 1000,
+			err: []string{
+				`subscription "example" would cost 1460.00 USD a month, over the ` + "`max_monthly_cost`" + ` of 1000.00 set on the provider`,
+				`database_name: "cache", type: "Shards", quantity: 10, price_per_unit: 0.2 USD per hour (1460.00 a month)`,
+			},
+		},
+		{
+			name:            "over the subscription's ceiling",
+			providerMax:     2000,
+			subscriptionMax: 100,
+			err:             []string{"of 100.00 set on the subscription"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := CheckMaxMonthlyCost(`subscription "example"`, list, test.providerMax, test.subscriptionMax)
+			if len(test.err) == 0 {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			for _, expected := range test.err {
+				assert.Contains(t, err.Error(), expected)
+			}
+		})
+	}
+}
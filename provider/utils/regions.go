@@ -0,0 +1,137 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/RedisLabs/rediscloud-go-api/service/account"
+
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/client"
+)
+
+// maxRegionSuggestions is the number of close matches suggested for an unknown region.
+const maxRegionSuggestions = 3
+
+// CheckRegions checks the regions a subscription is planned in against the provider's regions available to the
+// account, returning an error for each region which isn't one of them. Nothing is checked if the regions can't be
+// listed, as the API still rejects an unknown region when the subscription is created.
+func CheckRegions(ctx context.Context, api *client.ApiClient, provider string, regions []string) []error {
+	if api == nil || len(regions) == 0 {
+		return nil
+	}
+
+	available, err := api.Regions(ctx)
+	if err != nil {
+		log.Printf("[WARN] Couldn't list the regions to check %s: %s", strings.Join(regions, ", "), err)
+		return nil
+	}
+
+	var errs []error
+	for _, region := range regions {
+		if err := CheckRegion(available, provider, region); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+// CheckRegion returns an error if the region isn't one of the provider's regions, as listed by the account. The
+// error suggests the provider's regions closest to the name, or names the provider of a region of another provider.
+func CheckRegion(regions []*account.Region, provider string, region string) error {
+	var names []string
+	var otherProvider string
+	for _, r := range regions {
+		name := redis.StringValue(r.Name)
+		if redis.StringValue(r.Provider) != provider {
+			if name == region {
+				otherProvider = redis.StringValue(r.Provider)
+			}
+			continue
+		}
+		if name == region {
+			return nil
+		}
+		names = append(names, name)
+	}
+
+	switch {
+	case len(names) == 0:
+		return fmt.Errorf("no %s regions are available to the account", provider)
+	case otherProvider != "":
+		return fmt.Errorf("region %q is on %s, not %s", region, otherProvider, provider)
+	}
+
+	if matches := closeMatches(names, region); len(matches) > 0 {
+		return fmt.Errorf("region %q isn't available on %s. Did you mean %s?", region, provider, joinQuoted(matches, " or "))
+	}
+	return fmt.Errorf("region %q isn't available on %s. The available regions are listed by the rediscloud_regions data source", region, provider)
+}
+
+// closeMatches returns the names within a few edits of the name, ignoring case, closest first.
+func closeMatches(names []string, name string) []string {
+	maxDistance := len(name) / 3
+	if maxDistance < 2 {
+		maxDistance = 2
+	}
+
+	distances := make(map[string]int)
+	var matches []string
+	for _, candidate := range names {
+		distance := editDistance(strings.ToLower(candidate), strings.ToLower(name))
+		if distance <= maxDistance {
+			distances[candidate] = distance
+			matches = append(matches, candidate)
+		}
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		if distances[matches[i]] != distances[matches[j]] {
+			return distances[matches[i]] < distances[matches[j]]
+		}
+		return matches[i] < matches[j]
+	})
+	if len(matches) > maxRegionSuggestions {
+		matches = matches[:maxRegionSuggestions]
+	}
+	return matches
+}
+
+// editDistance returns the Levenshtein distance between two strings: the number of single character insertions,
+// deletions and substitutions turning one into the other.
+func editDistance(a string, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	previous := make([]int, len(rb)+1)
+	current := make([]int, len(rb)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		current[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(rb)]
+}
+
+// joinQuoted quotes the values and joins them, with the separator before the last.
+func joinQuoted(values []string, last string) string {
+	quoted := make([]string, len(values))
+	for i, value := range values {
+		quoted[i] = fmt.Sprintf("%q", value)
+	}
+	if len(quoted) == 1 {
+		return quoted[0]
+	}
+	return strings.Join(quoted[:len(quoted)-1], ", ") + last + quoted[len(quoted)-1]
+}
@@ -0,0 +1,114 @@
+package utils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/RedisLabs/rediscloud-go-api/service/account"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/client"
+)
+
+func TestUnitCheckRegion(t *testing.T) {
+	regions := []*account.Region{
+		{Name: redis.String("us-east-1"), Provider: redis.String("AWS")},
+		{Name: redis.String("us-east-2"), Provider: redis.String("AWS")},
+		{Name: redis.String("eu-west-1"), Provider: redis.String("AWS")},
+		{Name: redis.String("us-central1"), Provider: redis.String("GCP")},
+		{Name: redis.String("europe-west1"), Provider: redis.String("GCP")},
+	}
+
+	tests := []struct {
+		name     string
+		provider string
+		region   string
+		err      string
+	}{
+		{name: "valid", provider: "AWS", region: "us-east-1"},
+		{name: "valid on GCP", provider: "GCP", region: "europe-west1"},
+		{
+			name:     "missing dash",
+			provider: "AWS",
+			region:   "us-east1",
+			err:      `region "us-east1" isn't available on AWS. Did you mean "us-east-1" or "us-east-2"?`,
+		},
+		{
+			name:     "wrong case",
+			provider: "AWS",
+			region:   "EU-WEST-1",
+			err:      `region "EU-WEST-1" isn't available on AWS. Did you mean "eu-west-1"?`,
+		},
+		{
+			name:     "region of another provider",
+			provider: "AWS",
+			region:   "us-central1",
+			err:      `region "us-central1" is on GCP, not AWS`,
+		},
+		{
+			name:     "no close match",
+			provider: "GCP",
+			region:   "mars-north1",
+			err:      `region "mars-north1" isn't available on GCP. The available regions are listed by the rediscloud_regions data source`,
+		},
+		{
+			name:     "provider without regions",
+			provider: "Azure",
+			region:   "eastus",
+			err:      "no Azure regions are available to the account",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := CheckRegion(regions, test.provider, test.region)
+			if test.err == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, test.err)
+		})
+	}
+}
+
+func TestUnitCheckRegions(t *testing.T) {
+	_, api, transport := newWaiterTestClient(t, client.FaultConfig{})
+
+	errs := CheckRegions(context.Background(), api, "AWS", []string{"us-east-1", "us-west2", "asia-east1"})
+	require.Len(t, errs, 2)
+	assert.ErrorContains(t, errs[0], `Did you mean "us-west-2"?`)
+	assert.ErrorContains(t, errs[1], `region "asia-east1" is on GCP, not AWS`)
+
+	// The regions are listed once, so the API failing afterwards doesn't matter.
+	transport.SetFaults(client.FaultConfig{ServerErrorRate: 1})
+	errs = CheckRegions(context.Background(), api, "GCP", []string{"us-central"})
+	require.Len(t, errs, 1)
+	assert.ErrorContains(t, errs[0], `Did you mean "us-central1"?`)
+
+	// Regions which can't be listed aren't checked.
+	_, failing, _ := newWaiterTestClient(t, client.FaultConfig{ServerErrorRate: 1})
+	assert.Empty(t, CheckRegions(context.Background(), failing, "AWS", []string{"us-east1"}))
+}
+
+func TestUnitEditDistance(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected int
+	}{
+		{a: "", b: "", expected: 0},
+		{a: "us-east-1", b: "us-east-1", expected: 0},
+		{a: "us-east1", b: "us-east-1", expected: 1},
+		{a: "eu-west-1", b: "eu-west-2", expected: 1},
+		{a: "", b: "abc", expected: 3},
+		{a: "kitten", b: "sitting", expected: 3},
+	}
+
+	for _, test := range tests {
+		t.Run(test.a+"/"+test.b, func(t *testing.T) {
+			assert.Equal(t, test.expected, editDistance(test.a, test.b))
+			assert.Equal(t, test.expected, editDistance(test.b, test.a))
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// CreationPendingKey is the attribute marking a resource whose create was interrupted after Redis Cloud accepted it,
+// but before it finished provisioning. The resource is kept in state with this marker, so that the next apply resumes
+// waiting for it instead of creating another.
+const CreationPendingKey = "creation_pending"
+
+// CreationPendingDescription describes the CreationPendingKey attribute.
+const CreationPendingDescription = "Whether the apply which created this resource was interrupted, or timed out, before the resource finished provisioning. The next apply resumes waiting for it."
+
+// CreationPendingSchema is the schema of the CreationPendingKey attribute.
+func CreationPendingSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: CreationPendingDescription,
+		Type:        schema.TypeBool,
+		Computed:    true,
+	}
+}
+
+// CreateInterrupted reports whether err ended the wait for a new resource because the apply was interrupted or the
+// create timed out, rather than because the resource failed to provision.
+func CreateInterrupted(ctx context.Context, err error) bool {
+	return err != nil && ctx.Err() != nil
+}
+
+// CreationPendingDiagnostics converts an error waiting for a new resource into diagnostics. If the create was
+// interrupted, the resource is marked as pending and a warning returned instead of an error, so that it stays in
+// state untainted, and the next apply resumes waiting for it. The resource's ID must already be set.
+func CreationPendingDiagnostics(ctx context.Context, d *schema.ResourceData, description string, err error) diag.Diagnostics {
+	if !CreateInterrupted(ctx, err) {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(CreationPendingKey, true); err != nil {
+		return diag.FromErr(err)
+	}
+	return diag.Diagnostics{CreationPendingWarning(description, err)}
+}
+
+// CreationPendingWarning is the warning for a create interrupted while waiting for the resource to provision.
+func CreationPendingWarning(description string, err error) diag.Diagnostic {
+	return diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("%s is still being created", description),
+		Detail: fmt.Sprintf("The apply was interrupted before %s finished provisioning (%s). It has been saved to state "+
+			"as pending, and the next apply will resume waiting for it instead of creating another.", description, err),
+	}
+}
+
+// ResumeCreateDiff plans an update for a resource whose create was interrupted, clearing the CreationPendingKey
+// marker, so that the update can resume the create. It is meant to be called from CustomizeDiff.
+func ResumeCreateDiff(diff *schema.ResourceDiff) error {
+	if diff.Id() == "" || !diff.Get(CreationPendingKey).(bool) {
+		return nil
+	}
+	return diff.SetNew(CreationPendingKey, false)
+}
+
+// ResumeCreate calls resume if an update was planned by ResumeCreateDiff, to resume a create which was interrupted.
+// The resource stays marked as pending until resume succeeds, so that a resume which is interrupted or fails in turn
+// is retried by the next apply.
+func ResumeCreate(d *schema.ResourceData, resume func() diag.Diagnostics) diag.Diagnostics {
+	if pending, _ := d.GetChange(CreationPendingKey); !pending.(bool) {
+		return nil
+	}
+	if err := d.Set(CreationPendingKey, true); err != nil {
+		return diag.FromErr(err)
+	}
+	if diags := resume(); diags != nil {
+		return diags
+	}
+	return diag.FromErr(d.Set(CreationPendingKey, false))
+}
@@ -0,0 +1,129 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newResumeTestResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name":             {Type: schema.TypeString, Optional: true},
+			CreationPendingKey: CreationPendingSchema(),
+		},
+		CustomizeDiff: func(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+			return ResumeCreateDiff(diff)
+		},
+	}
+}
+
+func TestUnitCreationPendingDiagnostics(t *testing.T) {
+	tests := []struct {
+		name     string
+		cancel   bool
+		severity diag.Severity
+		summary  string
+		pending  bool
+	}{
+		{name: "interrupted", cancel: true, severity: diag.Warning, summary: "Subscription 1 is still being created", pending: true},
+		{name: "failed", severity: diag.Error, summary: "subscription 1 reached terminal state: error"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			err := errors.New("subscription 1 reached terminal state: error")
+			if test.cancel {
+				cancel()
+				err = ctx.Err()
+			}
+
+			d := newResumeTestResource().TestResourceData()
+			d.SetId("1")
+			diags := CreationPendingDiagnostics(ctx, d, "Subscription 1", err)
+			require.Len(t, diags, 1)
+			assert.Equal(t, test.severity, diags[0].Severity)
+			assert.Equal(t, test.summary, diags[0].Summary)
+			assert.Equal(t, test.pending, d.Get(CreationPendingKey))
+		})
+	}
+}
+
+func TestUnitResumeCreate(t *testing.T) {
+	tests := []struct {
+		name      string
+		pending   bool
+		resumeErr error
+		resumed   bool
+		planned   bool
+		expected  bool
+	}{
+		{name: "not pending", pending: false},
+		{name: "pending", pending: true, planned: true, resumed: true, expected: false},
+		{name: "resume fails", pending: true, planned: true, resumed: true, resumeErr: errors.New("boom"), expected: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := newResumeTestResource()
+			state := &terraform.InstanceState{
+				ID: "1",
+				Attributes: map[string]string{
+					"id":               "1",
+					CreationPendingKey: "false",
+				},
+			}
+			if test.pending {
+				state.Attributes[CreationPendingKey] = "true"
+			}
+
+			diff, err := r.SimpleDiff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{}), nil)
+			require.NoError(t, err)
+			assert.Equal(t, test.planned, diff != nil && diff.Attributes[CreationPendingKey] != nil)
+
+			d, err := schema.InternalMap(r.SchemaMap()).Data(state, diff)
+			require.NoError(t, err)
+
+			resumed := false
+			diags := ResumeCreate(d, func() diag.Diagnostics {
+				resumed = true
+				assert.True(t, d.Get(CreationPendingKey).(bool), "still pending while resuming")
+				return diag.FromErr(test.resumeErr)
+			})
+			assert.Equal(t, test.resumed, resumed)
+			assert.Equal(t, test.resumeErr != nil, diags.HasError())
+			assert.Equal(t, test.expected, d.Get(CreationPendingKey))
+		})
+	}
+}
+
+func TestUnitNullUnknownValues(t *testing.T) {
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"id":       tftypes.String,
+		"endpoint": tftypes.String,
+		"tags":     tftypes.Map{ElementType: tftypes.String},
+	}}
+	state := tfsdk.State{Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+		"id":       tftypes.NewValue(tftypes.String, "1/2"),
+		"endpoint": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"tags":     tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, tftypes.UnknownValue),
+	})}
+
+	require.NoError(t, NullUnknownValues(&state))
+	assert.True(t, state.Raw.IsFullyKnown())
+	assert.Equal(t, tftypes.NewValue(objectType, map[string]tftypes.Value{
+		"id":       tftypes.NewValue(tftypes.String, "1/2"),
+		"endpoint": tftypes.NewValue(tftypes.String, nil),
+		"tags":     tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+	}), state.Raw)
+}
@@ -0,0 +1,94 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/client"
+)
+
+// TaskError is returned when a Redis Cloud task started by an API call fails, carrying the reason the API gave.
+type TaskError struct {
+	Task client.Task
+	Err  error
+}
+
+func (e *TaskError) Error() string {
+	if e.Task.ErrorDescription != "" {
+		return e.Task.ErrorDescription
+	}
+	return e.Err.Error()
+}
+
+func (e *TaskError) Unwrap() error {
+	return e.Err
+}
+
+// WaitForTask makes an API call which starts a Redis Cloud task, such as an update or delete, waiting until the task
+// is processing-completed or processing-error. A failed task is returned as a *TaskError. The API client waits for the
+// task itself; if the call returns without the task having been seen to complete, for example because the client
+// wasn't created by NewTransport, fallback is used to wait for the change by polling status instead.
+func WaitForTask(ctx context.Context, description string, call func(ctx context.Context) error, fallback func(ctx context.Context) error) error {
+	taskCtx, tasks := client.TrackTasks(ctx)
+	err := call(taskCtx)
+
+	if task := tasks.Last(); task != nil {
+		log.Printf("[DEBUG] Task %s to %s finished in state %s", task.ID, description, task.Status)
+		if task.Failed() || err != nil {
+			if err == nil {
+				err = fmt.Errorf("task %s failed %s - %s", task.ID, task.Status, task.Description)
+			}
+			return &TaskError{Task: *task, Err: err}
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	if tasks.Completed() || fallback == nil {
+		return nil
+	}
+
+	log.Printf("[DEBUG] The task to %s wasn't seen to complete, polling status instead", description)
+	return fallback(ctx)
+}
+
+// SubscriptionFallback is a WaitForTask fallback, waiting for a subscription to be active again.
+func SubscriptionFallback(subId int, api *client.ApiClient) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		return WaitForSubscriptionToBeActive(ctx, subId, api)
+	}
+}
+
+// DatabaseFallback is a WaitForTask fallback, waiting for a database and then its subscription to be active again.
+func DatabaseFallback(subId int, dbId int, api *client.ApiClient) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		if err := WaitForDatabaseToBeActive(ctx, subId, dbId, api); err != nil {
+			return err
+		}
+		return WaitForSubscriptionToBeActive(ctx, subId, api)
+	}
+}
+
+// TaskDiagnostics converts an error into diagnostics, summarising a failed task with the reason the API gave for it.
+func TaskDiagnostics(err error) diag.Diagnostics {
+	var taskErr *TaskError
+	if !errors.As(err, &taskErr) {
+		return diag.FromErr(err)
+	}
+
+	task := taskErr.Task
+	summary := task.ErrorDescription
+	if summary == "" {
+		summary = fmt.Sprintf("Redis Cloud task %s failed", task.ID)
+	}
+	return diag.Diagnostics{{
+		Severity: diag.Error,
+		Summary:  summary,
+		Detail:   fmt.Sprintf("Redis Cloud task %s (%s) ended in state %s: %s", task.ID, task.CommandType, task.Status, taskErr.Err),
+	}}
+}
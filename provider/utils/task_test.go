@@ -0,0 +1,101 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	rediscloudApi "github.com/RedisLabs/rediscloud-go-api"
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/RedisLabs/rediscloud-go-api/service/access_control_lists/redis_rules"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/client"
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/fakeapi"
+)
+
+func TestUnitWaitForTask(t *testing.T) {
+	tests := []struct {
+		name        string
+		rule        string
+		tracked     bool
+		fallbackErr error
+		fallback    bool
+		err         string
+		taskErr     string
+	}{
+		{name: "completed", rule: "task-completed", tracked: true},
+		{name: "failed", rule: "Full-Access", tracked: true, err: "redis rule Full-Access already exists", taskErr: "ACL_REDIS_RULE_NAME_ALREADY_EXISTS"},
+		{name: "untracked falls back to polling", rule: "task-untracked", fallback: true},
+		{name: "fallback fails", rule: "task-fallback", fallback: true, fallbackErr: errors.New("still pending"), err: "still pending"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fake := fakeapi.New(fakeapi.Options{})
+			t.Cleanup(fake.Close)
+
+			transport := http.DefaultTransport
+			if test.tracked {
+				transport = client.NewTaskTransport(transport)
+			}
+			api, err := fake.Client(rediscloudApi.Transporter(transport))
+			require.NoError(t, err)
+
+			fallbackCalled := false
+			err = WaitForTask(context.Background(), "create redis rule", func(ctx context.Context) error {
+				_, err := api.RedisRules.Create(ctx, redis_rules.CreateRedisRuleRequest{
+					Name:      redis.String(test.rule),
+					RedisRule: redis.String("+@read"),
+				})
+				return err
+			}, func(ctx context.Context) error {
+				fallbackCalled = true
+				return test.fallbackErr
+			})
+
+			assert.Equal(t, test.fallback, fallbackCalled)
+			if test.err == "" {
+				require.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, test.err)
+
+			var taskErr *TaskError
+			if test.taskErr == "" {
+				assert.False(t, errors.As(err, &taskErr))
+				return
+			}
+			require.ErrorAs(t, err, &taskErr)
+			assert.Equal(t, client.TaskStatusError, taskErr.Task.Status)
+			assert.Equal(t, "aclRedisRuleCreateRequest", taskErr.Task.CommandType)
+			assert.ErrorContains(t, taskErr.Unwrap(), test.taskErr)
+		})
+	}
+}
+
+func TestUnitTaskDiagnostics(t *testing.T) {
+	taskErr := &TaskError{
+		Task: client.Task{
+			ID:               "task-1",
+			CommandType:      "subscriptionUpdateRequest",
+			Status:           client.TaskStatusError,
+			ErrorDescription: "Subscription is not active",
+		},
+		Err: errors.New("task task-1 failed processing-error - Task request failed during processing"),
+	}
+
+	assert.Equal(t, diag.Diagnostics{{
+		Severity: diag.Error,
+		Summary:  "Subscription is not active",
+		Detail:   "Redis Cloud task task-1 (subscriptionUpdateRequest) ended in state processing-error: task task-1 failed processing-error - Task request failed during processing",
+	}}, TaskDiagnostics(taskErr))
+
+	taskErr.Task.ErrorDescription = ""
+	assert.Equal(t, "Redis Cloud task task-1 failed", TaskDiagnostics(taskErr)[0].Summary)
+
+	assert.Equal(t, diag.FromErr(errors.New("boom")), TaskDiagnostics(errors.New("boom")))
+}
@@ -1,8 +1,9 @@
-package activeactive
+package utils
 
 import (
 	"context"
 	"fmt"
+	"net"
 	"regexp"
 	"time"
 
@@ -150,3 +151,35 @@ func (v portRangeValidator) ValidateInt64(ctx context.Context, req validator.Int
 func PortRangeValidator() validator.Int64 {
 	return portRangeValidator{min: 10000, max: 19999}
 }
+
+// cidrValidator validates that a string is a CIDR block, like the SDK's validation.IsCIDR.
+type cidrValidator struct{}
+
+var _ validator.String = cidrValidator{}
+
+// CIDRValidator returns a validator checking that a string is a CIDR block.
+func CIDRValidator() validator.String {
+	return cidrValidator{}
+}
+
+func (v cidrValidator) Description(_ context.Context) string {
+	return "value must be a CIDR block"
+}
+
+func (v cidrValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v cidrValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, _, err := net.ParseCIDR(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid CIDR block",
+			fmt.Sprintf("expected %s to be a valid IPv4 Value, got %v: %v", req.Path, req.ConfigValue.ValueString(), err),
+		)
+	}
+}
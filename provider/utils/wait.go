@@ -2,6 +2,7 @@ package utils
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"time"
@@ -11,18 +12,31 @@ import (
 
 	"github.com/RedisLabs/rediscloud-go-api/redis"
 	"github.com/RedisLabs/rediscloud-go-api/service/subscriptions"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 
 	"github.com/RedisLabs/terraform-provider-rediscloud/provider/client"
 )
 
+// WaitIntervalScale scales the delay and poll interval of every waiter, and the pauses between dependent API calls.
+// Tests running against a fake API or replaying cassettes reduce it, so that waiters poll in milliseconds rather than
+// seconds.
+var WaitIntervalScale = 1.0
+
+// WaitInterval scales a waiter's delay or poll interval, or a pause, by WaitIntervalScale.
+func WaitInterval(d time.Duration) time.Duration {
+	scaled := time.Duration(float64(d) * WaitIntervalScale)
+	if scaled < time.Millisecond {
+		// A zero poll interval would make the waiter fall back to its own backoff.
+		return time.Millisecond
+	}
+	return scaled
+}
+
 func WaitForSubscriptionToBeActive(ctx context.Context, id int, api *client.ApiClient) error {
-	wait := &retry.StateChangeConf{
-		Pending:      []string{subscriptions.SubscriptionStatusPending},
-		Target:       []string{subscriptions.SubscriptionStatusActive},
-		Timeout:      SafetyTimeout,
-		Delay:        10 * time.Second,
-		PollInterval: 30 * time.Second,
+	wait := &Waiter{
+		Description: fmt.Sprintf("subscription %d", id),
+		Pending:     []string{subscriptions.SubscriptionStatusPending},
+		Target:      []string{subscriptions.SubscriptionStatusActive},
+		Terminal:    []string{subscriptions.SubscriptionStatusError},
 
 		Refresh: func() (result interface{}, state string, err error) {
 			log.Printf("[DEBUG] Waiting for subscription %d to be %s", id, subscriptions.SubscriptionStatusActive)
@@ -35,7 +49,7 @@ func WaitForSubscriptionToBeActive(ctx context.Context, id int, api *client.ApiC
 			return redis.StringValue(subscription.Status), redis.StringValue(subscription.Status), nil
 		},
 	}
-	if _, err := wait.WaitForStateContext(ctx); err != nil {
+	if _, err := wait.Wait(ctx); err != nil {
 		return err
 	}
 
@@ -45,12 +59,14 @@ func WaitForSubscriptionToBeActive(ctx context.Context, id int, api *client.ApiC
 // WaitForSubscriptionPublicEndpointAccess waits for the subscription's public_endpoint_access
 // property to match the expected value. This handles API eventual consistency after updates.
 func WaitForSubscriptionPublicEndpointAccess(ctx context.Context, id int, api *client.ApiClient, expected bool) error {
-	wait := &retry.StateChangeConf{
-		Pending:      []string{"waiting"},
-		Target:       []string{"matched"},
-		Timeout:      30 * time.Second,
-		Delay:        2 * time.Second,
-		PollInterval: 5 * time.Second,
+	wait := &Waiter{
+		Description:     fmt.Sprintf("subscription %d public_endpoint_access", id),
+		Pending:         []string{"waiting"},
+		Target:          []string{"matched"},
+		Timeout:         30 * time.Second,
+		Delay:           2 * time.Second,
+		PollInterval:    5 * time.Second,
+		MaxPollInterval: 5 * time.Second,
 
 		Refresh: func() (result interface{}, state string, err error) {
 			log.Printf("[DEBUG] Waiting for subscription %d public_endpoint_access to be %t", id, expected)
@@ -68,7 +84,7 @@ func WaitForSubscriptionPublicEndpointAccess(ctx context.Context, id int, api *c
 			return subscription, "waiting", nil
 		},
 	}
-	if _, err := wait.WaitForStateContext(ctx); err != nil {
+	if _, err := wait.Wait(ctx); err != nil {
 		return err
 	}
 
@@ -76,7 +92,8 @@ func WaitForSubscriptionPublicEndpointAccess(ctx context.Context, id int, api *c
 }
 
 func WaitForDatabaseToBeActive(ctx context.Context, subId, id int, api *client.ApiClient) error {
-	wait := &retry.StateChangeConf{
+	wait := &Waiter{
+		Description: fmt.Sprintf("database %d in subscription %d", id, subId),
 		Pending: []string{
 			databases.StatusDraft,
 			databases.StatusPending,
@@ -92,10 +109,8 @@ func WaitForDatabaseToBeActive(ctx context.Context, subId, id int, api *client.A
 			"bdb-update-pending", // Database update in progress.
 			// TODO replace with api model string in next release
 		},
-		Target:       []string{databases.StatusActive},
-		Timeout:      SafetyTimeout,
-		Delay:        10 * time.Second,
-		PollInterval: 30 * time.Second,
+		Target:   []string{databases.StatusActive},
+		Terminal: []string{databases.StatusError},
 
 		Refresh: func() (result interface{}, state string, err error) {
 			log.Printf("[DEBUG] Waiting for database %d to be active", id)
@@ -108,7 +123,39 @@ func WaitForDatabaseToBeActive(ctx context.Context, subId, id int, api *client.A
 			return redis.StringValue(database.Status), redis.StringValue(database.Status), nil
 		},
 	}
-	if _, err := wait.WaitForStateContext(ctx); err != nil {
+	if _, err := wait.Wait(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// WaitForDatabaseToBeDeleted waits for the database to be deleted, once it is no longer found.
+func WaitForDatabaseToBeDeleted(ctx context.Context, subId, dbId int, api *client.ApiClient) error {
+	wait := &Waiter{
+		Description:  fmt.Sprintf("database %d in subscription %d", dbId, subId),
+		Delay:        30 * time.Second,
+		Pending:      []string{"pending"},
+		Target:       []string{"deleted"},
+		Timeout:      10 * time.Minute,
+		PollInterval: 30 * time.Second,
+
+		Refresh: func() (result interface{}, state string, err error) {
+			log.Printf("[DEBUG] Waiting for database %d to be deleted", dbId)
+
+			_, err = api.Client.Database.Get(ctx, subId, dbId)
+			if err != nil {
+				notFound := &databases.NotFound{}
+				if errors.As(err, &notFound) {
+					return "deleted", "deleted", nil
+				}
+				return nil, "", err
+			}
+
+			return "pending", "pending", nil
+		},
+	}
+	if _, err := wait.Wait(ctx); err != nil {
 		return err
 	}
 
@@ -118,12 +165,11 @@ func WaitForDatabaseToBeActive(ctx context.Context, subId, id int, api *client.A
 // WaitForActiveActiveTransitGatewayResourceToBeAvailable waits for Active-Active Transit Gateway API resources
 // to become available. This handles the case where Response.Resource is nil during initial subscription provisioning.
 func WaitForActiveActiveTransitGatewayResourceToBeAvailable(ctx context.Context, subId int, regionId int, api *client.ApiClient) (*attachments.GetAttachmentsTask, error) {
-	wait := &retry.StateChangeConf{
-		Pending:      []string{"provisioning"},
-		Target:       []string{"available"},
-		Timeout:      TransitGatewayProvisioningTimeout,
-		Delay:        10 * time.Second,
-		PollInterval: 30 * time.Second,
+	wait := &Waiter{
+		Description: fmt.Sprintf("Active-Active Transit Gateway resource for subscription %d, region %d", subId, regionId),
+		Pending:     []string{"provisioning"},
+		Target:      []string{"available"},
+		Timeout:     TransitGatewayProvisioningTimeout,
 
 		Refresh: func() (result interface{}, state string, err error) {
 			log.Printf("[DEBUG] Waiting for Active-Active Transit Gateway resource to be available for subscription %d, region %d", subId, regionId)
@@ -142,7 +188,7 @@ func WaitForActiveActiveTransitGatewayResourceToBeAvailable(ctx context.Context,
 		},
 	}
 
-	result, err := wait.WaitForStateContext(ctx)
+	result, err := wait.Wait(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("timeout waiting for Active-Active Transit Gateway resource to become available for subscription %d, region %d. "+
 			"This may indicate the subscription is still provisioning or there's an issue with the subscription setup. "+
@@ -173,10 +219,11 @@ var transitGatewayAttachmentPendingStates = []string{
 	"rollingBack",
 }
 
-var transitGatewayAttachmentTerminalStates = map[string]bool{
-	"failed":   true,
-	"rejected": true,
-	"deleted":  true,
+// transitGatewayAttachmentTerminalStates are states from which the attachment will never become available.
+var transitGatewayAttachmentTerminalStates = []string{
+	"failed",
+	"rejected",
+	"deleted",
 }
 
 // WaitForTransitGatewayAttachmentToBeAvailable waits for a Pro Transit Gateway attachment to reach
@@ -188,12 +235,12 @@ func WaitForTransitGatewayAttachmentToBeAvailable(
 	tgwId int,
 	api *client.ApiClient,
 ) (*attachments.TransitGatewayAttachment, error) {
-	wait := &retry.StateChangeConf{
-		Pending:      transitGatewayAttachmentPendingStates,
-		Target:       []string{TransitGatewayAttachmentStatusAvailable},
-		Timeout:      TransitGatewayProvisioningTimeout,
-		Delay:        10 * time.Second,
-		PollInterval: 30 * time.Second,
+	wait := &Waiter{
+		Description: "transit gateway attachment",
+		Pending:     transitGatewayAttachmentPendingStates,
+		Target:      []string{TransitGatewayAttachmentStatusAvailable},
+		Terminal:    transitGatewayAttachmentTerminalStates,
+		Timeout:     TransitGatewayProvisioningTimeout,
 
 		Refresh: func() (result interface{}, state string, err error) {
 			log.Printf("[DEBUG] Waiting for Transit Gateway attachment to be available for subscription %d, tgw %d", subId, tgwId)
@@ -213,11 +260,6 @@ func WaitForTransitGatewayAttachmentToBeAvailable(
 				if redis.IntValue(tgw.Id) == tgwId {
 					status := redis.StringValue(tgw.Status)
 					log.Printf("[DEBUG] Transit Gateway attachment status: %s", status)
-
-					if transitGatewayAttachmentTerminalStates[status] {
-						return nil, status, fmt.Errorf("transit gateway attachment reached terminal state: %s", status)
-					}
-
 					return tgw, status, nil
 				}
 			}
@@ -227,7 +269,7 @@ func WaitForTransitGatewayAttachmentToBeAvailable(
 		},
 	}
 
-	result, err := wait.WaitForStateContext(ctx)
+	result, err := wait.Wait(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("timeout waiting for Transit Gateway attachment to become available for subscription %d, tgw %d. "+
 			"Original error: %w", subId, tgwId, err)
@@ -251,12 +293,12 @@ func WaitForActiveActiveTransitGatewayAttachmentToBeAvailable(
 	tgwId int,
 	api *client.ApiClient,
 ) (*attachments.TransitGatewayAttachment, error) {
-	wait := &retry.StateChangeConf{
-		Pending:      transitGatewayAttachmentPendingStates,
-		Target:       []string{TransitGatewayAttachmentStatusAvailable},
-		Timeout:      TransitGatewayProvisioningTimeout,
-		Delay:        10 * time.Second,
-		PollInterval: 30 * time.Second,
+	wait := &Waiter{
+		Description: "Active-Active Transit Gateway attachment",
+		Pending:     transitGatewayAttachmentPendingStates,
+		Target:      []string{TransitGatewayAttachmentStatusAvailable},
+		Terminal:    transitGatewayAttachmentTerminalStates,
+		Timeout:     TransitGatewayProvisioningTimeout,
 
 		Refresh: func() (result interface{}, state string, err error) {
 			log.Printf("[DEBUG] Waiting for Active-Active Transit Gateway attachment to be available for subscription %d, region %d, tgw %d", subId, regionId, tgwId)
@@ -276,11 +318,6 @@ func WaitForActiveActiveTransitGatewayAttachmentToBeAvailable(
 				if redis.IntValue(tgw.Id) == tgwId {
 					status := redis.StringValue(tgw.Status)
 					log.Printf("[DEBUG] Active-Active Transit Gateway attachment status: %s", status)
-
-					if transitGatewayAttachmentTerminalStates[status] {
-						return nil, status, fmt.Errorf("Active-Active Transit Gateway attachment reached terminal state: %s", status)
-					}
-
 					return tgw, status, nil
 				}
 			}
@@ -290,7 +327,7 @@ func WaitForActiveActiveTransitGatewayAttachmentToBeAvailable(
 		},
 	}
 
-	result, err := wait.WaitForStateContext(ctx)
+	result, err := wait.Wait(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("timeout waiting for Active-Active Transit Gateway attachment to become available for subscription %d, region %d, tgw %d. "+
 			"Original error: %w", subId, regionId, tgwId, err)
@@ -305,12 +342,11 @@ func WaitForActiveActiveTransitGatewayAttachmentToBeAvailable(
 }
 
 func WaitForSubscriptionToBeEncryptionKeyPending(ctx context.Context, id int, api *client.ApiClient) error {
-	wait := &retry.StateChangeConf{
-		Pending:      []string{subscriptions.SubscriptionStatusPending},
-		Target:       []string{subscriptions.SubscriptionStatusEncryptionKeyPending, subscriptions.SubscriptionStatusActive},
-		Timeout:      SafetyTimeout,
-		Delay:        10 * time.Second,
-		PollInterval: 30 * time.Second,
+	wait := &Waiter{
+		Description: fmt.Sprintf("subscription %d", id),
+		Pending:     []string{subscriptions.SubscriptionStatusPending},
+		Target:      []string{subscriptions.SubscriptionStatusEncryptionKeyPending, subscriptions.SubscriptionStatusActive},
+		Terminal:    []string{subscriptions.SubscriptionStatusError},
 
 		Refresh: func() (result interface{}, state string, err error) {
 			log.Printf("[DEBUG] Waiting for subscription %d to be %s", id, subscriptions.SubscriptionStatusEncryptionKeyPending)
@@ -323,7 +359,7 @@ func WaitForSubscriptionToBeEncryptionKeyPending(ctx context.Context, id int, ap
 			return redis.StringValue(subscription.Status), redis.StringValue(subscription.Status), nil
 		},
 	}
-	if _, err := wait.WaitForStateContext(ctx); err != nil {
+	if _, err := wait.Wait(ctx); err != nil {
 		return err
 	}
 
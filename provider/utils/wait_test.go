@@ -0,0 +1,250 @@
+package utils
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	rediscloudApi "github.com/RedisLabs/rediscloud-go-api"
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/RedisLabs/rediscloud-go-api/service/databases"
+	"github.com/RedisLabs/rediscloud-go-api/service/subscriptions"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/client"
+	"github.com/RedisLabs/terraform-provider-rediscloud/provider/fakeapi"
+)
+
+// newWaiterTestClient returns a client for a fake API, through a transport injecting the given faults. Waiters poll
+// every few milliseconds for the duration of the test.
+func newWaiterTestClient(t *testing.T, faults client.FaultConfig) (*fakeapi.Server, *client.ApiClient, *client.FaultTransport) {
+	t.Helper()
+
+	scaleWaitIntervals(t, 0.0001)
+
+	fake := fakeapi.New(fakeapi.Options{ProvisioningPolls: 2})
+	t.Cleanup(fake.Close)
+
+	transport := client.NewFaultTransport(faults, http.DefaultTransport)
+	api, err := fake.Client(rediscloudApi.Transporter(transport))
+	require.NoError(t, err)
+	return fake, &client.ApiClient{Client: api}, transport
+}
+
+// scaleWaitIntervals scales every wait by the given factor, until the end of the test.
+func scaleWaitIntervals(t *testing.T, scale float64) {
+	t.Helper()
+	previous := WaitIntervalScale
+	WaitIntervalScale = scale
+	t.Cleanup(func() { WaitIntervalScale = previous })
+}
+
+func createWaiterTestSubscription(t *testing.T, api *client.ApiClient, create subscriptions.CreateSubscription) int {
+	t.Helper()
+	create.Name = redis.String("waiter")
+	create.PaymentMethodID = redis.Int(fakeapi.PaymentMethodId)
+	if create.CloudProviders == nil {
+		create.CloudProviders = []*subscriptions.CreateCloudProvider{{
+			Provider: redis.String("AWS"),
+			Regions: []*subscriptions.CreateRegion{{
+				Region:     redis.String("us-east-1"),
+				Networking: &subscriptions.CreateNetworking{DeploymentCIDR: redis.String("10.0.0.0/24")},
+			}},
+		}}
+	}
+	id, err := api.Client.Subscription.Create(context.Background(), create)
+	require.NoError(t, err)
+	return id
+}
+
+func TestUnitWaitForSubscriptionToBeActive(t *testing.T) {
+	tests := []struct {
+		name   string
+		faults client.FaultConfig
+		fault  string
+		err    string
+	}{
+		{name: "no faults"},
+		{name: "reordered transitions", faults: client.FaultConfig{ReorderRate: 1, Limit: 1}, fault: "reorder"},
+		{name: "throttled", faults: client.FaultConfig{ThrottleRate: 1, Limit: 1}, fault: "throttle"},
+		{name: "server error", faults: client.FaultConfig{ServerErrorRate: 1, Limit: 1}, fault: "server_error", err: "503"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, api, transport := newWaiterTestClient(t, client.FaultConfig{})
+			id := createWaiterTestSubscription(t, api, subscriptions.CreateSubscription{})
+
+			// Faults are only injected into the wait, not the create.
+			transport.SetFaults(test.faults)
+
+			err := WaitForSubscriptionToBeActive(context.Background(), id, api)
+			if test.err != "" {
+				assert.ErrorContains(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+			if test.fault != "" {
+				assert.Equal(t, 1, transport.Injected(test.fault))
+			}
+		})
+	}
+}
+
+func TestUnitWaitForSubscriptionToBeEncryptionKeyPending(t *testing.T) {
+	_, api, _ := newWaiterTestClient(t, client.FaultConfig{})
+	id := createWaiterTestSubscription(t, api, subscriptions.CreateSubscription{
+		PersistentStorageEncryptionType: redis.String("customer-managed-key"),
+	})
+
+	require.NoError(t, WaitForSubscriptionToBeEncryptionKeyPending(context.Background(), id, api))
+
+	subscription, err := api.Client.Subscription.Get(context.Background(), id)
+	require.NoError(t, err)
+	assert.Equal(t, subscriptions.SubscriptionStatusEncryptionKeyPending, redis.StringValue(subscription.Status))
+}
+
+func TestUnitWaitForSubscriptionPublicEndpointAccess(t *testing.T) {
+	_, api, _ := newWaiterTestClient(t, client.FaultConfig{})
+	id := createWaiterTestSubscription(t, api, subscriptions.CreateSubscription{})
+
+	require.NoError(t, WaitForSubscriptionPublicEndpointAccess(context.Background(), id, api, true))
+}
+
+func TestUnitWaitForDatabaseToBeActive(t *testing.T) {
+	_, api, transport := newWaiterTestClient(t, client.FaultConfig{})
+	subId := createWaiterTestSubscription(t, api, subscriptions.CreateSubscription{})
+	dbId, err := api.Client.Database.Create(context.Background(), subId, databases.CreateDatabase{
+		Name:            redis.String("db"),
+		DatasetSizeInGB: redis.Float64(1),
+	})
+	require.NoError(t, err)
+
+	transport.SetFaults(client.FaultConfig{ReorderRate: 1, Limit: 1})
+	require.NoError(t, WaitForDatabaseToBeActive(context.Background(), subId, dbId, api))
+	assert.Equal(t, 1, transport.Injected("reorder"))
+
+	_, err = api.Client.Database.Get(context.Background(), subId, dbId+1000)
+	require.Error(t, err)
+	assert.Error(t, WaitForDatabaseToBeActive(context.Background(), subId, dbId+1000, api), "a missing database fails the wait")
+}
+
+func createActiveActiveWaiterTestSubscription(t *testing.T, api *client.ApiClient) (int, int) {
+	t.Helper()
+	id := createWaiterTestSubscription(t, api, subscriptions.CreateSubscription{
+		DeploymentType: redis.String(subscriptions.SubscriptionDeploymentTypeActiveActive),
+		CloudProviders: []*subscriptions.CreateCloudProvider{{
+			Provider: redis.String("AWS"),
+			Regions: []*subscriptions.CreateRegion{
+				{Region: redis.String("us-east-1"), Networking: &subscriptions.CreateNetworking{DeploymentCIDR: redis.String("10.0.0.0/24")}},
+				{Region: redis.String("eu-west-1"), Networking: &subscriptions.CreateNetworking{DeploymentCIDR: redis.String("10.0.1.0/24")}},
+			},
+		}},
+	})
+	regions, err := api.Client.Subscription.ListActiveActiveRegions(context.Background(), id)
+	require.NoError(t, err)
+	require.Len(t, regions, 2)
+	return id, redis.IntValue(regions[1].RegionId)
+}
+
+func TestUnitWaitForActiveActiveTransitGatewayResourceToBeAvailable(t *testing.T) {
+	fake, api, transport := newWaiterTestClient(t, client.FaultConfig{})
+	subId, regionId := createActiveActiveWaiterTestSubscription(t, api)
+	tgwId, err := fake.AddTransitGateway(subId, regionId)
+	require.NoError(t, err)
+
+	// The Transit Gateway API reads its task again once complete, which is when the resource goes missing.
+	transport.SetFaults(client.FaultConfig{EmptyTaskRate: 1, Limit: 3})
+
+	task, err := WaitForActiveActiveTransitGatewayResourceToBeAvailable(context.Background(), subId, regionId, api)
+	require.NoError(t, err)
+	assert.Equal(t, 3, transport.Injected("empty_task"))
+	require.Len(t, task.Response.Resource.TransitGatewayAttachment, 1)
+	assert.Equal(t, tgwId, redis.IntValue(task.Response.Resource.TransitGatewayAttachment[0].Id))
+}
+
+func TestUnitWaitForTransitGatewayAttachmentToBeAvailable(t *testing.T) {
+	tests := []struct {
+		name   string
+		faults client.FaultConfig
+		status string
+		err    string
+	}{
+		{name: "available"},
+		{name: "empty task responses", faults: client.FaultConfig{EmptyTaskRate: 1, Limit: 2}},
+		{name: "reordered transitions", faults: client.FaultConfig{ReorderRate: 1, Limit: 1}},
+		{name: "failed", status: "failed", err: "transit gateway attachment reached terminal state: failed"},
+		{name: "rejected", status: "rejected", err: "transit gateway attachment reached terminal state: rejected"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fake, api, transport := newWaiterTestClient(t, client.FaultConfig{})
+			subId := createWaiterTestSubscription(t, api, subscriptions.CreateSubscription{})
+			tgwId, err := fake.AddTransitGateway(subId, 0)
+			require.NoError(t, err)
+			_, err = api.Client.TransitGatewayAttachments.Create(context.Background(), subId, tgwId)
+			require.NoError(t, err)
+			if test.status != "" {
+				require.NoError(t, fake.SetTransitGatewayStatus(subId, 0, tgwId, test.status))
+			}
+
+			transport.SetFaults(test.faults)
+
+			tgw, err := WaitForTransitGatewayAttachmentToBeAvailable(context.Background(), subId, tgwId, api)
+			if test.err != "" {
+				assert.ErrorContains(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, TransitGatewayAttachmentStatusAvailable, redis.StringValue(tgw.Status))
+		})
+	}
+}
+
+func TestUnitWaitForTransitGatewayAttachmentToBeAvailableUnknownAttachment(t *testing.T) {
+	_, api, _ := newWaiterTestClient(t, client.FaultConfig{})
+	subId := createWaiterTestSubscription(t, api, subscriptions.CreateSubscription{})
+
+	// An attachment which never appears is treated as still pending, until the context gives up.
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	_, err := WaitForTransitGatewayAttachmentToBeAvailable(ctx, subId, 12345, api)
+	assert.Error(t, err)
+}
+
+func TestUnitWaitForActiveActiveTransitGatewayAttachmentToBeAvailable(t *testing.T) {
+	tests := []struct {
+		name   string
+		status string
+		err    string
+	}{
+		{name: "available"},
+		{name: "failed", status: "failed", err: "Active-Active Transit Gateway attachment reached terminal state: failed"},
+		{name: "rejected", status: "rejected", err: "Active-Active Transit Gateway attachment reached terminal state: rejected"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fake, api, _ := newWaiterTestClient(t, client.FaultConfig{})
+			subId, regionId := createActiveActiveWaiterTestSubscription(t, api)
+			tgwId, err := fake.AddTransitGateway(subId, regionId)
+			require.NoError(t, err)
+			_, err = api.Client.TransitGatewayAttachments.CreateActiveActive(context.Background(), subId, regionId, tgwId)
+			require.NoError(t, err)
+			if test.status != "" {
+				require.NoError(t, fake.SetTransitGatewayStatus(subId, regionId, tgwId, test.status))
+			}
+
+			tgw, err := WaitForActiveActiveTransitGatewayAttachmentToBeAvailable(context.Background(), subId, regionId, tgwId, api)
+			if test.err != "" {
+				assert.ErrorContains(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, TransitGatewayAttachmentStatusAvailable, redis.StringValue(tgw.Status))
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+const (
+	// DefaultWaitDelay is how long a Waiter waits before its first poll, unless it sets its own Delay.
+	DefaultWaitDelay = 10 * time.Second
+	// DefaultPollInterval is how long a Waiter waits between its first polls, unless it sets its own PollInterval.
+	DefaultPollInterval = 10 * time.Second
+	// DefaultMaxPollInterval is the longest a Waiter waits between polls, unless it sets its own MaxPollInterval.
+	DefaultMaxPollInterval = 30 * time.Second
+	// DefaultProgressInterval is how often a Waiter logs that it is still waiting, unless it sets its own
+	// ProgressInterval.
+	DefaultProgressInterval = 5 * time.Minute
+)
+
+// Waiter polls a Refresh function until the object it reads reaches one of the Target states. Polls back off
+// exponentially from PollInterval up to MaxPollInterval, and every interval is scaled by WaitIntervalScale.
+type Waiter struct {
+	// Description names the object being waited on, in log messages and errors, such as "subscription 123".
+	Description string
+
+	// Refresh reads the object being waited on, returning it and its current state.
+	Refresh retry.StateRefreshFunc
+
+	// Pending are the states in which to keep polling.
+	Pending []string
+	// Target are the states which end the wait successfully.
+	Target []string
+	// Terminal are the states which end the wait with an error, as the object will never reach a Target state.
+	// Any other state which is neither Pending nor Target also ends the wait with an error.
+	Terminal []string
+
+	// NotFound reports whether an error from Refresh means that the object doesn't exist yet, in which case it is
+	// treated as still being provisioned rather than ending the wait.
+	NotFound func(err error) bool
+
+	// Timeout is the longest to wait, defaulting to SafetyTimeout.
+	Timeout time.Duration
+	// Delay is how long to wait before the first poll, defaulting to DefaultWaitDelay.
+	Delay time.Duration
+	// PollInterval is how long to wait after the first poll, doubling after each poll, defaulting to
+	// DefaultPollInterval.
+	PollInterval time.Duration
+	// MaxPollInterval caps the time between polls, defaulting to DefaultMaxPollInterval, or to PollInterval if that
+	// is longer.
+	MaxPollInterval time.Duration
+	// ProgressInterval is how often to log that the wait is still going on, defaulting to DefaultProgressInterval.
+	ProgressInterval time.Duration
+}
+
+// Wait polls until the object reaches a Target state, returning the last result of Refresh. The wait fails if the
+// object reaches any other state which isn't Pending, if Refresh fails, or if the timeout or context expire.
+func (w *Waiter) Wait(ctx context.Context) (interface{}, error) {
+	timeout := valueOrDefault(w.Timeout, SafetyTimeout)
+	pollInterval := WaitInterval(valueOrDefault(w.PollInterval, DefaultPollInterval))
+	maxPollInterval := WaitInterval(valueOrDefault(w.MaxPollInterval, DefaultMaxPollInterval))
+	if maxPollInterval < pollInterval {
+		maxPollInterval = pollInterval
+	}
+	progressInterval := valueOrDefault(w.ProgressInterval, DefaultProgressInterval)
+
+	start := time.Now()
+	deadline := start.Add(timeout)
+	nextProgress := start.Add(progressInterval)
+	wait := WaitInterval(valueOrDefault(w.Delay, DefaultWaitDelay))
+
+	var lastState string
+	var lastErr error
+	for {
+		timer := time.NewTimer(min(wait, time.Until(deadline)))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, fmt.Errorf("stopped waiting for %s in state %q: %w", w.Description, lastState, ctx.Err())
+		case <-timer.C:
+		}
+		if !time.Now().Before(deadline) {
+			return nil, &retry.TimeoutError{
+				LastError:     lastErr,
+				LastState:     lastState,
+				Timeout:       timeout,
+				ExpectedState: w.Target,
+			}
+		}
+
+		result, state, err := w.Refresh()
+		switch {
+		case err != nil && w.NotFound != nil && w.NotFound(err):
+			log.Printf("[DEBUG] %s not found yet, treating it as still being provisioned", w.Description)
+			lastErr = err
+			state = lastState
+		case err != nil:
+			return result, err
+		case slices.Contains(w.Target, state):
+			log.Printf("[DEBUG] %s reached state %q after %s", w.Description, state, time.Since(start).Round(time.Second))
+			return result, nil
+		case slices.Contains(w.Terminal, state):
+			return result, fmt.Errorf("%s reached terminal state: %s", w.Description, state)
+		case !slices.Contains(w.Pending, state):
+			return result, &retry.UnexpectedStateError{
+				LastError:     lastErr,
+				State:         state,
+				ExpectedState: w.Target,
+			}
+		default:
+			lastErr = nil
+		}
+		lastState = state
+
+		if now := time.Now(); !now.Before(nextProgress) {
+			log.Printf("[INFO] Still waiting for %s after %s, currently in state %q", w.Description, now.Sub(start).Round(time.Second), state)
+			nextProgress = now.Add(progressInterval)
+		}
+
+		wait = pollInterval
+		pollInterval = min(pollInterval*2, maxPollInterval)
+	}
+}
+
+func valueOrDefault(value time.Duration, defaultValue time.Duration) time.Duration {
+	if value <= 0 {
+		return defaultValue
+	}
+	return value
+}
@@ -0,0 +1,154 @@
+package utils
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errWaiterTestNotFound = errors.New("not found")
+
+// refreshStates returns a refresh function reporting each of the given states in turn, then the last one forever.
+// An empty state stands for the object not being found.
+func refreshStates(states ...string) (retry.StateRefreshFunc, *int) {
+	polls := 0
+	return func() (interface{}, string, error) {
+		state := states[min(polls, len(states)-1)]
+		polls++
+		if state == "" {
+			return nil, "", errWaiterTestNotFound
+		}
+		return "result-" + state, state, nil
+	}, &polls
+}
+
+func newTestWaiter(refresh retry.StateRefreshFunc) *Waiter {
+	return &Waiter{
+		Description:  "widget 1",
+		Refresh:      refresh,
+		Pending:      []string{"pending"},
+		Target:       []string{"active"},
+		Terminal:     []string{"failed"},
+		Timeout:      time.Second,
+		Delay:        time.Millisecond,
+		PollInterval: time.Millisecond,
+	}
+}
+
+func TestUnitWaiter(t *testing.T) {
+	tests := []struct {
+		name     string
+		states   []string
+		notFound bool
+		polls    int
+		err      string
+	}{
+		{name: "target", states: []string{"pending", "pending", "active"}, polls: 3},
+		{name: "immediately at target", states: []string{"active"}, polls: 1},
+		{name: "terminal", states: []string{"pending", "failed"}, polls: 2, err: "widget 1 reached terminal state: failed"},
+		{name: "unexpected", states: []string{"pending", "exploded"}, polls: 2, err: "unexpected state 'exploded', wanted target 'active'"},
+		{name: "not found is still provisioning", states: []string{"", "", "pending", "active"}, notFound: true, polls: 4},
+		{name: "not found is an error", states: []string{"", "active"}, polls: 1, err: "not found"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			refresh, polls := refreshStates(test.states...)
+			waiter := newTestWaiter(refresh)
+			if test.notFound {
+				waiter.NotFound = func(err error) bool { return errors.Is(err, errWaiterTestNotFound) }
+			}
+
+			result, err := waiter.Wait(context.Background())
+			assert.Equal(t, test.polls, *polls)
+			if test.err != "" {
+				assert.ErrorContains(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "result-active", result)
+		})
+	}
+}
+
+func TestUnitWaiterTimeout(t *testing.T) {
+	refresh, _ := refreshStates("", "pending")
+	waiter := newTestWaiter(refresh)
+	waiter.Timeout = 50 * time.Millisecond
+	waiter.NotFound = func(err error) bool { return errors.Is(err, errWaiterTestNotFound) }
+
+	_, err := waiter.Wait(context.Background())
+	var timeout *retry.TimeoutError
+	require.ErrorAs(t, err, &timeout)
+	assert.Equal(t, "pending", timeout.LastState)
+	assert.Equal(t, []string{"active"}, timeout.ExpectedState)
+}
+
+func TestUnitWaiterCancelled(t *testing.T) {
+	refresh, _ := refreshStates("pending")
+	waiter := newTestWaiter(refresh)
+	waiter.Timeout = time.Minute
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err := waiter.Wait(ctx)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.ErrorContains(t, err, `stopped waiting for widget 1 in state "pending"`)
+}
+
+func TestUnitWaiterBackoff(t *testing.T) {
+	var polls []time.Time
+	refresh, _ := refreshStates("pending", "pending", "pending", "pending", "active")
+	waiter := newTestWaiter(func() (interface{}, string, error) {
+		polls = append(polls, time.Now())
+		return refresh()
+	})
+	waiter.PollInterval = 20 * time.Millisecond
+	waiter.MaxPollInterval = 40 * time.Millisecond
+
+	_, err := waiter.Wait(context.Background())
+	require.NoError(t, err)
+	require.Len(t, polls, 5)
+
+	// The interval doubles after every poll, up to the maximum.
+	for i, expected := range []time.Duration{20, 40, 40, 40} {
+		assert.GreaterOrEqual(t, polls[i+1].Sub(polls[i]), expected*time.Millisecond, "poll %d", i+1)
+	}
+}
+
+func TestUnitWaiterScaled(t *testing.T) {
+	scaleWaitIntervals(t, 0.0001)
+
+	refresh, _ := refreshStates("pending", "active")
+	waiter := newTestWaiter(refresh)
+	waiter.Delay = time.Minute
+	waiter.PollInterval = time.Minute
+
+	start := time.Now()
+	_, err := waiter.Wait(context.Background())
+	require.NoError(t, err)
+	assert.Less(t, time.Since(start), time.Second)
+}
+
+func TestUnitWaiterProgress(t *testing.T) {
+	var output bytes.Buffer
+	log.SetOutput(&output)
+	t.Cleanup(func() { log.SetOutput(os.Stderr) })
+
+	refresh, _ := refreshStates("pending", "pending", "active")
+	waiter := newTestWaiter(refresh)
+	waiter.ProgressInterval = time.Nanosecond
+
+	_, err := waiter.Wait(context.Background())
+	require.NoError(t, err)
+	assert.Contains(t, output.String(), `[INFO] Still waiting for widget 1 after`)
+	assert.Contains(t, output.String(), `currently in state "pending"`)
+}